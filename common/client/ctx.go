@@ -6,6 +6,7 @@ type multiNodeContextKey int
 
 const (
 	contextKeyHeathCheckRequest multiNodeContextKey = iota + 1
+	contextKeyReadOpts
 )
 
 func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
@@ -15,3 +16,15 @@ func CtxAddHealthCheckFlag(ctx context.Context) context.Context {
 func CtxIsHeathCheckRequest(ctx context.Context) bool {
 	return ctx.Value(contextKeyHeathCheckRequest) != nil
 }
+
+// CtxWithReadOpts marks the requests made with ctx as critical reads, to be made as defined by opts. Unset fields of
+// opts default to the configuration of the chain.
+func CtxWithReadOpts(ctx context.Context, opts ReadOpts) context.Context {
+	return context.WithValue(ctx, contextKeyReadOpts, opts)
+}
+
+// CtxReadOpts returns the ReadOpts set with CtxWithReadOpts, if any.
+func CtxReadOpts(ctx context.Context) (opts ReadOpts, ok bool) {
+	opts, ok = ctx.Value(contextKeyReadOpts).(ReadOpts)
+	return
+}
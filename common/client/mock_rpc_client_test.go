@@ -211,6 +211,51 @@ func (_c *mockRPCClient_GetInterceptedChainInfo_Call[CHAIN_ID, HEAD]) RunAndRetu
 	return _c
 }
 
+// GetRequestStats provides a mock function with given fields:
+func (_m *mockRPCClient[CHAIN_ID, HEAD]) GetRequestStats() RequestStats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRequestStats")
+	}
+
+	var r0 RequestStats
+	if rf, ok := ret.Get(0).(func() RequestStats); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(RequestStats)
+	}
+
+	return r0
+}
+
+// mockRPCClient_GetRequestStats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRequestStats'
+type mockRPCClient_GetRequestStats_Call[CHAIN_ID types.ID, HEAD Head] struct {
+	*mock.Call
+}
+
+// GetRequestStats is a helper method to define mock.On call
+func (_e *mockRPCClient_Expecter[CHAIN_ID, HEAD]) GetRequestStats() *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD] {
+	return &mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD]{Call: _e.mock.On("GetRequestStats")}
+}
+
+func (_c *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD]) Run(run func()) *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD] {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD]) Return(_a0 RequestStats) *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD] {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD]) RunAndReturn(run func() RequestStats) *mockRPCClient_GetRequestStats_Call[CHAIN_ID, HEAD] {
+	_c.Call.Return(run)
+	return _c
+}
+
 // IsSyncing provides a mock function with given fields: ctx
 func (_m *mockRPCClient[CHAIN_ID, HEAD]) IsSyncing(ctx context.Context) (bool, error) {
 	ret := _m.Called(ctx)
@@ -1,9 +1,12 @@
 package client
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"math"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
@@ -22,6 +25,21 @@ var (
 		Name: "multi_node_states",
 		Help: "The number of RPC nodes currently in the given state for the given chain",
 	}, []string{"network", "chainId", "state"})
+	// PromMultiNodeRPCNodeLatency reports the latency percentiles of recent requests of each RPC node
+	PromMultiNodeRPCNodeLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "multi_node_rpc_latency_seconds",
+		Help: "The given percentile of the latency of recent successful requests of the given RPC node",
+	}, []string{"network", "chainId", "nodeName", "percentile"})
+	// PromMultiNodeRPCNodeErrorRate reports the error rate of recent requests of each RPC node
+	PromMultiNodeRPCNodeErrorRate = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "multi_node_rpc_error_rate",
+		Help: "The share of recent requests of the given RPC node which failed",
+	}, []string{"network", "chainId", "nodeName"})
+	// PromMultiNodeRPCNodeScore reports the score of each RPC node, as used by the LatencyWeighted NodeSelector
+	PromMultiNodeRPCNodeScore = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "multi_node_rpc_score",
+		Help: "The score of the given RPC node, the expected latency of a request in milliseconds; lower is better",
+	}, []string{"network", "chainId", "nodeName"})
 	ErroringNodeError = fmt.Errorf("no live nodes available")
 )
 
@@ -128,13 +146,28 @@ func (c *MultiNode[CHAIN_ID, RPC]) DoAll(ctx context.Context, do func(ctx contex
 	})
 }
 
-func (c *MultiNode[CHAIN_ID, RPC]) NodeStates() map[string]string {
-	states := map[string]string{}
+// NodeState - the state of a node of the MultiNode, with the stats of its recent requests for primary nodes
+type NodeState struct {
+	State        string
+	RequestStats *RequestStats
+}
+
+// Score - returns the score of the node, see RequestStats.Score, or NaN for send-only nodes.
+func (s NodeState) Score() float64 {
+	if s.RequestStats == nil {
+		return math.NaN()
+	}
+	return s.RequestStats.Score()
+}
+
+func (c *MultiNode[CHAIN_ID, RPC]) NodeStates() map[string]NodeState {
+	states := map[string]NodeState{}
 	for _, n := range c.primaryNodes {
-		states[n.Name()] = n.State().String()
+		stats := n.RequestStats()
+		states[n.Name()] = NodeState{State: n.State().String(), RequestStats: &stats}
 	}
 	for _, n := range c.sendOnlyNodes {
-		states[n.Name()] = n.State().String()
+		states[n.Name()] = NodeState{State: n.State().String()}
 	}
 	return states
 }
@@ -226,6 +259,32 @@ func (c *MultiNode[CHAIN_ID, RPC]) selectNode() (node Node[CHAIN_ID, RPC], err e
 	return c.activeNode, err
 }
 
+// selectRPCs returns the RPCs of up to n alive nodes: the active node first, followed by the other alive nodes by
+// priority order.
+func (c *MultiNode[CHAIN_ID, RPC]) selectRPCs(n int) ([]RPC, error) {
+	active, err := c.selectNode()
+	if err != nil {
+		return nil, err
+	}
+	var others []Node[CHAIN_ID, RPC]
+	for _, node := range c.primaryNodes {
+		if node != active && node.State() == nodeStateAlive {
+			others = append(others, node)
+		}
+	}
+	slices.SortStableFunc(others, func(a, b Node[CHAIN_ID, RPC]) int {
+		return cmp.Compare(a.Order(), b.Order())
+	})
+	rpcs := []RPC{active.RPC()}
+	for _, node := range others {
+		if len(rpcs) >= n {
+			break
+		}
+		rpcs = append(rpcs, node.RPC())
+	}
+	return rpcs, nil
+}
+
 // LatestChainInfo - returns number of live nodes available in the pool, so we can prevent the last alive node in a pool from being marked as out-of-sync.
 // Return highest ChainInfo most recently received by the alive nodes.
 // E.g. If Node A's the most recent block is 10 and highest 15 and for Node B it's - 12 and 14. This method will return 12.
@@ -330,6 +389,7 @@ func (c *MultiNode[CHAIN_ID, RPC]) report(nodesStateInfo []nodeWithState) {
 	var dead int
 	counts := make(map[nodeState]int)
 	for i, n := range c.primaryNodes {
+		c.reportRequestStats(n)
 		state := n.State()
 		counts[state]++
 		nodesStateInfo[i].State = state.String()
@@ -362,3 +422,13 @@ func (c *MultiNode[CHAIN_ID, RPC]) report(nodesStateInfo []nodeWithState) {
 		c.lggr.Errorw(fmt.Sprintf("At least one primary node is dead: %d/%d nodes are alive", live, total), "nodeStates", nodesStateInfo)
 	}
 }
+
+func (c *MultiNode[CHAIN_ID, RPC]) reportRequestStats(n Node[CHAIN_ID, RPC]) {
+	stats := n.RequestStats()
+	chainID := c.chainID.String()
+	for percentile, latency := range map[string]time.Duration{"50": stats.LatencyP50, "90": stats.LatencyP90, "99": stats.LatencyP99} {
+		PromMultiNodeRPCNodeLatency.WithLabelValues(c.chainFamily, chainID, n.Name(), percentile).Set(latency.Seconds())
+	}
+	PromMultiNodeRPCNodeErrorRate.WithLabelValues(c.chainFamily, chainID, n.Name()).Set(stats.ErrorRate)
+	PromMultiNodeRPCNodeScore.WithLabelValues(c.chainFamily, chainID, n.Name()).Set(stats.Score())
+}
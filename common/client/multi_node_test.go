@@ -2,6 +2,7 @@ package client
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"math/rand"
 	"testing"
@@ -61,6 +62,8 @@ func newNodeWithState(t *testing.T, chainID types.ID, state nodeState) *mockNode
 	node.On("String").Return(fmt.Sprintf("healthy_node_%d", rand.Int())).Maybe()
 	node.On("SetPoolChainInfoProvider", mock.Anything).Once()
 	node.On("State").Return(state).Maybe()
+	node.On("Name").Return(fmt.Sprintf("healthy_node_%d", rand.Int())).Maybe()
+	node.On("RequestStats").Return(RequestStats{}).Maybe()
 	return node
 }
 
@@ -304,11 +307,13 @@ func TestMultiNode_CheckLease(t *testing.T) {
 			chainID:       chainID,
 		}
 
-		expectedResult := map[string]string{}
+		expectedResult := map[string]NodeState{}
 		for name, state := range nodes {
+			stats := RequestStats{Requests: 10, LatencyP90: time.Second}
 			node := newMockNode[types.ID, multiNodeRPCClient](t)
 			node.On("State").Return(state).Once()
 			node.On("Name").Return(name).Once()
+			node.On("RequestStats").Return(stats).Once()
 			opts.nodes = append(opts.nodes, node)
 
 			sendOnly := newMockSendOnlyNode[types.ID, multiNodeRPCClient](t)
@@ -317,13 +322,17 @@ func TestMultiNode_CheckLease(t *testing.T) {
 			sendOnly.On("Name").Return(sendOnlyName).Once()
 			opts.sendonlys = append(opts.sendonlys, sendOnly)
 
-			expectedResult[name] = state.String()
-			expectedResult[sendOnlyName] = state.String()
+			expectedResult[name] = NodeState{State: state.String(), RequestStats: &stats}
+			expectedResult[sendOnlyName] = NodeState{State: state.String()}
 		}
 
 		mn := newTestMultiNode(t, opts)
 		states := mn.NodeStates()
 		assert.Equal(t, expectedResult, states)
+		for name := range nodes {
+			assert.InDelta(t, 1000.0, states[name].Score(), 0.001)
+			assert.True(t, math.IsNaN(states["send_only_"+name].Score()))
+		}
 	})
 }
 
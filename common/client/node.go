@@ -68,6 +68,8 @@ type Node[
 	StateAndLatest() (nodeState, ChainInfo)
 	// HighestUserObservations - returns highest ChainInfo ever observed by underlying RPC excluding results of health check requests
 	HighestUserObservations() ChainInfo
+	// RequestStats - returns the latency and error rate of the most recent requests made by underlying RPC
+	RequestStats() RequestStats
 	SetPoolChainInfoProvider(PoolChainInfoProvider)
 	// Name is a unique identifier for this node.
 	Name() string
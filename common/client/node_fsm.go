@@ -176,6 +176,11 @@ func (n *node[CHAIN_ID, HEAD, RPC]) HighestUserObservations() ChainInfo {
 	_, highestUserObservations := n.rpc.GetInterceptedChainInfo()
 	return highestUserObservations
 }
+
+func (n *node[CHAIN_ID, HEAD, RPC]) RequestStats() RequestStats {
+	return n.rpc.GetRequestStats()
+}
+
 func (n *node[CHAIN_ID, HEAD, RPC]) SetPoolChainInfoProvider(poolInfoProvider PoolChainInfoProvider) {
 	n.poolInfoProvider = poolInfoProvider
 }
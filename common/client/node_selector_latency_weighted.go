@@ -0,0 +1,75 @@
+package client
+
+import (
+	"math"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+// latencyWeightedHysteresis is how much lower the score of another node must be, relative to the score of the
+// selected one, for the selector to switch to it
+const latencyWeightedHysteresis = 0.2
+
+type latencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+] struct {
+	nodes []Node[CHAIN_ID, RPC]
+
+	mu       sync.Mutex
+	selected Node[CHAIN_ID, RPC]
+}
+
+// NewLatencyWeightedNodeSelector returns a NodeSelector selecting the alive node with the best RequestStats.Score,
+// i.e. the lowest latency and error rate of recent requests. It sticks to the selected node until another one scores
+// latencyWeightedHysteresis better, to avoid flapping between nodes of similar performance. Nodes without enough
+// requests to be scored are only selected if no node is scored, by priority order.
+func NewLatencyWeightedNodeSelector[
+	CHAIN_ID types.ID,
+	RPC any,
+](nodes []Node[CHAIN_ID, RPC]) NodeSelector[CHAIN_ID, RPC] {
+	return &latencyWeightedNodeSelector[CHAIN_ID, RPC]{nodes: nodes}
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Select() Node[CHAIN_ID, RPC] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var liveNodes []Node[CHAIN_ID, RPC]
+	var best Node[CHAIN_ID, RPC]
+	bestScore, selectedScore := math.Inf(1), math.Inf(1)
+	selectedAlive := false
+	for _, n := range s.nodes {
+		if n.State() != nodeStateAlive {
+			continue
+		}
+		liveNodes = append(liveNodes, n)
+		score := n.RequestStats().Score()
+		if n == s.selected {
+			selectedAlive = true
+			selectedScore = score
+		}
+		if score < bestScore {
+			best = n
+			bestScore = score
+		}
+	}
+
+	switch {
+	case len(liveNodes) == 0:
+		s.selected = nil
+	case best == nil:
+		// no node is scored yet
+		if !selectedAlive {
+			s.selected = firstOrHighestPriority(liveNodes)
+		}
+	case !selectedAlive || bestScore < selectedScore*(1-latencyWeightedHysteresis):
+		s.selected = best
+	}
+	return s.selected
+}
+
+func (s *latencyWeightedNodeSelector[CHAIN_ID, RPC]) Name() string {
+	return NodeSelectionModeLatencyWeighted
+}
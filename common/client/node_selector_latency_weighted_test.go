@@ -0,0 +1,109 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+func TestLatencyWeightedNodeSelectorName(t *testing.T) {
+	selector := newNodeSelector[types.ID, RPCClient[types.ID, Head]](NodeSelectionModeLatencyWeighted, nil)
+	assert.Equal(t, selector.Name(), NodeSelectionModeLatencyWeighted)
+}
+
+func TestLatencyWeightedNodeSelector(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+
+	newNode := func(state nodeState, stats *RequestStats, order int32) *mockNode[types.ID, nodeClient] {
+		node := newMockNode[types.ID, nodeClient](t)
+		node.On("State").Return(state)
+		node.On("RequestStats").Return(func() RequestStats { return *stats }).Maybe()
+		node.On("Order").Return(order).Maybe()
+		return node
+	}
+	scored := func(p90 time.Duration, errorRate float64) *RequestStats {
+		return &RequestStats{Requests: requestStatsWindow, LatencyP90: p90, ErrorRate: errorRate}
+	}
+
+	t.Run("selects the best scored alive node", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateOutOfSync, scored(10*time.Millisecond, 0), 1),
+			newNode(nodeStateAlive, scored(100*time.Millisecond, 0), 1),
+			newNode(nodeStateAlive, scored(20*time.Millisecond, 0.5), 1),
+			newNode(nodeStateAlive, scored(50*time.Millisecond, 0), 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[3], selector.Select())
+	})
+
+	t.Run("selects by priority until nodes are scored", func(t *testing.T) {
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, &RequestStats{}, 2),
+			newNode(nodeStateAlive, &RequestStats{Requests: minScoredRequests - 1}, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[1], selector.Select())
+	})
+
+	t.Run("switches with hysteresis", func(t *testing.T) {
+		stats1, stats2 := scored(100*time.Millisecond, 0), scored(150*time.Millisecond, 0)
+		nodes := []Node[types.ID, nodeClient]{
+			newNode(nodeStateAlive, stats1, 1),
+			newNode(nodeStateAlive, stats2, 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+
+		// slightly better is not enough
+		*stats2 = *scored(90*time.Millisecond, 0)
+		assert.Same(t, nodes[0], selector.Select())
+
+		*stats2 = *scored(70*time.Millisecond, 0)
+		assert.Same(t, nodes[1], selector.Select())
+
+		// degraded by errors
+		*stats2 = *scored(70*time.Millisecond, 0.1)
+		assert.Same(t, nodes[0], selector.Select())
+	})
+
+	t.Run("switches away from a node which is not alive", func(t *testing.T) {
+		node1 := newMockNode[types.ID, nodeClient](t)
+		node1.On("State").Return(nodeStateAlive).Once()
+		node1.On("State").Return(nodeStateUnreachable)
+		node1.On("RequestStats").Return(*scored(10*time.Millisecond, 0))
+		nodes := []Node[types.ID, nodeClient]{
+			node1,
+			newNode(nodeStateAlive, scored(100*time.Millisecond, 0), 1),
+		}
+		selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+		assert.Same(t, nodes[0], selector.Select())
+		assert.Same(t, nodes[1], selector.Select())
+	})
+}
+
+func TestLatencyWeightedNodeSelector_None(t *testing.T) {
+	t.Parallel()
+
+	type nodeClient RPCClient[types.ID, Head]
+	var nodes []Node[types.ID, nodeClient]
+
+	for i := 0; i < 3; i++ {
+		node := newMockNode[types.ID, nodeClient](t)
+		if i == 0 {
+			// first node is out of sync
+			node.On("State").Return(nodeStateOutOfSync)
+		} else {
+			// others are unreachable
+			node.On("State").Return(nodeStateUnreachable)
+		}
+		nodes = append(nodes, node)
+	}
+
+	selector := newNodeSelector(NodeSelectionModeLatencyWeighted, nodes)
+	assert.Nil(t, selector.Select())
+}
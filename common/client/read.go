@@ -0,0 +1,285 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+const (
+	ReadModeSingle = "Single"
+	ReadModeHedged = "Hedged"
+	ReadModeQuorum = "Quorum"
+)
+
+var (
+	// PromMultiNodeReadMismatches reports reads on which nodes disagreed
+	PromMultiNodeReadMismatches = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "multi_node_read_mismatches",
+		Help: "The number of hedged or quorum reads on which the results of the nodes did not match",
+	}, []string{"network", "chainId", "method", "mode"})
+
+	ErrReadQuorumNotReached = errors.New("nodes did not agree on the result of the read")
+)
+
+// ReadOpts - defines how a read is spread across the nodes of the MultiNode, see DoRead
+type ReadOpts struct {
+	// Mode is one of ReadModeSingle, ReadModeHedged or ReadModeQuorum
+	Mode string
+	// HedgeDelay is how long a hedged read waits for the active node before also reading from the next best node
+	HedgeDelay time.Duration
+	// Quorum is the number of nodes which must agree on the result of a quorum read
+	Quorum int
+}
+
+// ValidateConfig - validates the ReadOpts resolved for a read.
+func (o ReadOpts) ValidateConfig() error {
+	switch o.Mode {
+	case ReadModeSingle:
+	case ReadModeHedged:
+		if o.HedgeDelay <= 0 {
+			return fmt.Errorf("HedgeDelay must be positive for %s reads, got %s", o.Mode, o.HedgeDelay)
+		}
+	case ReadModeQuorum:
+		if o.Quorum < 1 {
+			return fmt.Errorf("Quorum must be at least 1 for %s reads, got %d", o.Mode, o.Quorum)
+		}
+	default:
+		return fmt.Errorf("unsupported read mode: %q", o.Mode)
+	}
+	return nil
+}
+
+// Merge - returns o, with its unset fields set from defaults.
+func (o ReadOpts) Merge(defaults ReadOpts) ReadOpts {
+	if o.Mode == "" {
+		o.Mode = defaults.Mode
+	}
+	if o.HedgeDelay == 0 {
+		o.HedgeDelay = defaults.HedgeDelay
+	}
+	if o.Quorum == 0 {
+		o.Quorum = defaults.Quorum
+	}
+	return o
+}
+
+type readResult[T any] struct {
+	value T
+	err   error
+}
+
+// DoRead - reads from the RPCs of the MultiNode as defined by opts:
+//   - ReadModeSingle reads from the active node, as with SelectRPC.
+//   - ReadModeHedged reads from the active node, and also from the next best alive node if the active node fails or
+//     doesn't respond within HedgeDelay. The first successful result is returned.
+//   - ReadModeQuorum reads from Quorum alive nodes, and returns a result once Quorum nodes agree on it. Nodes which
+//     fail or disagree are made up for by reading from the next best alive nodes. If no Quorum of the alive nodes
+//     agrees, ErrReadQuorumNotReached is returned.
+//
+// Results are compared with equal, and disagreements between nodes are reported by reportReadMismatch. Reads
+// outliving the call are detached from the caller's cancellation, so that their results can still be compared.
+// method names the read in logs and metrics.
+func DoRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	c *MultiNode[CHAIN_ID, RPC],
+	opts ReadOpts,
+	method string,
+	read func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	if err = opts.ValidateConfig(); err != nil {
+		return result, err
+	}
+	if opts.Mode == ReadModeSingle {
+		var rpc RPC
+		rpc, err = c.SelectRPC()
+		if err != nil {
+			return result, err
+		}
+		return read(ctx, rpc)
+	}
+
+	err = c.eng.IfNotStopped(func() error {
+		n := 2
+		if opts.Mode == ReadModeQuorum {
+			// nodes beyond the quorum are only read from if others fail or disagree
+			n = len(c.primaryNodes)
+		}
+		rpcs, rerr := c.selectRPCs(n)
+		if rerr != nil {
+			return rerr
+		}
+		if opts.Mode == ReadModeQuorum {
+			if len(rpcs) < opts.Quorum {
+				return fmt.Errorf("quorum read requires %d alive nodes, only %d available", opts.Quorum, len(rpcs))
+			}
+			result, rerr = quorumRead(ctx, c, opts, method, rpcs, read, equal)
+			return rerr
+		}
+		result, rerr = hedgedRead(ctx, c, opts, method, rpcs, read, equal)
+		return rerr
+	})
+	return result, err
+}
+
+// startRead reads from rpc in a goroutine detached from the caller's cancellation, sending the result to results.
+func startRead[CHAIN_ID types.ID, RPC any, T any](ctx context.Context, c *MultiNode[CHAIN_ID, RPC], rpc RPC, read func(ctx context.Context, rpc RPC) (T, error), results chan<- readResult[T]) {
+	ctx = context.WithoutCancel(ctx)
+	c.eng.Go(func(context.Context) {
+		ctx, cancel := c.eng.StopChan.Ctx(ctx)
+		defer cancel()
+		value, err := read(ctx, rpc)
+		// results is buffered for all reads
+		results <- readResult[T]{value: value, err: err}
+	})
+}
+
+func hedgedRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	c *MultiNode[CHAIN_ID, RPC],
+	opts ReadOpts,
+	method string,
+	rpcs []RPC,
+	read func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	results := make(chan readResult[T], len(rpcs))
+	started := 0
+	startNext := func() {
+		if started < len(rpcs) {
+			startRead(ctx, c, rpcs[started], read, results)
+			started++
+		}
+	}
+	startNext()
+	hedge := time.NewTimer(opts.HedgeDelay)
+	defer hedge.Stop()
+
+	for received := 0; received < started; {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case <-hedge.C:
+			startNext()
+		case r := <-results:
+			received++
+			if r.err == nil {
+				reportPendingReads(c, method, opts.Mode, r.value, results, started-received, equal)
+				return r.value, nil
+			}
+			if err == nil {
+				err = r.err
+			}
+			// the active node failed, hedge right away
+			startNext()
+		}
+	}
+	return result, err
+}
+
+func quorumRead[CHAIN_ID types.ID, RPC any, T any](
+	ctx context.Context,
+	c *MultiNode[CHAIN_ID, RPC],
+	opts ReadOpts,
+	method string,
+	rpcs []RPC,
+	read func(ctx context.Context, rpc RPC) (T, error),
+	equal func(a, b T) bool,
+) (result T, err error) {
+	results := make(chan readResult[T], len(rpcs))
+	started := 0
+	for ; started < opts.Quorum; started++ {
+		startRead(ctx, c, rpcs[started], read, results)
+	}
+	var values []T
+	for received := 0; received < started; {
+		select {
+		case <-ctx.Done():
+			return result, ctx.Err()
+		case r := <-results:
+			received++
+			if r.err != nil {
+				if err == nil {
+					err = r.err
+				}
+			} else {
+				values = append(values, r.value)
+				if countEqual(values, r.value, equal) >= opts.Quorum {
+					if len(values) > opts.Quorum {
+						reportReadMismatch(c, method, opts.Mode, values)
+					}
+					reportPendingReads(c, method, opts.Mode, r.value, results, started-received, equal)
+					return r.value, nil
+				}
+			}
+			// read from more nodes while the reads in flight can't reach the quorum on their own
+			for started < len(rpcs) && maxEqual(values, equal)+started-received < opts.Quorum {
+				startRead(ctx, c, rpcs[started], read, results)
+				started++
+			}
+		}
+	}
+	if len(values) > 1 && maxEqual(values, equal) < len(values) {
+		reportReadMismatch(c, method, opts.Mode, values)
+	}
+	if err != nil {
+		return result, fmt.Errorf("%s: %w: %w", method, ErrReadQuorumNotReached, err)
+	}
+	return result, fmt.Errorf("%s: %w", method, ErrReadQuorumNotReached)
+}
+
+// countEqual returns the number of values equal to v.
+func countEqual[T any](values []T, v T, equal func(a, b T) bool) int {
+	n := 0
+	for _, value := range values {
+		if equal(value, v) {
+			n++
+		}
+	}
+	return n
+}
+
+// maxEqual returns the size of the largest group of equal values.
+func maxEqual[T any](values []T, equal func(a, b T) bool) int {
+	n := 0
+	for _, v := range values {
+		n = max(n, countEqual(values, v, equal))
+	}
+	return n
+}
+
+// reportPendingReads compares the results of the reads still in flight to the returned result, in the background.
+func reportPendingReads[CHAIN_ID types.ID, RPC any, T any](c *MultiNode[CHAIN_ID, RPC], method, mode string, result T, results <-chan readResult[T], pending int, equal func(a, b T) bool) {
+	if pending == 0 {
+		return
+	}
+	c.eng.Go(func(ctx context.Context) {
+		values := []T{result}
+		for i := 0; i < pending; i++ {
+			select {
+			case <-ctx.Done():
+				return
+			case r := <-results:
+				if r.err == nil {
+					values = append(values, r.value)
+				}
+			}
+		}
+		if slices.ContainsFunc(values[1:], func(v T) bool { return !equal(result, v) }) {
+			reportReadMismatch(c, method, mode, values)
+		}
+	})
+}
+
+func reportReadMismatch[CHAIN_ID types.ID, RPC any, T any](c *MultiNode[CHAIN_ID, RPC], method, mode string, values []T) {
+	c.lggr.Warnw("observed mismatching results of read", "method", method, "mode", mode, "results", values)
+	PromMultiNodeReadMismatches.WithLabelValues(c.chainFamily, c.chainID.String(), method, mode).Inc()
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/common/types"
+)
+
+type testReadRPC struct {
+	delay time.Duration
+	value int
+	err   error
+}
+
+func readTestRPC(ctx context.Context, rpc *testReadRPC) (int, error) {
+	select {
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	case <-time.After(rpc.delay):
+		return rpc.value, rpc.err
+	}
+}
+
+func equalInts(a, b int) bool { return a == b }
+
+func newReadTestMultiNode(t *testing.T, rpcs ...*testReadRPC) *MultiNode[types.ID, *testReadRPC] {
+	chainID := types.RandomID()
+	var nodes []Node[types.ID, *testReadRPC]
+	for i, rpc := range rpcs {
+		node := newMockNode[types.ID, *testReadRPC](t)
+		node.On("ConfiguredChainID").Return(chainID).Once()
+		node.On("Start", mock.Anything).Return(nil).Once()
+		node.On("Close").Return(nil).Once()
+		node.On("SetPoolChainInfoProvider", mock.Anything).Once()
+		node.On("String").Return(fmt.Sprintf("node_%d", i)).Maybe()
+		node.On("Name").Return(fmt.Sprintf("node_%d", i)).Maybe()
+		node.On("State").Return(nodeStateAlive).Maybe()
+		node.On("RequestStats").Return(RequestStats{}).Maybe()
+		node.On("Order").Return(int32(i)).Maybe()
+		node.On("RPC").Return(rpc).Maybe()
+		nodes = append(nodes, node)
+	}
+	mn := NewMultiNode[types.ID, *testReadRPC](logger.Test(t), NodeSelectionModePriorityLevel, 0, nodes, nil, chainID, "test", 0)
+	servicetest.Run(t, mn)
+	return mn
+}
+
+func readMismatches(mn *MultiNode[types.ID, *testReadRPC], mode string) float64 {
+	return testutil.ToFloat64(PromMultiNodeReadMismatches.WithLabelValues("test", mn.chainID.String(), "read", mode))
+}
+
+func TestReadOpts_Merge(t *testing.T) {
+	t.Parallel()
+
+	defaults := ReadOpts{Mode: ReadModeHedged, HedgeDelay: time.Second, Quorum: 2}
+	assert.Equal(t, defaults, ReadOpts{}.Merge(defaults))
+	assert.Equal(t, ReadOpts{Mode: ReadModeQuorum, HedgeDelay: time.Second, Quorum: 3},
+		ReadOpts{Mode: ReadModeQuorum, Quorum: 3}.Merge(defaults))
+}
+
+func TestDoRead(t *testing.T) {
+	t.Parallel()
+
+	errRPC := errors.New("rpc failed")
+
+	t.Run("rejects invalid opts", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1})
+		_, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: "Fastest"}, "read", readTestRPC, equalInts)
+		require.ErrorContains(t, err, `unsupported read mode: "Fastest"`)
+		_, err = DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeHedged}, "read", readTestRPC, equalInts)
+		require.ErrorContains(t, err, "HedgeDelay must be positive")
+	})
+
+	t.Run("single reads from the active node", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{err: errRPC})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeSingle}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("hedged returns the active node's result within the delay", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{value: 2})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeHedged, HedgeDelay: time.Hour}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("hedged reads from the next node after the delay", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1, delay: 100 * time.Millisecond}, &testReadRPC{value: 2})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeHedged, HedgeDelay: time.Millisecond}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+		// the late result of the active node is still compared
+		tests.AssertEventually(t, func() bool { return readMismatches(mn, ReadModeHedged) == 1 })
+	})
+
+	t.Run("hedged reads from the next node when the active node fails", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{err: errRPC}, &testReadRPC{value: 2})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeHedged, HedgeDelay: time.Hour}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 2, result)
+	})
+
+	t.Run("hedged fails if all nodes fail", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{err: errRPC}, &testReadRPC{err: errors.New("other error"), delay: 10 * time.Millisecond})
+		_, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeHedged, HedgeDelay: time.Hour}, "read", readTestRPC, equalInts)
+		require.ErrorIs(t, err, errRPC)
+	})
+
+	t.Run("quorum returns the agreed result", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{value: 1, delay: 10 * time.Millisecond}, &testReadRPC{value: 2})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 2}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("quorum fails on mismatch", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{value: 2})
+		_, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 2}, "read", readTestRPC, equalInts)
+		require.ErrorIs(t, err, ErrReadQuorumNotReached)
+		assert.Equal(t, 1.0, readMismatches(mn, ReadModeQuorum))
+	})
+
+	t.Run("quorum reads from the next node on mismatch", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{value: 2}, &testReadRPC{value: 1})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 2}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.Equal(t, 1.0, readMismatches(mn, ReadModeQuorum))
+	})
+
+	t.Run("quorum tolerates failing nodes", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{err: errRPC}, &testReadRPC{value: 1})
+		result, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 2}, "read", readTestRPC, equalInts)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result)
+	})
+
+	t.Run("quorum fails if too many nodes fail", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{err: errRPC})
+		_, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 2}, "read", readTestRPC, equalInts)
+		require.ErrorIs(t, err, ErrReadQuorumNotReached)
+		require.ErrorIs(t, err, errRPC)
+	})
+
+	t.Run("quorum fails without enough alive nodes", func(t *testing.T) {
+		t.Parallel()
+		mn := newReadTestMultiNode(t, &testReadRPC{value: 1}, &testReadRPC{value: 1})
+		_, err := DoRead(tests.Context(t), mn, ReadOpts{Mode: ReadModeQuorum, Quorum: 3}, "read", readTestRPC, equalInts)
+		require.ErrorContains(t, err, "quorum read requires 3 alive nodes, only 2 available")
+	})
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"math"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	// requestStatsWindow is the number of most recent requests RequestStats are computed over
+	requestStatsWindow = 100
+	// minScoredRequests is the number of requests needed for RequestStats to be scored
+	minScoredRequests = 5
+	// requestErrorPenalty is the cost of a failed request in RequestStats.Score, as it has to be retried, possibly on
+	// another node
+	requestErrorPenalty = 5 * time.Second
+)
+
+// RequestStats - defines RPC's view on the latency and error rate of its most recent requests
+type RequestStats struct {
+	// Requests is the number of requests the stats are computed over
+	Requests int
+	// LatencyP50, LatencyP90 and LatencyP99 are percentiles of the latency of successful requests
+	LatencyP50 time.Duration
+	LatencyP90 time.Duration
+	LatencyP99 time.Duration
+	// ErrorRate is the share of failed requests, between 0 and 1
+	ErrorRate float64
+}
+
+// Score - returns the score of the RPC used by the LatencyWeighted NodeSelector, lower being better. It is the expected
+// latency of a request in milliseconds: the 90th percentile latency, plus requestErrorPenalty for the share of
+// failed requests. Returns +Inf if there are too few requests to tell.
+func (s RequestStats) Score() float64 {
+	if s.Requests < minScoredRequests {
+		return math.Inf(1)
+	}
+	p90 := float64(s.LatencyP90) / float64(time.Millisecond)
+	penalty := float64(requestErrorPenalty) / float64(time.Millisecond)
+	return p90 + s.ErrorRate*penalty
+}
+
+type requestResult struct {
+	latency time.Duration
+	failed  bool
+}
+
+// RequestStatsTracker keeps RequestStats over the last requestStatsWindow requests. It is safe for concurrent use.
+type RequestStatsTracker struct {
+	mu      sync.Mutex
+	results []requestResult
+	next    int
+}
+
+func NewRequestStatsTracker() *RequestStatsTracker {
+	return &RequestStatsTracker{results: make([]requestResult, 0, requestStatsWindow)}
+}
+
+// Observe records the outcome of a request. failed must only be set for failures of the RPC itself, such as
+// timeouts or transport errors, as opposed to errors returned for the request, such as reverts.
+func (t *RequestStatsTracker) Observe(latency time.Duration, failed bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	r := requestResult{latency: latency, failed: failed}
+	if len(t.results) < requestStatsWindow {
+		t.results = append(t.results, r)
+		return
+	}
+	t.results[t.next] = r
+	t.next = (t.next + 1) % requestStatsWindow
+}
+
+// Stats returns the RequestStats of the recorded requests.
+func (t *RequestStatsTracker) Stats() (stats RequestStats) {
+	t.mu.Lock()
+	latencies := make([]time.Duration, 0, len(t.results))
+	var failed int
+	for _, r := range t.results {
+		if r.failed {
+			failed++
+			continue
+		}
+		latencies = append(latencies, r.latency)
+	}
+	stats.Requests = len(t.results)
+	t.mu.Unlock()
+
+	if stats.Requests == 0 {
+		return
+	}
+	stats.ErrorRate = float64(failed) / float64(stats.Requests)
+	slices.Sort(latencies)
+	stats.LatencyP50 = percentile(latencies, 50)
+	stats.LatencyP90 = percentile(latencies, 90)
+	stats.LatencyP99 = percentile(latencies, 99)
+	return
+}
+
+// percentile returns the nearest-rank percentile p of sorted.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestStatsTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty", func(t *testing.T) {
+		stats := NewRequestStatsTracker().Stats()
+		assert.Equal(t, RequestStats{}, stats)
+		assert.True(t, math.IsInf(stats.Score(), 1))
+	})
+
+	t.Run("percentiles and error rate", func(t *testing.T) {
+		tracker := NewRequestStatsTracker()
+		for i := 100; i > 0; i-- {
+			tracker.Observe(time.Duration(i)*time.Millisecond, false)
+		}
+		stats := tracker.Stats()
+		assert.Equal(t, RequestStats{
+			Requests:   100,
+			LatencyP50: 50 * time.Millisecond,
+			LatencyP90: 90 * time.Millisecond,
+			LatencyP99: 99 * time.Millisecond,
+		}, stats)
+		assert.InDelta(t, 90.0, stats.Score(), 0.001)
+
+		// the oldest requests are replaced
+		for i := 0; i < 10; i++ {
+			tracker.Observe(time.Hour, true)
+		}
+		stats = tracker.Stats()
+		assert.Equal(t, 100, stats.Requests)
+		assert.InDelta(t, 0.1, stats.ErrorRate, 0.001)
+		assert.Equal(t, 90*time.Millisecond, stats.LatencyP99)
+		assert.InDelta(t, 81+0.1*float64(requestErrorPenalty/time.Millisecond), stats.Score(), 0.001)
+	})
+
+	t.Run("too few requests to score", func(t *testing.T) {
+		tracker := NewRequestStatsTracker()
+		for i := 0; i < minScoredRequests-1; i++ {
+			tracker.Observe(time.Millisecond, false)
+		}
+		assert.True(t, math.IsInf(tracker.Stats().Score(), 1))
+		tracker.Observe(time.Millisecond, false)
+		assert.InDelta(t, 1.0, tracker.Stats().Score(), 0.001)
+	})
+}
+
+func TestRequestStats_allFailed(t *testing.T) {
+	t.Parallel()
+
+	tracker := NewRequestStatsTracker()
+	for i := 0; i < minScoredRequests; i++ {
+		tracker.Observe(time.Millisecond, true)
+	}
+	stats := tracker.Stats()
+	assert.Equal(t, RequestStats{Requests: minScoredRequests, ErrorRate: 1}, stats)
+	assert.InDelta(t, float64(requestErrorPenalty/time.Millisecond), stats.Score(), 0.001)
+}
@@ -97,6 +97,8 @@ func TestTransactionSender_SendTransaction(t *testing.T) {
 		node.On("Start", mock.Anything).Return(nil).Maybe()
 		node.On("Close").Return(nil).Maybe()
 		node.On("SetPoolChainInfoProvider", mock.Anything).Return(nil).Maybe()
+		node.On("Name").Return("node name").Maybe()
+		node.On("RequestStats").Return(RequestStats{}).Maybe()
 		return node
 	}
 
@@ -39,6 +39,10 @@ type RPCClient[
 	// Ensure implementation does not have a race condition when values are reset before request completion and as
 	// a result latest ChainInfo contains information from the previous cycle.
 	GetInterceptedChainInfo() (latest, highestUserObservations ChainInfo)
+	// GetRequestStats - returns the latency and error rate of the most recent requests, including health checks, so
+	// that nodes can be compared even while not selected. Only failures of the RPC itself, such as timeouts, must be
+	// counted as errors, as opposed to errors returned for the request, such as reverts.
+	GetRequestStats() RequestStats
 }
 
 // Head is the interface required by the NodeClient
@@ -58,6 +58,14 @@ var (
 			float64(2 * time.Minute),
 		},
 	}, []string{"chainID"})
+	promUnstartedTxsByPriority = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_unstarted_transactions",
+		Help: "Number of unstarted transactions queued for broadcast, by from address and priority class.",
+	}, []string{"chainID", "fromAddress", "priority"})
+	promNumStarvedTxs = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_starved_transactions",
+		Help: "Number of transactions broadcast ahead of their turn because they waited too long in the queue, by priority class.",
+	}, []string{"chainID", "priority"})
 )
 
 var ErrTxRemoved = errors.New("tx removed")
@@ -144,6 +152,11 @@ type Broadcaster[
 	// Each key has its own trigger
 	triggers map[ADDR]chan struct{}
 
+	// schedulers pick the order unstarted transactions are sent in
+	// Each key has its own scheduler
+	schedulers   map[ADDR]*txScheduler
+	schedulersMu sync.Mutex
+
 	chStop services.StopChan
 	wg     sync.WaitGroup
 
@@ -190,6 +203,7 @@ func NewBroadcaster[
 		checkerFactory:   checkerFactory,
 		autoSyncSequence: autoSyncSequence,
 		sequenceTracker:  sequenceTracker,
+		schedulers:       make(map[ADDR]*txScheduler),
 	}
 
 	b.processUnstartedTxsImpl = b.processUnstartedTxs
@@ -374,6 +388,9 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) proc
 		}
 	}()
 
+	eb.observeUnstartedTxs(ctx, fromAddress)
+	defer eb.observeUnstartedTxs(ctx, fromAddress)
+
 	err, retryable = eb.handleAnyInProgressTx(ctx, fromAddress)
 	if err != nil {
 		return retryable, fmt.Errorf("processUnstartedTxs failed on handleAnyInProgressTx: %w", err)
@@ -671,17 +688,29 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) vali
 }
 
 // Finds next transaction in the queue, assigns a sequence, and moves it to "in_progress" state ready for broadcast.
+// The next transaction is the earliest one of the lane picked by the scheduler of the address, see txScheduler.
 // Returns nil if no transactions are in queue
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) nextUnstartedTransactionWithSequence(fromAddress ADDR) (*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], error) {
 	ctx, cancel := eb.chStop.NewCtx()
 	defer cancel()
-	etx, err := eb.txStore.FindNextUnstartedTransactionFromAddress(ctx, fromAddress, eb.chainID)
+	etxs, err := eb.txStore.FindNextUnstartedTransactionsByLane(ctx, fromAddress, eb.chainID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			// Finish. No more transactions left to process. Hoorah!
-			return nil, nil
-		}
-		return nil, fmt.Errorf("findNextUnstartedTransactionFromAddress failed: %w", err)
+		return nil, fmt.Errorf("findNextUnstartedTransactionsByLane failed: %w", err)
+	}
+	if len(etxs) == 0 {
+		// Finish. No more transactions left to process. Hoorah!
+		return nil, nil
+	}
+
+	heads := make([]txLaneHead, len(etxs))
+	for i, etx := range etxs {
+		heads[i] = txLaneHead{lane: txLane{priority: etx.Priority.OrDefault(), subject: etx.Subject}, createdAt: etx.CreatedAt}
+	}
+	i, starved := eb.scheduler(fromAddress).next(heads)
+	etx := etxs[i]
+	if starved {
+		eb.lggr.Warnw("Broadcasting transaction ahead of its turn, it waited too long in the queue", "etxID", etx.ID, "priority", etx.Priority, "subject", etx.Subject, "createdAt", etx.CreatedAt)
+		promNumStarvedTxs.WithLabelValues(eb.chainID.String(), string(etx.Priority.OrDefault())).Inc()
 	}
 
 	sequence, err := eb.sequenceTracker.GetNextSequence(ctx, etx.FromAddress)
@@ -692,6 +721,32 @@ func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) next
 	return etx, nil
 }
 
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) scheduler(fromAddress ADDR) *txScheduler {
+	eb.schedulersMu.Lock()
+	defer eb.schedulersMu.Unlock()
+	s, ok := eb.schedulers[fromAddress]
+	if !ok {
+		s = newTxScheduler()
+		eb.schedulers[fromAddress] = s
+	}
+	return s
+}
+
+// observeUnstartedTxs reports the depth of the unstarted queue of the address for each priority class
+func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) observeUnstartedTxs(ctx context.Context, fromAddress ADDR) {
+	if ctx.Err() != nil {
+		return
+	}
+	counts, err := eb.txStore.CountUnstartedTransactionsByPriority(ctx, fromAddress, eb.chainID)
+	if err != nil {
+		eb.lggr.Errorw("Failed to count unstarted transactions by priority", "address", fromAddress, "err", err)
+		return
+	}
+	for _, priority := range txmgrtypes.TxPriorities {
+		promUnstartedTxsByPriority.WithLabelValues(eb.chainID.String(), fromAddress.String(), string(priority)).Set(float64(counts[priority]))
+	}
+}
+
 // replaceAttemptWithBumpedGas performs the replacement of the existing tx attempt with a new bumped fee attempt.
 func (eb *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) replaceAttemptWithBumpedGas(ctx context.Context, lgr logger.Logger, txError error, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], attempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (replacedAttempt txmgrtypes.TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], retryable bool, err error) {
 	// This log error is not applicable to Hedera since the action required would not be needed for its gas estimator
@@ -377,9 +377,32 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	// Add newly confirmed transactions to the prom metric
 	promNumConfirmedTxs.WithLabelValues(ec.chainID.String()).Add(float64(len(includedTxs)))
 
+	cancelledTxIDs := make([]int64, 0, len(includedTxs))
 	purgeTxIDs := make([]int64, 0, len(includedTxs))
 	confirmedTxIDs := make([]int64, 0, len(includedTxs))
+	cancelledTxs := make([]*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], 0, len(includedTxs))
 	for _, tx := range includedTxs {
+		// If the transaction was cancelled on request, either the cancel attempt or an original attempt may have been included,
+		// so the receipts decide whether it is marked as fatal error or confirmed
+		if tx.HasCancelAttempt() {
+			cancelled, found, err := ec.includedCancelAttempt(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("failed to fetch receipts for cancelled transaction: %w", err)
+			}
+			if !found {
+				// The sequence was included but the receipt is not available yet, check again on the next head
+				tx.GetLogger(ec.lggr).Debugw("Receipt not found for included cancelled transaction", "etxID", tx.ID)
+				continue
+			}
+			if cancelled {
+				cancelledTxIDs = append(cancelledTxIDs, tx.ID)
+				cancelledTxs = append(cancelledTxs, tx)
+				continue
+			}
+			confirmedTxIDs = append(confirmedTxIDs, tx.ID)
+			observeUntilTxConfirmed(ec.chainID, tx.TxAttempts, head)
+			continue
+		}
 		// If any attempt in the transaction is marked for purge, the transaction was terminally stuck and should be marked as fatal error
 		if tx.HasPurgeAttempt() {
 			// Setting the purged block num here is ok since we have confirmation the tx has been included
@@ -390,6 +413,17 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 		confirmedTxIDs = append(confirmedTxIDs, tx.ID)
 		observeUntilTxConfirmed(ec.chainID, tx.TxAttempts, head)
 	}
+	// Mark the transactions whose cancel attempt was included on-chain as fatal error with the cancelled error message
+	if len(cancelledTxIDs) > 0 {
+		if err := ec.txStore.UpdateTxFatalError(ctx, cancelledTxIDs, ErrTxCancelled.Error()); err != nil {
+			return fmt.Errorf("failed to update cancelled transactions: %w", err)
+		}
+		for _, tx := range cancelledTxs {
+			if err := ec.resumeFailedTaskRuns(ctx, *tx, ErrTxCancelled); err != nil {
+				return fmt.Errorf("failed to resume pending task run for cancelled transaction: %w", err)
+			}
+		}
+	}
 	// Mark the transactions included on-chain with a purge attempt as fatal error with the terminally stuck error message
 	if err := ec.txStore.UpdateTxFatalError(ctx, purgeTxIDs, ec.stuckTxDetector.StuckTxFatalError()); err != nil {
 		return fmt.Errorf("failed to update terminally stuck transactions: %w", err)
@@ -401,6 +435,22 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	return nil
 }
 
+// includedCancelAttempt fetches the receipts of the attempts of a cancelled transaction, and reports whether the attempt
+// included on-chain is a cancel attempt. found is false if none of the attempts has a receipt yet.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) includedCancelAttempt(ctx context.Context, tx *txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) (cancelled bool, found bool, err error) {
+	receipts, receiptErrs, err := ec.client.BatchGetReceipts(ctx, tx.TxAttempts)
+	if err != nil {
+		return false, false, err
+	}
+	for i, receipt := range receipts {
+		if receiptErrs[i] != nil || receipt.IsZero() || receipt.IsUnmined() {
+			continue
+		}
+		return tx.TxAttempts[i].IsCancelAttempt, true, nil
+	}
+	return false, false, nil
+}
+
 // Determines if any of the unconfirmed transactions are terminally stuck for each enabled address
 // If any transaction is found to be terminally stuck, this method sends an empty attempt with bumped gas in an attempt to purge the stuck transaction
 func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) ProcessStuckTransactions(ctx context.Context, blockNum int64) error {
@@ -447,7 +497,7 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 				return
 			}
 			// Resume pending task runs with failure for stuck transactions
-			if err := ec.resumeFailedTaskRuns(ctx, tx); err != nil {
+			if err := ec.resumeFailedTaskRuns(ctx, tx, errors.New(ec.stuckTxDetector.StuckTxFatalError())); err != nil {
 				errMu.Lock()
 				errorList = append(errorList, fmt.Errorf("failed to resume pending task run for transaction: %w", err))
 				errMu.Unlock()
@@ -459,11 +509,32 @@ func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Pro
 	return errors.Join(errorList...)
 }
 
-func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) error {
+// CancelTransaction sends a cancel attempt for the unconfirmed transaction, which replaces it with a zero value self-send
+// at the same sequence and a bumped fee. The cancel attempt is bumped and rebroadcast like any other attempt until the
+// sequence is included. The original attempts may still be included instead, so pending task runs are only resumed with
+// ErrTxCancelled once a cancel attempt is included, see ProcessIncludedTxs.
+// This must not be run while the Confirmer is running.
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTransaction(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], blockNum int64) error {
+	lggr := etx.GetLogger(ec.lggr)
+	cancelAttempt, err := ec.TxAttemptBuilder.NewCancelTxAttempt(ctx, etx, lggr)
+	if err != nil {
+		return fmt.Errorf("failed to create a cancel attempt: %w", err)
+	}
+	if err = ec.txStore.SaveInProgressAttempt(ctx, &cancelAttempt); err != nil {
+		return fmt.Errorf("failed to save cancel attempt: %w", err)
+	}
+	lggr.Infow("Cancelling transaction", "etx", etx, "cancelAttempt", cancelAttempt)
+	if err = ec.handleInProgressAttempt(ctx, lggr, etx, cancelAttempt, blockNum); err != nil {
+		return fmt.Errorf("failed to send cancel attempt: %w", err)
+	}
+	return nil
+}
+
+func (ec *Confirmer[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) resumeFailedTaskRuns(ctx context.Context, etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], txErr error) error {
 	if !etx.PipelineTaskRunID.Valid || ec.resumeCallback == nil || !etx.SignalCallback || etx.CallbackCompleted {
 		return nil
 	}
-	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, errors.New(ec.stuckTxDetector.StuckTxFatalError()))
+	err := ec.resumeCallback(ctx, etx.PipelineTaskRunID.UUID, nil, txErr)
 	if errors.Is(err, sql.ErrNoRows) {
 		ec.lggr.Debugw("callback missing or already resumed", "etxID", etx.ID)
 	} else if err != nil {
@@ -1,6 +1,8 @@
 package txmgr
 
 import (
+	"errors"
+
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 )
 
@@ -13,3 +15,10 @@ const (
 	TxConfirmedMissingReceipt = txmgrtypes.TxState("confirmed_missing_receipt")
 	TxFinalized               = txmgrtypes.TxState("finalized")
 )
+
+var (
+	// ErrTxCancelled is the error of the transactions cancelled with CancelTx.
+	ErrTxCancelled = errors.New("transaction cancelled")
+	// ErrTxNotCancellable is returned by CancelTx for transactions which can't be cancelled in their current state.
+	ErrTxNotCancellable = errors.New("transaction cannot be cancelled")
+)
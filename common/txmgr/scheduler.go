@@ -0,0 +1,143 @@
+package txmgr
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+const (
+	// txAgingPeriod is how long an unstarted transaction waits at the head of its lane before its lane gets the
+	// largest aging boost
+	txAgingPeriod = 5 * time.Minute
+	// txMaxAgingBoost is the largest factor the weight of a lane is boosted by while its head is waiting. It is kept
+	// below the ratio between the weights of consecutive priority classes, so that a waiting lane can never get ahead
+	// of a lane of the next class.
+	txMaxAgingBoost = 2
+)
+
+// txPriorityWeights are the shares of the sequences of a from address given to each priority class, when all of them
+// have unstarted transactions
+var txPriorityWeights = map[txmgrtypes.TxPriority]float64{
+	txmgrtypes.TxPriorityLow:    1,
+	txmgrtypes.TxPriorityNormal: 4,
+	txmgrtypes.TxPriorityHigh:   16,
+}
+
+// txLane holds the unstarted transactions of a from address sharing a priority class and a subject. Transactions
+// without a subject share a single lane per priority class.
+type txLane struct {
+	priority txmgrtypes.TxPriority
+	subject  uuid.NullUUID
+}
+
+// txLaneHead is the earliest unstarted transaction of a lane.
+type txLaneHead struct {
+	lane      txLane
+	createdAt time.Time
+}
+
+// txScheduler picks the lane the next sequence of a from address goes to, with weighted fair queueing. Every lane
+// is served in turn, in proportion to the weight of its priority class. Lanes of the same class get an equal share,
+// so that a subject can't hold back the others of its class by flooding the queue. The weight of a lane grows with
+// the time its head has been waiting, up to txMaxAgingBoost times its class weight after agingPeriod, so that a
+// waiting lane gets a larger but bounded share, and never goes ahead of a fresh lane of a higher class.
+//
+// The scheduler isn't safe for concurrent use, which is fine since the unstarted transactions of a from address are
+// processed serially.
+type txScheduler struct {
+	agingPeriod time.Duration
+	now         func() time.Time
+
+	// virtualTime is the earliest start tag of the lanes with unstarted transactions. Lanes going idle don't build up
+	// credit, when they come back they are scheduled from the current virtual time.
+	virtualTime float64
+	// finishTags are the finish tags of the last transaction served by each lane, and so the start tags of their
+	// next transaction
+	finishTags map[txLane]float64
+}
+
+func newTxScheduler() *txScheduler {
+	return &txScheduler{
+		agingPeriod: txAgingPeriod,
+		now:         time.Now,
+		finishTags:  make(map[txLane]float64),
+	}
+}
+
+// next returns the index of the lane head to serve next, and whether it was picked ahead of its turn because of the
+// aging boost of its lane. heads must not be empty.
+func (s *txScheduler) next(heads []txLaneHead) (i int, starved bool) {
+	for _, head := range heads {
+		// New lanes start at the current virtual time
+		if _, ok := s.finishTags[head.lane]; !ok {
+			s.finishTags[head.lane] = s.virtualTime
+		}
+	}
+
+	i, fair := -1, -1
+	var nextFinish, fairFinish float64
+	now := s.now()
+	for j, head := range heads {
+		weight := txPriorityWeight(head.lane.priority)
+		finish := s.finishTag(head.lane, weight*s.agingBoost(now.Sub(head.createdAt)))
+		if i == -1 || finish < nextFinish || (finish == nextFinish && head.createdAt.Before(heads[i].createdAt)) {
+			i, nextFinish = j, finish
+		}
+		// The lane served without aging, to tell whether the boost changed the order
+		finish = s.finishTag(head.lane, weight)
+		if fair == -1 || finish < fairFinish || (finish == fairFinish && head.createdAt.Before(heads[fair].createdAt)) {
+			fair, fairFinish = j, finish
+		}
+	}
+	s.serve(heads[i].lane, nextFinish, heads)
+	return i, i != fair
+}
+
+// agingBoost returns the factor the weight of a lane is boosted by when its head has been waiting for wait, growing
+// linearly from 1 to txMaxAgingBoost over the aging period.
+func (s *txScheduler) agingBoost(wait time.Duration) float64 {
+	if s.agingPeriod <= 0 || wait <= 0 {
+		return 1
+	}
+	return 1 + (txMaxAgingBoost-1)*min(float64(wait)/float64(s.agingPeriod), 1)
+}
+
+// startTag returns the virtual time the next transaction of the lane starts at, which is when the last one finished.
+func (s *txScheduler) startTag(lane txLane) float64 {
+	return s.finishTags[lane]
+}
+
+// finishTag returns the virtual time the next transaction of the lane finishes at, lanes of higher weight finishing
+// sooner.
+func (s *txScheduler) finishTag(lane txLane, weight float64) float64 {
+	return s.startTag(lane) + 1/weight
+}
+
+// serve charges the lane for a transaction finishing at finish, and forgets the idle lanes behind the virtual time.
+func (s *txScheduler) serve(lane txLane, finish float64, heads []txLaneHead) {
+	s.finishTags[lane] = finish
+
+	// The virtual time moves on to the earliest start tag of the lanes with unstarted transactions
+	active := make(map[txLane]struct{}, len(heads))
+	nextStart := s.finishTags[lane]
+	for _, head := range heads {
+		active[head.lane] = struct{}{}
+		nextStart = min(nextStart, s.startTag(head.lane))
+	}
+	s.virtualTime = max(s.virtualTime, nextStart)
+	for l, finish := range s.finishTags {
+		if _, ok := active[l]; !ok && finish <= s.virtualTime {
+			delete(s.finishTags, l)
+		}
+	}
+}
+
+func txPriorityWeight(priority txmgrtypes.TxPriority) float64 {
+	if w, ok := txPriorityWeights[priority.OrDefault()]; ok {
+		return w
+	}
+	return txPriorityWeights[txmgrtypes.TxPriorityNormal]
+}
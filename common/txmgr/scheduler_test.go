@@ -0,0 +1,138 @@
+package txmgr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
+)
+
+func TestTxScheduler(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	newScheduler := func() *txScheduler {
+		s := newTxScheduler()
+		s.now = func() time.Time { return now }
+		return s
+	}
+	subject := func() uuid.NullUUID {
+		return uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	}
+	// serve picks n lane heads, all lanes staying backlogged, and returns the number of picks per lane
+	serve := func(s *txScheduler, heads []txLaneHead, n int) map[txLane]int {
+		served := make(map[txLane]int)
+		for range n {
+			i, _ := s.next(heads)
+			served[heads[i].lane]++
+		}
+		return served
+	}
+
+	t.Run("shares sequences across priority classes by weight", func(t *testing.T) {
+		high := txLane{priority: txmgrtypes.TxPriorityHigh}
+		normal := txLane{priority: txmgrtypes.TxPriorityNormal}
+		low := txLane{priority: txmgrtypes.TxPriorityLow}
+		heads := []txLaneHead{{lane: low, createdAt: now}, {lane: normal, createdAt: now}, {lane: high, createdAt: now}}
+
+		served := serve(newScheduler(), heads, 210)
+		assert.Equal(t, 160, served[high])
+		assert.Equal(t, 40, served[normal])
+		assert.Equal(t, 10, served[low])
+	})
+
+	t.Run("serves high priority lane first", func(t *testing.T) {
+		heads := []txLaneHead{
+			{lane: txLane{priority: txmgrtypes.TxPriorityLow}, createdAt: now.Add(-time.Minute)},
+			{lane: txLane{priority: txmgrtypes.TxPriorityHigh}, createdAt: now},
+		}
+		i, starved := newScheduler().next(heads)
+		assert.Equal(t, 1, i)
+		assert.False(t, starved)
+	})
+
+	t.Run("shares sequences equally across subjects of a class", func(t *testing.T) {
+		a := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		b := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		c := txLane{priority: txmgrtypes.TxPriorityLow}
+		heads := []txLaneHead{{lane: a, createdAt: now}, {lane: b, createdAt: now}, {lane: c, createdAt: now}}
+
+		served := serve(newScheduler(), heads, 30)
+		assert.Equal(t, 10, served[a])
+		assert.Equal(t, 10, served[b])
+		assert.Equal(t, 10, served[c])
+	})
+
+	t.Run("new lanes don't get credit for the time they were idle", func(t *testing.T) {
+		s := newScheduler()
+		low := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		serve(s, []txLaneHead{{lane: low, createdAt: now}}, 100)
+
+		other := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		served := serve(s, []txLaneHead{{lane: low, createdAt: now}, {lane: other, createdAt: now}}, 10)
+		assert.Equal(t, 5, served[low])
+		assert.Equal(t, 5, served[other])
+	})
+
+	t.Run("boosts waiting lanes by a bounded share", func(t *testing.T) {
+		low := txLane{priority: txmgrtypes.TxPriorityLow}
+		normal := txLane{priority: txmgrtypes.TxPriorityNormal}
+		high := txLane{priority: txmgrtypes.TxPriorityHigh}
+		waiting := now.Add(-10 * txAgingPeriod)
+
+		served := serve(newScheduler(), []txLaneHead{{lane: low, createdAt: waiting}, {lane: normal, createdAt: now}}, 60)
+		assert.Equal(t, 20, served[low])
+		assert.Equal(t, 40, served[normal])
+
+		served = serve(newScheduler(), []txLaneHead{{lane: low, createdAt: waiting}, {lane: high, createdAt: now}}, 90)
+		assert.Equal(t, 10, served[low])
+		assert.Equal(t, 80, served[high])
+	})
+
+	t.Run("serves low priority lane ahead of its turn once it waited", func(t *testing.T) {
+		s := newScheduler()
+		a := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		b := txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}
+		normal := txLane{priority: txmgrtypes.TxPriorityNormal}
+		serve(s, []txLaneHead{{lane: a, createdAt: now}, {lane: b, createdAt: now}}, 2)
+
+		// a waited for the whole aging period, and is served once ahead of the normal lane
+		heads := []txLaneHead{{lane: a, createdAt: now.Add(-txAgingPeriod)}, {lane: b, createdAt: now.Add(-time.Second)}, {lane: normal, createdAt: now}}
+		served := make([]int, 0, 3)
+		starvedCount := 0
+		for range 3 {
+			i, starved := s.next(heads)
+			served = append(served, i)
+			if starved {
+				starvedCount++
+			}
+		}
+		assert.Equal(t, []int{2, 0, 2}, served)
+		assert.Equal(t, 1, starvedCount)
+	})
+
+	t.Run("serves high priority transaction next during a waiting low priority backlog", func(t *testing.T) {
+		s := newScheduler()
+		heads := make([]txLaneHead, 0, 11)
+		for range 10 {
+			heads = append(heads, txLaneHead{lane: txLane{priority: txmgrtypes.TxPriorityLow, subject: subject()}, createdAt: now.Add(-10 * txAgingPeriod)})
+		}
+		serve(s, heads, 25)
+
+		heads = append(heads, txLaneHead{lane: txLane{priority: txmgrtypes.TxPriorityHigh}, createdAt: now})
+		i, starved := s.next(heads)
+		assert.Equal(t, len(heads)-1, i)
+		assert.False(t, starved)
+	})
+
+	t.Run("forgets idle lanes", func(t *testing.T) {
+		s := newScheduler()
+		for range 10 {
+			s.next([]txLaneHead{{lane: txLane{priority: txmgrtypes.TxPriorityNormal, subject: subject()}, createdAt: now}})
+		}
+		assert.LessOrEqual(t, len(s.finishTags), 2)
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -51,6 +52,8 @@ type TxManager[
 	RegisterResumeCallback(fn ResumeCallback)
 	SendNativeToken(ctx context.Context, chainID CHAIN_ID, from, to ADDR, value big.Int, gasLimit uint64) (etx txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	Reset(addr ADDR, abandon bool) error
+	// CancelTx cancels the transaction with the given ID if it has not been confirmed yet, see Txm.CancelTx
+	CancelTx(ctx context.Context, id int64) error
 	// Find transactions by a field in the TxMeta blob and transaction states
 	FindTxesByMetaFieldAndStates(ctx context.Context, metaField string, metaValue string, states []txmgrtypes.TxState, chainID *big.Int) (txes []*txmgrtypes.Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE], err error)
 	// Find transactions with a non-null TxMeta field that was provided by transaction states
@@ -103,6 +106,8 @@ type Txm[
 	chSubbed chan struct{}
 	wg       sync.WaitGroup
 
+	latestBlockNum atomic.Int64
+
 	reaper             *Reaper[CHAIN_ID]
 	resender           *Resender[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]
 	broadcaster        *Broadcaster[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]
@@ -261,6 +266,67 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) abandon(ad
 	return nil
 }
 
+// CancelTx cancels the transaction with the given ID, if it has not been confirmed yet.
+//
+// Unstarted transactions are marked as fatally errored right away. Unconfirmed transactions are replaced with a zero
+// value self-send at the same sequence and a bumped fee, which the Confirmer bumps and rebroadcasts like any other
+// attempt. If the cancel attempt is included, the transaction is marked as fatally errored with ErrTxCancelled, and if an
+// original attempt wins the race it is confirmed as usual. Other transactions can't be cancelled, and return
+// ErrTxNotCancellable.
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) CancelTx(ctx context.Context, id int64) (err error) {
+	ok := b.IfStarted(func() {
+		var cancelErr error
+		done := make(chan error)
+		f := func() {
+			cancelErr = b.cancelTx(ctx, id)
+		}
+
+		b.reset <- reset{f, done}
+		if err = <-done; err == nil {
+			err = cancelErr
+		}
+	})
+	if !ok {
+		return errors.New("not started")
+	}
+	return err
+}
+
+// cancelTx must not be run while Broadcaster or Confirmer are running
+func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) cancelTx(ctx context.Context, id int64) error {
+	ctx, cancel := b.chStop.Ctx(ctx)
+	defer cancel()
+	tx, err := b.txStore.GetTxByID(ctx, id)
+	if err != nil {
+		return fmt.Errorf("failed to find transaction %d: %w", id, err)
+	}
+	// This check is required since a no-rows error returns nil err
+	if tx == nil || tx.ChainID.String() != b.chainID.String() {
+		return fmt.Errorf("failed to find transaction %d", id)
+	}
+
+	switch tx.State {
+	case TxUnstarted:
+		if err = b.txStore.UpdateTxFatalError(ctx, []int64{tx.ID}, ErrTxCancelled.Error()); err != nil {
+			return fmt.Errorf("failed to cancel transaction %d: %w", id, err)
+		}
+		b.logger.Infow("Cancelled unstarted transaction", "txID", tx.ID)
+		return b.confirmer.resumeFailedTaskRuns(ctx, *tx, ErrTxCancelled)
+	case TxUnconfirmed:
+		if tx.HasCancelAttempt() {
+			return fmt.Errorf("%w: transaction %d is already being cancelled", ErrTxNotCancellable, id)
+		}
+		if len(tx.TxAttempts) == 0 {
+			return fmt.Errorf("invariant violation: expected tx %v to have at least one attempt", tx.ID)
+		}
+		return b.confirmer.CancelTransaction(ctx, *tx, b.latestBlockNum.Load())
+	case TxInProgress:
+		return fmt.Errorf("%w: transaction %d is being broadcast, retry once it is unconfirmed", ErrTxNotCancellable, id)
+	default:
+		return fmt.Errorf("%w: transaction %d is %s", ErrTxNotCancellable, id, tx.State)
+	}
+}
+
 func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) Close() (merr error) {
 	return b.StopOnce("Txm", func() error {
 		close(b.chStop)
@@ -422,6 +488,7 @@ func (b *Txm[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, R, SEQ, FEE]) runLoop()
 		case address := <-b.trigger:
 			b.broadcaster.Trigger(address)
 		case head := <-b.chHeads:
+			b.latestBlockNum.Store(head.BlockNumber())
 			b.confirmer.mb.Deliver(head)
 			b.tracker.mb.Deliver(head.BlockNumber())
 			b.finalizer.DeliverLatestHead(head)
@@ -751,6 +818,10 @@ func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) Fin
 	return nullv4.Time{}, errors.New(n.ErrMsg)
 }
 
+func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) CancelTx(ctx context.Context, id int64) error {
+	return errors.New(n.ErrMsg)
+}
+
 func (n *NullTxManager[CHAIN_ID, HEAD, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) FindEarliestUnconfirmedTxAttemptBlock(ctx context.Context) (nullv4.Int, error) {
 	return nullv4.Int{}, errors.New(n.ErrMsg)
 }
@@ -88,6 +88,10 @@ type TxRequest[ADDR types.Hashable, TX_HASH types.Hashable] struct {
 
 	Strategy TxStrategy
 
+	// Priority is the priority class the Broadcaster sends the transaction with, relative to the other transactions
+	// of the from address. Empty defaults to TxPriorityNormal.
+	Priority TxPriority
+
 	// Checker defines the check that should be run before a transaction is submitted on chain.
 	Checker TransmitCheckerSpec[ADDR]
 
@@ -114,6 +118,40 @@ type TransmitCheckerSpec[ADDR types.Hashable] struct {
 // executed on-chain.
 type TransmitCheckerType string
 
+// TxPriority is the priority class of a transaction. The Broadcaster shares the sequences of a from address across the
+// priority classes of its unstarted transactions in proportion to their weights, so that a flood of low priority
+// transactions can't hold back the high priority ones.
+type TxPriority string
+
+const (
+	TxPriorityLow    TxPriority = "low"
+	TxPriorityNormal TxPriority = "normal"
+	TxPriorityHigh   TxPriority = "high"
+)
+
+// TxPriorities lists the priority classes from the lowest to the highest.
+var TxPriorities = []TxPriority{TxPriorityLow, TxPriorityNormal, TxPriorityHigh}
+
+// ParseTxPriority parses a priority class. An empty string is left empty, which defaults to TxPriorityNormal.
+func ParseTxPriority(s string) (TxPriority, error) {
+	if s == "" {
+		return "", nil
+	}
+	p := TxPriority(strings.ToLower(s))
+	if !slices.Contains(TxPriorities, p) {
+		return "", fmt.Errorf("invalid transaction priority %q, expected one of %v", s, TxPriorities)
+	}
+	return p, nil
+}
+
+// OrDefault returns the priority, or TxPriorityNormal if it is empty.
+func (p TxPriority) OrDefault() TxPriority {
+	if p == "" {
+		return TxPriorityNormal
+	}
+	return p
+}
+
 // TxMeta contains fields of the transaction metadata
 // Not all fields are guaranteed to be present
 type TxMeta[ADDR types.Hashable, TX_HASH types.Hashable] struct {
@@ -186,6 +224,9 @@ type TxAttempt[
 	Receipts                []ChainReceipt[TX_HASH, BLOCK_HASH] `json:"-"`
 	TxType                  int
 	IsPurgeAttempt          bool
+	// IsCancelAttempt marks the attempts which cancel a transaction on request of the node operator. They are also
+	// marked as purge attempts, but are sent to the from address instead of the original recipient.
+	IsCancelAttempt bool
 }
 
 func (a *TxAttempt[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) String() string {
@@ -224,6 +265,8 @@ type Tx[
 	Meta    *sqlutil.JSON
 	Subject uuid.NullUUID
 	ChainID CHAIN_ID
+	// Priority is the priority class of the transaction, see TxPriority
+	Priority TxPriority
 
 	PipelineTaskRunID uuid.NullUUID
 	MinConfirmations  clnull.Uint32
@@ -351,6 +394,15 @@ func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HasPurgeAttempt() bo
 	return false
 }
 
+func (e *Tx[CHAIN_ID, ADDR, TX_HASH, BLOCK_HASH, SEQ, FEE]) HasCancelAttempt() bool {
+	for _, attempt := range e.TxAttempts {
+		if attempt.IsCancelAttempt {
+			return true
+		}
+	}
+	return false
+}
+
 // Provides error classification to external components in a chain agnostic way
 // Only exposes the error types that could be set in the transaction error field
 type ErrorClassifier interface {
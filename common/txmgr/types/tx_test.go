@@ -48,3 +48,27 @@ func TestTxAttemptState(t *testing.T) {
 		}
 	})
 }
+
+func TestParseTxPriority(t *testing.T) {
+	for _, tc := range []struct {
+		in       string
+		expected TxPriority
+	}{
+		{"", ""},
+		{"low", TxPriorityLow},
+		{"normal", TxPriorityNormal},
+		{"HIGH", TxPriorityHigh},
+	} {
+		t.Run(tc.in, func(t *testing.T) {
+			p, err := ParseTxPriority(tc.in)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, p)
+		})
+	}
+
+	_, err := ParseTxPriority("urgent")
+	assert.ErrorContains(t, err, `invalid transaction priority "urgent"`)
+
+	assert.Equal(t, TxPriorityNormal, TxPriority("").OrDefault())
+	assert.Equal(t, TxPriorityHigh, TxPriorityHigh.OrDefault())
+}
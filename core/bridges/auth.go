@@ -0,0 +1,388 @@
+package bridges
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+)
+
+// AuthType is the scheme used to authenticate requests to an external adapter.
+type AuthType string
+
+const (
+	AuthTypeNone   AuthType = "none"
+	AuthTypeBearer AuthType = "bearer"
+	AuthTypeOAuth2 AuthType = "oauth2"
+	AuthTypeMTLS   AuthType = "mtls"
+	AuthTypeHMAC   AuthType = "hmac"
+)
+
+const (
+	// HMACSignatureHeader carries the hex encoded HMAC-SHA256 of the request, see HMACAuth.
+	HMACSignatureHeader = "X-Chainlink-Signature"
+	// HMACTimestampHeader carries the unix timestamp included in the signature.
+	HMACTimestampHeader = "X-Chainlink-Timestamp"
+	// HMACKeyIDHeader carries HMACAuth.KeyID, if set.
+	HMACKeyIDHeader = "X-Chainlink-Key-Id"
+)
+
+// AuthConfig is the typed authentication config of a bridge. It is stored
+// encrypted, see AuthCipher.
+type AuthConfig struct {
+	Type   AuthType    `json:"type"`
+	Bearer *BearerAuth `json:"bearer,omitempty"`
+	OAuth2 *OAuth2Auth `json:"oauth2,omitempty"`
+	MTLS   *MTLSAuth   `json:"mtls,omitempty"`
+	HMAC   *HMACAuth   `json:"hmac,omitempty"`
+}
+
+// BearerAuth sends a static token in the Authorization header.
+type BearerAuth struct {
+	Token string `json:"token"`
+}
+
+// OAuth2Auth fetches access tokens with the OAuth2 client credentials grant and
+// refreshes them before they expire.
+type OAuth2Auth struct {
+	TokenURL     string   `json:"tokenURL"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	Scopes       []string `json:"scopes,omitempty"`
+}
+
+// MTLSAuth presents a client certificate issued for one of the node's CSA keys.
+// The private key never leaves the keystore.
+type MTLSAuth struct {
+	// Certificate is the PEM encoded client certificate, optionally followed by intermediates.
+	Certificate string `json:"certificate"`
+	// CSAKeyID is the public key of the CSA key the certificate was issued for.
+	CSAKeyID string `json:"csaKeyID"`
+}
+
+// HMACAuth signs each request with HMAC-SHA256 over
+// "<timestamp>\n<method>\n<path>\n<body>", sent in HMACSignatureHeader.
+type HMACAuth struct {
+	KeyID  string `json:"keyID,omitempty"`
+	Secret string `json:"secret"`
+}
+
+// Validate checks that the config for the selected Type is present and complete.
+func (c *AuthConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+	switch c.Type {
+	case AuthTypeNone:
+		return nil
+	case AuthTypeBearer:
+		if c.Bearer == nil || c.Bearer.Token == "" {
+			return pkgerrors.New("bearer auth requires a token")
+		}
+	case AuthTypeOAuth2:
+		if c.OAuth2 == nil || c.OAuth2.ClientID == "" || c.OAuth2.ClientSecret == "" {
+			return pkgerrors.New("oauth2 auth requires a clientID and clientSecret")
+		}
+		if u, err := url.Parse(c.OAuth2.TokenURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return pkgerrors.New("oauth2 auth requires a valid tokenURL")
+		}
+	case AuthTypeMTLS:
+		if c.MTLS == nil || c.MTLS.CSAKeyID == "" {
+			return pkgerrors.New("mtls auth requires a csaKeyID")
+		}
+		if _, err := parseCertificateChain(c.MTLS.Certificate); err != nil {
+			return pkgerrors.Wrap(err, "mtls auth requires a valid certificate")
+		}
+	case AuthTypeHMAC:
+		if c.HMAC == nil || c.HMAC.Secret == "" {
+			return pkgerrors.New("hmac auth requires a secret")
+		}
+	default:
+		return pkgerrors.Errorf("unknown auth type %q, must be one of: %s, %s, %s, %s, %s", c.Type, AuthTypeNone, AuthTypeBearer, AuthTypeOAuth2, AuthTypeMTLS, AuthTypeHMAC)
+	}
+	return nil
+}
+
+// IsNone returns true if requests to the bridge are not authenticated.
+func (c *AuthConfig) IsNone() bool {
+	return c == nil || c.Type == AuthTypeNone
+}
+
+func parseCertificateChain(certPEM string) ([][]byte, error) {
+	var chain [][]byte
+	rest := []byte(certPEM)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		if _, err := x509.ParseCertificate(block.Bytes); err != nil {
+			return nil, err
+		}
+		chain = append(chain, block.Bytes)
+	}
+	if len(chain) == 0 {
+		return nil, pkgerrors.New("no PEM encoded certificate found")
+	}
+	return chain, nil
+}
+
+// CSAKeyStore is the subset of the keystore used for mTLS client certificates.
+type CSAKeyStore interface {
+	Get(id string) (csakey.KeyV2, error)
+}
+
+// Authenticator builds http clients that authenticate requests to bridges.
+// Clients are cached per bridge and rebuilt when the bridge is updated, so
+// OAuth2 tokens are shared by all tasks calling the same bridge.
+type Authenticator struct {
+	csaKeyStore CSAKeyStore
+
+	mu      sync.Mutex
+	clients map[BridgeName]authClient
+}
+
+type authClient struct {
+	updatedAt time.Time
+	client    *http.Client
+}
+
+func NewAuthenticator(csaKeyStore CSAKeyStore) *Authenticator {
+	return &Authenticator{csaKeyStore: csaKeyStore, clients: make(map[BridgeName]authClient)}
+}
+
+// Client returns base, or a client derived from it which authenticates requests
+// according to the bridge's AuthConfig.
+func (a *Authenticator) Client(bt BridgeType, base *http.Client) (*http.Client, error) {
+	if bt.AuthConfig.IsNone() {
+		return base, nil
+	}
+	if base == nil {
+		base = http.DefaultClient
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if cached, ok := a.clients[bt.Name]; ok && cached.updatedAt.Equal(bt.UpdatedAt) {
+		return cached.client, nil
+	}
+	client, err := a.newClient(bt.AuthConfig, base)
+	if err != nil {
+		return nil, pkgerrors.Wrapf(err, "failed to configure %s auth for bridge %s", bt.AuthConfig.Type, bt.Name)
+	}
+	a.clients[bt.Name] = authClient{updatedAt: bt.UpdatedAt, client: client}
+	return client, nil
+}
+
+func (a *Authenticator) newClient(cfg *AuthConfig, base *http.Client) (*http.Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	client := *base
+	transport := base.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	switch cfg.Type {
+	case AuthTypeBearer:
+		client.Transport = &headerTransport{base: transport, header: func(context.Context) (string, string, error) {
+			return "Authorization", "Bearer " + cfg.Bearer.Token, nil
+		}}
+	case AuthTypeOAuth2:
+		source := &oauth2TokenSource{cfg: *cfg.OAuth2, client: base}
+		client.Transport = &headerTransport{base: transport, header: func(ctx context.Context) (string, string, error) {
+			token, err := source.token(ctx)
+			return "Authorization", "Bearer " + token, err
+		}, onUnauthorized: source.invalidate}
+	case AuthTypeMTLS:
+		t, ok := transport.(*http.Transport)
+		if !ok {
+			return nil, pkgerrors.Errorf("mtls requires an *http.Transport, got %T", transport)
+		}
+		cert, err := a.clientCertificate(cfg.MTLS)
+		if err != nil {
+			return nil, err
+		}
+		t = t.Clone()
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		t.TLSClientConfig.Certificates = []tls.Certificate{cert}
+		client.Transport = t
+	case AuthTypeHMAC:
+		client.Transport = &hmacTransport{base: transport, cfg: *cfg.HMAC}
+	}
+	return &client, nil
+}
+
+func (a *Authenticator) clientCertificate(cfg *MTLSAuth) (tls.Certificate, error) {
+	if a.csaKeyStore == nil {
+		return tls.Certificate{}, pkgerrors.New("keystore is not available")
+	}
+	key, err := a.csaKeyStore.Get(cfg.CSAKeyID)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	chain, err := parseCertificateChain(cfg.Certificate)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	leaf, err := x509.ParseCertificate(chain[0])
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	if pub, ok := leaf.PublicKey.(ed25519.PublicKey); !ok || !pub.Equal(key.PublicKey) {
+		return tls.Certificate{}, pkgerrors.Errorf("certificate was not issued for CSA key %s", cfg.CSAKeyID)
+	}
+	return tls.Certificate{Certificate: chain, PrivateKey: ed25519.PrivateKey(key.Raw()), Leaf: leaf}, nil
+}
+
+// headerTransport sets a single header on each request.
+type headerTransport struct {
+	base           http.RoundTripper
+	header         func(ctx context.Context) (key, value string, err error)
+	onUnauthorized func()
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key, value, err := t.header(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set(key, value)
+	resp, err := t.base.RoundTrip(req)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized && t.onUnauthorized != nil {
+		t.onUnauthorized()
+	}
+	return resp, err
+}
+
+// hmacTransport signs each request, see HMACAuth.
+type hmacTransport struct {
+	base http.RoundTripper
+	cfg  HMACAuth
+}
+
+func (t *hmacTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		_ = req.Body.Close()
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req = req.Clone(req.Context())
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.Header.Set(HMACTimestampHeader, timestamp)
+	req.Header.Set(HMACSignatureHeader, HMACSignature(t.cfg.Secret, timestamp, req.Method, req.URL.EscapedPath(), body))
+	if t.cfg.KeyID != "" {
+		req.Header.Set(HMACKeyIDHeader, t.cfg.KeyID)
+	}
+	return t.base.RoundTrip(req)
+}
+
+// HMACSignature returns the hex encoded signature sent in HMACSignatureHeader.
+func HMACSignature(secret, timestamp, method, path string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s\n%s\n%s\n", timestamp, method, path)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// oauth2ExpiryDelta refreshes tokens this long before they expire.
+const oauth2ExpiryDelta = 30 * time.Second
+
+// oauth2TokenSource implements the client credentials grant (RFC 6749 section 4.4).
+type oauth2TokenSource struct {
+	cfg    OAuth2Auth
+	client *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+func (s *oauth2TokenSource) token(ctx context.Context) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.accessToken != "" && (s.expiry.IsZero() || time.Now().Add(oauth2ExpiryDelta).Before(s.expiry)) {
+		return s.accessToken, nil
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	if len(s.cfg.Scopes) > 0 {
+		form.Set("scope", strings.Join(s.cfg.Scopes, " "))
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.cfg.TokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(s.cfg.ClientID), url.QueryEscape(s.cfg.ClientSecret))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", pkgerrors.Wrap(err, "oauth2: failed to fetch token")
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", pkgerrors.Wrap(err, "oauth2: failed to read token response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", pkgerrors.Errorf("oauth2: token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var tokenResp struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err = json.Unmarshal(body, &tokenResp); err != nil {
+		return "", pkgerrors.Wrap(err, "oauth2: failed to parse token response")
+	}
+	if tokenResp.AccessToken == "" {
+		return "", pkgerrors.New("oauth2: token response is missing access_token")
+	}
+	s.accessToken = tokenResp.AccessToken
+	s.expiry = time.Time{}
+	if tokenResp.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(tokenResp.ExpiresIn) * time.Second)
+	}
+	return s.accessToken, nil
+}
+
+// invalidate forces a new token to be fetched, e.g. after it was revoked.
+func (s *oauth2TokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.accessToken = ""
+}
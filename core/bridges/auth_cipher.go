@@ -0,0 +1,119 @@
+package bridges
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"sync"
+
+	pkgerrors "github.com/pkg/errors"
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// authCipherSalt domain separates the bridge auth key from other uses of the keystore password.
+const authCipherSalt = "chainlink-bridge-auth-config"
+
+// AuthCipher encrypts bridge AuthConfigs at rest with AES-256-GCM, using a key
+// derived from the keystore password. The key is derived on first use, and
+// again after the keystore password changes.
+type AuthCipher struct {
+	scryptParams utils.ScryptParams
+
+	// rotateMu is held for reading while AuthConfigs encrypted with the current key are written, and for writing
+	// while they are re-encrypted with a new key, so that none is written with the old key once re-encrypted.
+	rotateMu sync.RWMutex
+
+	mu       sync.Mutex
+	password string
+	aead     cipher.AEAD
+}
+
+func NewAuthCipher(password string, scryptParams utils.ScryptParams) *AuthCipher {
+	return &AuthCipher{password: password, scryptParams: scryptParams}
+}
+
+// ChangePassword runs reencrypt, which must re-encrypt the AuthConfigs with the key derived from password, and
+// switches to that key if it succeeds. AuthConfig writes wait until the key is switched, see lockWrites.
+func (c *AuthCipher) ChangePassword(password string, reencrypt func() error) error {
+	c.rotateMu.Lock()
+	defer c.rotateMu.Unlock()
+	if err := reencrypt(); err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.password = password
+	c.aead = nil
+	return nil
+}
+
+// lockWrites must be held while an AuthConfig encrypted with the current key is written, and returns the func
+// releasing it.
+func (c *AuthCipher) lockWrites() func() {
+	c.rotateMu.RLock()
+	return c.rotateMu.RUnlock
+}
+
+func (c *AuthCipher) init() (cipher.AEAD, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aead != nil {
+		return c.aead, nil
+	}
+	if c.password == "" {
+		return nil, pkgerrors.New("bridge auth encryption requires a keystore password")
+	}
+	key, err := scrypt.Key([]byte(c.password), []byte(authCipherSalt), c.scryptParams.N, 8, c.scryptParams.P, 32)
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to derive bridge auth encryption key")
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	if c.aead, err = cipher.NewGCM(block); err != nil {
+		return nil, err
+	}
+	return c.aead, nil
+}
+
+// Encrypt returns the nonce-prefixed ciphertext of the JSON encoded config.
+func (c *AuthCipher) Encrypt(cfg *AuthConfig) ([]byte, error) {
+	aead, err := c.init()
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, []byte(authCipherSalt)), nil
+}
+
+// Decrypt reverses Encrypt.
+func (c *AuthCipher) Decrypt(ciphertext []byte) (*AuthConfig, error) {
+	aead, err := c.init()
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, pkgerrors.New("bridge auth config ciphertext is too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, []byte(authCipherSalt))
+	if err != nil {
+		return nil, pkgerrors.Wrap(err, "failed to decrypt bridge auth config")
+	}
+	var cfg AuthConfig
+	if err = json.Unmarshal(plaintext, &cfg); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
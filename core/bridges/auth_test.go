@@ -0,0 +1,269 @@
+package bridges_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/csakey"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+type csaKeyStore map[string]csakey.KeyV2
+
+func (ks csaKeyStore) Get(id string) (csakey.KeyV2, error) {
+	key, ok := ks[id]
+	if !ok {
+		return csakey.KeyV2{}, fmt.Errorf("unable to find CSA key with id %s", id)
+	}
+	return key, nil
+}
+
+func selfSignedCertificate(t *testing.T, key csakey.KeyV2) string {
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "chainlink-node"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.PublicKey, ed25519.PrivateKey(key.Raw()))
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func TestAuthConfig_Validate(t *testing.T) {
+	t.Parallel()
+
+	key := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	cert := selfSignedCertificate(t, key)
+
+	tests := []struct {
+		name   string
+		cfg    *bridges.AuthConfig
+		errMsg string
+	}{
+		{"nil", nil, ""},
+		{"none", &bridges.AuthConfig{Type: bridges.AuthTypeNone}, ""},
+		{"bearer", &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: "t"}}, ""},
+		{"bearer without token", &bridges.AuthConfig{Type: bridges.AuthTypeBearer}, "bearer auth requires a token"},
+		{"oauth2", &bridges.AuthConfig{Type: bridges.AuthTypeOAuth2, OAuth2: &bridges.OAuth2Auth{TokenURL: "https://auth.example.com/token", ClientID: "id", ClientSecret: "secret"}}, ""},
+		{"oauth2 bad token url", &bridges.AuthConfig{Type: bridges.AuthTypeOAuth2, OAuth2: &bridges.OAuth2Auth{TokenURL: "auth.example.com", ClientID: "id", ClientSecret: "secret"}}, "valid tokenURL"},
+		{"oauth2 without secret", &bridges.AuthConfig{Type: bridges.AuthTypeOAuth2, OAuth2: &bridges.OAuth2Auth{TokenURL: "https://auth.example.com/token", ClientID: "id"}}, "clientID and clientSecret"},
+		{"mtls", &bridges.AuthConfig{Type: bridges.AuthTypeMTLS, MTLS: &bridges.MTLSAuth{Certificate: cert, CSAKeyID: key.ID()}}, ""},
+		{"mtls bad certificate", &bridges.AuthConfig{Type: bridges.AuthTypeMTLS, MTLS: &bridges.MTLSAuth{Certificate: "not a cert", CSAKeyID: key.ID()}}, "valid certificate"},
+		{"hmac", &bridges.AuthConfig{Type: bridges.AuthTypeHMAC, HMAC: &bridges.HMACAuth{Secret: "s"}}, ""},
+		{"hmac without secret", &bridges.AuthConfig{Type: bridges.AuthTypeHMAC, HMAC: &bridges.HMACAuth{KeyID: "k"}}, "hmac auth requires a secret"},
+		{"unknown", &bridges.AuthConfig{Type: "basic"}, `unknown auth type "basic"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.errMsg)
+			}
+		})
+	}
+}
+
+func TestAuthCipher(t *testing.T) {
+	t.Parallel()
+
+	cfg := &bridges.AuthConfig{Type: bridges.AuthTypeOAuth2, OAuth2: &bridges.OAuth2Auth{
+		TokenURL: "https://auth.example.com/token", ClientID: "id", ClientSecret: "very-secret", Scopes: []string{"prices"},
+	}}
+	cipher := bridges.NewAuthCipher("password", utils.FastScryptParams)
+
+	ciphertext, err := cipher.Encrypt(cfg)
+	require.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), "very-secret")
+
+	decrypted, err := cipher.Decrypt(ciphertext)
+	require.NoError(t, err)
+	assert.Equal(t, cfg, decrypted)
+
+	_, err = bridges.NewAuthCipher("other-password", utils.FastScryptParams).Decrypt(ciphertext)
+	require.ErrorContains(t, err, "failed to decrypt bridge auth config")
+
+	_, err = bridges.NewAuthCipher("", utils.FastScryptParams).Encrypt(cfg)
+	require.Error(t, err)
+
+	// the key is kept if re-encryption fails
+	require.ErrorContains(t, cipher.ChangePassword("other-password", func() error { return errors.New("boom") }), "boom")
+	_, err = cipher.Decrypt(ciphertext)
+	require.NoError(t, err)
+
+	require.NoError(t, cipher.ChangePassword("other-password", func() error { return nil }))
+	_, err = cipher.Decrypt(ciphertext)
+	require.ErrorContains(t, err, "failed to decrypt bridge auth config")
+}
+
+func newAuthBridge(t *testing.T, url string, cfg *bridges.AuthConfig) bridges.BridgeType {
+	return bridges.BridgeType{Name: "auth-bridge", URL: cltest.WebURL(t, url), AuthConfig: cfg, UpdatedAt: time.Now()}
+}
+
+func TestAuthenticator_Bearer(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	bt := newAuthBridge(t, server.URL, &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: "token"}})
+	client, err := bridges.NewAuthenticator(nil).Client(bt, server.Client())
+	require.NoError(t, err)
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAuthenticator_None(t *testing.T) {
+	t.Parallel()
+
+	base := &http.Client{}
+	client, err := bridges.NewAuthenticator(nil).Client(bridges.BridgeType{Name: "plain"}, base)
+	require.NoError(t, err)
+	assert.Same(t, base, client)
+}
+
+func TestAuthenticator_HMAC(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"data":{}}`, string(body))
+		assert.Equal(t, "key-1", r.Header.Get(bridges.HMACKeyIDHeader))
+		timestamp := r.Header.Get(bridges.HMACTimestampHeader)
+		assert.Equal(t, bridges.HMACSignature("secret", timestamp, r.Method, r.URL.EscapedPath(), body), r.Header.Get(bridges.HMACSignatureHeader))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	bt := newAuthBridge(t, server.URL, &bridges.AuthConfig{Type: bridges.AuthTypeHMAC, HMAC: &bridges.HMACAuth{KeyID: "key-1", Secret: "secret"}})
+	client, err := bridges.NewAuthenticator(nil).Client(bt, server.Client())
+	require.NoError(t, err)
+
+	resp, err := client.Post(server.URL+"/price", "application/json", strings.NewReader(`{"data":{}}`))
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestAuthenticator_OAuth2(t *testing.T) {
+	t.Parallel()
+
+	var tokensIssued atomic.Int32
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, secret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "id", id)
+		assert.Equal(t, "secret", secret)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "prices feeds", r.PostForm.Get("scope"))
+		n := tokensIssued.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":3600}`, n)
+	}))
+	defer tokenServer.Close()
+
+	var revoked atomic.Bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if revoked.Load() && r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	bt := newAuthBridge(t, server.URL, &bridges.AuthConfig{Type: bridges.AuthTypeOAuth2, OAuth2: &bridges.OAuth2Auth{
+		TokenURL: tokenServer.URL, ClientID: "id", ClientSecret: "secret", Scopes: []string{"prices", "feeds"},
+	}})
+	authenticator := bridges.NewAuthenticator(nil)
+
+	get := func() int {
+		// the client is cached, so the token is shared between calls
+		client, err := authenticator.Client(bt, server.Client())
+		require.NoError(t, err)
+		resp, err := client.Get(server.URL)
+		require.NoError(t, err)
+		require.NoError(t, resp.Body.Close())
+		return resp.StatusCode
+	}
+
+	assert.Equal(t, http.StatusOK, get())
+	assert.Equal(t, http.StatusOK, get())
+	assert.Equal(t, int32(1), tokensIssued.Load())
+
+	// a rejected token is refreshed on the next request
+	revoked.Store(true)
+	assert.Equal(t, http.StatusUnauthorized, get())
+	assert.Equal(t, http.StatusOK, get())
+	assert.Equal(t, int32(2), tokensIssued.Load())
+}
+
+func TestAuthenticator_MTLS(t *testing.T) {
+	t.Parallel()
+
+	key := csakey.MustNewV2XXXTestingOnly(big.NewInt(1))
+	other := csakey.MustNewV2XXXTestingOnly(big.NewInt(2))
+	ks := csaKeyStore{key.ID(): key, other.ID(): other}
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if assert.Len(t, r.TLS.PeerCertificates, 1) {
+			assert.Equal(t, key.PublicKey, r.TLS.PeerCertificates[0].PublicKey)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert, MinVersion: tls.VersionTLS12}
+	server.StartTLS()
+	defer server.Close()
+
+	authenticator := bridges.NewAuthenticator(ks)
+	bt := newAuthBridge(t, server.URL, &bridges.AuthConfig{Type: bridges.AuthTypeMTLS, MTLS: &bridges.MTLSAuth{
+		Certificate: selfSignedCertificate(t, key), CSAKeyID: key.ID(),
+	}})
+	client, err := authenticator.Client(bt, server.Client())
+	require.NoError(t, err)
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	t.Run("certificate for another key", func(t *testing.T) {
+		bt := newAuthBridge(t, server.URL, &bridges.AuthConfig{Type: bridges.AuthTypeMTLS, MTLS: &bridges.MTLSAuth{
+			Certificate: selfSignedCertificate(t, key), CSAKeyID: other.ID(),
+		}})
+		bt.Name = "other-bridge"
+		_, err := authenticator.Client(bt, server.Client())
+		require.ErrorContains(t, err, "certificate was not issued for CSA key")
+	})
+}
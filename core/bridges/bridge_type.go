@@ -21,6 +21,9 @@ type BridgeTypeRequest struct {
 	URL                    models.WebURL `json:"url"`
 	Confirmations          uint32        `json:"confirmations"`
 	MinimumContractPayment *assets.Link  `json:"minimumContractPayment"`
+	// AuthConfig is optional. On update, a nil AuthConfig leaves the existing one
+	// unchanged, while type "none" removes it.
+	AuthConfig *AuthConfig `json:"authConfig,omitempty"`
 }
 
 // GetID returns the ID of this structure for jsonapi serialization.
@@ -62,6 +65,9 @@ type BridgeType struct {
 	MinimumContractPayment *assets.Link
 	CreatedAt              time.Time
 	UpdatedAt              time.Time
+	// AuthConfig is decrypted from EncryptedAuthConfig by the ORM.
+	AuthConfig          *AuthConfig `db:"-"`
+	EncryptedAuthConfig []byte      `db:"auth_config"`
 }
 
 // NewBridgeType returns a bridge type authentication (with plaintext
@@ -92,6 +98,7 @@ func NewBridgeType(btr *BridgeTypeRequest) (*BridgeTypeAuthentication,
 			Salt:                   salt,
 			OutgoingToken:          outgoingToken,
 			MinimumContractPayment: btr.MinimumContractPayment,
+			AuthConfig:             btr.AuthConfig,
 		}, nil
 }
 
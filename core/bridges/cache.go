@@ -52,7 +52,7 @@ func NewCache(base ORM, lggr logger.Logger, upsertInterval time.Duration) *Cache
 }
 
 func (c *Cache) WithDataSource(ds sqlutil.DataSource) ORM {
-	return NewCache(NewORM(ds), c.eng, c.interval)
+	return NewCache(c.ORM.WithDataSource(ds), c.eng, c.interval)
 }
 
 func (c *Cache) FindBridge(ctx context.Context, name BridgeName) (BridgeType, error) {
@@ -758,6 +758,53 @@ func (_c *ORM_GetCachedResponseWithFinished_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// ReencryptAuthConfigs provides a mock function with given fields: ctx, newCipher
+func (_m *ORM) ReencryptAuthConfigs(ctx context.Context, newCipher *bridges.AuthCipher) error {
+	ret := _m.Called(ctx, newCipher)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReencryptAuthConfigs")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *bridges.AuthCipher) error); ok {
+		r0 = rf(ctx, newCipher)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ORM_ReencryptAuthConfigs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReencryptAuthConfigs'
+type ORM_ReencryptAuthConfigs_Call struct {
+	*mock.Call
+}
+
+// ReencryptAuthConfigs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newCipher *bridges.AuthCipher
+func (_e *ORM_Expecter) ReencryptAuthConfigs(ctx interface{}, newCipher interface{}) *ORM_ReencryptAuthConfigs_Call {
+	return &ORM_ReencryptAuthConfigs_Call{Call: _e.mock.On("ReencryptAuthConfigs", ctx, newCipher)}
+}
+
+func (_c *ORM_ReencryptAuthConfigs_Call) Run(run func(ctx context.Context, newCipher *bridges.AuthCipher)) *ORM_ReencryptAuthConfigs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*bridges.AuthCipher))
+	})
+	return _c
+}
+
+func (_c *ORM_ReencryptAuthConfigs_Call) Return(_a0 error) *ORM_ReencryptAuthConfigs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ORM_ReencryptAuthConfigs_Call) RunAndReturn(run func(context.Context, *bridges.AuthCipher) error) *ORM_ReencryptAuthConfigs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateBridgeType provides a mock function with given fields: ctx, bt, btr
 func (_m *ORM) UpdateBridgeType(ctx context.Context, bt *bridges.BridgeType, btr *bridges.BridgeTypeRequest) error {
 	ret := _m.Called(ctx, bt, btr)
@@ -20,6 +20,7 @@ type ORM interface {
 	BridgeTypes(ctx context.Context, offset int, limit int) ([]BridgeType, int, error)
 	CreateBridgeType(ctx context.Context, bt *BridgeType) error
 	UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error
+	ReencryptAuthConfigs(ctx context.Context, newCipher *AuthCipher) error
 
 	GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error)
 	UpsertBridgeResponse(ctx context.Context, dotId string, specId int32, response []byte) error
@@ -37,20 +38,63 @@ type ORM interface {
 }
 
 type orm struct {
-	ds sqlutil.DataSource
+	ds         sqlutil.DataSource
+	authCipher *AuthCipher
 }
 
 var _ ORM = (*orm)(nil)
 
+// NewORM returns an ORM which cannot store bridge AuthConfigs, see NewORMWithAuthCipher.
 func NewORM(ds sqlutil.DataSource) ORM {
 	return &orm{ds: ds}
 }
 
-func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM { return NewORM(ds) }
+// NewORMWithAuthCipher returns an ORM which encrypts bridge AuthConfigs at rest with authCipher.
+func NewORMWithAuthCipher(ds sqlutil.DataSource, authCipher *AuthCipher) ORM {
+	return &orm{ds: ds, authCipher: authCipher}
+}
+
+func (o *orm) WithDataSource(ds sqlutil.DataSource) ORM {
+	return NewORMWithAuthCipher(ds, o.authCipher)
+}
 
 func (o *orm) transact(ctx context.Context, readOnly bool, fn func(tx *orm) error) error {
 	opts := sqlutil.TxOptions{TxOptions: sql.TxOptions{ReadOnly: readOnly}}
-	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *orm { return &orm{ds: ds} }, o.ds, &opts, fn)
+	return sqlutil.Transact(ctx, func(ds sqlutil.DataSource) *orm { return &orm{ds: ds, authCipher: o.authCipher} }, o.ds, &opts, fn)
+}
+
+func (o *orm) encryptAuthConfig(cfg *AuthConfig) ([]byte, error) {
+	if cfg.IsNone() {
+		return nil, nil
+	}
+	if o.authCipher == nil {
+		return nil, pkgerrors.New("bridge auth configs cannot be stored: no encryption key is configured")
+	}
+	return o.authCipher.Encrypt(cfg)
+}
+
+// lockAuthConfigWrites must be held while an encrypted AuthConfig is written, see AuthCipher.lockWrites.
+func (o *orm) lockAuthConfigWrites() func() {
+	if o.authCipher == nil {
+		return func() {}
+	}
+	return o.authCipher.lockWrites()
+}
+
+func (o *orm) decryptAuthConfig(bt *BridgeType) error {
+	bt.AuthConfig = nil
+	if len(bt.EncryptedAuthConfig) == 0 {
+		return nil
+	}
+	if o.authCipher == nil {
+		return pkgerrors.Errorf("cannot decrypt auth config of bridge %s: no encryption key is configured", bt.Name)
+	}
+	cfg, err := o.authCipher.Decrypt(bt.EncryptedAuthConfig)
+	if err != nil {
+		return pkgerrors.Wrapf(err, "bridge %s", bt.Name)
+	}
+	bt.AuthConfig = cfg
+	return nil
 }
 
 // FindBridge looks up a Bridge by its Name.
@@ -58,6 +102,9 @@ func (o *orm) transact(ctx context.Context, readOnly bool, fn func(tx *orm) erro
 func (o *orm) FindBridge(ctx context.Context, name BridgeName) (bt BridgeType, err error) {
 	stmt := "SELECT * FROM bridge_types WHERE name = $1"
 	err = o.ds.GetContext(ctx, &bt, stmt, name.String())
+	if err == nil {
+		err = o.decryptAuthConfig(&bt)
+	}
 
 	return
 }
@@ -81,6 +128,11 @@ func (o *orm) FindBridges(ctx context.Context, names []BridgeName) ([]BridgeType
 	if len(bts) != len(names) {
 		return nil, pkgerrors.Errorf("not all bridges exist, asked for %v, exists %v", names, bts)
 	}
+	for i := range bts {
+		if err = o.decryptAuthConfig(&bts[i]); err != nil {
+			return nil, err
+		}
+	}
 
 	return bts, nil
 }
@@ -116,6 +168,11 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 		if err = tx.ds.SelectContext(ctx, &bridges, sql, limit, offset); err != nil {
 			return pkgerrors.Wrap(err, "BridgeTypes failed to load bridge_types")
 		}
+		for i := range bridges {
+			if err = tx.decryptAuthConfig(&bridges[i]); err != nil {
+				return err
+			}
+		}
 		return nil
 	})
 
@@ -124,16 +181,25 @@ func (o *orm) BridgeTypes(ctx context.Context, offset int, limit int) (bridges [
 
 // CreateBridgeType saves the bridge type.
 func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
-	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, created_at, updated_at)
-	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, now(), now())
+	stmt := `INSERT INTO bridge_types (name, url, confirmations, incoming_token_hash, salt, outgoing_token, minimum_contract_payment, auth_config, created_at, updated_at)
+	VALUES (:name, :url, :confirmations, :incoming_token_hash, :salt, :outgoing_token, :minimum_contract_payment, :auth_config, now(), now())
 	RETURNING *;`
-	err := o.transact(ctx, false, func(tx *orm) error {
+	defer o.lockAuthConfigWrites()()
+	encrypted, err := o.encryptAuthConfig(bt.AuthConfig)
+	if err != nil {
+		return pkgerrors.Wrap(err, "CreateBridgeType failed")
+	}
+	bt.EncryptedAuthConfig = encrypted
+	err = o.transact(ctx, false, func(tx *orm) error {
 		stmt, err := tx.ds.PrepareNamedContext(ctx, stmt)
 		if err != nil {
 			return err
 		}
 		defer stmt.Close()
-		return stmt.GetContext(ctx, bt, bt)
+		if err = stmt.GetContext(ctx, bt, bt); err != nil {
+			return err
+		}
+		return tx.decryptAuthConfig(bt)
 	})
 
 	return pkgerrors.Wrap(err, "CreateBridgeType failed")
@@ -141,10 +207,46 @@ func (o *orm) CreateBridgeType(ctx context.Context, bt *BridgeType) error {
 
 // UpdateBridgeType updates the bridge type.
 func (o *orm) UpdateBridgeType(ctx context.Context, bt *BridgeType, btr *BridgeTypeRequest) error {
-	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3 WHERE name = $4 RETURNING *"
-	err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name)
+	if btr.AuthConfig == nil {
+		stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, updated_at = now() WHERE name = $4 RETURNING *"
+		if err := o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, bt.Name); err != nil {
+			return err
+		}
+		return o.decryptAuthConfig(bt)
+	}
 
-	return err
+	defer o.lockAuthConfigWrites()()
+	encrypted, err := o.encryptAuthConfig(btr.AuthConfig)
+	if err != nil {
+		return err
+	}
+	stmt := "UPDATE bridge_types SET url = $1, confirmations = $2, minimum_contract_payment = $3, auth_config = $4, updated_at = now() WHERE name = $5 RETURNING *"
+	if err = o.ds.GetContext(ctx, bt, stmt, btr.URL, btr.Confirmations, btr.MinimumContractPayment, encrypted, bt.Name); err != nil {
+		return err
+	}
+	return o.decryptAuthConfig(bt)
+}
+
+// ReencryptAuthConfigs re-encrypts the AuthConfigs of all the bridges with newCipher, when the keystore password
+// changes. It is meant to run in the transaction saving the re-encrypted keystore, see WithDataSource.
+func (o *orm) ReencryptAuthConfigs(ctx context.Context, newCipher *AuthCipher) error {
+	var bts []BridgeType
+	if err := o.ds.SelectContext(ctx, &bts, "SELECT * FROM bridge_types WHERE auth_config IS NOT NULL"); err != nil {
+		return pkgerrors.Wrap(err, "failed to load bridge auth configs")
+	}
+	for i := range bts {
+		if err := o.decryptAuthConfig(&bts[i]); err != nil {
+			return err
+		}
+		encrypted, err := newCipher.Encrypt(bts[i].AuthConfig)
+		if err != nil {
+			return pkgerrors.Wrapf(err, "bridge %s", bts[i].Name)
+		}
+		if _, err = o.ds.ExecContext(ctx, "UPDATE bridge_types SET auth_config = $1 WHERE name = $2", encrypted, bts[i].Name); err != nil {
+			return pkgerrors.Wrapf(err, "failed to save auth config of bridge %s", bts[i].Name)
+		}
+	}
+	return nil
 }
 
 func (o *orm) GetCachedResponse(ctx context.Context, dotId string, specId int32, maxElapsed time.Duration) ([]byte, error) {
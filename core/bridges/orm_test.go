@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func setupORM(t *testing.T) (*sqlx.DB, bridges.ORM) {
@@ -137,6 +138,69 @@ func TestORM_UpdateBridgeType(t *testing.T) {
 	require.Len(t, bs, 0)
 }
 
+func TestORM_BridgeTypeAuthConfig(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := bridges.NewORMWithAuthCipher(db, bridges.NewAuthCipher("password", utils.FastScryptParams))
+
+	bt := &bridges.BridgeType{
+		Name:       "AuthBridge",
+		URL:        cltest.WebURL(t, "https://ea.example.com"),
+		AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: "secret-token"}},
+	}
+	require.NoError(t, orm.CreateBridgeType(ctx, bt))
+	require.NotEmpty(t, bt.EncryptedAuthConfig)
+	require.NotContains(t, string(bt.EncryptedAuthConfig), "secret-token")
+
+	found, err := orm.FindBridge(ctx, "AuthBridge")
+	require.NoError(t, err)
+	require.Equal(t, bt.AuthConfig, found.AuthConfig)
+
+	// a nil AuthConfig leaves the existing config in place
+	require.NoError(t, orm.UpdateBridgeType(ctx, &found, &bridges.BridgeTypeRequest{URL: cltest.WebURL(t, "https://ea2.example.com")}))
+	require.Equal(t, bt.AuthConfig, found.AuthConfig)
+
+	require.NoError(t, orm.UpdateBridgeType(ctx, &found, &bridges.BridgeTypeRequest{URL: found.URL, AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeNone}}))
+	require.Nil(t, found.AuthConfig)
+	require.Empty(t, found.EncryptedAuthConfig)
+
+	t.Run("without a cipher", func(t *testing.T) {
+		_, plain := setupORM(t)
+		err := plain.CreateBridgeType(ctx, &bridges.BridgeType{
+			Name:       "AuthBridge2",
+			URL:        cltest.WebURL(t, "https://ea.example.com"),
+			AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeHMAC, HMAC: &bridges.HMACAuth{Secret: "s"}},
+		})
+		require.Error(t, err)
+	})
+}
+
+func TestORM_ReencryptAuthConfigs(t *testing.T) {
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	cipher := bridges.NewAuthCipher("password", utils.FastScryptParams)
+	orm := bridges.NewORMWithAuthCipher(db, cipher)
+
+	authConfig := &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: "secret-token"}}
+	require.NoError(t, orm.CreateBridgeType(ctx, &bridges.BridgeType{Name: "AuthBridge", URL: cltest.WebURL(t, "https://ea.example.com"), AuthConfig: authConfig}))
+	require.NoError(t, orm.CreateBridgeType(ctx, &bridges.BridgeType{Name: "PlainBridge", URL: cltest.WebURL(t, "https://ea.example.com")}))
+
+	require.NoError(t, cipher.ChangePassword("new-password", func() error {
+		require.NoError(t, orm.ReencryptAuthConfigs(ctx, bridges.NewAuthCipher("new-password", utils.FastScryptParams)))
+
+		_, err := orm.FindBridge(ctx, "AuthBridge")
+		require.ErrorContains(t, err, "failed to decrypt bridge auth config")
+		return nil
+	}))
+
+	found, err := orm.FindBridge(ctx, "AuthBridge")
+	require.NoError(t, err)
+	require.Equal(t, authConfig, found.AuthConfig)
+	found, err = orm.FindBridge(ctx, "PlainBridge")
+	require.NoError(t, err)
+	require.Nil(t, found.AuthConfig)
+}
+
 func TestORM_TestCachedResponse(t *testing.T) {
 	ctx := testutils.Context(t)
 	cfg := configtest.NewGeneralConfig(t, nil)
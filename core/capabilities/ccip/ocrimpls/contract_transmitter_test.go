@@ -623,6 +623,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Forecast() evmconfig.Forecast {
+	return &TestForecastConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 1e6 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 2 }
@@ -675,6 +679,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestForecastConfig struct {
+	evmconfig.Forecast
+}
+
 type transactionsConfig struct {
 	evmconfig.Transactions
 	e         *TestEvmConfig
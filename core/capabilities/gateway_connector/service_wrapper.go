@@ -77,6 +77,9 @@ func (e *ServiceWrapper) Start(ctx context.Context) error {
 		if idx == -1 {
 			return errors.New("key for configured node address not found")
 		}
+		if enabledKeys[idx].IsExternal() {
+			return errors.New("key for configured node address is held by the external signer")
+		}
 		e.signerKey = enabledKeys[idx].ToEcdsaPrivKey()
 		if enabledKeys[idx].ID() != nodeAddress {
 			return errors.New("node address mismatch")
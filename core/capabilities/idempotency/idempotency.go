@@ -0,0 +1,25 @@
+package idempotency
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+)
+
+// ConfigField is the reserved config field the workflow engine sets on requests to actions and targets. A step
+// executed again after a restart carries the same key, so that capabilities with side effects can deduplicate it.
+const ConfigField = "cre_idempotency_key"
+
+// Key returns the idempotency key of the request, and false if the workflow engine did not set one.
+func Key(req capabilities.CapabilityRequest) (string, bool) {
+	if req.Config == nil {
+		return "", false
+	}
+	v, ok := req.Config.Underlying[ConfigField]
+	if !ok {
+		return "", false
+	}
+	var key string
+	if err := v.UnwrapTo(&key); err != nil || key == "" {
+		return "", false
+	}
+	return key, true
+}
@@ -20,6 +20,7 @@ import (
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/idempotency"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
 )
 
@@ -217,6 +218,20 @@ func evaluate(rawRequest capabilities.CapabilityRequest) (r Request, err error)
 	return r, nil
 }
 
+// transactionID returns the ID to submit the transaction with. CW expects us to generate an ID, rather than return
+// one, and the ID doubles as the idempotency key of the transaction, so a request executed again by the workflow
+// engine with the same idempotency key gets back the transaction submitted the first time.
+func transactionID(request capabilities.CapabilityRequest) (string, error) {
+	if key, ok := idempotency.Key(request); ok {
+		return "write-target-" + key, nil
+	}
+	txID, err := uuid.NewUUID()
+	if err != nil {
+		return "", err
+	}
+	return txID.String(), nil
+}
+
 func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
 	// Bind to the contract address on the write path.
 	// Bind() requires a connection to the node's RPCs and
@@ -281,7 +296,7 @@ func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.Cap
 		return capabilities.CapabilityResponse{}, fmt.Errorf("unexpected transmission state: %v", transmissionInfo.State)
 	}
 
-	txID, err := uuid.NewUUID() // NOTE: CW expects us to generate an ID, rather than return one
+	txID, err := transactionID(rawRequest)
 	if err != nil {
 		return capabilities.CapabilityResponse{}, err
 	}
@@ -315,12 +330,12 @@ func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.Cap
 	}
 
 	value := big.NewInt(0)
-	if err := cap.cw.SubmitTransaction(ctx, "forwarder", "report", req, txID.String(), cap.forwarderAddress, &meta, value); err != nil {
+	if err := cap.cw.SubmitTransaction(ctx, "forwarder", "report", req, txID, cap.forwarderAddress, &meta, value); err != nil {
 		if !commontypes.ErrSettingTransactionGasLimitNotSupported.Is(err) {
 			return capabilities.CapabilityResponse{}, fmt.Errorf("failed to submit transaction: %w", err)
 		}
 		meta.GasLimit = nil
-		if err := cap.cw.SubmitTransaction(ctx, "forwarder", "report", req, txID.String(), cap.forwarderAddress, &meta, value); err != nil {
+		if err := cap.cw.SubmitTransaction(ctx, "forwarder", "report", req, txID, cap.forwarderAddress, &meta, value); err != nil {
 			return capabilities.CapabilityResponse{}, fmt.Errorf("failed to submit transaction: %w", err)
 		}
 	}
@@ -334,7 +349,7 @@ func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.Cap
 		case <-ctx.Done():
 			return capabilities.CapabilityResponse{}, nil
 		case <-tick.C:
-			txStatus, err := cap.cw.GetTransactionStatus(ctx, txID.String())
+			txStatus, err := cap.cw.GetTransactionStatus(ctx, txID)
 			if err != nil {
 				cap.lggr.Errorw("Failed to get transaction status", "request", request, "transaction", txID, "err", err)
 				continue
@@ -345,7 +360,7 @@ func (cap *WriteTarget) Execute(ctx context.Context, rawRequest capabilities.Cap
 				return capabilities.CapabilityResponse{}, nil
 			case commontypes.Failed, commontypes.Fatal:
 				cap.lggr.Error("Transaction failed", "request", request, "transaction", txID)
-				msg := "failed to submit transaction with ID: " + txID.String()
+				msg := "failed to submit transaction with ID: " + txID
 				err = cap.emitter.With(
 					platform.KeyWorkflowID, request.Metadata.WorkflowID,
 					platform.KeyWorkflowName, request.Metadata.WorkflowName,
@@ -263,3 +263,74 @@ func TestWriteTarget(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteTarget_IdempotencyKey(t *testing.T) {
+	lggr := logger.TestLogger(t)
+	ctx := context.Background()
+
+	cw := mocks.NewContractWriter(t)
+	cr := mocks.NewContractValueGetter(t)
+
+	forwarderAddr := testutils.NewAddress().Hex()
+	writeTarget := targets.NewWriteTarget(lggr, "test-write-target@1.0.0", cr, cw, forwarderAddr, 400_000)
+
+	reportID := [2]byte{0x00, 0x01}
+	var workflowName [10]byte
+	copy(workflowName[:], []byte("name"))
+	workflowOwner := common.HexToAddress("219BFD3D78fbb740c614432975CBE829E26C490e")
+	reportMetadataBytes, err := targets.ReportV1Metadata{
+		Version:       1,
+		WorkflowName:  workflowName,
+		WorkflowOwner: workflowOwner,
+		ReportID:      reportID,
+	}.Encode()
+	require.NoError(t, err)
+
+	inputs, err := values.NewMap(map[string]any{
+		"signed_report": map[string]any{
+			"report":     reportMetadataBytes,
+			"signatures": [][]byte{},
+			"context":    []byte{4, 5},
+			"id":         reportID[:],
+		},
+	})
+	require.NoError(t, err)
+
+	config, err := values.NewMap(map[string]any{
+		"Address":             forwarderAddr,
+		"cre_idempotency_key": "step-request-id",
+	})
+	require.NoError(t, err)
+
+	req := capabilities.CapabilityRequest{
+		Metadata: capabilities.RequestMetadata{
+			WorkflowID:          hex.EncodeToString(make([]byte, 32)),
+			WorkflowOwner:       "219BFD3D78fbb740c614432975CBE829E26C490e",
+			WorkflowName:        hex.EncodeToString(workflowName[:]),
+			WorkflowExecutionID: hex.EncodeToString(make([]byte, 32)),
+		},
+		Config: config,
+		Inputs: inputs,
+	}
+
+	cr.On("Bind", mock.Anything, mock.Anything).Return(nil)
+	cr.EXPECT().GetLatestValue(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(_ context.Context, _ string, _ primitives.ConfidenceLevel, _, retVal any) {
+		*retVal.(*targets.TransmissionInfo) = targets.TransmissionInfo{GasLimit: big.NewInt(0), State: 0}
+	})
+
+	var txIDs []string
+	cw.On("SubmitTransaction", mock.Anything, "forwarder", "report", mock.Anything, mock.Anything, forwarderAddr, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		txIDs = append(txIDs, args.String(4))
+	}).Twice()
+	cw.On("GetTransactionStatus", mock.Anything, mock.Anything).Return(types.Finalized, nil).Twice()
+
+	// the step is executed again after a restart, with the same idempotency key
+	for range 2 {
+		_, err = writeTarget.Execute(ctx, req)
+		require.NoError(t, err)
+	}
+
+	require.Len(t, txIDs, 2)
+	require.Equal(t, txIDs[0], txIDs[1])
+	cw.AssertCalled(t, "GetTransactionStatus", mock.Anything, txIDs[0])
+}
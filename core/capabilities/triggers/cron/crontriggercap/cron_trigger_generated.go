@@ -0,0 +1,72 @@
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+package crontriggercap
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+type Config struct {
+	// Schedule corresponds to the JSON schema field "schedule".
+	Schedule string `json:"schedule" yaml:"schedule" mapstructure:"schedule"`
+
+	// Timezone corresponds to the JSON schema field "timezone".
+	Timezone *string `json:"timezone,omitempty" yaml:"timezone,omitempty" mapstructure:"timezone,omitempty"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *Config) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if _, ok := raw["schedule"]; raw != nil && !ok {
+		return fmt.Errorf("field schedule in Config: required")
+	}
+	type Plain Config
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	if len(plain.Schedule) < 1 {
+		return fmt.Errorf("field %s length: must be >= %d", "schedule", 1)
+	}
+	*j = Config(plain)
+	return nil
+}
+
+type Payload struct {
+	// ScheduledExecutionTime corresponds to the JSON schema field
+	// "ScheduledExecutionTime".
+	ScheduledExecutionTime string `json:"ScheduledExecutionTime" yaml:"ScheduledExecutionTime" mapstructure:"ScheduledExecutionTime"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (j *Payload) UnmarshalJSON(b []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	if _, ok := raw["ScheduledExecutionTime"]; raw != nil && !ok {
+		return fmt.Errorf("field ScheduledExecutionTime in Payload: required")
+	}
+	type Plain Payload
+	var plain Plain
+	if err := json.Unmarshal(b, &plain); err != nil {
+		return err
+	}
+	if len(plain.ScheduledExecutionTime) < 1 {
+		return fmt.Errorf("field %s length: must be >= %d", "ScheduledExecutionTime", 1)
+	}
+	*j = Payload(plain)
+	return nil
+}
+
+type Trigger struct {
+	// Config corresponds to the JSON schema field "Config".
+	Config *Config `json:"Config,omitempty" yaml:"Config,omitempty" mapstructure:"Config,omitempty"`
+
+	// Outputs corresponds to the JSON schema field "Outputs".
+	Outputs *Payload `json:"Outputs,omitempty" yaml:"Outputs,omitempty" mapstructure:"Outputs,omitempty"`
+}
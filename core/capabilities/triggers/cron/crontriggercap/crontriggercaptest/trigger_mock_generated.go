@@ -0,0 +1,17 @@
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+// Code generated by github.com/smartcontractkit/chainlink-common/pkg/capabilities/cli, DO NOT EDIT.
+
+package crontriggercaptest
+
+import (
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/crontriggercap"
+)
+
+// Trigger registers a new capability mock with the runner
+func Trigger(runner *testutils.Runner, fn func() (crontriggercap.Payload, error)) *testutils.TriggerMock[crontriggercap.Payload] {
+	mock := testutils.MockTrigger[crontriggercap.Payload]("cron-trigger@1.0.0", fn)
+	runner.MockCapability("cron-trigger@1.0.0", nil, mock)
+	return mock
+}
@@ -0,0 +1,293 @@
+package cron
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/robfig/cron/v3"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/crontriggercap"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const ID = "cron-trigger@1.0.0"
+
+const defaultSendChannelBufferSize = 1000
+
+// defaultFastestScheduleInterval is the shortest interval between two ticks of a schedule, unless configured otherwise.
+const defaultFastestScheduleInterval = 30 * time.Second
+
+// maxCheckedTicks bounds the number of upcoming ticks of a schedule whose intervals are checked on registration.
+const maxCheckedTicks = 10_000
+
+var cronTriggerInfo = capabilities.MustNewCapabilityInfo(
+	ID,
+	capabilities.CapabilityTypeTrigger,
+	"A trigger that starts a workflow execution on a cron schedule",
+)
+
+// parser accepts the same schedules as cron jobs, with an optional seconds field.
+var parser = cron.NewParser(cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+
+// Config is the capability level config, shared by the triggers of all workflows.
+type Config struct {
+	// FastestScheduleIntervalSeconds is the shortest interval between two ticks of a schedule which can be registered.
+	FastestScheduleIntervalSeconds uint32 `toml:"fastestScheduleIntervalSeconds" json:"fastestScheduleIntervalSeconds"`
+}
+
+func (c Config) fastestScheduleInterval() time.Duration {
+	if c.FastestScheduleIntervalSeconds == 0 {
+		return defaultFastestScheduleInterval
+	}
+	return time.Duration(c.FastestScheduleIntervalSeconds) * time.Second
+}
+
+type cronTrigger struct {
+	ch       chan capabilities.TriggerResponse
+	schedule cron.Schedule
+	location *time.Location
+	stopCh   services.StopChan
+	done     chan struct{}
+}
+
+// next returns the first tick of the schedule strictly after the given time.
+// Ticks only depend on the schedule, and not on when the trigger was registered, so that every node of a DON ticks at
+// the same times. In particular, @every schedules are aligned to multiples of their interval.
+func (t *cronTrigger) next(after time.Time) time.Time {
+	if every, ok := t.schedule.(cron.ConstantDelaySchedule); ok {
+		return after.Truncate(every.Delay).Add(every.Delay)
+	}
+	return t.schedule.Next(after.In(t.location))
+}
+
+// shortestInterval returns the shortest interval between the ticks of the schedule starting at first, over the next
+// year or the next maxCheckedTicks ticks, whichever comes first. Intervals of a schedule can be uneven, e.g.
+// "0,1 * * * *" ticks one minute and then 59 minutes apart.
+func (t *cronTrigger) shortestInterval(first time.Time) time.Duration {
+	shortest := time.Duration(math.MaxInt64)
+	end := first.AddDate(1, 0, 0)
+	for i, tick := 0, first; i < maxCheckedTicks && tick.Before(end); i++ {
+		next := t.next(tick)
+		if next.IsZero() {
+			// no tick within the next five years
+			break
+		}
+		shortest = min(shortest, next.Sub(tick))
+		tick = next
+	}
+	return shortest
+}
+
+// TriggerService is the cron trigger capability, which sends a trigger event to each registered workflow on every
+// tick of its schedule.
+type TriggerService struct {
+	services.StateMachine
+	capabilities.CapabilityInfo
+	capabilities.Validator[crontriggercap.Config, struct{}, crontriggercap.Payload]
+
+	config   Config
+	registry core.CapabilitiesRegistry
+	clock    clockwork.Clock
+	lggr     logger.Logger
+
+	mu       sync.Mutex
+	triggers map[string]*cronTrigger
+}
+
+var _ capabilities.TriggerCapability = (*TriggerService)(nil)
+var _ services.Service = &TriggerService{}
+
+// NewTriggerService creates a new cron trigger capability, which is added to the registry on Start.
+func NewTriggerService(config Config, registry core.CapabilitiesRegistry, clock clockwork.Clock, lggr logger.Logger) *TriggerService {
+	return &TriggerService{
+		CapabilityInfo: cronTriggerInfo,
+		Validator:      capabilities.NewValidator[crontriggercap.Config, struct{}, crontriggercap.Payload](capabilities.ValidatorArgs{Info: cronTriggerInfo}),
+		config:         config,
+		registry:       registry,
+		clock:          clock,
+		lggr:           lggr.Named("CronTriggerService"),
+		triggers:       map[string]*cronTrigger{},
+	}
+}
+
+// parseSchedule parses the schedule of the config, in the location given either by the timezone of the config or by
+// a CRON_TZ prefix of the schedule, and defaulting to UTC.
+func parseSchedule(config *crontriggercap.Config) (cron.Schedule, *time.Location, error) {
+	schedule, err := parser.Parse(config.Schedule)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid schedule %q: %w", config.Schedule, err)
+	}
+
+	location := time.UTC
+	if config.Timezone != nil && *config.Timezone != "" {
+		if strings.HasPrefix(config.Schedule, "CRON_TZ=") || strings.HasPrefix(config.Schedule, "TZ=") {
+			return nil, nil, errors.New("timezone cannot be set both in the schedule and in the config")
+		}
+		location, err = time.LoadLocation(*config.Timezone)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid timezone %q: %w", *config.Timezone, err)
+		}
+	}
+	return schedule, location, nil
+}
+
+func (s *TriggerService) RegisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	if req.Config == nil {
+		return nil, errors.New("config is required to register a cron trigger")
+	}
+	reqConfig, err := s.ValidateConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	schedule, location, err := parseSchedule(reqConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	trigger := &cronTrigger{
+		ch:       make(chan capabilities.TriggerResponse, defaultSendChannelBufferSize),
+		schedule: schedule,
+		location: location,
+		stopCh:   make(services.StopChan),
+		done:     make(chan struct{}),
+	}
+	first := trigger.next(s.clock.Now())
+	if interval := trigger.shortestInterval(first); interval < s.config.fastestScheduleInterval() {
+		return nil, fmt.Errorf("schedule %q has ticks %s apart, which is faster than the fastest allowed interval of %s", reqConfig.Schedule, interval, s.config.fastestScheduleInterval())
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.triggers[req.TriggerID]; ok {
+		return nil, fmt.Errorf("triggerId %s already registered", req.TriggerID)
+	}
+	s.triggers[req.TriggerID] = trigger
+	go s.run(req.TriggerID, trigger, first)
+
+	s.lggr.Infow("RegisterTrigger", "triggerId", req.TriggerID, "workflowID", req.Metadata.WorkflowID, "schedule", reqConfig.Schedule, "location", location)
+	return trigger.ch, nil
+}
+
+func (s *TriggerService) UnregisterTrigger(ctx context.Context, req capabilities.TriggerRegistrationRequest) error {
+	s.mu.Lock()
+	trigger, ok := s.triggers[req.TriggerID]
+	delete(s.triggers, req.TriggerID)
+	s.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("triggerId %s not registered", req.TriggerID)
+	}
+
+	stopTrigger(trigger)
+	s.lggr.Infow("UnregisterTrigger", "triggerId", req.TriggerID, "workflowID", req.Metadata.WorkflowID)
+	return nil
+}
+
+// stopTrigger stops the schedule of the trigger, and closes its channel once nothing can be sent on it anymore.
+func stopTrigger(trigger *cronTrigger) {
+	close(trigger.stopCh)
+	<-trigger.done
+	close(trigger.ch)
+}
+
+// run sends a trigger event on every tick of the schedule, starting with the given one.
+func (s *TriggerService) run(triggerID string, trigger *cronTrigger, next time.Time) {
+	defer close(trigger.done)
+	for {
+		select {
+		case <-trigger.stopCh:
+			return
+		case <-s.clock.After(next.Sub(s.clock.Now())):
+		}
+
+		event, err := newTriggerEvent(triggerID, next)
+		if err != nil {
+			s.lggr.Errorw("Failed to create trigger event", "triggerId", triggerID, "err", err)
+		} else {
+			select {
+			case <-trigger.stopCh:
+				return
+			case trigger.ch <- capabilities.TriggerResponse{Event: event}:
+			}
+		}
+
+		// Ticks which were missed, because sending the event took longer than the interval, are skipped rather than
+		// sent late, since the other nodes of the DON won't have sent them either.
+		now := s.clock.Now()
+		following := trigger.next(next)
+		if following.Before(now) {
+			following = trigger.next(now)
+			s.lggr.Warnw("Skipped missed ticks", "triggerId", triggerID, "lastTick", next, "nextTick", following)
+		}
+		next = following
+	}
+}
+
+// newTriggerEvent returns the event of the tick scheduled at the given time.
+// The ID of the event is derived from the trigger and the scheduled time, rather than from the time the event is sent,
+// so that every node of a DON sends the same event for a tick, and the workflow is executed once per tick.
+func newTriggerEvent(triggerID string, scheduled time.Time) (capabilities.TriggerEvent, error) {
+	outputs, err := values.WrapMap(crontriggercap.Payload{
+		ScheduledExecutionTime: scheduled.UTC().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return capabilities.TriggerEvent{}, err
+	}
+	return capabilities.TriggerEvent{
+		TriggerType: ID,
+		ID:          generateTriggerEventID(triggerID, scheduled),
+		Outputs:     outputs,
+	}, nil
+}
+
+func generateTriggerEventID(triggerID string, scheduled time.Time) string {
+	sum := sha256.Sum256([]byte(triggerID + "|" + scheduled.UTC().Format(time.RFC3339Nano)))
+	return hex.EncodeToString(sum[:])
+}
+
+func (s *TriggerService) Info(ctx context.Context) (capabilities.CapabilityInfo, error) {
+	return s.CapabilityInfo, nil
+}
+
+func (s *TriggerService) Start(ctx context.Context) error {
+	return s.StartOnce("CronTriggerService", func() error {
+		return s.registry.Add(ctx, s)
+	})
+}
+
+// Close stops the schedules of all triggers, and removes the capability from the registry.
+func (s *TriggerService) Close() error {
+	return s.StopOnce("CronTriggerService", func() error {
+		s.mu.Lock()
+		triggers := s.triggers
+		s.triggers = map[string]*cronTrigger{}
+		s.mu.Unlock()
+		for _, trigger := range triggers {
+			stopTrigger(trigger)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		return s.registry.Remove(ctx, s.ID)
+	})
+}
+
+func (s *TriggerService) HealthReport() map[string]error {
+	return map[string]error{s.Name(): s.Healthy()}
+}
+
+func (s *TriggerService) Name() string {
+	return s.lggr.Name()
+}
@@ -0,0 +1,140 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	registrymock "github.com/smartcontractkit/chainlink-common/pkg/types/core/mocks"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron/crontriggercap"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+const (
+	triggerID1  = "wf_15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0_trigger_0"
+	workflowID1 = "15c631d295ef5e32deb99a10ee6804bc4af13855687559d7ff6552ac6dbb2ce0"
+)
+
+func newTestService(t *testing.T, now time.Time) (*TriggerService, clockwork.FakeClock) {
+	registry := registrymock.NewCapabilitiesRegistry(t)
+	registry.On("Add", mock.Anything, mock.Anything).Return(nil)
+	registry.On("Remove", mock.Anything, ID).Return(nil)
+
+	clock := clockwork.NewFakeClockAt(now)
+	s := NewTriggerService(Config{}, registry, clock, logger.TestLogger(t))
+	require.NoError(t, s.Start(testutils.Context(t)))
+	t.Cleanup(func() { assert.NoError(t, s.Close()) })
+	return s, clock
+}
+
+func registrationRequest(t *testing.T, config map[string]any) capabilities.TriggerRegistrationRequest {
+	cfg, err := values.NewMap(config)
+	require.NoError(t, err)
+	return capabilities.TriggerRegistrationRequest{
+		TriggerID: triggerID1,
+		Metadata:  capabilities.RequestMetadata{WorkflowID: workflowID1},
+		Config:    cfg,
+	}
+}
+
+func requireEvent(t *testing.T, ch <-chan capabilities.TriggerResponse, scheduled time.Time) capabilities.TriggerEvent {
+	select {
+	case resp := <-ch:
+		require.NoError(t, resp.Err)
+		assert.Equal(t, ID, resp.Event.TriggerType)
+		var payload crontriggercap.Payload
+		require.NoError(t, resp.Event.Outputs.UnwrapTo(&payload))
+		assert.Equal(t, scheduled.UTC().Format(time.RFC3339Nano), payload.ScheduledExecutionTime)
+		return resp.Event
+	case <-time.After(testutils.WaitTimeout(t)):
+		require.FailNow(t, "timed out waiting for trigger event")
+	}
+	return capabilities.TriggerEvent{}
+}
+
+func TestTriggerService_Schedule(t *testing.T) {
+	ctx := testutils.Context(t)
+	start := time.Date(2024, 1, 1, 0, 0, 10, 0, time.UTC)
+	s, clock := newTestService(t, start)
+
+	ch, err := s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/30 * * * * *"}))
+	require.NoError(t, err)
+
+	clock.BlockUntil(1)
+	clock.Advance(20 * time.Second)
+	first := requireEvent(t, ch, start.Add(20*time.Second))
+
+	clock.BlockUntil(1)
+	clock.Advance(30 * time.Second)
+	second := requireEvent(t, ch, start.Add(50*time.Second))
+	assert.NotEqual(t, first.ID, second.ID)
+
+	// Another node, which registered the trigger at a different time, sends the same event for the same tick.
+	other, otherClock := newTestService(t, start.Add(5*time.Second))
+	otherCh, err := other.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/30 * * * * *"}))
+	require.NoError(t, err)
+	otherClock.BlockUntil(1)
+	otherClock.Advance(15 * time.Second)
+	assert.Equal(t, first, requireEvent(t, otherCh, start.Add(20*time.Second)))
+
+	_, err = s.RegisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/30 * * * * *"}))
+	require.ErrorContains(t, err, "already registered")
+
+	require.NoError(t, s.UnregisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/30 * * * * *"})))
+	_, ok := <-ch
+	assert.False(t, ok)
+	require.Error(t, s.UnregisterTrigger(ctx, registrationRequest(t, map[string]any{"schedule": "*/30 * * * * *"})))
+}
+
+func TestTriggerService_RegisterTrigger_Invalid(t *testing.T) {
+	ctx := testutils.Context(t)
+	s, _ := newTestService(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for _, tc := range []struct {
+		name   string
+		config map[string]any
+		err    string
+	}{
+		{"missing schedule", map[string]any{}, "schedule"},
+		{"invalid schedule", map[string]any{"schedule": "every minute"}, "invalid schedule"},
+		{"too frequent", map[string]any{"schedule": "*/10 * * * * *"}, "faster than the fastest allowed interval of 30s"},
+		{"too frequent every", map[string]any{"schedule": "@every 5s"}, "faster than the fastest allowed interval of 30s"},
+		{"too frequent uneven", map[string]any{"schedule": "0,10 * * * * *"}, "has ticks 10s apart"},
+		{"too frequent once a day", map[string]any{"schedule": "0,10 0 0 * * *"}, "has ticks 10s apart"},
+		{"invalid timezone", map[string]any{"schedule": "0 * * * *", "timezone": "Mars/Olympus_Mons"}, "invalid timezone"},
+		{"ambiguous timezone", map[string]any{"schedule": "CRON_TZ=UTC 0 * * * *", "timezone": "Europe/London"}, "timezone cannot be set both"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := s.RegisterTrigger(ctx, registrationRequest(t, tc.config))
+			require.ErrorContains(t, err, tc.err)
+		})
+	}
+}
+
+func TestCronTrigger_Next(t *testing.T) {
+	newTrigger := func(t *testing.T, schedule string, timezone *string) *cronTrigger {
+		s, location, err := parseSchedule(&crontriggercap.Config{Schedule: schedule, Timezone: timezone})
+		require.NoError(t, err)
+		return &cronTrigger{schedule: s, location: location}
+	}
+	newYork := "America/New_York"
+	now := time.Date(2024, 1, 1, 12, 34, 56, 0, time.UTC)
+
+	// Schedules are evaluated in UTC unless a timezone is given.
+	assert.Equal(t, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), newTrigger(t, "0 9 * * *", nil).next(now).UTC())
+	assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), newTrigger(t, "0 9 * * *", &newYork).next(now).UTC())
+	assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), newTrigger(t, "CRON_TZ=America/New_York 0 9 * * *", nil).next(now).UTC())
+
+	// @every schedules are aligned to multiples of their interval, regardless of when they start.
+	every := newTrigger(t, "@every 1h", nil)
+	assert.Equal(t, time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC), every.next(now).UTC())
+	assert.Equal(t, time.Date(2024, 1, 1, 14, 0, 0, 0, time.UTC), every.next(time.Date(2024, 1, 1, 13, 0, 0, 0, time.UTC)).UTC())
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/logger"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/core"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/idempotency"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/validation"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/webapicap"
@@ -32,6 +33,9 @@ const (
 	DefaultHTTPMethod   = "GET"
 	DefaultTimeoutMs    = 30000
 	MaxTimeoutMs        = 600000
+	// IdempotencyKeyHeader carries the idempotency key set by the workflow engine, so that external clients can
+	// deduplicate a request sent again by a step executed after a restart.
+	IdempotencyKeyHeader = "Idempotency-Key"
 )
 
 // Capability is a target capability that sends HTTP requests to external clients via the Chainlink Gateway.
@@ -110,6 +114,19 @@ func getPayload(input webapicap.TargetPayload, cfg webapicap.TargetConfig) (ghca
 	}, nil
 }
 
+// withIdempotencyKey returns headers with the IdempotencyKeyHeader set to key, unless the workflow already set it.
+func withIdempotencyKey(headers map[string]string, key string) map[string]string {
+	out := make(map[string]string, len(headers)+1)
+	for k, v := range headers {
+		if strings.EqualFold(k, IdempotencyKeyHeader) {
+			return headers
+		}
+		out[k] = v
+	}
+	out[IdempotencyKeyHeader] = key
+	return out
+}
+
 func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
 	c.lggr.Debugw("executing http target", "capabilityRequest", req)
 
@@ -134,6 +151,9 @@ func (c *Capability) Execute(ctx context.Context, req capabilities.CapabilityReq
 	if err != nil {
 		return capabilities.CapabilityResponse{}, err
 	}
+	if key, ok := idempotency.Key(req); ok {
+		payload.Headers = withIdempotencyKey(payload.Headers, key)
+	}
 
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
@@ -366,6 +366,55 @@ func TestCapability_Execute(t *testing.T) {
 		require.NoError(t, err)
 		verifyResp(t, resp)
 	})
+
+	t.Run("sends the same idempotency key when a step is executed again", func(t *testing.T) {
+		regReq := capabilities.RegisterToWorkflowRequest{
+			Metadata: capabilities.RegistrationMetadata{
+				WorkflowID:    workflowID1,
+				WorkflowOwner: owner1,
+			},
+		}
+		err := th.capability.RegisterToWorkflow(ctx, regReq)
+		require.NoError(t, err)
+
+		inputs, _ := inputsAndConfig(t)
+		wfConfig, err := values.NewMap(map[string]any{
+			"timeoutMs":           1000,
+			"cre_idempotency_key": "step-request-id",
+		})
+		require.NoError(t, err)
+		req := capabilities.CapabilityRequest{
+			Metadata: capabilities.RequestMetadata{
+				WorkflowID:          workflowID1,
+				WorkflowExecutionID: workflowExecutionID1,
+			},
+			Inputs: inputs,
+			Config: wfConfig,
+		}
+
+		msgID, err := getMessageID(req)
+		require.NoError(t, err)
+		gatewayResp := gatewayResponse(t, msgID)
+		var sent []ghcapabilities.Request
+		th.connector.On("SignAndSendToGateway", mock.Anything, "gateway1", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			var payload ghcapabilities.Request
+			require.NoError(t, json.Unmarshal(args.Get(2).(*api.MessageBody).Payload, &payload))
+			sent = append(sent, payload)
+			th.connectorHandler.HandleGatewayMessage(ctx, "gateway1", gatewayResp)
+		}).Twice()
+
+		for range 2 {
+			resp, err := th.capability.Execute(ctx, req)
+			require.NoError(t, err)
+			verifyResp(t, resp)
+		}
+
+		require.Len(t, sent, 2)
+		for _, payload := range sent {
+			require.Equal(t, "step-request-id", payload.Headers[IdempotencyKeyHeader])
+			require.Equal(t, "application/json", payload.Headers["Content-Type"])
+		}
+	})
 }
 
 func verifyResp(t *testing.T, resp capabilities.CapabilityResponse) {
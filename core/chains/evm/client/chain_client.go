@@ -1,14 +1,18 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rpc"
 
@@ -31,9 +35,9 @@ type Client interface {
 	// ChainID locally stored for quick access
 	ConfiguredChainID() *big.Int
 
-	// NodeStates returns a map of node Name->node state
+	// NodeStates returns a map of node Name->node state, including the stats and score of primary nodes
 	// It might be nil or empty, e.g. for mock clients etc
-	NodeStates() map[string]string
+	NodeStates() map[string]commonclient.NodeState
 
 	TokenBalance(ctx context.Context, address common.Address, contractAddress common.Address) (*big.Int, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
@@ -105,6 +109,8 @@ type chainClient struct {
 	logger       logger.SugaredLogger
 	chainType    chaintype.ChainType
 	clientErrors evmconfig.ClientErrors
+	// criticalReads are the default ReadOpts of reads marked with commonclient.CtxWithReadOpts
+	criticalReads commonclient.ReadOpts
 }
 
 func NewChainClient(
@@ -115,6 +121,7 @@ func NewChainClient(
 	sendonlys []commonclient.SendOnlyNode[*big.Int, *RPCClient],
 	chainID *big.Int,
 	clientErrors evmconfig.ClientErrors,
+	criticalReads commonclient.ReadOpts,
 	deathDeclarationDelay time.Duration,
 	chainType chaintype.ChainType,
 ) Client {
@@ -140,11 +147,12 @@ func NewChainClient(
 	)
 
 	return &chainClient{
-		multiNode:    multiNode,
-		txSender:     txSender,
-		logger:       logger.Sugared(lggr),
-		chainType:    chainType,
-		clientErrors: clientErrors,
+		multiNode:     multiNode,
+		txSender:      txSender,
+		logger:        logger.Sugared(lggr),
+		chainType:     chainType,
+		clientErrors:  clientErrors,
+		criticalReads: criticalReads,
 	}
 }
 
@@ -235,27 +243,75 @@ func (c *chainClient) BlockByNumber(ctx context.Context, number *big.Int) (b *ty
 }
 
 func (c *chainClient) CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error {
-	r, err := c.multiNode.SelectRPC()
+	opts := c.readOpts(ctx)
+	if opts.Mode == commonclient.ReadModeSingle {
+		r, err := c.multiNode.SelectRPC()
+		if err != nil {
+			return err
+		}
+		return r.CallContext(ctx, result, method, args...)
+	}
+	if n := len(args); n > 0 {
+		var tag rpc.BlockNumber
+		if arg, ok := args[n-1].(string); ok && tag.UnmarshalJSON([]byte(`"`+arg+`"`)) == nil && isLatestOrPending(tag) {
+			blockNumber, err := c.LatestBlockHeight(ctx)
+			if err != nil {
+				return err
+			}
+			args = append(slices.Clone(args[:n-1]), hexutil.EncodeBig(blockNumber))
+		}
+	}
+	// each node decodes into its own result, so only the raw results are compared
+	raw, err := commonclient.DoRead(ctx, c.multiNode, opts, method, func(ctx context.Context, r *RPCClient) (json.RawMessage, error) {
+		var raw json.RawMessage
+		err := r.CallContext(ctx, &raw, method, args...)
+		return raw, err
+	}, func(a, b json.RawMessage) bool { return bytes.Equal(a, b) })
 	if err != nil {
 		return err
 	}
-	return r.CallContext(ctx, result, method, args...)
+	return json.Unmarshal(raw, result)
 }
 
 func (c *chainClient) CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
+	opts := c.readOpts(ctx)
+	if opts.Mode != commonclient.ReadModeSingle && (blockNumber == nil || blockNumber.Sign() < 0 && isLatestOrPending(rpc.BlockNumber(blockNumber.Int64()))) {
+		var err error
+		if blockNumber, err = c.LatestBlockHeight(ctx); err != nil {
+			return nil, err
+		}
 	}
-	return r.CallContract(ctx, msg, blockNumber)
+	return commonclient.DoRead(ctx, c.multiNode, opts, "eth_call", func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.CallContract(ctx, msg, blockNumber)
+	}, bytes.Equal)
 }
 
+// PendingCallContract reads the pending state of the active node. Pending state differs between nodes, so reads
+// spread across nodes are made at the latest block instead.
 func (c *chainClient) PendingCallContract(ctx context.Context, msg ethereum.CallMsg) ([]byte, error) {
-	r, err := c.multiNode.SelectRPC()
-	if err != nil {
-		return nil, err
+	opts := c.readOpts(ctx)
+	if opts.Mode != commonclient.ReadModeSingle {
+		return c.CallContract(ctx, msg, nil)
+	}
+	return commonclient.DoRead(ctx, c.multiNode, opts, "eth_call", func(ctx context.Context, r *RPCClient) ([]byte, error) {
+		return r.PendingCallContract(ctx, msg)
+	}, bytes.Equal)
+}
+
+// isLatestOrPending reports whether reads at n depend on the head of the node. Reads spread across nodes at different
+// heads would disagree on them, so they are pinned to the latest block number of the active node instead.
+func isLatestOrPending(n rpc.BlockNumber) bool {
+	return n == rpc.LatestBlockNumber || n == rpc.PendingBlockNumber
+}
+
+// readOpts returns the ReadOpts of a read made with ctx. Reads marked as critical with commonclient.CtxWithReadOpts
+// default to the chain's CriticalReads config, and all other reads are made from the active node only.
+func (c *chainClient) readOpts(ctx context.Context) commonclient.ReadOpts {
+	opts, ok := commonclient.CtxReadOpts(ctx)
+	if !ok {
+		return commonclient.ReadOpts{Mode: commonclient.ReadModeSingle}
 	}
-	return r.PendingCallContract(ctx, msg)
+	return opts.Merge(c.criticalReads)
 }
 
 func (c *chainClient) Close() {
@@ -350,7 +406,7 @@ func (c *chainClient) LatestBlockHeight(ctx context.Context) (*big.Int, error) {
 	return r.LatestBlockHeight(ctx)
 }
 
-func (c *chainClient) NodeStates() map[string]string {
+func (c *chainClient) NodeStates() map[string]commonclient.NodeState {
 	return c.multiNode.NodeStates()
 }
 
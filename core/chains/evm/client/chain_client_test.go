@@ -10,6 +10,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -788,6 +789,55 @@ func TestEthClient_BatchCallContext(t *testing.T) {
 	})
 }
 
+func TestEthClient_CriticalReadsPinLatestBlock(t *testing.T) {
+	t.Parallel()
+
+	var blocks []string
+	var mu sync.Mutex
+	wsURL := testutils.NewWSServer(t, testutils.FixtureChainID, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "eth_subscribe":
+			resp.Result = `"0x00"`
+			resp.Notify = headResult
+			return
+		case "eth_unsubscribe":
+			resp.Result = "true"
+			return
+		case "eth_blockNumber":
+			resp.Result = `"0x100"`
+			return
+		}
+		if !assert.Equal(t, "eth_call", method) {
+			return
+		}
+		mu.Lock()
+		blocks = append(blocks, params.Array()[1].String())
+		mu.Unlock()
+		resp.Result = `"0x01"`
+		return
+	}).WSURL().String()
+
+	ethClient := mustNewChainClient(t, wsURL)
+	require.NoError(t, ethClient.Dial(tests.Context(t)))
+
+	ctx := commonclient.CtxWithReadOpts(tests.Context(t), commonclient.ReadOpts{Mode: commonclient.ReadModeHedged, HedgeDelay: time.Hour})
+	_, err := ethClient.CallContract(ctx, ethereum.CallMsg{}, nil)
+	require.NoError(t, err)
+	_, err = ethClient.PendingCallContract(ctx, ethereum.CallMsg{})
+	require.NoError(t, err)
+	var result string
+	require.NoError(t, ethClient.CallContext(ctx, &result, "eth_call", map[string]string{}, "latest"))
+	_, err = ethClient.CallContract(ctx, ethereum.CallMsg{}, big.NewInt(42))
+	require.NoError(t, err)
+	// reads from the active node only are not pinned
+	_, err = ethClient.CallContract(tests.Context(t), ethereum.CallMsg{}, nil)
+	require.NoError(t, err)
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{"0x100", "0x100", "0x100", "0x2a", "latest"}, blocks)
+}
+
 func TestEthClient_ErroringClient(t *testing.T) {
 	t.Parallel()
 	ctx := tests.Context(t)
@@ -49,6 +49,8 @@ func NewClientConfigs(
 	if err != nil {
 		return nil, nil, nil, err
 	}
+	// critical reads are made from the active node only, see toml.CriticalReads for the other defaults
+	criticalReadsMode, criticalReadsQuorum := commonclient.ReadModeSingle, uint32(2)
 	nodePool := toml.NodePool{
 		SelectionMode:              selectionMode,
 		LeaseDuration:              commonconfig.MustNewDuration(leaseDuration),
@@ -60,6 +62,11 @@ func NewClientConfigs(
 		DeathDeclarationDelay:      commonconfig.MustNewDuration(deathDeclarationDelay),
 		FinalizedBlockPollInterval: commonconfig.MustNewDuration(finalizedBlockPollInterval),
 		NewHeadsPollInterval:       commonconfig.MustNewDuration(newHeadsPollInterval),
+		CriticalReads: toml.CriticalReads{
+			Mode:       &criticalReadsMode,
+			HedgeDelay: commonconfig.MustNewDuration(500 * time.Millisecond),
+			Quorum:     &criticalReadsQuorum,
+		},
 	}
 	nodePoolCfg := &evmconfig.NodePoolConfig{C: nodePool}
 	chainConfig := &evmconfig.EVMConfig{
@@ -35,8 +35,13 @@ func NewEvmClient(cfg evmconfig.NodePool, chainCfg commonclient.ChainConfig, cli
 		}
 	}
 
+	criticalReads := commonclient.ReadOpts{
+		Mode:       cfg.CriticalReads().Mode(),
+		HedgeDelay: cfg.CriticalReads().HedgeDelay(),
+		Quorum:     int(cfg.CriticalReads().Quorum()),
+	}
 	return NewChainClient(lggr, cfg.SelectionMode(), cfg.LeaseDuration(),
-		primaries, sendonlys, chainID, clientErrors, cfg.DeathDeclarationDelay(), chainType), nil
+		primaries, sendonlys, chainID, clientErrors, criticalReads, cfg.DeathDeclarationDelay(), chainType), nil
 }
 
 func getRPCTimeouts(chainType chaintype.ChainType) (largePayload, defaultTimeout time.Duration) {
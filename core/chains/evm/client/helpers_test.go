@@ -92,6 +92,7 @@ type TestNodePoolConfig struct {
 	EnforceRepeatableReadVal       bool
 	NodeDeathDeclarationDelay      time.Duration
 	NodeNewHeadsPollInterval       time.Duration
+	NodeCriticalReads              config.CriticalReads
 }
 
 func (tc TestNodePoolConfig) PollFailureThreshold() uint32 { return tc.NodePollFailureThreshold }
@@ -126,6 +127,10 @@ func (tc TestNodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return tc.NodeDeathDeclarationDelay
 }
 
+func (tc TestNodePoolConfig) CriticalReads() config.CriticalReads {
+	return tc.NodeCriticalReads
+}
+
 func NewChainClientWithTestNode(
 	t *testing.T,
 	nodeCfg commonclient.NodeConfig,
@@ -168,7 +173,7 @@ func NewChainClientWithTestNode(
 	}
 
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, nodeCfg.SelectionMode(), leaseDuration, primaries, sendonlys, chainID, &clientErrors, commonclient.ReadOpts{Mode: commonclient.ReadModeSingle}, 0, "")
 	t.Cleanup(c.Close)
 	return c, nil
 }
@@ -182,7 +187,7 @@ func NewChainClientWithEmptyNode(
 ) Client {
 	lggr := logger.Test(t)
 
-	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, nil, nil, chainID, nil, commonclient.ReadOpts{Mode: commonclient.ReadModeSingle}, 0, "")
 	t.Cleanup(c.Close)
 	return c
 }
@@ -206,7 +211,7 @@ func NewChainClientWithMockedRpc(
 		cfg, clientMocks.ChainConfig{NoNewHeadsThresholdVal: noNewHeadsThreshold}, lggr, parsed, nil, "eth-primary-node-0", 1, chainID, 1, rpc, "EVM")
 	primaries := []commonclient.Node[*big.Int, *RPCClient]{n}
 	clientErrors := NewTestClientErrors()
-	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, 0, "")
+	c := NewChainClient(lggr, selectionMode, leaseDuration, primaries, nil, chainID, &clientErrors, commonclient.ReadOpts{Mode: commonclient.ReadModeSingle}, 0, "")
 	t.Cleanup(c.Close)
 	return c
 }
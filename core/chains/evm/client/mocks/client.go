@@ -1301,19 +1301,19 @@ func (_c *Client_LatestFinalizedBlock_Call) RunAndReturn(run func(context.Contex
 }
 
 // NodeStates provides a mock function with given fields:
-func (_m *Client) NodeStates() map[string]string {
+func (_m *Client) NodeStates() map[string]commonclient.NodeState {
 	ret := _m.Called()
 
 	if len(ret) == 0 {
 		panic("no return value specified for NodeStates")
 	}
 
-	var r0 map[string]string
-	if rf, ok := ret.Get(0).(func() map[string]string); ok {
+	var r0 map[string]commonclient.NodeState
+	if rf, ok := ret.Get(0).(func() map[string]commonclient.NodeState); ok {
 		r0 = rf()
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(map[string]string)
+			r0 = ret.Get(0).(map[string]commonclient.NodeState)
 		}
 	}
 
@@ -1337,12 +1337,12 @@ func (_c *Client_NodeStates_Call) Run(run func()) *Client_NodeStates_Call {
 	return _c
 }
 
-func (_c *Client_NodeStates_Call) Return(_a0 map[string]string) *Client_NodeStates_Call {
+func (_c *Client_NodeStates_Call) Return(_a0 map[string]commonclient.NodeState) *Client_NodeStates_Call {
 	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *Client_NodeStates_Call) RunAndReturn(run func() map[string]string) *Client_NodeStates_Call {
+func (_c *Client_NodeStates_Call) RunAndReturn(run func() map[string]commonclient.NodeState) *Client_NodeStates_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -120,6 +120,9 @@ type RPCClient struct {
 	highestUserObservations commonclient.ChainInfo
 	// most recent chain info observed during current lifecycle (reseted on DisconnectAll)
 	latestChainInfo commonclient.ChainInfo
+
+	// latency and error rate of the most recent calls, including health checks
+	requestStats *commonclient.RequestStatsTracker
 }
 
 var _ commonclient.RPCClient[*big.Int, *evmtypes.Head] = (*RPCClient)(nil)
@@ -167,6 +170,7 @@ func NewRPCClient(
 	)
 	r.rpcLog = logger.Sugared(lggr).Named("RPC")
 	r.subs = map[ethereum.Subscription]struct{}{}
+	r.requestStats = commonclient.NewRequestStatsTracker()
 
 	return r
 }
@@ -307,6 +311,7 @@ func (r *RPCClient) logResult(
 			append(results, "err", err)...,
 		)
 	}
+	r.requestStats.Observe(callDuration, isRPCFailure(err))
 	promEVMPoolRPCCallTiming.
 		WithLabelValues(
 			r.chainID.String(),             // chain id
@@ -319,6 +324,12 @@ func (r *RPCClient) logResult(
 		Observe(float64(callDuration))
 }
 
+// isRPCFailure returns true if err is a failure of the RPC itself, rather than an error response to the call, such
+// as a revert, or a cancellation by the caller.
+func isRPCFailure(err error) bool {
+	return err != nil && !errors.Is(err, context.Canceled) && ExtractRPCErrorOrNil(err) == nil
+}
+
 func (r *RPCClient) getRPCDomain() string {
 	if r.http != nil {
 		return r.http.uri.Host
@@ -1446,6 +1457,10 @@ func (r *RPCClient) GetInterceptedChainInfo() (latest, highestUserObservations c
 	return r.latestChainInfo, r.highestUserObservations
 }
 
+func (r *RPCClient) GetRequestStats() commonclient.RequestStats {
+	return r.requestStats.Stats()
+}
+
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
@@ -678,3 +678,42 @@ func TestAstarCustomFinality(t *testing.T) {
 		})
 	}
 }
+
+func TestRPCClient_GetRequestStats(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithTimeout(tests.Context(t), tests.WaitTimeout(t))
+	defer cancel()
+
+	chainId := big.NewInt(123456)
+	rpcURL := testutils.NewWSServer(t, chainId, func(method string, params gjson.Result) (resp testutils.JSONRPCResponse) {
+		switch method {
+		case "eth_blockNumber":
+			resp.Result = `"0x1"`
+		case "eth_call":
+			resp.Error.Code = 3
+			resp.Error.Message = "execution reverted"
+		case "eth_getBalance":
+			// block until timeout
+			<-ctx.Done()
+		}
+		return
+	}).WSURL()
+
+	rpc := client.NewRPCClient(client.TestNodePoolConfig{}, logger.Test(t), rpcURL, nil, "rpc", 1, chainId, commonclient.Primary, tests.TestInterval, tests.TestInterval, "")
+	require.NoError(t, rpc.Dial(ctx))
+	defer rpc.Close()
+	assert.Equal(t, commonclient.RequestStats{}, rpc.GetRequestStats())
+
+	_, err := rpc.BlockNumber(ctx)
+	require.NoError(t, err)
+	// reverts are not failures of the RPC
+	_, err = rpc.CallContract(ctx, ethereum.CallMsg{}, nil)
+	require.Error(t, err)
+	_, err = rpc.BalanceAt(ctx, testutils.NewAddress(), nil)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	stats := rpc.GetRequestStats()
+	assert.Equal(t, 3, stats.Requests)
+	assert.InDelta(t, 1.0/3, stats.ErrorRate, 0.001)
+	assert.Less(t, stats.LatencyP99, tests.TestInterval)
+}
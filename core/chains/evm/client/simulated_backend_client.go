@@ -567,7 +567,7 @@ func (c *SimulatedBackendClient) Backend() evmtypes.Backend {
 }
 
 // NodeStates implements evmclient.Client
-func (c *SimulatedBackendClient) NodeStates() map[string]string { return nil }
+func (c *SimulatedBackendClient) NodeStates() map[string]commonclient.NodeState { return nil }
 
 // Commit imports all the pending transactions as a single block and starts a
 // fresh new state.
@@ -43,6 +43,10 @@ func (g *gasEstimatorConfig) FeeHistory() FeeHistory {
 	return &feeHistoryConfig{c: g.c.FeeHistory}
 }
 
+func (g *gasEstimatorConfig) Forecast() Forecast {
+	return &forecastConfig{c: g.c.Forecast}
+}
+
 func (g *gasEstimatorConfig) DAOracle() DAOracle {
 	return &daOracleConfig{c: g.c.DAOracle}
 }
@@ -216,3 +220,27 @@ type feeHistoryConfig struct {
 func (u *feeHistoryConfig) CacheTimeout() time.Duration {
 	return u.c.CacheTimeout.Duration()
 }
+
+type forecastConfig struct {
+	c toml.ForecastEstimator
+}
+
+func (u *forecastConfig) CacheTimeout() time.Duration {
+	return u.c.CacheTimeout.Duration()
+}
+
+func (u *forecastConfig) BlockHistorySize() uint16 {
+	return *u.c.BlockHistorySize
+}
+
+func (u *forecastConfig) TargetBlocks() uint16 {
+	return *u.c.TargetBlocks
+}
+
+func (u *forecastConfig) Confidence() uint8 {
+	return *u.c.Confidence
+}
+
+func (u *forecastConfig) TxPoolEnabled() bool {
+	return *u.c.TxPoolEnabled
+}
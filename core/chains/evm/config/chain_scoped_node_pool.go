@@ -51,3 +51,23 @@ func (n *NodePoolConfig) EnforceRepeatableRead() bool {
 func (n *NodePoolConfig) DeathDeclarationDelay() time.Duration {
 	return n.C.DeathDeclarationDelay.Duration()
 }
+
+func (n *NodePoolConfig) CriticalReads() CriticalReads {
+	return &criticalReadsConfig{c: n.C.CriticalReads}
+}
+
+type criticalReadsConfig struct {
+	c toml.CriticalReads
+}
+
+func (r *criticalReadsConfig) Mode() string {
+	return *r.c.Mode
+}
+
+func (r *criticalReadsConfig) HedgeDelay() time.Duration {
+	return r.c.HedgeDelay.Duration()
+}
+
+func (r *criticalReadsConfig) Quorum() uint32 {
+	return *r.c.Quorum
+}
@@ -122,6 +122,7 @@ type AutoPurgeConfig interface {
 type GasEstimator interface {
 	BlockHistory() BlockHistory
 	FeeHistory() FeeHistory
+	Forecast() Forecast
 	LimitJobType() LimitJobType
 
 	EIP1559DynamicFees() bool
@@ -174,6 +175,14 @@ type FeeHistory interface {
 	CacheTimeout() time.Duration
 }
 
+type Forecast interface {
+	CacheTimeout() time.Duration
+	BlockHistorySize() uint16
+	TargetBlocks() uint16
+	Confidence() uint8
+	TxPoolEnabled() bool
+}
+
 type Workflow interface {
 	FromAddress() *types.EIP55Address
 	ForwarderAddress() *types.EIP55Address
@@ -192,6 +201,13 @@ type NodePool interface {
 	EnforceRepeatableRead() bool
 	DeathDeclarationDelay() time.Duration
 	NewHeadsPollInterval() time.Duration
+	CriticalReads() CriticalReads
+}
+
+type CriticalReads interface {
+	Mode() string
+	HedgeDelay() time.Duration
+	Quorum() uint32
 }
 
 // TODO BCF-2509 does the chainscopedconfig really need the entire app config?
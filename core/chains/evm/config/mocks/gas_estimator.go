@@ -484,6 +484,53 @@ func (_c *GasEstimator_FeeHistory_Call) RunAndReturn(run func() config.FeeHistor
 	return _c
 }
 
+// Forecast provides a mock function with given fields:
+func (_m *GasEstimator) Forecast() config.Forecast {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Forecast")
+	}
+
+	var r0 config.Forecast
+	if rf, ok := ret.Get(0).(func() config.Forecast); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(config.Forecast)
+		}
+	}
+
+	return r0
+}
+
+// GasEstimator_Forecast_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Forecast'
+type GasEstimator_Forecast_Call struct {
+	*mock.Call
+}
+
+// Forecast is a helper method to define mock.On call
+func (_e *GasEstimator_Expecter) Forecast() *GasEstimator_Forecast_Call {
+	return &GasEstimator_Forecast_Call{Call: _e.mock.On("Forecast")}
+}
+
+func (_c *GasEstimator_Forecast_Call) Run(run func()) *GasEstimator_Forecast_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *GasEstimator_Forecast_Call) Return(_a0 config.Forecast) *GasEstimator_Forecast_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *GasEstimator_Forecast_Call) RunAndReturn(run func() config.Forecast) *GasEstimator_Forecast_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LimitDefault provides a mock function with given fields:
 func (_m *GasEstimator) LimitDefault() uint64 {
 	ret := _m.Called()
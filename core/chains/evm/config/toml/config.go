@@ -17,6 +17,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	commontypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/chaintype"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -600,6 +601,7 @@ type GasEstimator struct {
 
 	BlockHistory BlockHistoryEstimator `toml:",omitempty"`
 	FeeHistory   FeeHistoryEstimator   `toml:",omitempty"`
+	Forecast     ForecastEstimator     `toml:",omitempty"`
 	DAOracle     DAOracle              `toml:",omitempty"`
 }
 
@@ -696,6 +698,7 @@ func (e *GasEstimator) setFrom(f *GasEstimator) {
 	e.LimitJobType.setFrom(&f.LimitJobType)
 	e.BlockHistory.setFrom(&f.BlockHistory)
 	e.FeeHistory.setFrom(&f.FeeHistory)
+	e.Forecast.setFrom(&f.Forecast)
 	e.DAOracle.setFrom(&f.DAOracle)
 }
 
@@ -769,6 +772,48 @@ func (u *FeeHistoryEstimator) setFrom(f *FeeHistoryEstimator) {
 	}
 }
 
+type ForecastEstimator struct {
+	CacheTimeout     *commonconfig.Duration
+	BlockHistorySize *uint16
+	TargetBlocks     *uint16
+	Confidence       *uint8
+	TxPoolEnabled    *bool
+}
+
+func (u *ForecastEstimator) setFrom(f *ForecastEstimator) {
+	if v := f.CacheTimeout; v != nil {
+		u.CacheTimeout = v
+	}
+	if v := f.BlockHistorySize; v != nil {
+		u.BlockHistorySize = v
+	}
+	if v := f.TargetBlocks; v != nil {
+		u.TargetBlocks = v
+	}
+	if v := f.Confidence; v != nil {
+		u.Confidence = v
+	}
+	if v := f.TxPoolEnabled; v != nil {
+		u.TxPoolEnabled = v
+	}
+}
+
+func (u *ForecastEstimator) ValidateConfig() (err error) {
+	if u.BlockHistorySize != nil && *u.BlockHistorySize < 2 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "BlockHistorySize", Value: *u.BlockHistorySize,
+			Msg: "must be greater than or equal to 2"})
+	}
+	if u.TargetBlocks != nil && *u.TargetBlocks < 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "TargetBlocks", Value: *u.TargetBlocks,
+			Msg: "must be greater than or equal to 1"})
+	}
+	if u.Confidence != nil && (*u.Confidence < 1 || *u.Confidence > 99) {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Confidence", Value: *u.Confidence,
+			Msg: "must be between 1 and 99"})
+	}
+	return
+}
+
 type DAOracle struct {
 	OracleType             *DAOracleType
 	OracleAddress          *types.EIP55Address
@@ -972,6 +1017,7 @@ type NodePool struct {
 	EnforceRepeatableRead      *bool
 	DeathDeclarationDelay      *commonconfig.Duration
 	NewHeadsPollInterval       *commonconfig.Duration
+	CriticalReads              CriticalReads `toml:",omitempty"`
 }
 
 func (p *NodePool) setFrom(f *NodePool) {
@@ -1010,6 +1056,7 @@ func (p *NodePool) setFrom(f *NodePool) {
 	}
 
 	p.Errors.setFrom(&f.Errors)
+	p.CriticalReads.setFrom(&f.CriticalReads)
 }
 
 func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
@@ -1026,6 +1073,42 @@ func (p *NodePool) ValidateConfig(finalityTagEnabled *bool) (err error) {
 	return
 }
 
+type CriticalReads struct {
+	Mode       *string
+	HedgeDelay *commonconfig.Duration
+	Quorum     *uint32
+}
+
+func (r *CriticalReads) setFrom(f *CriticalReads) {
+	if v := f.Mode; v != nil {
+		r.Mode = v
+	}
+	if v := f.HedgeDelay; v != nil {
+		r.HedgeDelay = v
+	}
+	if v := f.Quorum; v != nil {
+		r.Quorum = v
+	}
+}
+
+func (r *CriticalReads) ValidateConfig() (err error) {
+	if r.Mode != nil {
+		switch *r.Mode {
+		case commonclient.ReadModeSingle, commonclient.ReadModeHedged, commonclient.ReadModeQuorum:
+		default:
+			err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Mode", Value: *r.Mode,
+				Msg: fmt.Sprintf("must be one of %s, %s or %s", commonclient.ReadModeSingle, commonclient.ReadModeHedged, commonclient.ReadModeQuorum)})
+		}
+	}
+	if r.HedgeDelay != nil && r.HedgeDelay.Duration() <= 0 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "HedgeDelay", Value: r.HedgeDelay, Msg: "must be greater than 0"})
+	}
+	if r.Quorum != nil && *r.Quorum < 1 {
+		err = multierr.Append(err, commonconfig.ErrInvalid{Name: "Quorum", Value: *r.Quorum, Msg: "must be at least 1"})
+	}
+	return
+}
+
 type OCR struct {
 	ContractConfirmations              *uint16
 	ContractTransmitterTransmitTimeout *commonconfig.Duration
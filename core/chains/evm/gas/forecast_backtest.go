@@ -0,0 +1,88 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+)
+
+// BacktestResult summarizes how the fees estimated over recorded blocks would have fared.
+type BacktestResult struct {
+	// Estimates is the number of blocks a fee was estimated for.
+	Estimates int
+	// IncludedInTarget is the number of estimates which would have been included within the target number of blocks.
+	IncludedInTarget int
+	// Included is the number of estimates which would have been included at all before the end of the history.
+	Included int
+	// TotalDelay is the sum of the number of blocks until inclusion, over the included estimates.
+	TotalDelay int
+	// TotalOverpay is the sum of the fees paid above the cheapest fee included, over the included estimates.
+	TotalOverpay *assets.Wei
+}
+
+// HitRate is the share of estimates included within the target number of blocks.
+func (r BacktestResult) HitRate() float64 {
+	if r.Estimates == 0 {
+		return 0
+	}
+	return float64(r.IncludedInTarget) / float64(r.Estimates)
+}
+
+// AverageDelay is the average number of blocks until inclusion, over the included estimates.
+func (r BacktestResult) AverageDelay() float64 {
+	if r.Included == 0 {
+		return 0
+	}
+	return float64(r.TotalDelay) / float64(r.Included)
+}
+
+// AverageOverpay is the average fee paid above the cheapest fee included, over the included estimates.
+func (r BacktestResult) AverageOverpay() *assets.Wei {
+	if r.Included == 0 {
+		return assets.NewWeiI(0)
+	}
+	return assets.NewWei(new(big.Int).Div(r.TotalOverpay.ToInt(), big.NewInt(int64(r.Included))))
+}
+
+// BacktestForecast replays blocks through a ForecastEstimator, estimating a fee as of each block with enough history,
+// and checks in which of the following blocks a transaction paying it would have been included. A transaction is
+// included in a block if its fee cap covers the base fee and its tip is at least the lowest tip of the block.
+func BacktestForecast(ctx context.Context, lggr logger.Logger, cfg ForecastEstimatorConfig, blocks []HistoricalBlock) (BacktestResult, error) {
+	result := BacktestResult{TotalOverpay: assets.NewWeiI(0)}
+	if uint64(len(blocks)) <= cfg.BlockHistorySize {
+		return result, errors.New("not enough blocks to backtest, need more than BlockHistorySize")
+	}
+	client := NewHistoryReplayClient(blocks)
+	estimator := NewForecastEstimator(lggr, client, cfg, big.NewInt(0), nil)
+
+	for i := int(cfg.BlockHistorySize) - 1; i < len(blocks)-1; i++ {
+		client.SetLatest(i)
+		forecast, err := estimator.Refresh(ctx)
+		if err != nil {
+			return result, fmt.Errorf("failed to forecast as of block %d: %w", blocks[i].Number, err)
+		}
+		result.Estimates++
+
+		fee := EvmFee{DynamicFee: DynamicFee{GasFeeCap: forecast.BaseFee.Add(forecast.TipCap), GasTipCap: forecast.TipCap}}
+		for j := i + 1; j < len(blocks); j++ {
+			tip, included := includedTip(blocks[j], fee)
+			if !included {
+				continue
+			}
+			delay := j - i
+			result.Included++
+			result.TotalDelay += delay
+			if delay <= int(cfg.TargetBlocks) {
+				result.IncludedInTarget++
+			}
+			result.TotalOverpay = result.TotalOverpay.Add(tip.Sub(tipPercentile(blocks[j].Tips, 0)))
+			break
+		}
+	}
+	return result, nil
+}
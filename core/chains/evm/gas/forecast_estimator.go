@@ -0,0 +1,433 @@
+package gas
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
+
+	commonfee "github.com/smartcontractkit/chainlink/v2/common/fee"
+	feetypes "github.com/smartcontractkit/chainlink/v2/common/fee/types"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/rollups"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// metrics are thread safe
+var (
+	promForecastEstimatorBaseFee = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_forecast_base_fee",
+		Help: "Sets the base fee (in Wei) forecasted for the target inclusion block",
+	},
+		[]string{"evmChainID"},
+	)
+	promForecastEstimatorTipCap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "gas_forecast_tip_cap",
+		Help: "Sets the priority fee (in Wei) forecasted for inclusion within the target number of blocks",
+	},
+		[]string{"evmChainID"},
+	)
+)
+
+var _ EvmEstimator = (*ForecastEstimator)(nil)
+
+type ForecastEstimatorConfig struct {
+	BumpPercent  uint16
+	CacheTimeout time.Duration
+
+	EIP1559          bool
+	BlockHistorySize uint64
+	// TargetBlocks is the number of blocks within which transactions should be included
+	TargetBlocks uint16
+	// Confidence is the probability, in percent, of inclusion within TargetBlocks that the estimations aim for
+	Confidence    uint8
+	TxPoolEnabled bool
+}
+
+type forecastEstimatorClient interface {
+	FeeHistory(ctx context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (feeHistory *ethereum.FeeHistory, err error)
+	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
+}
+
+// FeeForecast is the fee the ForecastEstimator expects to be needed for inclusion within the target number of blocks.
+type FeeForecast struct {
+	// BaseFee is the highest base fee expected up to the target block
+	BaseFee *assets.Wei
+	// TipCap is the priority fee expected to be included, accounting for pending transactions if available
+	TipCap *assets.Wei
+}
+
+// ForecastEstimator forecasts fees, rather than only looking back at recently included transactions. It combines:
+//   - the priority fees paid in recent blocks, from eth_feeHistory, at the percentile which gives a Confidence chance
+//     of inclusion within TargetBlocks,
+//   - the trend of the base fee, extrapolated up to TargetBlocks,
+//   - the pressure of pending transactions paying higher priority fees, from txpool_content, if enabled.
+type ForecastEstimator struct {
+	services.StateMachine
+
+	client  forecastEstimatorClient
+	logger  logger.Logger
+	config  ForecastEstimatorConfig
+	chainID *big.Int
+
+	forecastMu sync.RWMutex
+	forecast   *FeeForecast
+
+	l1Oracle rollups.L1Oracle
+
+	wg        *sync.WaitGroup
+	stopCh    services.StopChan
+	refreshCh chan struct{}
+}
+
+func NewForecastEstimator(lggr logger.Logger, client forecastEstimatorClient, cfg ForecastEstimatorConfig, chainID *big.Int, l1Oracle rollups.L1Oracle) *ForecastEstimator {
+	return &ForecastEstimator{
+		client:    client,
+		logger:    logger.Named(lggr, "ForecastEstimator"),
+		config:    cfg,
+		chainID:   chainID,
+		l1Oracle:  l1Oracle,
+		wg:        new(sync.WaitGroup),
+		stopCh:    make(chan struct{}),
+		refreshCh: make(chan struct{}),
+	}
+}
+
+func (f *ForecastEstimator) Start(context.Context) error {
+	return f.StartOnce("ForecastEstimator", func() error {
+		if f.config.BumpPercent < MinimumBumpPercentage {
+			return fmt.Errorf("BumpPercent: %s is less than minimum allowed percentage: %s",
+				strconv.FormatUint(uint64(f.config.BumpPercent), 10), strconv.Itoa(MinimumBumpPercentage))
+		}
+		if f.config.BlockHistorySize < 2 {
+			return fmt.Errorf("BlockHistorySize: %d must be at least 2 to forecast the base fee", f.config.BlockHistorySize)
+		}
+		f.wg.Add(1)
+		go f.run()
+
+		return nil
+	})
+}
+
+func (f *ForecastEstimator) Close() error {
+	return f.StopOnce("ForecastEstimator", func() error {
+		close(f.stopCh)
+		f.wg.Wait()
+		return nil
+	})
+}
+
+func (f *ForecastEstimator) run() {
+	defer f.wg.Done()
+
+	t := services.TickerConfig{
+		JitterPct: services.DefaultJitter,
+	}.NewTicker(f.config.CacheTimeout)
+
+	for {
+		select {
+		case <-f.stopCh:
+			return
+		case <-f.refreshCh:
+			t.Reset()
+		case <-t.C:
+			ctx, cancel := f.stopCh.CtxWithTimeout(commonclient.QueryTimeout)
+			if _, err := f.Refresh(ctx); err != nil {
+				f.logger.Error(err)
+			}
+			cancel()
+		}
+	}
+}
+
+// Refresh fetches the latest fee history, and pending transactions if enabled, and caches a new FeeForecast.
+func (f *ForecastEstimator) Refresh(ctx context.Context) (*FeeForecast, error) {
+	percentile := inclusionPercentile(f.config.Confidence, f.config.TargetBlocks)
+	feeHistory, err := f.client.FeeHistory(ctx, f.config.BlockHistorySize, nil, []float64{percentile})
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch fee history: %w", err)
+	}
+	if len(feeHistory.BaseFee) == 0 {
+		return nil, fmt.Errorf("fee history returned no base fees")
+	}
+
+	forecast := &FeeForecast{
+		BaseFee: forecastBaseFee(feeHistory.BaseFee, f.config.Confidence, f.config.TargetBlocks),
+		TipCap:  medianReward(feeHistory.Reward),
+	}
+
+	if f.config.TxPoolEnabled {
+		// eth_feeHistory returns the base fee of the next block last
+		nextBaseFee := assets.NewWei(feeHistory.BaseFee[len(feeHistory.BaseFee)-1])
+		pressureTipCap, perr := f.pendingTipCap(ctx, nextBaseFee)
+		if perr != nil {
+			f.logger.Debugw("Failed to account for pending transactions, only using the fee history", "err", perr)
+		} else if pressureTipCap.Cmp(forecast.TipCap) > 0 {
+			f.logger.Debugw("Raising tip cap due to pending transactions", "historicalTipCap", forecast.TipCap, "pressureTipCap", pressureTipCap)
+			forecast.TipCap = pressureTipCap
+		}
+	}
+
+	promForecastEstimatorBaseFee.WithLabelValues(f.chainID.String()).Set(float64(forecast.BaseFee.Int64()))
+	promForecastEstimatorTipCap.WithLabelValues(f.chainID.String()).Set(float64(forecast.TipCap.Int64()))
+
+	f.logger.Debugw("Forecasted new fees", "oldestBlock", feeHistory.OldestBlock, "rewardPercentile", percentile,
+		"baseFee", forecast.BaseFee, "tipCap", forecast.TipCap)
+
+	f.forecastMu.Lock()
+	defer f.forecastMu.Unlock()
+	f.forecast = forecast
+	return forecast, nil
+}
+
+func (f *ForecastEstimator) getForecast() (*FeeForecast, error) {
+	f.forecastMu.RLock()
+	defer f.forecastMu.RUnlock()
+	if f.forecast == nil {
+		return nil, fmt.Errorf("fee forecast not set")
+	}
+	return f.forecast, nil
+}
+
+// inclusionPercentile returns the percentile of priority fees which gives a confidence percent chance of inclusion
+// within targetBlocks, if each block includes the transaction with that percentile's chance: 1 - (1 - c)^(1/n).
+func inclusionPercentile(confidence uint8, targetBlocks uint16) float64 {
+	c := float64(confidence) / 100
+	return 100 * (1 - math.Pow(1-c, 1/float64(max(targetBlocks, 1))))
+}
+
+// forecastBaseFee extrapolates the base fee of the next block, the last of baseFees, up to targetBlocks. The change
+// of the base fee per block is the confidence percentile of its recent changes, and is never forecasted to decrease,
+// as the transaction may be included in the next block.
+func forecastBaseFee(baseFees []*big.Int, confidence uint8, targetBlocks uint16) *assets.Wei {
+	next := baseFees[len(baseFees)-1]
+	var changes []float64
+	for i := 0; i+1 < len(baseFees); i++ {
+		if baseFees[i] == nil || baseFees[i].Sign() <= 0 || baseFees[i+1] == nil {
+			continue
+		}
+		change, _ := new(big.Rat).SetFrac(baseFees[i+1], baseFees[i]).Float64()
+		changes = append(changes, change)
+	}
+	if len(changes) == 0 || next == nil || targetBlocks <= 1 {
+		return assets.NewWei(next)
+	}
+	slices.Sort(changes)
+	rank := (int(confidence)*len(changes) + 99) / 100
+	change := max(changes[max(rank, 1)-1], 1)
+
+	forecast := new(big.Float).SetInt(next)
+	forecast.Mul(forecast, big.NewFloat(math.Pow(change, float64(targetBlocks-1))))
+	forecastInt, _ := forecast.Int(nil)
+	return assets.NewWei(forecastInt)
+}
+
+// medianReward returns the median of the non-zero rewards of the blocks. Rewards of empty blocks are zero, so they
+// are excluded to pick a value from a more representative sample.
+func medianReward(rewards [][]*big.Int) *assets.Wei {
+	var nonZero []*big.Int
+	for _, reward := range rewards {
+		if len(reward) > 0 && reward[0] != nil && reward[0].Sign() > 0 {
+			nonZero = append(nonZero, reward[0])
+		}
+	}
+	if len(nonZero) == 0 {
+		return assets.NewWeiI(0)
+	}
+	slices.SortFunc(nonZero, func(a, b *big.Int) int { return a.Cmp(b) })
+	return assets.NewWei(nonZero[(len(nonZero)-1)/2])
+}
+
+type txPoolTx struct {
+	Gas                  hexutil.Uint64 `json:"gas"`
+	GasPrice             *hexutil.Big   `json:"gasPrice"`
+	MaxFeePerGas         *hexutil.Big   `json:"maxFeePerGas"`
+	MaxPriorityFeePerGas *hexutil.Big   `json:"maxPriorityFeePerGas"`
+}
+
+// effectiveTipCap returns the priority fee the transaction pays at baseFee, or nil if it can't be included.
+func (tx *txPoolTx) effectiveTipCap(baseFee *big.Int) *big.Int {
+	var tip *big.Int
+	switch {
+	case tx.MaxFeePerGas != nil && tx.MaxPriorityFeePerGas != nil:
+		tip = new(big.Int).Sub(tx.MaxFeePerGas.ToInt(), baseFee)
+		if p := tx.MaxPriorityFeePerGas.ToInt(); p.Cmp(tip) < 0 {
+			tip = new(big.Int).Set(p)
+		}
+	case tx.GasPrice != nil:
+		tip = new(big.Int).Sub(tx.GasPrice.ToInt(), baseFee)
+	default:
+		return nil
+	}
+	if tip.Sign() < 0 {
+		return nil
+	}
+	return tip
+}
+
+type pendingTx struct {
+	gas uint64
+	tip *big.Int
+}
+
+// pendingTipCap returns the priority fee needed to outbid the pending transactions which would otherwise fill the
+// TargetBlocks, or zero if they all fit.
+func (f *ForecastEstimator) pendingTipCap(ctx context.Context, baseFee *assets.Wei) (*assets.Wei, error) {
+	var content struct {
+		Pending map[string]map[string]*txPoolTx `json:"pending"`
+	}
+	if err := f.client.CallContext(ctx, &content, "txpool_content"); err != nil {
+		return nil, fmt.Errorf("failed to fetch txpool content: %w", err)
+	}
+	var latest struct {
+		GasLimit hexutil.Uint64 `json:"gasLimit"`
+	}
+	if err := f.client.CallContext(ctx, &latest, "eth_getBlockByNumber", "latest", false); err != nil {
+		return nil, fmt.Errorf("failed to fetch latest block: %w", err)
+	}
+
+	var pending []pendingTx
+	for _, txs := range content.Pending {
+		for _, tx := range txs {
+			if tip := tx.effectiveTipCap(baseFee.ToInt()); tip != nil {
+				pending = append(pending, pendingTx{gas: uint64(tx.Gas), tip: tip})
+			}
+		}
+	}
+	return outbidTipCap(pending, uint64(latest.GasLimit)*uint64(f.config.TargetBlocks)), nil
+}
+
+// outbidTipCap returns the lowest priority fee which is higher than that of the pending transactions that would fill
+// capacity, if transactions are included by priority fee.
+func outbidTipCap(pending []pendingTx, capacity uint64) *assets.Wei {
+	slices.SortFunc(pending, func(a, b pendingTx) int { return b.tip.Cmp(a.tip) })
+	var gas uint64
+	for _, tx := range pending {
+		gas += tx.gas
+		if gas > capacity {
+			return assets.NewWei(new(big.Int).Add(tx.tip, big.NewInt(1)))
+		}
+	}
+	return assets.NewWeiI(0)
+}
+
+// GetLegacyGas returns the forecasted base fee plus priority fee, as the gas price to pay.
+func (f *ForecastEstimator) GetLegacyGas(ctx context.Context, _ []byte, gasLimit uint64, maxPrice *assets.Wei, opts ...feetypes.Opt) (gasPrice *assets.Wei, chainSpecificGasLimit uint64, err error) {
+	chainSpecificGasLimit = gasLimit
+	forecast, err := f.getForecast()
+	if err != nil {
+		return nil, 0, err
+	}
+	gasPrice = forecast.BaseFee.Add(forecast.TipCap)
+	if gasPrice.Cmp(maxPrice) > 0 {
+		f.logger.Warnf("estimated gas price: %s is greater than the maximum gas price configured: %s, returning the maximum price instead.", gasPrice, maxPrice)
+		return maxPrice, chainSpecificGasLimit, nil
+	}
+	return
+}
+
+// GetDynamicFee returns the forecasted priority fee as the tip cap, and the forecasted base fee plus tip cap as the
+// fee cap.
+func (f *ForecastEstimator) GetDynamicFee(ctx context.Context, maxPrice *assets.Wei) (fee DynamicFee, err error) {
+	forecast, err := f.getForecast()
+	if err != nil {
+		return fee, err
+	}
+	fee = DynamicFee{GasFeeCap: forecast.BaseFee.Add(forecast.TipCap), GasTipCap: forecast.TipCap}
+	if fee.GasFeeCap.Cmp(maxPrice) > 0 {
+		f.logger.Warnf("estimated maxFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+			fee.GasFeeCap, maxPrice)
+		fee.GasFeeCap = maxPrice
+		if fee.GasTipCap.Cmp(maxPrice) > 0 {
+			f.logger.Warnf("estimated maxPriorityFeePerGas: %v is greater than the maximum price configured: %v, returning the maximum price instead.",
+				fee.GasTipCap, maxPrice)
+			fee.GasTipCap = maxPrice
+		}
+	}
+	return fee, nil
+}
+
+// refreshForBump refreshes the forecast before bumping, so that the bumped fee accounts for the current market.
+func (f *ForecastEstimator) refreshForBump(ctx context.Context) (forecast *FeeForecast, err error) {
+	if !f.IfStarted(func() {
+		forecast, err = f.Refresh(ctx)
+		if err == nil {
+			f.refreshCh <- struct{}{}
+		}
+	}) {
+		return nil, fmt.Errorf("estimator not started")
+	}
+	return
+}
+
+// BumpLegacyGas bumps the previous gas price by BumpPercent, or to the current forecast if it is higher.
+func (f *ForecastEstimator) BumpLegacyGas(ctx context.Context, originalGasPrice *assets.Wei, gasLimit uint64, maxPrice *assets.Wei, _ []EvmPriorAttempt) (*assets.Wei, uint64, error) {
+	if originalGasPrice == nil || originalGasPrice.Cmp(maxPrice) >= 0 {
+		return nil, 0, fmt.Errorf("%w: error while retrieving original gas price: originalGasPrice: %s. Maximum price configured: %s",
+			commonfee.ErrBump, originalGasPrice, maxPrice)
+	}
+
+	forecast, err := f.refreshForBump(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	currentGasPrice := forecast.BaseFee.Add(forecast.TipCap)
+
+	bumpedGasPrice, err := LimitBumpedFee(originalGasPrice, currentGasPrice, originalGasPrice.AddPercentage(f.config.BumpPercent), maxPrice)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to limit gas price: %w", err)
+	}
+
+	f.logger.Debugw("bumped gas price", "originalGasPrice", originalGasPrice, "marketGasPrice", currentGasPrice, "bumpedGasPrice", bumpedGasPrice)
+
+	return bumpedGasPrice, gasLimit, nil
+}
+
+// BumpDynamicFee bumps both the fee cap and the tip cap of the previous fee by BumpPercent, or to the current forecast
+// if it is higher.
+func (f *ForecastEstimator) BumpDynamicFee(ctx context.Context, originalFee DynamicFee, maxPrice *assets.Wei, _ []EvmPriorAttempt) (bumped DynamicFee, err error) {
+	if originalFee.GasFeeCap == nil ||
+		originalFee.GasTipCap == nil ||
+		((originalFee.GasTipCap.Cmp(originalFee.GasFeeCap)) > 0) ||
+		(originalFee.GasFeeCap.Cmp(maxPrice) >= 0) {
+		return bumped, fmt.Errorf("%w: error while retrieving original dynamic fees: (originalFeePerGas: %s - originalPriorityFeePerGas: %s). Maximum price configured: %s",
+			commonfee.ErrBump, originalFee.GasFeeCap, originalFee.GasTipCap, maxPrice)
+	}
+
+	forecast, err := f.refreshForBump(ctx)
+	if err != nil {
+		return bumped, err
+	}
+
+	bumpedTipCap, err := LimitBumpedFee(originalFee.GasTipCap, forecast.TipCap, originalFee.GasTipCap.AddPercentage(f.config.BumpPercent), maxPrice)
+	if err != nil {
+		return bumped, fmt.Errorf("failed to limit maxPriorityFeePerGas: %w", err)
+	}
+	bumpedFeeCap, err := LimitBumpedFee(originalFee.GasFeeCap, forecast.BaseFee.Add(bumpedTipCap), originalFee.GasFeeCap.AddPercentage(f.config.BumpPercent), maxPrice)
+	if err != nil {
+		return bumped, fmt.Errorf("failed to limit maxFeePerGas: %w", err)
+	}
+
+	bumped = DynamicFee{GasFeeCap: bumpedFeeCap, GasTipCap: bumpedTipCap}
+	f.logger.Debugw("bumped dynamic fee", "originalFee", originalFee, "forecast", forecast, "bumpedFee", bumped)
+
+	return bumped, nil
+}
+
+func (f *ForecastEstimator) Name() string                                      { return f.logger.Name() }
+func (f *ForecastEstimator) L1Oracle() rollups.L1Oracle                        { return f.l1Oracle }
+func (f *ForecastEstimator) HealthReport() map[string]error                    { return map[string]error{f.Name(): nil} }
+func (f *ForecastEstimator) OnNewLongestChain(context.Context, *evmtypes.Head) {}
@@ -0,0 +1,258 @@
+package gas_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/services/servicetest"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// txPoolClient replays history and serves txpool_content and the latest block from raw JSON.
+type txPoolClient struct {
+	*gas.HistoryReplayClient
+	content  string
+	gasLimit string
+}
+
+func (c *txPoolClient) CallContext(_ context.Context, result interface{}, method string, _ ...interface{}) error {
+	switch method {
+	case "txpool_content":
+		return json.Unmarshal([]byte(c.content), result)
+	case "eth_getBlockByNumber":
+		return json.Unmarshal([]byte(`{"gasLimit":"`+c.gasLimit+`"}`), result)
+	}
+	return c.HistoryReplayClient.CallContext(context.Background(), result, method)
+}
+
+func historicalBlocks(baseFees []int64, tips ...int64) []gas.HistoricalBlock {
+	var blocks []gas.HistoricalBlock
+	for i, baseFee := range baseFees {
+		block := gas.HistoricalBlock{Number: int64(i), BaseFee: assets.NewWeiI(baseFee)}
+		for _, tip := range tips {
+			block.Tips = append(block.Tips, assets.NewWeiI(tip))
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func TestForecastEstimatorLifecycle(t *testing.T) {
+	t.Parallel()
+	chainID := big.NewInt(0)
+
+	t.Run("fails if you fetch fees before the first forecast", func(t *testing.T) {
+		u := gas.NewForecastEstimator(logger.Test(t), nil, gas.ForecastEstimatorConfig{}, chainID, nil)
+		_, _, err := u.GetLegacyGas(tests.Context(t), nil, 21000, assets.NewWeiI(100))
+		assert.ErrorContains(t, err, "fee forecast not set")
+		_, err = u.GetDynamicFee(tests.Context(t), assets.NewWeiI(100))
+		assert.ErrorContains(t, err, "fee forecast not set")
+	})
+
+	t.Run("fails to start if BumpPercent is lower than the minimum cap", func(t *testing.T) {
+		u := gas.NewForecastEstimator(logger.Test(t), nil, gas.ForecastEstimatorConfig{BumpPercent: 9, BlockHistorySize: 10}, chainID, nil)
+		assert.ErrorContains(t, u.Start(tests.Context(t)), "BumpPercent")
+	})
+
+	t.Run("fails to start if BlockHistorySize is too small to forecast", func(t *testing.T) {
+		u := gas.NewForecastEstimator(logger.Test(t), nil, gas.ForecastEstimatorConfig{BumpPercent: 20, BlockHistorySize: 1}, chainID, nil)
+		assert.ErrorContains(t, u.Start(tests.Context(t)), "BlockHistorySize")
+	})
+}
+
+func TestForecastEstimatorRefresh(t *testing.T) {
+	t.Parallel()
+	chainID := big.NewInt(0)
+	maxPrice := assets.NewWeiI(1000)
+	cfg := gas.ForecastEstimatorConfig{BumpPercent: 20, BlockHistorySize: 4, TargetBlocks: 3, Confidence: 90}
+
+	t.Run("forecasts a flat base fee and the median tip", func(t *testing.T) {
+		blocks := historicalBlocks([]int64{100, 100, 100, 100, 100}, 5, 10, 20)
+		client := gas.NewHistoryReplayClient(blocks)
+		client.SetLatest(3)
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		forecast, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(100), forecast.BaseFee)
+		// 90% confidence within 3 blocks takes the ~54th percentile of each block
+		assert.Equal(t, assets.NewWeiI(10), forecast.TipCap)
+
+		fee, err := u.GetDynamicFee(tests.Context(t), maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(110), GasTipCap: assets.NewWeiI(10)}, fee)
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, 21000, maxPrice)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(110), gasPrice)
+	})
+
+	t.Run("extrapolates a rising base fee to the target block", func(t *testing.T) {
+		blocks := historicalBlocks([]int64{100, 110, 121, 133, 146}, 10)
+		client := gas.NewHistoryReplayClient(blocks)
+		client.SetLatest(3)
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		forecast, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		// the next base fee is 146, raised by 10% per block for the 2 following blocks
+		assert.Equal(t, assets.NewWeiI(176), forecast.BaseFee)
+	})
+
+	t.Run("does not forecast a falling base fee", func(t *testing.T) {
+		blocks := historicalBlocks([]int64{200, 180, 160, 140, 120}, 10)
+		client := gas.NewHistoryReplayClient(blocks)
+		client.SetLatest(3)
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		forecast, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(120), forecast.BaseFee)
+	})
+
+	t.Run("outbids pending transactions which would fill the target blocks", func(t *testing.T) {
+		cfg := cfg
+		cfg.TxPoolEnabled = true
+		cfg.TargetBlocks = 1
+		client := &txPoolClient{
+			HistoryReplayClient: gas.NewHistoryReplayClient(historicalBlocks([]int64{100, 100, 100, 100, 100}, 10)),
+			content: `{"pending":{"0xa":{
+				"0":{"gas":"0x5208","maxFeePerGas":"0x96","maxPriorityFeePerGas":"0x32"},
+				"1":{"gas":"0x5208","gasPrice":"0x8c"},
+				"2":{"gas":"0x5208","gasPrice":"0x82"}}}}`,
+			gasLimit: "0xa410", // fits two transactions
+		}
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		forecast, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		// the third transaction pays a tip of 30, so 31 gets included instead
+		assert.Equal(t, assets.NewWeiI(31), forecast.TipCap)
+	})
+
+	t.Run("ignores pending transactions if the txpool is not supported", func(t *testing.T) {
+		cfg := cfg
+		cfg.TxPoolEnabled = true
+		client := gas.NewHistoryReplayClient(historicalBlocks([]int64{100, 100, 100, 100, 100}, 10))
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		forecast, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(10), forecast.TipCap)
+	})
+
+	t.Run("returns the max price if the forecast exceeds it", func(t *testing.T) {
+		client := gas.NewHistoryReplayClient(historicalBlocks([]int64{100, 100, 100, 100, 100}, 10))
+
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		_, err := u.Refresh(tests.Context(t))
+		require.NoError(t, err)
+		fee, err := u.GetDynamicFee(tests.Context(t), assets.NewWeiI(50))
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(50), GasTipCap: assets.NewWeiI(10)}, fee)
+		gasPrice, _, err := u.GetLegacyGas(tests.Context(t), nil, 21000, assets.NewWeiI(50))
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(50), gasPrice)
+	})
+}
+
+func TestForecastEstimatorBump(t *testing.T) {
+	t.Parallel()
+	chainID := big.NewInt(0)
+	maxPrice := assets.NewWeiI(1000)
+	cfg := gas.ForecastEstimatorConfig{BumpPercent: 20, BlockHistorySize: 4, TargetBlocks: 3, Confidence: 90, CacheTimeout: 10 * time.Second}
+
+	t.Run("bumps by BumpPercent if the forecast is lower", func(t *testing.T) {
+		client := gas.NewHistoryReplayClient(historicalBlocks([]int64{100, 100, 100, 100}, 10))
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		servicetest.RunHealthy(t, u)
+
+		bumped, err := u.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: assets.NewWeiI(200), GasTipCap: assets.NewWeiI(20)}, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(240), GasTipCap: assets.NewWeiI(24)}, bumped)
+
+		gasPrice, _, err := u.BumpLegacyGas(tests.Context(t), assets.NewWeiI(200), 21000, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, assets.NewWeiI(240), gasPrice)
+	})
+
+	t.Run("bumps to the forecast if it is higher", func(t *testing.T) {
+		client := gas.NewHistoryReplayClient(historicalBlocks([]int64{100, 100, 100, 100}, 50))
+		u := gas.NewForecastEstimator(logger.Test(t), client, cfg, chainID, nil)
+		servicetest.RunHealthy(t, u)
+
+		bumped, err := u.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: assets.NewWeiI(110), GasTipCap: assets.NewWeiI(10)}, maxPrice, nil)
+		require.NoError(t, err)
+		assert.Equal(t, gas.DynamicFee{GasFeeCap: assets.NewWeiI(150), GasTipCap: assets.NewWeiI(50)}, bumped)
+	})
+
+	t.Run("fails if the original fee is already at the max price", func(t *testing.T) {
+		u := gas.NewForecastEstimator(logger.Test(t), nil, cfg, chainID, nil)
+		_, err := u.BumpDynamicFee(tests.Context(t), gas.DynamicFee{GasFeeCap: maxPrice, GasTipCap: assets.NewWeiI(10)}, maxPrice, nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestBacktestForecast(t *testing.T) {
+	t.Parallel()
+
+	t.Run("all estimates are included in a flat market", func(t *testing.T) {
+		blocks := historicalBlocks([]int64{100, 100, 100, 100, 100, 100, 100, 100}, 5, 10, 20)
+		cfg := gas.ForecastEstimatorConfig{BumpPercent: 20, BlockHistorySize: 4, TargetBlocks: 3, Confidence: 90}
+
+		result, err := gas.BacktestForecast(tests.Context(t), logger.Test(t), cfg, blocks)
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Estimates)
+		assert.Equal(t, 4, result.IncludedInTarget)
+		assert.Equal(t, 1.0, result.HitRate())
+		assert.Equal(t, 1.0, result.AverageDelay())
+		assert.Equal(t, assets.NewWeiI(5), result.AverageOverpay())
+	})
+
+	t.Run("estimates lag behind a tip spike", func(t *testing.T) {
+		blocks := historicalBlocks([]int64{100, 100, 100, 100, 100, 100, 100, 100}, 10)
+		for i := 5; i < len(blocks); i++ {
+			blocks[i].Tips = []*assets.Wei{assets.NewWeiI(50)}
+		}
+		cfg := gas.ForecastEstimatorConfig{BumpPercent: 20, BlockHistorySize: 4, TargetBlocks: 1, Confidence: 50}
+
+		result, err := gas.BacktestForecast(tests.Context(t), logger.Test(t), cfg, blocks)
+		require.NoError(t, err)
+		assert.Equal(t, 4, result.Estimates)
+		assert.Equal(t, 1, result.IncludedInTarget)
+		assert.Equal(t, 1, result.Included)
+		assert.Equal(t, 0.25, result.HitRate())
+	})
+
+	t.Run("fails without enough blocks", func(t *testing.T) {
+		cfg := gas.ForecastEstimatorConfig{BlockHistorySize: 4}
+		_, err := gas.BacktestForecast(tests.Context(t), logger.Test(t), cfg, historicalBlocks([]int64{100, 100}))
+		assert.Error(t, err)
+	})
+}
+
+func TestHistoricalBlocksFromBlocks(t *testing.T) {
+	t.Parallel()
+
+	blocks := gas.HistoricalBlocksFromBlocks([]evmtypes.Block{
+		{Number: 2, BaseFeePerGas: assets.NewWeiI(100), Transactions: []evmtypes.Transaction{
+			{Type: 0x0, GasPrice: assets.NewWeiI(130)},
+			{Type: 0x2, MaxFeePerGas: assets.NewWeiI(120), MaxPriorityFeePerGas: assets.NewWeiI(50)},
+			{Type: 0x2, MaxFeePerGas: assets.NewWeiI(200), MaxPriorityFeePerGas: assets.NewWeiI(5)},
+		}},
+		{Number: 1, BaseFeePerGas: assets.NewWeiI(90)},
+	})
+	require.Len(t, blocks, 2)
+	assert.Equal(t, int64(1), blocks[0].Number)
+	assert.Equal(t, []*assets.Wei{assets.NewWeiI(30), assets.NewWeiI(20), assets.NewWeiI(5)}, blocks[1].Tips)
+}
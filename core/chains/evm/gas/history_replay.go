@@ -0,0 +1,326 @@
+package gas
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// HistoricalBlock is the fee market of an included block, as recorded for backtesting estimators.
+type HistoricalBlock struct {
+	Number  int64       `json:"number"`
+	BaseFee *assets.Wei `json:"baseFee"`
+	// Tips are the effective priority fees paid by the transactions included in the block.
+	Tips []*assets.Wei `json:"tips"`
+}
+
+// HistoricalBlocksFromHeads returns the blocks of heads, ordered by number. Heads don't include transactions, so the
+// blocks have no tips.
+func HistoricalBlocksFromHeads(heads []*evmtypes.Head) []HistoricalBlock {
+	blocks := make([]HistoricalBlock, 0, len(heads))
+	for _, h := range heads {
+		blocks = append(blocks, HistoricalBlock{Number: h.Number, BaseFee: h.BaseFeePerGas})
+	}
+	slices.SortFunc(blocks, func(a, b HistoricalBlock) int { return int(a.Number - b.Number) })
+	return blocks
+}
+
+// HistoricalBlocksFromBlocks returns the blocks with the effective priority fees of their transactions, ordered by
+// number.
+func HistoricalBlocksFromBlocks(evmBlocks []evmtypes.Block) []HistoricalBlock {
+	blocks := make([]HistoricalBlock, 0, len(evmBlocks))
+	for _, b := range evmBlocks {
+		block := HistoricalBlock{Number: b.Number, BaseFee: b.BaseFeePerGas}
+		for _, tx := range b.Transactions {
+			if tip := effectiveTip(b.BaseFeePerGas, tx); tip != nil {
+				block.Tips = append(block.Tips, tip)
+			}
+		}
+		blocks = append(blocks, block)
+	}
+	slices.SortFunc(blocks, func(a, b HistoricalBlock) int { return int(a.Number - b.Number) })
+	return blocks
+}
+
+// LoadHistoricalBlocks reads a JSON array of either HistoricalBlock, or blocks as returned by eth_getBlockByNumber
+// with full transactions.
+func LoadHistoricalBlocks(r io.Reader) ([]HistoricalBlock, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, fmt.Errorf("failed to decode blocks: %w", err)
+	}
+	if len(raw) == 0 {
+		return nil, errors.New("no blocks found")
+	}
+	var probe map[string]json.RawMessage
+	if err := json.Unmarshal(raw[0], &probe); err != nil {
+		return nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+
+	if _, isRPCBlock := probe["transactions"]; isRPCBlock {
+		evmBlocks := make([]evmtypes.Block, len(raw))
+		for i := range raw {
+			if err := json.Unmarshal(raw[i], &evmBlocks[i]); err != nil {
+				return nil, fmt.Errorf("failed to decode block %d: %w", i, err)
+			}
+		}
+		return HistoricalBlocksFromBlocks(evmBlocks), nil
+	}
+
+	blocks := make([]HistoricalBlock, len(raw))
+	for i := range raw {
+		if err := json.Unmarshal(raw[i], &blocks[i]); err != nil {
+			return nil, fmt.Errorf("failed to decode block %d: %w", i, err)
+		}
+	}
+	slices.SortFunc(blocks, func(a, b HistoricalBlock) int { return int(a.Number - b.Number) })
+	return blocks, nil
+}
+
+func effectiveTip(baseFee *assets.Wei, tx evmtypes.Transaction) *assets.Wei {
+	if baseFee == nil {
+		baseFee = assets.NewWeiI(0)
+	}
+	var tip *assets.Wei
+	switch tx.Type {
+	case 0x0, 0x1:
+		if tx.GasPrice == nil {
+			return nil
+		}
+		tip = tx.GasPrice.Sub(baseFee)
+	case 0x2, 0x3:
+		if tx.MaxFeePerGas == nil || tx.MaxPriorityFeePerGas == nil {
+			return nil
+		}
+		tip = assets.WeiMin(tx.MaxPriorityFeePerGas, tx.MaxFeePerGas.Sub(baseFee))
+	default:
+		return nil
+	}
+	if tip.IsNegative() {
+		return nil
+	}
+	return tip
+}
+
+// includedTip returns the priority fee a transaction paying fee would pay in block, and whether it would have been
+// included: its fee must cover the base fee, and its tip must be at least the lowest tip of the block.
+func includedTip(block HistoricalBlock, fee EvmFee) (*assets.Wei, bool) {
+	baseFee := assets.NewWei(baseFeeOrZero(block.BaseFee))
+	var tip *assets.Wei
+	if fee.ValidDynamic() {
+		if fee.GasFeeCap.Cmp(baseFee) < 0 {
+			return nil, false
+		}
+		tip = assets.WeiMin(fee.GasTipCap, fee.GasFeeCap.Sub(baseFee))
+	} else {
+		if fee.GasPrice == nil || fee.GasPrice.Cmp(baseFee) < 0 {
+			return nil, false
+		}
+		tip = fee.GasPrice.Sub(baseFee)
+	}
+	return tip, tip.Cmp(tipPercentile(block.Tips, 0)) >= 0
+}
+
+var _ feeEstimatorClient = (*HistoryReplayClient)(nil)
+
+// HistoryReplayClient serves the RPC calls used by estimators from recorded blocks, as if the latest block was one of
+// them. Calls which can't be answered from the blocks, like txpool_content or eth_call, return an error, so estimators
+// relying on them fall back to the fee history or fail.
+type HistoryReplayClient struct {
+	blocks []HistoricalBlock
+
+	latestMu sync.RWMutex
+	latest   int
+}
+
+func NewHistoryReplayClient(blocks []HistoricalBlock) *HistoryReplayClient {
+	return &HistoryReplayClient{blocks: blocks, latest: len(blocks) - 1}
+}
+
+// SetLatest sets the index of the block treated as the latest one.
+func (c *HistoryReplayClient) SetLatest(i int) {
+	c.latestMu.Lock()
+	defer c.latestMu.Unlock()
+	c.latest = i
+}
+
+func (c *HistoryReplayClient) getLatest() int {
+	c.latestMu.RLock()
+	defer c.latestMu.RUnlock()
+	return c.latest
+}
+
+// index returns the index of the block with number n, if it isn't after the latest block.
+func (c *HistoryReplayClient) index(n int64) (int, bool) {
+	i := slices.IndexFunc(c.blocks[:c.getLatest()+1], func(b HistoricalBlock) bool { return b.Number == n })
+	return i, i >= 0
+}
+
+func (c *HistoryReplayClient) FeeHistory(_ context.Context, blockCount uint64, lastBlock *big.Int, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	last := c.getLatest()
+	if lastBlock != nil {
+		var ok bool
+		if last, ok = c.index(lastBlock.Int64()); !ok {
+			return nil, fmt.Errorf("block %s not found in history", lastBlock)
+		}
+	}
+	first := max(last-int(blockCount)+1, 0)
+
+	feeHistory := &ethereum.FeeHistory{OldestBlock: big.NewInt(c.blocks[first].Number)}
+	for i := first; i <= last; i++ {
+		block := c.blocks[i]
+		feeHistory.BaseFee = append(feeHistory.BaseFee, baseFeeOrZero(block.BaseFee))
+		rewards := make([]*big.Int, len(rewardPercentiles))
+		for j, p := range rewardPercentiles {
+			rewards[j] = tipPercentile(block.Tips, p).ToInt()
+		}
+		feeHistory.Reward = append(feeHistory.Reward, rewards)
+	}
+	feeHistory.BaseFee = append(feeHistory.BaseFee, c.nextBaseFee(last).ToInt())
+	return feeHistory, nil
+}
+
+// nextBaseFee returns the base fee of the block after the i-th one. It is determined by the i-th block, so it isn't a
+// peek into the future.
+func (c *HistoryReplayClient) nextBaseFee(i int) *assets.Wei {
+	if i+1 < len(c.blocks) {
+		i++
+	}
+	return assets.NewWei(baseFeeOrZero(c.blocks[i].BaseFee))
+}
+
+// SuggestGasPrice returns the next base fee plus the 60th percentile of the tips of the latest 20 blocks, like the gas
+// price oracle of geth.
+func (c *HistoryReplayClient) SuggestGasPrice(context.Context) (*big.Int, error) {
+	latest := c.getLatest()
+	var tips []*assets.Wei
+	for i := max(latest-19, 0); i <= latest; i++ {
+		tips = append(tips, c.blocks[i].Tips...)
+	}
+	return c.nextBaseFee(latest).Add(tipPercentile(tips, 60)).ToInt(), nil
+}
+
+func (c *HistoryReplayClient) CallContext(ctx context.Context, result interface{}, method string, _ ...interface{}) error {
+	if method == "eth_gasPrice" {
+		res, ok := result.(*hexutil.Big)
+		if !ok {
+			return fmt.Errorf("expected result to be a %T, got %T", &hexutil.Big{}, result)
+		}
+		price, err := c.SuggestGasPrice(ctx)
+		if err != nil {
+			return err
+		}
+		*res = hexutil.Big(*price)
+		return nil
+	}
+	return fmt.Errorf("%s is not supported when replaying history", method)
+}
+
+// BatchCallContext serves eth_getBlockByNumber with a transaction paying each of the tips of the block.
+func (c *HistoryReplayClient) BatchCallContext(_ context.Context, b []rpc.BatchElem) error {
+	for i, elem := range b {
+		if elem.Method != "eth_getBlockByNumber" {
+			b[i].Error = fmt.Errorf("%s is not supported when replaying history", elem.Method)
+			continue
+		}
+		result, ok := elem.Result.(*evmtypes.Block)
+		if !ok {
+			return fmt.Errorf("expected result to be a %T, got %T", &evmtypes.Block{}, elem.Result)
+		}
+		idx, found := c.index(HexToInt64(elem.Args[0]))
+		if !found {
+			b[i].Error = evmtypes.ErrMissingBlock
+			continue
+		}
+		*result = c.evmBlock(idx)
+	}
+	return nil
+}
+
+func (c *HistoryReplayClient) evmBlock(i int) evmtypes.Block {
+	block := c.blocks[i]
+	evmBlock := evmtypes.Block{
+		Number:        block.Number,
+		Hash:          replayBlockHash(block.Number),
+		ParentHash:    replayBlockHash(block.Number - 1),
+		BaseFeePerGas: block.BaseFee,
+	}
+	for _, tip := range block.Tips {
+		tx := evmtypes.Transaction{GasLimit: 21000}
+		if block.BaseFee == nil {
+			tx.Type = 0x0
+			tx.GasPrice = tip
+		} else {
+			tx.Type = 0x2
+			tx.MaxFeePerGas = block.BaseFee.Add(tip)
+			tx.MaxPriorityFeePerGas = tip
+		}
+		evmBlock.Transactions = append(evmBlock.Transactions, tx)
+	}
+	return evmBlock
+}
+
+func (c *HistoryReplayClient) HeadByNumber(_ context.Context, n *big.Int) (*evmtypes.Head, error) {
+	i := c.getLatest()
+	if n != nil {
+		var ok bool
+		if i, ok = c.index(n.Int64()); !ok {
+			return nil, fmt.Errorf("block %s not found in history", n)
+		}
+	}
+	return c.head(i), nil
+}
+
+func (c *HistoryReplayClient) head(i int) *evmtypes.Head {
+	block := c.blocks[i]
+	return &evmtypes.Head{
+		Number:        block.Number,
+		Hash:          replayBlockHash(block.Number),
+		ParentHash:    replayBlockHash(block.Number - 1),
+		BaseFeePerGas: block.BaseFee,
+	}
+}
+
+func (c *HistoryReplayClient) CallContract(context.Context, ethereum.CallMsg, *big.Int) ([]byte, error) {
+	return nil, errors.New("eth_call is not supported when replaying history")
+}
+
+func (c *HistoryReplayClient) EstimateGas(context.Context, ethereum.CallMsg) (uint64, error) {
+	return 0, errors.New("eth_estimateGas is not supported when replaying history")
+}
+
+// replayBlockHash returns a unique non-zero hash for the block number.
+func replayBlockHash(n int64) common.Hash {
+	return common.BigToHash(big.NewInt(n + 1))
+}
+
+func baseFeeOrZero(baseFee *assets.Wei) *big.Int {
+	if baseFee == nil {
+		return big.NewInt(0)
+	}
+	return baseFee.ToInt()
+}
+
+// tipPercentile returns the nearest-rank percentile of tips, or zero if there are none.
+func tipPercentile(tips []*assets.Wei, percentile float64) *assets.Wei {
+	if len(tips) == 0 {
+		return assets.NewWeiI(0)
+	}
+	sorted := slices.Clone(tips)
+	slices.SortFunc(sorted, func(a, b *assets.Wei) int { return a.Cmp(b) })
+	rank := int(percentile / 100 * float64(len(sorted)))
+	return sorted[min(max(rank, 0), len(sorted)-1)]
+}
@@ -118,6 +118,19 @@ func NewEstimator(lggr logger.Logger, ethClient feeEstimatorClient, chaintype ch
 			}
 			return NewFeeHistoryEstimator(lggr, ethClient, ccfg, chainID, l1Oracle)
 		}
+	case "Forecast":
+		newEstimator = func(l logger.Logger) EvmEstimator {
+			ccfg := ForecastEstimatorConfig{
+				BumpPercent:      geCfg.BumpPercent(),
+				CacheTimeout:     geCfg.Forecast().CacheTimeout(),
+				EIP1559:          geCfg.EIP1559DynamicFees(),
+				BlockHistorySize: uint64(geCfg.Forecast().BlockHistorySize()),
+				TargetBlocks:     geCfg.Forecast().TargetBlocks(),
+				Confidence:       geCfg.Forecast().Confidence(),
+				TxPoolEnabled:    geCfg.Forecast().TxPoolEnabled(),
+			}
+			return NewForecastEstimator(lggr, ethClient, ccfg, chainID, l1Oracle)
+		}
 
 	default:
 		lggr.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
@@ -0,0 +1,200 @@
+package gas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+)
+
+// SimulationOptions configures the transactions sent while simulating an estimator.
+type SimulationOptions struct {
+	// TxInterval is the number of blocks between simulated transactions. Defaults to 1.
+	TxInterval int
+	// Warmup is the number of blocks replayed before the first transaction, so that the estimator has history.
+	// Defaults to the BlockHistorySize of the estimator.
+	Warmup int
+}
+
+// SimulationResult summarizes the transactions sent with the fees of an estimator while replaying history.
+type SimulationResult struct {
+	// Transactions is the number of transactions sent.
+	Transactions int
+	// Included is the number of transactions included before the end of the history.
+	Included int
+	// Bumps is the number of times transactions were bumped, over all transactions.
+	Bumps int
+	// TotalDelay is the sum of the number of blocks until inclusion, over the included transactions.
+	TotalDelay int
+	// MaxDelay is the highest number of blocks until inclusion.
+	MaxDelay int
+	// TotalGasPrice is the sum of the effective gas prices paid, over the included transactions.
+	TotalGasPrice *assets.Wei
+	// TotalFees is the sum of the fees paid for LimitDefault gas, over the included transactions.
+	TotalFees *assets.Wei
+}
+
+// AverageDelay is the average number of blocks until inclusion, over the included transactions.
+func (r SimulationResult) AverageDelay() float64 {
+	if r.Included == 0 {
+		return 0
+	}
+	return float64(r.TotalDelay) / float64(r.Included)
+}
+
+// AverageGasPrice is the average effective gas price paid, over the included transactions.
+func (r SimulationResult) AverageGasPrice() *assets.Wei {
+	if r.Included == 0 {
+		return assets.NewWeiI(0)
+	}
+	return assets.NewWei(new(big.Int).Div(r.TotalGasPrice.ToInt(), big.NewInt(int64(r.Included))))
+}
+
+type simulatedTx struct {
+	sentAt   int
+	bumpedAt int
+	fee      EvmFee
+	attempts []EvmPriorAttempt
+}
+
+// SimulateEstimator replays blocks through the estimator configured by geCfg, without any RPC. After warming up, a
+// transaction is sent every TxInterval blocks with the estimated fee, and is included in the first following block in
+// which its fee covers the base fee and its tip is at least the lowest tip of the block. Pending transactions are bumped
+// every BumpThreshold blocks, like the transaction manager does.
+//
+// Estimators relying on RPC calls which can't be replayed from the blocks, like the L1 oracle of Arbitrum, aren't
+// supported.
+func SimulateEstimator(ctx context.Context, lggr logger.Logger, geCfg evmconfig.GasEstimator, blocks []HistoricalBlock, opts SimulationOptions) (SimulationResult, error) {
+	result := SimulationResult{TotalGasPrice: assets.NewWeiI(0), TotalFees: assets.NewWeiI(0)}
+	switch mode := geCfg.Mode(); mode {
+	case "BlockHistory", "FixedPrice", "L2Suggested", "SuggestedPrice", "FeeHistory", "Forecast":
+	default:
+		return result, fmt.Errorf("estimator mode %s is not supported for simulations", mode)
+	}
+	if opts.TxInterval <= 0 {
+		opts.TxInterval = 1
+	}
+	if opts.Warmup <= 0 {
+		opts.Warmup = int(geCfg.BlockHistory().BlockHistorySize())
+		if geCfg.Mode() == "Forecast" {
+			opts.Warmup = int(geCfg.Forecast().BlockHistorySize())
+		}
+	}
+	if opts.Warmup >= len(blocks)-1 {
+		return result, fmt.Errorf("not enough blocks to simulate: got %d, need more than %d to warm up", len(blocks), opts.Warmup+1)
+	}
+
+	client := NewHistoryReplayClient(blocks)
+	client.SetLatest(opts.Warmup)
+	estimator, err := NewEstimator(lggr, client, "", big.NewInt(0), geCfg, nil)
+	if err != nil {
+		return result, fmt.Errorf("failed to create estimator: %w", err)
+	}
+	if err = estimator.Start(ctx); err != nil {
+		return result, fmt.Errorf("failed to start estimator: %w", err)
+	}
+	defer func() {
+		if cerr := estimator.Close(); cerr != nil {
+			lggr.Errorw("Failed to close estimator", "err", cerr)
+		}
+	}()
+
+	gasLimit := geCfg.LimitDefault()
+	maxPrice := geCfg.PriceMax()
+	var pending []*simulatedTx
+	for i := opts.Warmup; i < len(blocks); i++ {
+		stillPending := pending[:0]
+		for _, tx := range pending {
+			tip, included := includedTip(blocks[i], tx.fee)
+			if !included {
+				stillPending = append(stillPending, tx)
+				continue
+			}
+			delay := i - tx.sentAt
+			gasPrice := assets.NewWei(baseFeeOrZero(blocks[i].BaseFee)).Add(tip)
+			result.Included++
+			result.TotalDelay += delay
+			result.MaxDelay = max(result.MaxDelay, delay)
+			result.TotalGasPrice = result.TotalGasPrice.Add(gasPrice)
+			result.TotalFees = result.TotalFees.Add(gasPrice.Mul(new(big.Int).SetUint64(gasLimit)))
+		}
+		pending = stillPending
+
+		client.SetLatest(i)
+		if err = refreshEstimator(ctx, estimator, client, i); err != nil {
+			return result, fmt.Errorf("failed to refresh estimator at block %d: %w", blocks[i].Number, err)
+		}
+
+		if threshold := int(geCfg.BumpThreshold()); threshold > 0 {
+			for _, tx := range pending {
+				if i-tx.bumpedAt < threshold {
+					continue
+				}
+				bumped, _, berr := estimator.BumpFee(ctx, tx.fee, gasLimit, maxPrice, tx.attempts)
+				if berr != nil {
+					lggr.Debugw("Failed to bump fee, waiting for inclusion", "block", blocks[i].Number, "fee", tx.fee, "err", berr)
+					continue
+				}
+				result.Bumps++
+				tx.fee = bumped
+				tx.bumpedAt = i
+				tx.attempts = append([]EvmPriorAttempt{priorAttempt(bumped, gasLimit, blocks[i].Number)}, tx.attempts...)
+			}
+		}
+
+		if (i-opts.Warmup)%opts.TxInterval != 0 || i == len(blocks)-1 {
+			continue
+		}
+		fee, _, ferr := estimator.GetFee(ctx, nil, gasLimit, maxPrice, nil, nil)
+		if ferr != nil {
+			return result, fmt.Errorf("failed to estimate fee at block %d: %w", blocks[i].Number, ferr)
+		}
+		result.Transactions++
+		pending = append(pending, &simulatedTx{sentAt: i, bumpedAt: i, fee: fee,
+			attempts: []EvmPriorAttempt{priorAttempt(fee, gasLimit, blocks[i].Number)}})
+	}
+	return result, nil
+}
+
+// refreshEstimator synchronously updates the estimator with the history up to the i-th block, instead of waiting for
+// its own polling.
+func refreshEstimator(ctx context.Context, estimator EvmFeeEstimator, client *HistoryReplayClient, i int) error {
+	wrapped, ok := estimator.(*evmFeeEstimator)
+	if !ok {
+		return fmt.Errorf("unexpected estimator type %T", estimator)
+	}
+	switch e := wrapped.EvmEstimator.(type) {
+	case *BlockHistoryEstimator:
+		head := client.head(i)
+		e.setLatest(head)
+		e.FetchBlocksAndRecalculate(ctx, head)
+	case *FeeHistoryEstimator:
+		if e.config.EIP1559 {
+			return e.RefreshDynamicPrice()
+		}
+		_, err := e.RefreshGasPrice()
+		return err
+	case *ForecastEstimator:
+		_, err := e.Refresh(ctx)
+		return err
+	case *SuggestedPriceEstimator:
+		return e.forceRefresh(ctx)
+	case *fixedPriceEstimator:
+	default:
+		return errors.New("estimator is not supported for simulations")
+	}
+	return nil
+}
+
+func priorAttempt(fee EvmFee, gasLimit uint64, blockNum int64) EvmPriorAttempt {
+	attempt := EvmPriorAttempt{ChainSpecificFeeLimit: gasLimit, BroadcastBeforeBlockNum: &blockNum, GasPrice: fee.GasPrice, DynamicFee: fee.DynamicFee}
+	if fee.ValidDynamic() {
+		attempt.TxType = 0x2
+	}
+	return attempt
+}
@@ -0,0 +1,138 @@
+package gas_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/logger"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/tests"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/testutils"
+)
+
+func gweiBlocks(n int, baseFee int64, tips ...int64) []gas.HistoricalBlock {
+	var blocks []gas.HistoricalBlock
+	for i := 0; i < n; i++ {
+		block := gas.HistoricalBlock{Number: int64(100 + i), BaseFee: assets.GWei(baseFee)}
+		for _, tip := range tips {
+			block.Tips = append(block.Tips, assets.GWei(tip))
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks
+}
+
+func TestSimulateEstimator(t *testing.T) {
+	t.Parallel()
+
+	blocks := gweiBlocks(30, 10, 1, 2, 3)
+
+	t.Run("fixed price above the market is included in the next block", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.GasEstimator.Mode = ptr("FixedPrice")
+			c.GasEstimator.PriceDefault = assets.GWei(20)
+		})
+
+		result, err := gas.SimulateEstimator(tests.Context(t), logger.Test(t), cfg.EVM().GasEstimator(), blocks, gas.SimulationOptions{Warmup: 5})
+		require.NoError(t, err)
+		assert.Equal(t, 24, result.Transactions)
+		assert.Equal(t, 24, result.Included)
+		assert.Equal(t, 0, result.Bumps)
+		assert.Equal(t, 1.0, result.AverageDelay())
+		assert.Equal(t, assets.GWei(20), result.AverageGasPrice())
+	})
+
+	t.Run("fixed price below the market is bumped until included", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.GasEstimator.Mode = ptr("FixedPrice")
+			c.GasEstimator.PriceDefault = assets.GWei(10)
+			c.GasEstimator.BumpThreshold = ptr[uint32](2)
+			c.GasEstimator.BumpMin = assets.GWei(5)
+			c.GasEstimator.LimitDefault = ptr[uint64](21000)
+		})
+
+		result, err := gas.SimulateEstimator(tests.Context(t), logger.Test(t), cfg.EVM().GasEstimator(), blocks, gas.SimulationOptions{Warmup: 5, TxInterval: 5})
+		require.NoError(t, err)
+		assert.Equal(t, 5, result.Transactions)
+		assert.Equal(t, 5, result.Included)
+		// each transaction is bumped once by BumpMin after BumpThreshold blocks, then included in the next block
+		assert.Equal(t, 5, result.Bumps)
+		assert.Equal(t, 3, result.MaxDelay)
+		assert.Equal(t, assets.GWei(15), result.AverageGasPrice())
+		assert.Equal(t, assets.GWei(75).Mul(big.NewInt(21000)), result.TotalFees)
+	})
+
+	for _, mode := range []string{"BlockHistory", "FeeHistory", "Forecast", "SuggestedPrice"} {
+		t.Run(mode+" estimates are included in a steady market", func(t *testing.T) {
+			cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+				c.GasEstimator.Mode = ptr(mode)
+				c.GasEstimator.EIP1559DynamicFees = ptr(mode != "SuggestedPrice")
+				c.GasEstimator.LimitDefault = ptr[uint64](21000)
+			})
+
+			result, err := gas.SimulateEstimator(tests.Context(t), logger.Test(t), cfg.EVM().GasEstimator(), blocks, gas.SimulationOptions{Warmup: 10})
+			require.NoError(t, err)
+			assert.Equal(t, 19, result.Transactions)
+			assert.Equal(t, 19, result.Included)
+			assert.Equal(t, 1.0, result.AverageDelay())
+		})
+	}
+
+	t.Run("fails for unsupported modes", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, func(c *toml.EVMConfig) {
+			c.GasEstimator.Mode = ptr("Arbitrum")
+		})
+		_, err := gas.SimulateEstimator(tests.Context(t), logger.Test(t), cfg.EVM().GasEstimator(), blocks, gas.SimulationOptions{})
+		require.ErrorContains(t, err, "estimator mode Arbitrum is not supported")
+	})
+
+	t.Run("fails without enough blocks to warm up", func(t *testing.T) {
+		cfg := testutils.NewTestChainScopedConfig(t, nil)
+		_, err := gas.SimulateEstimator(tests.Context(t), logger.Test(t), cfg.EVM().GasEstimator(), blocks[:5], gas.SimulationOptions{})
+		require.ErrorContains(t, err, "not enough blocks to simulate")
+	})
+}
+
+func TestLoadHistoricalBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("loads recorded blocks", func(t *testing.T) {
+		blocks, err := gas.LoadHistoricalBlocks(strings.NewReader(`[
+			{"number": 2, "baseFee": "11 gwei", "tips": ["1 gwei"]},
+			{"number": 1, "baseFee": "10 gwei", "tips": []}
+		]`))
+		require.NoError(t, err)
+		assert.Equal(t, []gas.HistoricalBlock{
+			{Number: 1, BaseFee: assets.GWei(10), Tips: []*assets.Wei{}},
+			{Number: 2, BaseFee: assets.GWei(11), Tips: []*assets.Wei{assets.GWei(1)}},
+		}, blocks)
+	})
+
+	t.Run("loads RPC blocks", func(t *testing.T) {
+		blocks, err := gas.LoadHistoricalBlocks(strings.NewReader(`[{
+			"number": "0x1",
+			"hash": "0x0000000000000000000000000000000000000000000000000000000000000001",
+			"parentHash": "0x0000000000000000000000000000000000000000000000000000000000000000",
+			"baseFeePerGas": "0xa",
+			"timestamp": "0x1",
+			"transactions": [
+				{"type": "0x2", "gas": "0x5208", "maxFeePerGas": "0x14", "maxPriorityFeePerGas": "0x3", "hash": "0x0000000000000000000000000000000000000000000000000000000000000002"}
+			]
+		}]`))
+		require.NoError(t, err)
+		require.Len(t, blocks, 1)
+		assert.Equal(t, []*assets.Wei{assets.NewWeiI(3)}, blocks[0].Tips)
+	})
+
+	t.Run("fails on empty input", func(t *testing.T) {
+		_, err := gas.LoadHistoricalBlocks(strings.NewReader(`[]`))
+		require.ErrorContains(t, err, "no blocks found")
+	})
+}
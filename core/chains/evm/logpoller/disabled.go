@@ -2,6 +2,7 @@ package logpoller
 
 import (
 	"context"
+	"iter"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -38,6 +39,14 @@ func (disabled) Replay(ctx context.Context, fromBlock int64) error { return ErrD
 
 func (disabled) ReplayAsync(fromBlock int64) {}
 
+func (disabled) ReplayFilter(ctx context.Context, name string, fromBlock, toBlock int64) error {
+	return ErrDisabled
+}
+
+func (disabled) GetFilterReplay(ctx context.Context, name string) (*FilterReplay, error) {
+	return nil, ErrDisabled
+}
+
 func (disabled) RegisterFilter(ctx context.Context, filter Filter) error { return ErrDisabled }
 
 func (disabled) UnregisterFilter(ctx context.Context, name string) error { return ErrDisabled }
@@ -122,6 +131,12 @@ func (d disabled) FilteredLogs(_ context.Context, _ []query.Expression, _ query.
 	return nil, ErrDisabled
 }
 
+func (d disabled) StreamLogs(_ context.Context, _ *LogQuery) iter.Seq2[Log, error] {
+	return func(yield func(Log, error) bool) {
+		yield(Log{}, ErrDisabled)
+	}
+}
+
 func (d disabled) FindLCA(ctx context.Context) (*LogPollerBlock, error) {
 	return nil, ErrDisabled
 }
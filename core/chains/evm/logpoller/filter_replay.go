@@ -0,0 +1,250 @@
+package logpoller
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	pkgerrors "github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/mathutil"
+)
+
+// ErrFilterNotFound is returned when replaying a filter which is not registered.
+var ErrFilterNotFound = pkgerrors.New("filter not found")
+
+// filterReplayRun is a filter replay running in the background.
+type filterReplayRun struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// query returns an eth_getLogs query for the logs matching the filter's addresses and topics only.
+func (filter *Filter) query(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery {
+	topics := [][]common.Hash{filter.EventSigs, filter.Topic2, filter.Topic3, filter.Topic4}
+	for len(topics) > 1 && len(topics[len(topics)-1]) == 0 {
+		topics = topics[:len(topics)-1]
+	}
+	return ethereum.FilterQuery{FromBlock: from, ToBlock: to, BlockHash: bh, Addresses: filter.Addresses, Topics: topics}
+}
+
+// ReplayFilter starts backfilling the logs of the named filter in the block range [fromBlock, toBlock] in the background,
+// and returns once the replay is saved. A toBlock of zero replays up to the latest block.
+//
+// Unlike Replay, blocks are backfilled by fetching only the filter's addresses and topics, so a filter registered
+// with a long retention can be backfilled without refetching the logs of every other filter on the chain. Blocks
+// after the latest finalized block are fetched by block hash, and blocks not yet polled are left to the main loop.
+//
+// Progress is saved after each batch, and a replay interrupted by a shutdown resumes on the next Start. Replaying a
+// filter cancels any replay of it which is still in progress. See GetFilterReplay.
+func (lp *logPoller) ReplayFilter(ctx context.Context, name string, fromBlock, toBlock int64) error {
+	if fromBlock < 1 || (toBlock != 0 && toBlock < fromBlock) {
+		return pkgerrors.Errorf("Invalid replay block range [%d, %d]", fromBlock, toBlock)
+	}
+	filter, err := lp.loadFilter(ctx, name)
+	if err != nil {
+		return err
+	}
+	latest, err := lp.ec.HeadByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if fromBlock > latest.Number {
+		return pkgerrors.Errorf("Invalid replay block number %v, acceptable range [1, %v]", fromBlock, latest.Number)
+	}
+
+	lp.stopFilterReplay(name)
+	replay := FilterReplay{
+		FilterName: name,
+		FromBlock:  fromBlock,
+		ToBlock:    toBlock,
+		NextBlock:  fromBlock,
+		State:      FilterReplayInProgress,
+	}
+	if err = lp.orm.UpsertFilterReplay(ctx, replay); err != nil {
+		return pkgerrors.Wrap(err, "failed to save filter replay")
+	}
+	lp.lggr.Infow("Replaying filter", "name", name, "fromBlock", fromBlock, "toBlock", toBlock)
+	lp.startFilterReplay(replay, filter)
+	return nil
+}
+
+// GetFilterReplay returns the progress of the latest replay of the named filter.
+func (lp *logPoller) GetFilterReplay(ctx context.Context, name string) (*FilterReplay, error) {
+	return lp.orm.SelectFilterReplay(ctx, name)
+}
+
+// loadFilter loads the named filter from the db, so it can be replayed before the main loop has loaded the filters.
+func (lp *logPoller) loadFilter(ctx context.Context, name string) (Filter, error) {
+	filters, err := lp.orm.LoadFilters(ctx)
+	if err != nil {
+		return Filter{}, err
+	}
+	filter, ok := filters[name]
+	if !ok {
+		return Filter{}, pkgerrors.Wrapf(ErrFilterNotFound, "%q", name)
+	}
+	return filter, nil
+}
+
+// startFilterReplay runs the replay in the background, replacing any replay of the same filter. The replaced replay
+// is swapped out under the lock and waited for by the new one, so that two replays of a filter never run at once.
+func (lp *logPoller) startFilterReplay(replay FilterReplay, filter Filter) {
+	ctx, cancel := lp.stopCh.NewCtx()
+	run := &filterReplayRun{cancel: cancel, done: make(chan struct{})}
+
+	lp.filterReplayMu.Lock()
+	replaced := lp.filterReplays[replay.FilterName]
+	lp.filterReplays[replay.FilterName] = run
+	lp.filterReplayMu.Unlock()
+
+	lp.wg.Add(1)
+	go func() {
+		defer lp.wg.Done()
+		defer close(run.done)
+		defer cancel()
+		if replaced != nil {
+			replaced.cancel()
+			<-replaced.done
+		}
+		lp.runFilterReplay(ctx, replay, filter)
+
+		lp.filterReplayMu.Lock()
+		defer lp.filterReplayMu.Unlock()
+		if lp.filterReplays[replay.FilterName] == run {
+			delete(lp.filterReplays, replay.FilterName)
+		}
+	}()
+}
+
+// stopFilterReplay cancels the replay of the named filter, if any, and waits for it to exit. The replay stays
+// registered until it exits, so that a replay started meanwhile waits for it too.
+func (lp *logPoller) stopFilterReplay(name string) {
+	lp.filterReplayMu.Lock()
+	run, ok := lp.filterReplays[name]
+	lp.filterReplayMu.Unlock()
+	if ok {
+		run.cancel()
+		<-run.done
+	}
+}
+
+func (lp *logPoller) runFilterReplay(ctx context.Context, replay FilterReplay, filter Filter) {
+	err := lp.replayFilter(ctx, &replay, filter)
+	if ctx.Err() != nil {
+		// Cancelled by shutdown, or superseded by another replay of the same filter.
+		lp.lggr.Infow("Filter replay interrupted", "name", replay.FilterName, "nextBlock", replay.NextBlock)
+		return
+	}
+	replay.State = FilterReplayComplete
+	if err != nil {
+		lp.lggr.Errorw("Filter replay failed", "name", replay.FilterName, "nextBlock", replay.NextBlock, "err", err)
+		replay.State = FilterReplayFailed
+		replay.Error = err.Error()
+	} else {
+		lp.lggr.Infow("Filter replay complete", "name", replay.FilterName, "fromBlock", replay.FromBlock, "toBlock", replay.ToBlock)
+	}
+	if err = lp.orm.UpsertFilterReplay(ctx, replay); err != nil {
+		lp.lggr.Errorw("Failed to save filter replay", "name", replay.FilterName, "state", replay.State, "err", err)
+	}
+}
+
+func (lp *logPoller) replayFilter(ctx context.Context, replay *FilterReplay, filter Filter) error {
+	// Only finalized blocks are safe to backfill outside the main loop, see Replay.
+	end, err := lp.savedFinalizedBlockNumber(ctx)
+	if err != nil {
+		return err
+	}
+	if replay.ToBlock != 0 {
+		end = mathutil.Min(end, replay.ToBlock)
+	}
+	if replay.NextBlock <= end {
+		err = lp.backfillQuery(ctx, replay.NextBlock, end, filter.query, func(ctx context.Context, to int64) error {
+			replay.NextBlock = to + 1
+			return lp.orm.UpsertFilterReplay(ctx, *replay)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if replay.ToBlock != 0 && replay.ToBlock <= end {
+		return nil
+	}
+
+	// Blocks after the latest finalized block may still be reorged, so their logs are fetched by the hash of the
+	// block saved by the main loop, up to the latest saved block. A reorg deletes these logs along with their
+	// blocks, and blocks after the latest saved block are polled by the main loop with the filter registered.
+	latest, err := lp.orm.SelectLatestBlock(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	last := latest.BlockNumber
+	if replay.ToBlock != 0 {
+		last = mathutil.Min(last, replay.ToBlock)
+	}
+	fromBlock := mathutil.Max(replay.NextBlock, end+1)
+	if fromBlock > last {
+		return nil
+	}
+	blocks, err := lp.orm.GetBlocksRange(ctx, fromBlock, last)
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		gethLogs, err := lp.ec.FilterLogs(ctx, filter.query(nil, nil, &block.BlockHash))
+		if err != nil {
+			return err
+		}
+		if len(gethLogs) > 0 {
+			// skip the block if it was reorged while its logs were fetched
+			saved, err := lp.orm.SelectBlockByNumber(ctx, block.BlockNumber)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
+				return err
+			}
+			if saved != nil && saved.BlockHash == block.BlockHash {
+				if err = lp.orm.InsertLogs(ctx, convertLogs(gethLogs, []LogPollerBlock{block}, lp.lggr, lp.ec.ConfiguredChainID())); err != nil {
+					return err
+				}
+			}
+		}
+		replay.NextBlock = block.BlockNumber + 1
+		if err = lp.orm.UpsertFilterReplay(ctx, *replay); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resumeFilterReplays resumes the filter replays which were interrupted by a shutdown.
+func (lp *logPoller) resumeFilterReplays() {
+	defer lp.wg.Done()
+	ctx, cancel := lp.stopCh.NewCtx()
+	defer cancel()
+
+	replays, err := lp.orm.SelectFilterReplaysByState(ctx, FilterReplayInProgress)
+	if err != nil {
+		lp.lggr.Errorw("Failed to load filter replays", "err", err)
+		return
+	}
+	for _, replay := range replays {
+		filter, err := lp.loadFilter(ctx, replay.FilterName)
+		if err != nil {
+			if errors.Is(err, ErrFilterNotFound) {
+				replay.State = FilterReplayFailed
+				replay.Error = err.Error()
+				err = lp.orm.UpsertFilterReplay(ctx, replay)
+			}
+			if err != nil {
+				lp.lggr.Errorw("Failed to resume filter replay", "name", replay.FilterName, "err", err)
+			}
+			continue
+		}
+		lp.lggr.Infow("Resuming filter replay", "name", replay.FilterName, "nextBlock", replay.NextBlock, "toBlock", replay.ToBlock)
+		lp.startFilterReplay(replay, filter)
+	}
+}
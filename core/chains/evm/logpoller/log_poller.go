@@ -7,6 +7,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"iter"
 	"math/big"
 	"math/rand/v2"
 	"sort"
@@ -40,6 +41,8 @@ type LogPoller interface {
 	Healthy() error
 	Replay(ctx context.Context, fromBlock int64) error
 	ReplayAsync(fromBlock int64)
+	ReplayFilter(ctx context.Context, name string, fromBlock, toBlock int64) error
+	GetFilterReplay(ctx context.Context, name string) (*FilterReplay, error)
 	RegisterFilter(ctx context.Context, filter Filter) error
 	UnregisterFilter(ctx context.Context, name string) error
 	HasFilter(name string) bool
@@ -71,6 +74,7 @@ type LogPoller interface {
 
 	// chainlink-common query filtering
 	FilteredLogs(ctx context.Context, filter []query.Expression, limitAndSort query.LimitAndSort, queryName string) ([]Log, error)
+	StreamLogs(ctx context.Context, q *LogQuery) iter.Seq2[Log, error]
 }
 
 type LogPollerTest interface {
@@ -125,6 +129,9 @@ type logPoller struct {
 	cachedAddresses []common.Address
 	cachedEventSigs []common.Hash
 
+	filterReplayMu sync.Mutex
+	filterReplays  map[string]*filterReplayRun
+
 	replayStart    chan int64
 	replayComplete chan error
 	stopCh         services.StopChan
@@ -179,6 +186,7 @@ func NewLogPoller(orm ORM, ec Client, lggr logger.Logger, headTracker HeadTracke
 		logPrunePageSize:         opts.LogPrunePageSize,
 		clientErrors:             opts.ClientErrors,
 		filters:                  make(map[string]Filter),
+		filterReplays:            make(map[string]*filterReplayRun),
 		filterDirty:              true, // Always build Filter on first call to cache an empty filter if nothing registered yet.
 	}
 }
@@ -306,6 +314,8 @@ func (lp *logPoller) RegisterFilter(ctx context.Context, filter Filter) error {
 // If the name does not exist, it will log an error but not return an error.
 // Warnings/debug information is keyed by filter name.
 func (lp *logPoller) UnregisterFilter(ctx context.Context, name string) error {
+	lp.stopFilterReplay(name)
+
 	lp.filterMu.Lock()
 	defer lp.filterMu.Unlock()
 
@@ -504,9 +514,10 @@ func (lp *logPoller) ReplayAsync(fromBlock int64) {
 
 func (lp *logPoller) Start(context.Context) error {
 	return lp.StartOnce("LogPoller", func() error {
-		lp.wg.Add(2)
+		lp.wg.Add(3)
 		go lp.run()
 		go lp.backgroundWorkerRun()
+		go lp.resumeFilterReplays()
 		return nil
 	})
 }
@@ -866,11 +877,17 @@ func (lp *logPoller) blocksFromLogs(ctx context.Context, logs []types.Log, endBl
 // Retries until ctx cancelled. Will return an error if cancelled
 // or if there is an error backfilling.
 func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
+	return lp.backfillQuery(ctx, start, end, lp.Filter, nil)
+}
+
+// backfillQuery is like backfill, but fetches logs with the queries built by filterQuery.
+// If set, batchSaved is called with the last block of each batch once its logs are saved.
+func (lp *logPoller) backfillQuery(ctx context.Context, start, end int64, filterQuery func(from, to *big.Int, bh *common.Hash) ethereum.FilterQuery, batchSaved func(ctx context.Context, to int64) error) error {
 	batchSize := lp.backfillBatchSize
 	for from := start; from <= end; from += batchSize {
 		to := mathutil.Min(from+batchSize-1, end)
 
-		gethLogs, err := lp.ec.FilterLogs(ctx, lp.Filter(big.NewInt(from), big.NewInt(to), nil))
+		gethLogs, err := lp.ec.FilterLogs(ctx, filterQuery(big.NewInt(from), big.NewInt(to), nil))
 		if err != nil {
 			if !client.IsTooManyResults(err, lp.clientErrors) {
 				lp.lggr.Errorw("Unable to query for logs", "err", err, "from", from, "to", to)
@@ -886,26 +903,37 @@ func (lp *logPoller) backfill(ctx context.Context, start, end int64) error {
 			from -= batchSize // counteract +=batchSize on next loop iteration, so starting block does not change
 			continue
 		}
-		if len(gethLogs) == 0 {
-			continue
+		if len(gethLogs) > 0 {
+			if err = lp.saveBackfilledLogs(ctx, gethLogs, from, to); err != nil {
+				return err
+			}
 		}
-		blocks, err := lp.blocksFromLogs(ctx, gethLogs, uint64(to))
-		if err != nil {
-			return err
+		if batchSaved != nil {
+			if err = batchSaved(ctx, to); err != nil {
+				return err
+			}
 		}
+	}
+	return nil
+}
 
-		endblock := blocks[len(blocks)-1]
-		if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) {
-			// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
-			blocks = blocks[:len(blocks)-1]
-		}
+func (lp *logPoller) saveBackfilledLogs(ctx context.Context, gethLogs []types.Log, from, to int64) error {
+	blocks, err := lp.blocksFromLogs(ctx, gethLogs, uint64(to))
+	if err != nil {
+		return err
+	}
 
-		lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
-		err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
-		if err != nil {
-			lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
-			return err
-		}
+	endblock := blocks[len(blocks)-1]
+	if gethLogs[len(gethLogs)-1].BlockNumber != uint64(to) {
+		// Pop endblock if there were no logs for it, so that length of blocks & gethLogs are the same to pass to convertLogs
+		blocks = blocks[:len(blocks)-1]
+	}
+
+	lp.lggr.Debugw("Backfill found logs", "from", from, "to", to, "logs", len(gethLogs), "blocks", blocks)
+	err = lp.orm.InsertLogsWithBlock(ctx, convertLogs(gethLogs, blocks, lp.lggr, lp.ec.ConfiguredChainID()), endblock)
+	if err != nil {
+		lp.lggr.Warnw("Unable to insert logs, retrying", "err", err, "from", from, "to", to)
+		return err
 	}
 	return nil
 }
@@ -2,6 +2,7 @@ package logpoller
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
 	"math/big"
@@ -11,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -32,6 +34,7 @@ import (
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
+	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/gethwrappers/generated/log_emitter"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
@@ -548,6 +551,206 @@ func (lp *logPoller) reset() {
 	lp.stopCh = make(chan struct{})
 }
 
+func TestFilter_query(t *testing.T) {
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	event := EmitterABI.Events["Log1"].ID
+	topic := common.HexToHash("0x1111")
+
+	filter := Filter{Name: "filter", Addresses: evmtypes.AddressArray{addr}, EventSigs: evmtypes.HashArray{event}}
+	q := filter.query(big.NewInt(1), big.NewInt(10), nil)
+	assert.Equal(t, []common.Address{addr}, q.Addresses)
+	assert.Equal(t, [][]common.Hash{{event}}, q.Topics)
+	assert.Equal(t, big.NewInt(1), q.FromBlock)
+	assert.Equal(t, big.NewInt(10), q.ToBlock)
+
+	// topics between the event sig and the last constrained topic match anything
+	filter.Topic3 = evmtypes.HashArray{topic}
+	q = filter.query(big.NewInt(1), big.NewInt(10), nil)
+	assert.Equal(t, [][]common.Hash{{event}, nil, {topic}}, q.Topics)
+}
+
+func TestLogPoller_ReplayFilter(t *testing.T) {
+	t.Parallel()
+	addrA := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	addrB := common.HexToAddress("0x6e1f36b08f5ac3f2a1f3c1ddf6e6e18ba5ea3dbc")
+	event := EmitterABI.Events["Log1"].ID
+
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	ec := evmclimocks.NewClient(t)
+	ec.On("HeadByNumber", mock.Anything, mock.Anything).Return(&evmtypes.Head{Number: 10}, nil)
+	ec.On("ConfiguredChainID").Return(chainID, nil)
+	mockBatchCallContext(t, ec)
+
+	var queried []ethereum.FilterQuery
+	var mu sync.Mutex
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(func(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+		mu.Lock()
+		queried = append(queried, q)
+		mu.Unlock()
+		if q.BlockHash != nil {
+			if *q.BlockHash != common.HexToHash("0x9") {
+				return nil, nil
+			}
+			return []types.Log{{
+				BlockNumber: 9,
+				BlockHash:   common.HexToHash("0x9"),
+				Topics:      []common.Hash{event},
+				Address:     addrA,
+				TxHash:      common.HexToHash("0x5678"),
+				Data:        EvmWord(2).Bytes(),
+			}}, nil
+		}
+		if q.FromBlock.Int64() > 5 || q.ToBlock.Int64() < 5 {
+			return nil, nil
+		}
+		return []types.Log{{
+			BlockNumber: 5,
+			BlockHash:   common.HexToHash("0x5"),
+			Topics:      []common.Hash{event},
+			Address:     addrA,
+			TxHash:      common.HexToHash("0x1234"),
+			Data:        EvmWord(1).Bytes(),
+		}}, nil
+	})
+
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{PollPeriod: time.Hour, FinalityDepth: 2, BackfillBatchSize: 3, RpcBatchSize: 3})
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "A", Addresses: evmtypes.AddressArray{addrA}, EventSigs: evmtypes.HashArray{event}}))
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "B", Addresses: evmtypes.AddressArray{addrB}, EventSigs: evmtypes.HashArray{event}}))
+	// blocks up to 8 are finalized
+	require.NoError(t, orm.InsertBlock(ctx, common.HexToHash("0xa"), 10, time.Now(), 8))
+
+	t.Run("invalid requests", func(t *testing.T) {
+		require.ErrorIs(t, lp.ReplayFilter(ctx, "C", 2, 8), ErrFilterNotFound)
+		require.ErrorContains(t, lp.ReplayFilter(ctx, "A", 0, 8), "Invalid replay block range")
+		require.ErrorContains(t, lp.ReplayFilter(ctx, "A", 5, 4), "Invalid replay block range")
+		require.ErrorContains(t, lp.ReplayFilter(ctx, "A", 11, 0), "Invalid replay block number")
+	})
+
+	require.NoError(t, lp.ReplayFilter(ctx, "A", 2, 8))
+	require.Eventually(t, func() bool {
+		replay, err := lp.GetFilterReplay(ctx, "A")
+		require.NoError(t, err)
+		return replay.State == FilterReplayComplete
+	}, tests.WaitTimeout(t), 100*time.Millisecond)
+
+	replay, err := lp.GetFilterReplay(ctx, "A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), replay.FromBlock)
+	assert.Equal(t, int64(8), replay.ToBlock)
+	assert.Equal(t, int64(9), replay.NextBlock)
+	assert.Empty(t, replay.Error)
+
+	mu.Lock()
+	require.Len(t, queried, 3) // [2, 4], [5, 7], [8, 8]
+	for _, q := range queried {
+		assert.Equal(t, []common.Address{addrA}, q.Addresses)
+	}
+	mu.Unlock()
+
+	logs, err := lp.Logs(ctx, 1, 10, event, addrA)
+	require.NoError(t, err)
+	require.Len(t, logs, 1)
+	assert.Equal(t, int64(5), logs[0].BlockNumber)
+
+	// unfinalized blocks are fetched by the hash of the saved block, up to the latest saved block
+	require.NoError(t, orm.InsertBlock(ctx, common.HexToHash("0x9"), 9, time.Now(), 7))
+	mu.Lock()
+	queried = nil
+	mu.Unlock()
+	require.NoError(t, lp.ReplayFilter(ctx, "A", 8, 0))
+	require.Eventually(t, func() bool {
+		replay, err := lp.GetFilterReplay(ctx, "A")
+		require.NoError(t, err)
+		return replay.State == FilterReplayComplete
+	}, tests.WaitTimeout(t), 100*time.Millisecond)
+
+	replay, err = lp.GetFilterReplay(ctx, "A")
+	require.NoError(t, err)
+	assert.Equal(t, int64(11), replay.NextBlock)
+
+	mu.Lock()
+	require.Len(t, queried, 3) // [8, 8], 0x9, 0xa
+	assert.Nil(t, queried[0].BlockHash)
+	assert.Equal(t, common.HexToHash("0x9"), *queried[1].BlockHash)
+	assert.Equal(t, common.HexToHash("0xa"), *queried[2].BlockHash)
+	for _, q := range queried {
+		assert.Equal(t, []common.Address{addrA}, q.Addresses)
+	}
+	mu.Unlock()
+
+	logs, err = lp.Logs(ctx, 1, 10, event, addrA)
+	require.NoError(t, err)
+	require.Len(t, logs, 2)
+	assert.Equal(t, int64(9), logs[1].BlockNumber)
+
+	// unregistering the filter removes its replay
+	require.NoError(t, lp.UnregisterFilter(ctx, "A"))
+	_, err = lp.GetFilterReplay(ctx, "A")
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestLogPoller_ReplayFilter_Concurrent(t *testing.T) {
+	t.Parallel()
+	addr := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	event := EmitterABI.Events["Log1"].ID
+
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+
+	ec := evmclimocks.NewClient(t)
+	ec.On("HeadByNumber", mock.Anything, mock.Anything).Return(&evmtypes.Head{Number: 10}, nil)
+	ec.On("ConfiguredChainID").Return(chainID, nil).Maybe()
+
+	// replays of the same filter must never fetch logs at the same time
+	var inFlight, maxInFlight atomic.Int32
+	ec.On("FilterLogs", mock.Anything, mock.Anything).Return(func(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			m := maxInFlight.Load()
+			if n <= m || maxInFlight.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		return nil, nil
+	})
+
+	lp := NewLogPoller(orm, ec, lggr, nil, Opts{PollPeriod: time.Hour, FinalityDepth: 2, BackfillBatchSize: 1, RpcBatchSize: 3})
+	require.NoError(t, lp.RegisterFilter(ctx, Filter{Name: "A", Addresses: evmtypes.AddressArray{addr}, EventSigs: evmtypes.HashArray{event}}))
+	require.NoError(t, orm.InsertBlock(ctx, common.HexToHash("0xa"), 10, time.Now(), 8))
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, lp.ReplayFilter(ctx, "A", 1, 8))
+		}()
+	}
+	wg.Wait()
+
+	require.Eventually(t, func() bool {
+		lp.filterReplayMu.Lock()
+		defer lp.filterReplayMu.Unlock()
+		return len(lp.filterReplays) == 0
+	}, tests.WaitTimeout(t), 10*time.Millisecond)
+	assert.Equal(t, int32(1), maxInFlight.Load())
+
+	replay, err := lp.GetFilterReplay(ctx, "A")
+	require.NoError(t, err)
+	assert.Equal(t, FilterReplayComplete, replay.State)
+	assert.Equal(t, int64(9), replay.NextBlock)
+}
+
 func Test_latestBlockAndFinalityDepth(t *testing.T) {
 	lggr := logger.Test(t)
 
@@ -691,3 +894,104 @@ func BenchmarkFilter100_10(b *testing.B) {
 func BenchmarkFilter1000_100(b *testing.B) {
 	benchmarkFilter(b, 1000, 100, 100)
 }
+
+func TestLogPoller_StreamLogs(t *testing.T) {
+	t.Parallel()
+	addrA := common.HexToAddress("0x2ab9a2dc53736b361b72d900cdf9f78f9406fbbc")
+	addrB := common.HexToAddress("0x6e1f36b08f5ac3f2a1f3c1ddf6e6e18ba5ea3dbc")
+	event := EmitterABI.Events["Log1"].ID
+
+	lggr := logger.Test(t)
+	chainID := testutils.NewRandomEVMChainID()
+	db := pgtest.NewSqlxDB(t)
+	orm := NewORM(chainID, db, lggr)
+	ctx := testutils.Context(t)
+	lp := NewLogPoller(orm, nil, lggr, nil, Opts{PollPeriod: 10 * time.Millisecond, FinalityDepth: 2, BackfillBatchSize: 3, RpcBatchSize: 3})
+
+	newLog := func(address common.Address, block, logIndex int64) Log {
+		return Log{
+			EvmChainId:     ubig.New(chainID),
+			LogIndex:       logIndex,
+			BlockHash:      common.BigToHash(big.NewInt(block)),
+			BlockNumber:    block,
+			BlockTimestamp: time.Now(),
+			EventSig:       event,
+			Topics:         [][]byte{event[:]},
+			Address:        address,
+			TxHash:         common.BigToHash(big.NewInt(block*10 + logIndex)),
+			Data:           EvmWord(uint64(block)).Bytes(),
+		}
+	}
+	require.NoError(t, orm.InsertLogs(ctx, []Log{
+		newLog(addrA, 1, 0), newLog(addrB, 1, 1), newLog(addrA, 1, 2),
+		newLog(addrA, 2, 0), newLog(addrA, 3, 0), newLog(addrB, 3, 1),
+	}))
+
+	stream := func(ctx context.Context, q *LogQuery) <-chan Log {
+		ch := make(chan Log)
+		go func() {
+			defer close(ch)
+			for log, err := range lp.StreamLogs(ctx, q) {
+				assert.NoError(t, err)
+				select {
+				case ch <- log:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+		return ch
+	}
+	receive := func(t *testing.T, ch <-chan Log, n int) (logs []Log) {
+		for range n {
+			select {
+			case log := <-ch:
+				logs = append(logs, log)
+			case <-time.After(tests.WaitTimeout(t)):
+				t.Fatalf("timed out waiting for log %d", len(logs))
+			}
+		}
+		return logs
+	}
+	sequence := func(logs []Log) (seq [][2]int64) {
+		for _, log := range logs {
+			seq = append(seq, [2]int64{log.BlockNumber, log.LogIndex})
+		}
+		return seq
+	}
+
+	t.Run("pages and follows new logs", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		ch := stream(ctx, NewLogQuery().Address(addrA).Limit(2))
+
+		assert.Equal(t, [][2]int64{{1, 0}, {1, 2}, {2, 0}, {3, 0}}, sequence(receive(t, ch, 4)))
+
+		require.NoError(t, orm.InsertLogs(ctx, []Log{newLog(addrB, 4, 0), newLog(addrA, 4, 1), newLog(addrA, 5, 0)}))
+		assert.Equal(t, [][2]int64{{4, 1}, {5, 0}}, sequence(receive(t, ch, 2)))
+
+		cancel()
+		for range ch {
+		}
+	})
+
+	t.Run("resumes after cursor", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		cursor := FormatContractReaderCursor(newLog(addrA, 2, 0))
+		ch := stream(ctx, NewLogQuery().Address(addrA).After(cursor, 0))
+
+		assert.Equal(t, [][2]int64{{3, 0}, {4, 1}, {5, 0}}, sequence(receive(t, ch, 3)))
+	})
+
+	t.Run("stops with the consumer", func(t *testing.T) {
+		var logs []Log
+		for log, err := range lp.StreamLogs(ctx, NewLogQuery().Address(addrB)) {
+			require.NoError(t, err)
+			logs = append(logs, log)
+			if len(logs) == 2 {
+				break
+			}
+		}
+		assert.Equal(t, [][2]int64{{1, 1}, {3, 1}}, sequence(logs))
+	})
+}
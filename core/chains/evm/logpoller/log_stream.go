@@ -0,0 +1,85 @@
+package logpoller
+
+import (
+	"context"
+	"iter"
+	"slices"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+)
+
+// defaultStreamPageSize is the number of logs StreamLogs loads at a time, unless the query sets a Limit.
+const defaultStreamPageSize = 1000
+
+// StreamLogs returns an iterator over the logs matching the query, in ascending block number and log index order. The
+// logs are loaded one page at a time, the size of the query's Limit or defaultStreamPageSize, so arbitrarily large
+// result sets can be processed in constant memory. Once it has caught up, the iterator polls for the matching logs
+// persisted since, until ctx is cancelled or the log poller is closed. The order set with OrderBy is ignored, and a
+// cursor set with After resumes the stream following the log of the cursor.
+//
+// Logs are yielded once each, so logs persisted behind the stream, by a replay for example, are skipped, and logs in
+// blocks which are later reorged are not retracted. Use Confirmations to stream only the logs which are unlikely to be
+// reorged, or evmtypes.Finalized for the logs which can't be.
+//
+// An error is yielded at most once, and ends the stream.
+func (lp *logPoller) StreamLogs(ctx context.Context, q *LogQuery) iter.Seq2[Log, error] {
+	return func(yield func(Log, error) bool) {
+		ctx, cancel := lp.stopCh.Ctx(ctx)
+		defer cancel()
+
+		var after *query.Expression
+		if q.limit.Cursor != "" && q.limit.CursorDirection == query.CursorFollowing {
+			block, logIdx, _, err := valuesFromCursor(q.limit.Cursor)
+			if err != nil {
+				yield(Log{}, err)
+				return
+			}
+			exp := newSequenceAfterFilter(block, int64(logIdx))
+			after = &exp
+		}
+
+		pageSize := q.limit.Count
+		if pageSize == 0 {
+			pageSize = defaultStreamPageSize
+		}
+		limitAndSort := query.NewLimitAndSort(query.CountLimit(pageSize), query.NewSortBySequence(query.Asc))
+
+		ticker := time.NewTicker(max(lp.pollPeriod, time.Millisecond))
+		defer ticker.Stop()
+
+		for {
+			expressions := q.expressions
+			if after != nil {
+				expressions = append(slices.Clip(expressions), *after)
+			}
+			logs, err := lp.orm.FilteredLogs(ctx, expressions, limitAndSort, "StreamLogs")
+			if err != nil {
+				if ctx.Err() == nil {
+					yield(Log{}, err)
+				}
+				return
+			}
+			for _, log := range logs {
+				if !yield(log, nil) {
+					return
+				}
+			}
+			if len(logs) > 0 {
+				last := logs[len(logs)-1]
+				exp := newSequenceAfterFilter(last.BlockNumber, last.LogIndex)
+				after = &exp
+			}
+			if uint64(len(logs)) == pageSize {
+				// There may be more logs already persisted.
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}
+}
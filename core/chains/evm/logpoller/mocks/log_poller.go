@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	iter "iter"
+
 	common "github.com/ethereum/go-ethereum/common"
 
 	logpoller "github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
@@ -301,6 +303,65 @@ func (_c *LogPoller_GetBlocksRange_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetFilterReplay provides a mock function with given fields: ctx, name
+func (_m *LogPoller) GetFilterReplay(ctx context.Context, name string) (*logpoller.FilterReplay, error) {
+	ret := _m.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFilterReplay")
+	}
+
+	var r0 *logpoller.FilterReplay
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*logpoller.FilterReplay, error)); ok {
+		return rf(ctx, name)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *logpoller.FilterReplay); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*logpoller.FilterReplay)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogPoller_GetFilterReplay_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetFilterReplay'
+type LogPoller_GetFilterReplay_Call struct {
+	*mock.Call
+}
+
+// GetFilterReplay is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *LogPoller_Expecter) GetFilterReplay(ctx interface{}, name interface{}) *LogPoller_GetFilterReplay_Call {
+	return &LogPoller_GetFilterReplay_Call{Call: _e.mock.On("GetFilterReplay", ctx, name)}
+}
+
+func (_c *LogPoller_GetFilterReplay_Call) Run(run func(ctx context.Context, name string)) *LogPoller_GetFilterReplay_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *LogPoller_GetFilterReplay_Call) Return(_a0 *logpoller.FilterReplay, _a1 error) *LogPoller_GetFilterReplay_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *LogPoller_GetFilterReplay_Call) RunAndReturn(run func(context.Context, string) (*logpoller.FilterReplay, error)) *LogPoller_GetFilterReplay_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetFilters provides a mock function with given fields:
 func (_m *LogPoller) GetFilters() map[string]logpoller.Filter {
 	ret := _m.Called()
@@ -1763,6 +1824,55 @@ func (_c *LogPoller_ReplayAsync_Call) RunAndReturn(run func(int64)) *LogPoller_R
 	return _c
 }
 
+// ReplayFilter provides a mock function with given fields: ctx, name, fromBlock, toBlock
+func (_m *LogPoller) ReplayFilter(ctx context.Context, name string, fromBlock int64, toBlock int64) error {
+	ret := _m.Called(ctx, name, fromBlock, toBlock)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplayFilter")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) error); ok {
+		r0 = rf(ctx, name, fromBlock, toBlock)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogPoller_ReplayFilter_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ReplayFilter'
+type LogPoller_ReplayFilter_Call struct {
+	*mock.Call
+}
+
+// ReplayFilter is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+//   - fromBlock int64
+//   - toBlock int64
+func (_e *LogPoller_Expecter) ReplayFilter(ctx interface{}, name interface{}, fromBlock interface{}, toBlock interface{}) *LogPoller_ReplayFilter_Call {
+	return &LogPoller_ReplayFilter_Call{Call: _e.mock.On("ReplayFilter", ctx, name, fromBlock, toBlock)}
+}
+
+func (_c *LogPoller_ReplayFilter_Call) Run(run func(ctx context.Context, name string, fromBlock int64, toBlock int64)) *LogPoller_ReplayFilter_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *LogPoller_ReplayFilter_Call) Return(_a0 error) *LogPoller_ReplayFilter_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogPoller_ReplayFilter_Call) RunAndReturn(run func(context.Context, string, int64, int64) error) *LogPoller_ReplayFilter_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *LogPoller) Start(_a0 context.Context) error {
 	ret := _m.Called(_a0)
@@ -1809,6 +1919,55 @@ func (_c *LogPoller_Start_Call) RunAndReturn(run func(context.Context) error) *L
 	return _c
 }
 
+// StreamLogs provides a mock function with given fields: ctx, q
+func (_m *LogPoller) StreamLogs(ctx context.Context, q *logpoller.LogQuery) iter.Seq2[logpoller.Log, error] {
+	ret := _m.Called(ctx, q)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamLogs")
+	}
+
+	var r0 iter.Seq2[logpoller.Log, error]
+	if rf, ok := ret.Get(0).(func(context.Context, *logpoller.LogQuery) iter.Seq2[logpoller.Log, error]); ok {
+		r0 = rf(ctx, q)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(iter.Seq2[logpoller.Log, error])
+		}
+	}
+
+	return r0
+}
+
+// LogPoller_StreamLogs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamLogs'
+type LogPoller_StreamLogs_Call struct {
+	*mock.Call
+}
+
+// StreamLogs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - q *logpoller.LogQuery
+func (_e *LogPoller_Expecter) StreamLogs(ctx interface{}, q interface{}) *LogPoller_StreamLogs_Call {
+	return &LogPoller_StreamLogs_Call{Call: _e.mock.On("StreamLogs", ctx, q)}
+}
+
+func (_c *LogPoller_StreamLogs_Call) Run(run func(ctx context.Context, q *logpoller.LogQuery)) *LogPoller_StreamLogs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*logpoller.LogQuery))
+	})
+	return _c
+}
+
+func (_c *LogPoller_StreamLogs_Call) Return(_a0 iter.Seq2[logpoller.Log, error]) *LogPoller_StreamLogs_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *LogPoller_StreamLogs_Call) RunAndReturn(run func(context.Context, *logpoller.LogQuery) iter.Seq2[logpoller.Log, error]) *LogPoller_StreamLogs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UnregisterFilter provides a mock function with given fields: ctx, name
 func (_m *LogPoller) UnregisterFilter(ctx context.Context, name string) error {
 	ret := _m.Called(ctx, name)
@@ -56,3 +56,27 @@ func (l *Log) ToGethLog() types.Log {
 		Index:       uint(l.LogIndex),
 	}
 }
+
+// FilterReplayState is the state of a filter-scoped replay.
+type FilterReplayState string
+
+const (
+	FilterReplayInProgress FilterReplayState = "in_progress"
+	FilterReplayComplete   FilterReplayState = "complete"
+	FilterReplayFailed     FilterReplayState = "failed"
+)
+
+// FilterReplay tracks the progress of backfilling the logs of a single filter, see LogPoller.ReplayFilter.
+type FilterReplay struct {
+	EvmChainId *big.Big
+	FilterName string
+	FromBlock  int64
+	// ToBlock is the last block to replay, or zero to replay up to the latest block.
+	ToBlock int64
+	// NextBlock is the first block which has not been replayed yet.
+	NextBlock int64
+	State     FilterReplayState
+	Error     string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
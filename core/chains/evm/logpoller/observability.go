@@ -124,6 +124,24 @@ func (o *ObservedORM) DeleteFilter(ctx context.Context, name string) error {
 	})
 }
 
+func (o *ObservedORM) UpsertFilterReplay(ctx context.Context, replay FilterReplay) error {
+	return withObservedExec(o, "UpsertFilterReplay", create, func() error {
+		return o.ORM.UpsertFilterReplay(ctx, replay)
+	})
+}
+
+func (o *ObservedORM) SelectFilterReplay(ctx context.Context, name string) (*FilterReplay, error) {
+	return withObservedQuery(o, "SelectFilterReplay", func() (*FilterReplay, error) {
+		return o.ORM.SelectFilterReplay(ctx, name)
+	})
+}
+
+func (o *ObservedORM) SelectFilterReplaysByState(ctx context.Context, state FilterReplayState) ([]FilterReplay, error) {
+	return withObservedQueryAndResults(o, "SelectFilterReplaysByState", func() ([]FilterReplay, error) {
+		return o.ORM.SelectFilterReplaysByState(ctx, state)
+	})
+}
+
 func (o *ObservedORM) DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error) {
 	return withObservedExecAndRowsAffected(o, "DeleteBlocksBefore", del, func() (int64, error) {
 		return o.ORM.DeleteBlocksBefore(ctx, end, limit)
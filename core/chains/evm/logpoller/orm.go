@@ -34,6 +34,10 @@ type ORM interface {
 	LoadFilters(ctx context.Context) (map[string]Filter, error)
 	DeleteFilter(ctx context.Context, name string) error
 
+	UpsertFilterReplay(ctx context.Context, replay FilterReplay) error
+	SelectFilterReplay(ctx context.Context, name string) (*FilterReplay, error)
+	SelectFilterReplaysByState(ctx context.Context, state FilterReplayState) ([]FilterReplay, error)
+
 	DeleteLogsByRowID(ctx context.Context, rowIDs []uint64) (int64, error)
 	InsertBlock(ctx context.Context, blockHash common.Hash, blockNumber int64, blockTimestamp time.Time, finalizedBlock int64) error
 	DeleteBlocksBefore(ctx context.Context, end int64, limit int64) (int64, error)
@@ -159,14 +163,54 @@ func (o *DSORM) InsertFilter(ctx context.Context, filter Filter) (err error) {
 	return err
 }
 
-// DeleteFilter removes all events,address pairs associated with the Filter
+// DeleteFilter removes all events,address pairs associated with the Filter, along with its replay progress
 func (o *DSORM) DeleteFilter(ctx context.Context, name string) error {
-	_, err := o.ds.ExecContext(ctx,
-		`DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
-		name, ubig.New(o.chainID))
+	return o.Transact(ctx, func(orm *DSORM) error {
+		if _, err := orm.ds.ExecContext(ctx,
+			`DELETE FROM evm.log_poller_filters WHERE name = $1 AND evm_chain_id = $2`,
+			name, ubig.New(o.chainID)); err != nil {
+			return err
+		}
+		_, err := orm.ds.ExecContext(ctx,
+			`DELETE FROM evm.log_poller_filter_replays WHERE filter_name = $1 AND evm_chain_id = $2`,
+			name, ubig.New(o.chainID))
+		return err
+	})
+}
+
+// UpsertFilterReplay saves the progress of a filter replay, replacing any previous replay of the same filter.
+func (o *DSORM) UpsertFilterReplay(ctx context.Context, replay FilterReplay) error {
+	_, err := o.ds.ExecContext(ctx, `
+		INSERT INTO evm.log_poller_filter_replays
+			(evm_chain_id, filter_name, from_block, to_block, next_block, state, error, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NOW(), NOW())
+		ON CONFLICT (evm_chain_id, filter_name) DO UPDATE SET
+			from_block = EXCLUDED.from_block, to_block = EXCLUDED.to_block, next_block = EXCLUDED.next_block,
+			state = EXCLUDED.state, error = EXCLUDED.error, updated_at = NOW()`,
+		ubig.New(o.chainID), replay.FilterName, replay.FromBlock, replay.ToBlock, replay.NextBlock, replay.State, replay.Error)
 	return err
 }
 
+// SelectFilterReplay returns the latest replay of the named filter.
+func (o *DSORM) SelectFilterReplay(ctx context.Context, name string) (*FilterReplay, error) {
+	var replay FilterReplay
+	if err := o.ds.GetContext(ctx, &replay,
+		`SELECT * FROM evm.log_poller_filter_replays WHERE evm_chain_id = $1 AND filter_name = $2`,
+		ubig.New(o.chainID), name); err != nil {
+		return nil, err
+	}
+	return &replay, nil
+}
+
+// SelectFilterReplaysByState returns the replays of all filters on this chain in the given state.
+func (o *DSORM) SelectFilterReplaysByState(ctx context.Context, state FilterReplayState) ([]FilterReplay, error) {
+	var replays []FilterReplay
+	err := o.ds.SelectContext(ctx, &replays,
+		`SELECT * FROM evm.log_poller_filter_replays WHERE evm_chain_id = $1 AND state = $2 ORDER BY filter_name`,
+		ubig.New(o.chainID), state)
+	return replays, err
+}
+
 // LoadFilters returns all filters for this chain
 func (o *DSORM) LoadFilters(ctx context.Context) (map[string]Filter, error) {
 	query := `SELECT name,
@@ -204,6 +204,17 @@ func (v *pgDSLParser) VisitConfirmationsFilter(p *confirmationsFilter) {
 	}
 }
 
+func (v *pgDSLParser) VisitSequenceAfterFilter(p *sequenceAfterFilter) {
+	block := v.args.withIndexedField(blockFieldName, p.BlockNumber)
+
+	v.expression = fmt.Sprintf(
+		"(%s > :%s OR (%s = :%s AND log_index > :%s))",
+		blockFieldName, block,
+		blockFieldName, block,
+		v.args.withIndexedField("log_index", p.LogIndex),
+	)
+}
+
 func makeComp(comp HashedValueComparator, args *queryArgs, field, subfield, pattern string) (string, error) {
 	cmp, err := cmpOpToString(comp.Operator)
 	if err != nil {
@@ -562,3 +573,24 @@ func (f *confirmationsFilter) Accept(visitor primitives.Visitor) {
 		v.VisitConfirmationsFilter(f)
 	}
 }
+
+// sequenceAfterFilter matches the logs after the given log in (block_number, log_index) order. Unlike a cursor limit,
+// it can be combined with any confirmations, which allows StreamLogs to page through logs which are not yet finalized.
+type sequenceAfterFilter struct {
+	BlockNumber int64
+	LogIndex    int64
+}
+
+func newSequenceAfterFilter(blockNumber, logIndex int64) query.Expression {
+	return query.Expression{Primitive: &sequenceAfterFilter{
+		BlockNumber: blockNumber,
+		LogIndex:    logIndex,
+	}}
+}
+
+func (f *sequenceAfterFilter) Accept(visitor primitives.Visitor) {
+	switch v := visitor.(type) {
+	case *pgDSLParser:
+		v.VisitSequenceAfterFilter(f)
+	}
+}
@@ -270,6 +270,33 @@ func TestDSLParser(t *testing.T) {
 		assertArgs(t, args, 4)
 	})
 
+	t.Run("query for logs after sequence", func(t *testing.T) {
+		t.Parallel()
+
+		parser := &pgDSLParser{}
+		chainID := big.NewInt(1)
+		expressions := []query.Expression{
+			NewAddressFilter(common.HexToAddress("0x42")),
+			NewConfirmationsFilter(types.Unconfirmed),
+			newSequenceAfterFilter(10, 5),
+		}
+		limiter := query.NewLimitAndSort(query.CountLimit(20), query.NewSortBySequence(query.Asc))
+
+		result, args, err := parser.buildQuery(chainID, expressions, limiter)
+		expected := logsQuery(
+			" WHERE evm_chain_id = :evm_chain_id " +
+				"AND (address = :address_0 " +
+				"AND block_number <= (SELECT greatest(block_number - :confs_0, 0) FROM evm.log_poller_blocks WHERE evm_chain_id = :evm_chain_id ORDER BY block_number DESC LIMIT 1) " +
+				"AND (block_number > :block_number_0 OR (block_number = :block_number_0 AND log_index > :log_index_0))) " +
+				"ORDER BY block_number ASC, log_index ASC, tx_hash ASC " +
+				"LIMIT 20")
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		assertArgs(t, args, 5)
+	})
+
 	// nested query -> a & (b || c)
 	t.Run("nested query", func(t *testing.T) {
 		t.Parallel()
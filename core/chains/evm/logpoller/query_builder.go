@@ -0,0 +1,158 @@
+package logpoller
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+// LogQuery composes a query over the logs saved by the log poller out of the chainlink-common query DSL and the log
+// poller specific primitives. Every predicate added to the query is ANDed with the others. The query can be run with
+// FilteredLogs, using Build, or streamed with StreamLogs.
+//
+//	exprs, limitAndSort := NewLogQuery().
+//		Address(addr).
+//		EventSig(sig).
+//		Topic(1, primitives.Eq, owner).
+//		Confirmations(evmtypes.Finalized).
+//		OrderBy(query.NewSortBySequence(query.Asc)).
+//		After(cursor, 100).
+//		Build()
+type LogQuery struct {
+	expressions []query.Expression
+	sortBy      []query.SortBy
+	limit       query.Limit
+}
+
+// NewLogQuery returns an empty query, which matches every log of the chain.
+func NewLogQuery() *LogQuery {
+	return &LogQuery{}
+}
+
+// Address matches the logs emitted by any of the addresses.
+func (q *LogQuery) Address(addresses ...common.Address) *LogQuery {
+	expressions := make([]query.Expression, len(addresses))
+	for i, address := range addresses {
+		expressions[i] = NewAddressFilter(address)
+	}
+	return q.any(expressions)
+}
+
+// EventSig matches the logs with any of the event signatures.
+func (q *LogQuery) EventSig(eventSigs ...common.Hash) *LogQuery {
+	expressions := make([]query.Expression, len(eventSigs))
+	for i, eventSig := range eventSigs {
+		expressions[i] = NewEventSigFilter(eventSig)
+	}
+	return q.any(expressions)
+}
+
+// Topic matches the logs whose indexed topic compares to value. Topics are indexed from 1 to 3, after the event
+// signature, as with NewEventByTopicFilter.
+func (q *LogQuery) Topic(topicIndex uint64, op primitives.ComparisonOperator, value common.Hash) *LogQuery {
+	return q.Where(NewEventByTopicFilter(topicIndex, []HashedValueComparator{{Value: value, Operator: op}}))
+}
+
+// TopicIn matches the logs whose indexed topic is any of the values.
+func (q *LogQuery) TopicIn(topicIndex uint64, values ...common.Hash) *LogQuery {
+	expressions := make([]query.Expression, len(values))
+	for i, value := range values {
+		expressions[i] = NewEventByTopicFilter(topicIndex, []HashedValueComparator{{Value: value, Operator: primitives.Eq}})
+	}
+	return q.any(expressions)
+}
+
+// DataWord matches the logs whose 32 byte data word at wordIndex compares to value.
+func (q *LogQuery) DataWord(wordIndex int, op primitives.ComparisonOperator, value common.Hash) *LogQuery {
+	return q.Where(NewEventByWordFilter(wordIndex, []HashedValueComparator{{Value: value, Operator: op}}))
+}
+
+// Confirmations matches the logs with at least confs confirmations, or the finalized logs for evmtypes.Finalized.
+func (q *LogQuery) Confirmations(confs evmtypes.Confirmations) *LogQuery {
+	return q.Where(NewConfirmationsFilter(confs))
+}
+
+// BlockRange matches the logs in the inclusive block range [start, end]. A zero start or end leaves the range open on
+// that side.
+func (q *LogQuery) BlockRange(start, end int64) *LogQuery {
+	if start > 0 {
+		q.Where(query.Block(strconv.FormatInt(start, 10), primitives.Gte))
+	}
+	if end > 0 {
+		q.Where(query.Block(strconv.FormatInt(end, 10), primitives.Lte))
+	}
+	return q
+}
+
+// TimeRange matches the logs with a block timestamp in the inclusive range [from, to], to a precision of a second. A
+// zero from or to leaves the range open on that side.
+func (q *LogQuery) TimeRange(from, to time.Time) *LogQuery {
+	if !from.IsZero() {
+		q.Where(query.Timestamp(uint64(from.Unix()), primitives.Gte))
+	}
+	if !to.IsZero() {
+		q.Where(query.Timestamp(uint64(to.Unix()), primitives.Lte))
+	}
+	return q
+}
+
+// TxHash matches the logs emitted by the transaction.
+func (q *LogQuery) TxHash(txHash common.Hash) *LogQuery {
+	return q.Where(query.TxHash(txHash.Hex()))
+}
+
+// Where adds arbitrary expressions to the query, for predicates which have no dedicated method such as query.Or of
+// several query.And expressions.
+func (q *LogQuery) Where(expressions ...query.Expression) *LogQuery {
+	q.expressions = append(q.expressions, expressions...)
+	return q
+}
+
+// OrderBy sets the order of the logs returned. Without it, logs are ordered by descending block number and log index,
+// or in the direction of the cursor for queries paged with After or Before.
+func (q *LogQuery) OrderBy(sortBy ...query.SortBy) *LogQuery {
+	q.sortBy = sortBy
+	return q
+}
+
+// Limit returns at most count logs.
+func (q *LogQuery) Limit(count uint64) *LogQuery {
+	q.limit = query.CountLimit(count)
+	return q
+}
+
+// After returns at most count logs following the log of the cursor, see FormatContractReaderCursor. Like any cursor
+// query, it must be restricted to finalized logs with Confirmations(evmtypes.Finalized), otherwise use StreamLogs.
+func (q *LogQuery) After(cursor string, count uint64) *LogQuery {
+	q.limit = query.CursorLimit(cursor, query.CursorFollowing, count)
+	return q
+}
+
+// Before returns at most count logs preceding the log of the cursor, see After.
+func (q *LogQuery) Before(cursor string, count uint64) *LogQuery {
+	q.limit = query.CursorLimit(cursor, query.CursorPrevious, count)
+	return q
+}
+
+// Build returns the expressions and limits of the query, as accepted by FilteredLogs.
+func (q *LogQuery) Build() ([]query.Expression, query.LimitAndSort) {
+	return q.expressions, query.NewLimitAndSort(q.limit, q.sortBy...)
+}
+
+// any adds a predicate matching any of the expressions. Empty expressions add nothing.
+func (q *LogQuery) any(expressions []query.Expression) *LogQuery {
+	switch len(expressions) {
+	case 0:
+		return q
+	case 1:
+		return q.Where(expressions[0])
+	default:
+		return q.Where(query.Or(expressions...))
+	}
+}
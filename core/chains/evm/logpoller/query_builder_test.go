@@ -0,0 +1,91 @@
+package logpoller
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
+	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
+
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
+)
+
+func TestLogQuery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty query", func(t *testing.T) {
+		t.Parallel()
+
+		expressions, limiter := NewLogQuery().Address().EventSig().TopicIn(1).Build()
+
+		assert.Empty(t, expressions)
+		assert.Equal(t, query.LimitAndSort{}, limiter)
+	})
+
+	t.Run("combined predicates", func(t *testing.T) {
+		t.Parallel()
+
+		expressions, limiter := NewLogQuery().
+			Address(common.HexToAddress("0x42"), common.HexToAddress("0x43")).
+			EventSig(common.HexToHash("0x21")).
+			Topic(1, primitives.Gt, common.HexToHash("0x1")).
+			TopicIn(2, common.HexToHash("0x2"), common.HexToHash("0x3")).
+			DataWord(0, primitives.Lte, common.HexToHash("0x4")).
+			BlockRange(10, 20).
+			TimeRange(time.Unix(100, 0), time.Time{}).
+			Confirmations(types.Finalized).
+			OrderBy(query.NewSortBySequence(query.Asc)).
+			Limit(50).
+			Build()
+
+		parser := &pgDSLParser{}
+		result, args, err := parser.buildQuery(big.NewInt(1), expressions, limiter)
+		expected := logsQuery(
+			" WHERE evm_chain_id = :evm_chain_id " +
+				"AND ((address = :address_0 OR address = :address_1) " +
+				"AND event_sig = :event_sig_0 " +
+				"AND topics[:topic_index_0] > :topic_value_0 " +
+				"AND (topics[:topic_index_1] = :topic_value_1 OR topics[:topic_index_2] = :topic_value_2) " +
+				"AND substring(data from 32*:word_index_0+1 for 32) <= :word_value_0 " +
+				"AND block_number >= :block_number_0 " +
+				"AND block_number <= :block_number_1 " +
+				"AND block_timestamp >= :block_timestamp_0 " +
+				"AND block_number <= (SELECT finalized_block_number FROM evm.log_poller_blocks WHERE evm_chain_id = :evm_chain_id ORDER BY block_number DESC LIMIT 1)) " +
+				"ORDER BY block_number ASC, log_index ASC, tx_hash ASC " +
+				"LIMIT 50")
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+
+		assertArgs(t, args, 15)
+	})
+
+	t.Run("cursor pagination", func(t *testing.T) {
+		t.Parallel()
+
+		expressions, limiter := NewLogQuery().
+			Address(common.HexToAddress("0x42")).
+			Confirmations(types.Finalized).
+			Before("10-5-0x42", 20).
+			Build()
+
+		require.Len(t, expressions, 2)
+		assert.Equal(t, query.NewLimitAndSort(query.CursorLimit("10-5-0x42", query.CursorPrevious, 20)), limiter)
+
+		parser := &pgDSLParser{}
+		result, _, err := parser.buildQuery(big.NewInt(1), expressions, limiter)
+		require.NoError(t, err)
+		assert.Contains(t, result, "(block_number < :cursor_block_number OR (block_number = :cursor_block_number AND log_index < :cursor_log_index)) "+
+			"ORDER BY block_number DESC, log_index DESC, tx_hash DESC LIMIT 20")
+
+		// cursors are limited to finalized logs
+		expressions, limiter = NewLogQuery().Address(common.HexToAddress("0x42")).After("10-5-0x42", 20).Build()
+		_, _, err = parser.buildQuery(big.NewInt(1), expressions, limiter)
+		require.Error(t, err)
+	})
+}
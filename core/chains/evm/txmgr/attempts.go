@@ -80,11 +80,18 @@ func (c *evmTxAttemptBuilder) NewBumpTxAttempt(ctx context.Context, etx Tx, prev
 		etx.Value = *big.NewInt(0)
 		bumpedFeeLimit = c.feeConfig.LimitDefault()
 	}
+	// If transaction's previous attempt is marked for cancellation, ensure the new bumped attempt is also a self-send
+	if previousAttempt.IsCancelAttempt {
+		etx.ToAddress = etx.FromAddress
+	}
 	attempt, retryable, err = c.NewCustomTxAttempt(ctx, etx, bumpedFee, bumpedFeeLimit, previousAttempt.TxType, lggr)
 	// If transaction's previous attempt is marked for purge, ensure the new bumped attempt is also marked for purge
 	if previousAttempt.IsPurgeAttempt {
 		attempt.IsPurgeAttempt = true
 	}
+	if previousAttempt.IsCancelAttempt {
+		attempt.IsCancelAttempt = true
+	}
 	return attempt, bumpedFee, bumpedFeeLimit, retryable, err
 }
 
@@ -109,6 +116,18 @@ func (c *evmTxAttemptBuilder) NewPurgeTxAttempt(ctx context.Context, etx Tx, lgg
 	return attempt, nil
 }
 
+// NewCancelTxAttempt builds a purge attempt sent to the from address, which replaces the transaction with a zero value
+// self-send at the same nonce
+func (c *evmTxAttemptBuilder) NewCancelTxAttempt(ctx context.Context, etx Tx, lggr logger.Logger) (attempt TxAttempt, err error) {
+	etx.ToAddress = etx.FromAddress
+	attempt, err = c.NewPurgeTxAttempt(ctx, etx, lggr)
+	if err != nil {
+		return attempt, fmt.Errorf("failed to create cancel attempt: %w", err)
+	}
+	attempt.IsCancelAttempt = true
+	return attempt, nil
+}
+
 // NewCustomTxAttempt is the lowest level func where the fee parameters + tx type must be passed in
 // used in the txm for force rebroadcast where fees and tx type are pre-determined without an estimator
 func (c *evmTxAttemptBuilder) NewCustomTxAttempt(ctx context.Context, etx Tx, fee gas.EvmFee, gasLimit uint64, txType int, lggr logger.Logger) (attempt TxAttempt, retryable bool, err error) {
@@ -301,6 +301,58 @@ func TestTxm_NewPurgeAttempt(t *testing.T) {
 	})
 }
 
+func TestTxm_NewCancelAttempt(t *testing.T) {
+	addr := NewEvmAddress()
+	kst := ksmocks.NewEth(t)
+	tx := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", mock.Anything, addr, mock.Anything, big.NewInt(1)).Return(tx, nil)
+	gc := newFeeConfig()
+	gc.priceMin = assets.GWei(10)
+	gc.priceMax = assets.GWei(50)
+	gc.limitDefault = uint64(10)
+	est := gasmocks.NewEvmFeeEstimator(t)
+	bumpedLegacy := assets.GWei(30)
+	bumpedFee := gas.EvmFee{GasPrice: bumpedLegacy}
+	est.On("BumpFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(bumpedFee, uint64(10_000), nil)
+	cks := txmgr.NewEvmTxAttemptBuilder(*big.NewInt(1), gc, kst, est)
+	lggr := logger.Test(t)
+	ctx := tests.Context(t)
+
+	t.Run("creates bumped zero value self-send", func(t *testing.T) {
+		n := evmtypes.Nonce(0)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: NewEvmAddress(), EncodedPayload: []byte{1, 2, 3}, Value: *big.NewInt(42)}
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: bumpedLegacy.Sub(assets.GWei(1))}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+		a, err := cks.NewCancelTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		require.Equal(t, gc.limitDefault, a.ChainSpecificFeeLimit)
+		require.Equal(t, bumpedLegacy.String(), a.TxFee.GasPrice.String())
+		require.True(t, a.IsCancelAttempt)
+		require.True(t, a.IsPurgeAttempt)
+		require.Equal(t, addr, a.Tx.ToAddress)
+		require.Equal(t, []byte{}, a.Tx.EncodedPayload)
+		require.Equal(t, *big.NewInt(0), a.Tx.Value)
+	})
+
+	t.Run("bumps cancel attempt as cancel attempt", func(t *testing.T) {
+		n := evmtypes.Nonce(0)
+		etx := txmgr.Tx{Sequence: &n, FromAddress: addr, ToAddress: NewEvmAddress(), EncodedPayload: []byte{1, 2, 3}}
+		prevAttempt, _, err := cks.NewCustomTxAttempt(ctx, etx, gas.EvmFee{GasPrice: bumpedLegacy.Sub(assets.GWei(1))}, 100, 0x0, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, prevAttempt)
+		cancelAttempt, err := cks.NewCancelTxAttempt(ctx, etx, lggr)
+		require.NoError(t, err)
+		etx.TxAttempts = append(etx.TxAttempts, cancelAttempt)
+		bumpAttempt, _, _, _, err := cks.NewBumpTxAttempt(ctx, etx, cancelAttempt, etx.TxAttempts, lggr)
+		require.NoError(t, err)
+		require.True(t, bumpAttempt.IsCancelAttempt)
+		require.True(t, bumpAttempt.IsPurgeAttempt)
+		require.Equal(t, addr, bumpAttempt.Tx.ToAddress)
+		require.Equal(t, []byte{}, bumpAttempt.Tx.EncodedPayload)
+	})
+}
+
 func TestTxm_NewCustomTxAttempt_NonRetryableErrors(t *testing.T) {
 	t.Parallel()
 
@@ -733,6 +733,51 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success_WithMultiplier(t *testing
 	}
 }
 
+func TestEthBroadcaster_ProcessUnstartedEthTxs_Priority(t *testing.T) {
+	db := pgtest.NewSqlxDB(t)
+	cfg := configtest.NewGeneralConfig(t, nil)
+	txStore := cltest.NewTestTxStore(t, db)
+
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+	_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(0), nil).Once()
+	nonceTracker := txmgr.NewNonceTracker(logger.Test(t), txStore, txmgr.NewEvmTxmClient(ethClient, nil))
+	eb := NewTestEthBroadcaster(t, txStore, ethClient, ethKeyStore, cfg, evmcfg, &testCheckerFactory{}, false, nonceTracker)
+	ethClient.On("SendTransactionReturnCode", mock.Anything, mock.Anything, fromAddress).Return(commonclient.Successful, nil).Times(3)
+
+	newTxRequest := func(priority txmgrtypes.TxPriority) txmgr.TxRequest {
+		return txmgr.TxRequest{
+			FromAddress:    fromAddress,
+			ToAddress:      gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+			EncodedPayload: []byte{42, 42, 0},
+			FeeLimit:       1231,
+			Strategy:       txmgrcommon.NewSendEveryStrategy(),
+			Priority:       priority,
+		}
+	}
+	lowTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(txmgrtypes.TxPriorityLow), testutils.FixtureChainID)
+	normalTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(""), testutils.FixtureChainID)
+	highTx := mustCreateUnstartedTxFromEvmTxRequest(t, txStore, newTxRequest(txmgrtypes.TxPriorityHigh), testutils.FixtureChainID)
+
+	retryable, err := eb.ProcessUnstartedTxs(tests.Context(t), fromAddress)
+	require.NoError(t, err)
+	assert.False(t, retryable)
+
+	// Sequences are assigned from the highest priority to the lowest, whatever the order the transactions were created in
+	for nonce, id := range []int64{highTx.ID, normalTx.ID, lowTx.ID} {
+		etx, err := txStore.FindTxWithAttempts(tests.Context(t), id)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+		require.NotNil(t, etx.Sequence)
+		assert.Equal(t, evmtypes.Nonce(nonce), *etx.Sequence)
+	}
+	assert.Equal(t, txmgrtypes.TxPriorityNormal, normalTx.Priority)
+}
+
 func TestEthBroadcaster_ProcessUnstartedEthTxs_ResumingFromCrash(t *testing.T) {
 	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
 	value := big.Int(assets.NewEthValue(142))
@@ -14,6 +14,7 @@ import (
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -28,6 +29,7 @@ import (
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
+	clmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	gasmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas/mocks"
@@ -352,6 +354,73 @@ func TestEthConfirmer_CheckForConfirmation(t *testing.T) {
 		require.Equal(t, client.TerminallyStuckMsg, etx.Error.String)
 	})
 
+	t.Run("marks cancelled transaction as fatal error if the cancel attempt was included", func(t *testing.T) {
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		etx := mustInsertUnconfirmedEthTxWithBroadcastCancelAttempt(t, txStore, 0, fromAddress)
+		var resumed []error
+		ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, func(_ context.Context, _ uuid.UUID, _ interface{}, err error) error {
+			resumed = append(resumed, err)
+			return nil
+		})
+
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(1), nil).Once()
+		mockIncludedAttemptReceipt(t, ethClient, etx, cancelAttempt(t, etx).Hash)
+		require.NoError(t, ec.CheckForConfirmation(ctx, &head))
+
+		var err error
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		require.Equal(t, txmgrcommon.ErrTxCancelled.Error(), etx.Error.String)
+		require.Equal(t, []error{txmgrcommon.ErrTxCancelled}, resumed)
+	})
+
+	t.Run("confirms cancelled transaction if the original attempt won the race", func(t *testing.T) {
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		etx := mustInsertUnconfirmedEthTxWithBroadcastCancelAttempt(t, txStore, 0, fromAddress)
+		var resumed []error
+		ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, func(_ context.Context, _ uuid.UUID, _ interface{}, err error) error {
+			resumed = append(resumed, err)
+			return nil
+		})
+
+		var original txmgr.TxAttempt
+		for _, attempt := range etx.TxAttempts {
+			if !attempt.IsCancelAttempt {
+				original = attempt
+			}
+		}
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(1), nil).Once()
+		mockIncludedAttemptReceipt(t, ethClient, etx, original.Hash)
+		require.NoError(t, ec.CheckForConfirmation(ctx, &head))
+
+		var err error
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxConfirmed, etx.State)
+		require.Empty(t, etx.Error.String)
+		// the task run is resumed with the receipt by the finalizer
+		require.Empty(t, resumed)
+	})
+
+	t.Run("leaves cancelled transaction unconfirmed until a receipt is found", func(t *testing.T) {
+		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
+		etx := mustInsertUnconfirmedEthTxWithBroadcastCancelAttempt(t, txStore, 0, fromAddress)
+		ec := newEthConfirmer(t, txStore, ethClient, cfg, evmcfg, ethKeyStore, nil)
+
+		ethClient.On("NonceAt", mock.Anything, fromAddress, mock.Anything).Return(uint64(1), nil).Once()
+		mockIncludedAttemptReceipt(t, ethClient, etx, utils.EmptyHash)
+		require.NoError(t, ec.CheckForConfirmation(ctx, &head))
+
+		var err error
+		etx, err = txStore.FindTxWithAttempts(ctx, etx.ID)
+		require.NoError(t, err)
+		require.Equal(t, txmgrcommon.TxUnconfirmed, etx.State)
+	})
+
 	t.Run("handles multiple confirmed transactions at a time", func(t *testing.T) {
 		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 		_, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore)
@@ -1795,6 +1864,66 @@ func TestEthConfirmer_ProcessStuckTransactions(t *testing.T) {
 
 func ptr[T any](t T) *T { return &t }
 
+// mustInsertUnconfirmedEthTxWithBroadcastCancelAttempt inserts an unconfirmed transaction with a pending task run, and
+// both its original attempt and a cancel attempt broadcast
+func mustInsertUnconfirmedEthTxWithBroadcastCancelAttempt(t *testing.T, txStore txmgr.TestEvmTxStore, nonce int64, fromAddress gethCommon.Address) txmgr.Tx {
+	ctx := tests.Context(t)
+	etx := cltest.NewEthTx(fromAddress)
+	etx.State = txmgrcommon.TxUnconfirmed
+	n := evmtypes.Nonce(nonce)
+	etx.Sequence = &n
+	now := time.Now()
+	etx.BroadcastAt = &now
+	etx.InitialBroadcastAt = &now
+	etx.SignalCallback = true
+	etx.PipelineTaskRunID = uuid.NullUUID{UUID: uuid.New(), Valid: true}
+	require.NoError(t, txStore.InsertTx(ctx, &etx))
+
+	original := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+	original.State = txmgrtypes.TxAttemptBroadcast
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &original))
+
+	cancel := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+	cancel.State = txmgrtypes.TxAttemptBroadcast
+	cancel.TxFee = gas.EvmFee{GasPrice: assets.NewWeiI(2)}
+	cancel.IsPurgeAttempt = true
+	cancel.IsCancelAttempt = true
+	require.NoError(t, txStore.InsertTxAttempt(ctx, &cancel))
+
+	etx, err := txStore.FindTxWithAttempts(ctx, etx.ID)
+	require.NoError(t, err)
+	require.Len(t, etx.TxAttempts, 2)
+	return etx
+}
+
+func cancelAttempt(t *testing.T, etx txmgr.Tx) txmgr.TxAttempt {
+	for _, attempt := range etx.TxAttempts {
+		if attempt.IsCancelAttempt {
+			return attempt
+		}
+	}
+	t.Fatalf("transaction %d has no cancel attempt", etx.ID)
+	return txmgr.TxAttempt{}
+}
+
+// mockIncludedAttemptReceipt returns a receipt for the attempt of etx with the given hash only
+func mockIncludedAttemptReceipt(t *testing.T, ethClient *clmocks.Client, etx txmgr.Tx, included gethCommon.Hash) {
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == len(etx.TxAttempts) && cltest.BatchElemMatchesParams(b[0], etx.TxAttempts[0].Hash, "eth_getTransactionReceipt")
+	})).Return(nil).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		for i, attempt := range etx.TxAttempts {
+			if attempt.Hash == included {
+				*(elems[i].Result.(*evmtypes.Receipt)) = evmtypes.Receipt{
+					TxHash:      attempt.Hash,
+					BlockHash:   utils.NewHash(),
+					BlockNumber: big.NewInt(42),
+				}
+			}
+		}
+	}).Once()
+}
+
 func newEthConfirmer(t testing.TB, txStore txmgr.EvmTxStore, ethClient client.Client, gconfig chainlink.GeneralConfig, config evmconfig.ChainScopedConfig, ks keystore.Eth, fn txmgrcommon.ResumeCallback) *txmgr.Confirmer {
 	lggr := logger.Test(t)
 	ge := config.EVM().GasEstimator()
@@ -1174,6 +1174,47 @@ func TestORM_FindNextUnstartedTransactionFromAddress(t *testing.T) {
 	})
 }
 
+func TestORM_FindNextUnstartedTransactionsByLane(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	t.Run("finds no unstarted tx", func(t *testing.T) {
+		etxs, err := txStore.FindNextUnstartedTransactionsByLane(tests.Context(t), fromAddress, testutils.FixtureChainID)
+		require.NoError(t, err)
+		assert.Empty(t, etxs)
+	})
+
+	t.Run("finds earliest unstarted tx of each lane", func(t *testing.T) {
+		withPriority := func(priority txmgrtypes.TxPriority) func(*txmgr.TxRequest) {
+			return func(txRequest *txmgr.TxRequest) { txRequest.Priority = priority }
+		}
+		subject := uuid.New()
+		withSubject := func(txRequest *txmgr.TxRequest) {
+			txRequest.Strategy = txmgrcommon.NewQueueingTxStrategy(subject, 0)
+		}
+		highTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withPriority(txmgrtypes.TxPriorityHigh))
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withPriority(txmgrtypes.TxPriorityHigh))
+		normalTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+		subjectTx := mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withSubject)
+		mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, withSubject)
+		mustCreateUnstartedGeneratedTx(t, txStore, otherAddress, testutils.FixtureChainID, withPriority(txmgrtypes.TxPriorityLow))
+
+		etxs, err := txStore.FindNextUnstartedTransactionsByLane(tests.Context(t), fromAddress, testutils.FixtureChainID)
+		require.NoError(t, err)
+		ids := make([]int64, len(etxs))
+		for i, etx := range etxs {
+			ids[i] = etx.ID
+		}
+		assert.ElementsMatch(t, []int64{highTx.ID, normalTx.ID, subjectTx.ID}, ids)
+	})
+}
+
 func TestORM_UpdateTxFatalErrorAndDeleteAttempts(t *testing.T) {
 	t.Parallel()
 
@@ -1525,6 +1566,31 @@ func TestORM_CountUnstartedTransactions(t *testing.T) {
 	assert.Equal(t, int(count), 2)
 }
 
+func TestORM_CountUnstartedTransactionsByPriority(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	txStore := cltest.NewTestTxStore(t, db)
+	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
+
+	_, fromAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+	_, otherAddress := cltest.MustInsertRandomKey(t, ethKeyStore)
+
+	highPriority := func(txRequest *txmgr.TxRequest) { txRequest.Priority = txmgrtypes.TxPriorityHigh }
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, highPriority)
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID, highPriority)
+	mustCreateUnstartedGeneratedTx(t, txStore, fromAddress, testutils.FixtureChainID)
+	mustCreateUnstartedGeneratedTx(t, txStore, otherAddress, testutils.FixtureChainID)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, txStore, 2, fromAddress)
+
+	counts, err := txStore.CountUnstartedTransactionsByPriority(tests.Context(t), fromAddress, testutils.FixtureChainID)
+	require.NoError(t, err)
+	assert.Equal(t, map[txmgrtypes.TxPriority]uint32{
+		txmgrtypes.TxPriorityHigh:   2,
+		txmgrtypes.TxPriorityNormal: 1,
+	}, counts)
+}
+
 func TestORM_CheckTxQueueCapacity(t *testing.T) {
 	t.Parallel()
 
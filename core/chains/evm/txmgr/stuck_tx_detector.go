@@ -99,7 +99,7 @@ func (d *stuckTxDetector) LoadPurgeBlockNumMap(ctx context.Context, addresses []
 	// Set the purgeBlockNumMap with the receipt block num of purge attempts
 	for _, tx := range txs {
 		for _, attempt := range tx.TxAttempts {
-			if attempt.IsPurgeAttempt && len(attempt.Receipts) > 0 {
+			if attempt.IsPurgeAttempt && !attempt.IsCancelAttempt && len(attempt.Receipts) > 0 {
 				// There should only be 1 receipt in an attempt for a transaction
 				d.purgeBlockNumMap[tx.FromAddress] = attempt.Receipts[0].GetBlockNumber().Int64()
 				break
@@ -103,6 +103,10 @@ func (g *TestGasEstimatorConfig) FeeHistory() evmconfig.FeeHistory {
 	return &TestFeeHistoryConfig{}
 }
 
+func (g *TestGasEstimatorConfig) Forecast() evmconfig.Forecast {
+	return &TestForecastConfig{}
+}
+
 func (g *TestGasEstimatorConfig) EIP1559DynamicFees() bool   { return false }
 func (g *TestGasEstimatorConfig) LimitDefault() uint64       { return 42 }
 func (g *TestGasEstimatorConfig) BumpPercent() uint16        { return 42 }
@@ -155,6 +159,10 @@ type TestFeeHistoryConfig struct {
 	evmconfig.FeeHistory
 }
 
+type TestForecastConfig struct {
+	evmconfig.Forecast
+}
+
 func (b *TestFeeHistoryConfig) CacheTimeout() time.Duration { return 0 * time.Second }
 
 type transactionsConfig struct {
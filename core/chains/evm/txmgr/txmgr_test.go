@@ -602,6 +602,59 @@ func TestTxm_Reset(t *testing.T) {
 	})
 }
 
+func TestTxm_CancelTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+	gcfg := configtest.NewTestGeneralConfig(t)
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := cltest.NewKeyStore(t, db)
+
+	// The key is disabled so the Broadcaster leaves the unstarted transaction alone
+	_, addr := cltest.RandomKey{Disabled: true}.MustInsert(t, kst.Eth())
+	txStore := cltest.NewTestTxStore(t, db)
+	confirmedTx := cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, txStore, 0, 1, addr)
+
+	ethClient := testutils.NewEthClientMockWithDefaultChain(t)
+	ethClient.On("HeadByNumber", mock.Anything, (*big.Int)(nil)).Return(nil, nil)
+	ethClient.On("BatchCallContextAll", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	estimator, err := gas.NewEstimator(logger.Test(t), ethClient, cfg.EVM().ChainType(), ethClient.ConfiguredChainID(), cfg.EVM().GasEstimator(), nil)
+	require.NoError(t, err)
+	txm, err := makeTestEvmTxm(t, db, ethClient, estimator, cfg.EVM(), cfg.EVM().GasEstimator(), cfg.EVM().Transactions(), gcfg.Database(), gcfg.Database().Listener(), kst.Eth())
+	require.NoError(t, err)
+
+	unstartedTx := mustCreateUnstartedGeneratedTx(t, txStore, addr, testutils.FixtureChainID)
+
+	t.Run("returns error if not started", func(t *testing.T) {
+		err := txm.CancelTx(tests.Context(t), unstartedTx.ID)
+		require.EqualError(t, err, "not started")
+	})
+
+	servicetest.Run(t, txm)
+
+	t.Run("cancels unstarted transaction", func(t *testing.T) {
+		ctx := tests.Context(t)
+		require.NoError(t, txm.CancelTx(ctx, unstartedTx.ID))
+
+		etx, err := txStore.FindTxWithAttempts(ctx, unstartedTx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, txmgrcommon.TxFatalError, etx.State)
+		assert.Equal(t, txmgrcommon.ErrTxCancelled.Error(), etx.Error.String)
+	})
+
+	t.Run("returns error for confirmed transaction", func(t *testing.T) {
+		err := txm.CancelTx(tests.Context(t), confirmedTx.ID)
+		require.ErrorIs(t, err, txmgrcommon.ErrTxNotCancellable)
+	})
+
+	t.Run("returns error for unknown transaction", func(t *testing.T) {
+		err := txm.CancelTx(tests.Context(t), confirmedTx.ID+1000)
+		require.Error(t, err)
+		require.NotErrorIs(t, err, txmgrcommon.ErrTxNotCancellable)
+	})
+}
+
 func TestTxm_GetTransactionStatus(t *testing.T) {
 	t.Parallel()
 
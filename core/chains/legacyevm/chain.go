@@ -443,7 +443,7 @@ func (c *chain) listNodeStatuses(start, end int) ([]types.NodeStatus, int, error
 			nodeState = "NotLoaded"
 			s, exists := states[*n.Name]
 			if exists {
-				nodeState = s
+				nodeState = s.State
 			}
 		}
 		stats = append(stats, types.NodeStatus{
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -20,7 +21,9 @@ import (
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -131,6 +134,181 @@ func initAdminSubCmds(s *Shell) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:  "roles",
+			Usage: "Create, edit, assign, or delete custom roles",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists all custom roles, their permissions and users",
+					Action: s.ListRoles,
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a new custom role",
+					Action: s.CreateRole,
+					Flags:  roleFlags("Name of new role to create"),
+				},
+				{
+					Name:   "update",
+					Usage:  "Replace the description and permissions of a custom role",
+					Action: s.UpdateRole,
+					Flags:  roleFlags("Name of role to update"),
+				},
+				{
+					Name:   "delete",
+					Usage:  "Delete a custom role. Users it is assigned to fall back to their built-in role",
+					Action: s.DeleteRole,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of role to delete",
+							Required: true,
+						},
+					},
+				},
+				{
+					Name:   "assign",
+					Usage:  "Assign a custom role to a user, in place of their built-in role",
+					Action: s.AssignRole,
+					Flags:  roleAssignmentFlags(),
+				},
+				{
+					Name:   "unassign",
+					Usage:  "Remove a custom role from a user, who falls back to their built-in role",
+					Action: s.UnassignRole,
+					Flags:  roleAssignmentFlags(),
+				},
+			},
+		},
+		{
+			Name:  "tokens",
+			Usage: "Create, list, or revoke named API tokens",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "Lists your named API tokens",
+					Action: s.ListAPITokens,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "all-users",
+							Usage: "list the tokens of all users, which requires permission to read users",
+						},
+					},
+				},
+				{
+					Name:   "create",
+					Usage:  "Create a named API token, whose secret is only shown once",
+					Action: s.CreateAPIToken,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:     "name",
+							Usage:    "Name of the token, unique among your tokens",
+							Required: true,
+						},
+						cli.StringSliceFlag{
+							Name:  "permission",
+							Usage: "Permission the token is scoped to, in the form resource:action[,action...][:jobType[,jobType...]]. Can be repeated. The token has your full role when no permission is given",
+						},
+						cli.BoolFlag{
+							Name:  "read-only",
+							Usage: "scope the token to reading all resources your role can read",
+						},
+						cli.DurationFlag{
+							Name:  "expires-in",
+							Usage: "duration after which the token expires, for example 720h. The token never expires when not set",
+						},
+					},
+				},
+				{
+					Name:   "revoke",
+					Usage:  "Revoke a named API token",
+					Action: s.RevokeAPIToken,
+					Flags: []cli.Flag{
+						cli.Int64Flag{
+							Name:     "id",
+							Usage:    "ID of the token to revoke",
+							Required: true,
+						},
+						cli.BoolFlag{
+							Name:  "any-user",
+							Usage: "revoke a token of any user, which requires permission to update users",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:  "audit",
+			Usage: "List or verify the audit log stored in the database, when AuditLogger.Persist is enabled",
+			Subcommands: cli.Commands{
+				{
+					Name:   "list",
+					Usage:  "List audit log records, most recent first",
+					Action: s.ListAuditLogRecords,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "user",
+							Usage: "only list records of the user with this email",
+						},
+						cli.StringFlag{
+							Name:  "event-id",
+							Usage: "only list records of this event, for example AUTH_LOGIN_FAILED_EMAIL",
+						},
+						cli.StringFlag{
+							Name:  "created-after",
+							Usage: "only list records created at or after this RFC3339 timestamp",
+						},
+						cli.StringFlag{
+							Name:  "created-before",
+							Usage: "only list records created before this RFC3339 timestamp",
+						},
+					},
+				},
+				{
+					Name:   "verify",
+					Usage:  "Verify the hash chain of the audit log, and exit with an error if a record has been tampered with",
+					Action: s.VerifyAuditLog,
+				},
+			},
+		},
+	}
+}
+
+func roleFlags(nameUsage string) []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:     "name",
+			Usage:    nameUsage,
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:  "description",
+			Usage: "Description of the role",
+		},
+		cli.StringSliceFlag{
+			Name:  "permission",
+			Usage: "Permission granted by the role, in the form resource:action[,action...][:jobType[,jobType...]], for example 'bridges:read,create' or 'jobs:read,run:offchainreporting2'. Can be repeated",
+		},
+	}
+}
+
+func roleAssignmentFlags() []cli.Flag {
+	return []cli.Flag{
+		cli.StringFlag{
+			Name:     "name",
+			Usage:    "Name of the role",
+			Required: true,
+		},
+		cli.StringFlag{
+			Name:     "email",
+			Usage:    "Email of the user",
+			Required: true,
+		},
 	}
 }
 
@@ -302,6 +480,333 @@ func (s *Shell) DeleteUser(c *cli.Context) (err error) {
 	return s.renderAPIResponse(response, &AdminUsersPresenter{}, "Successfully deleted API user")
 }
 
+type AdminRolePresenter struct {
+	JAID
+	presenters.RoleResource
+}
+
+var adminRolesTableHeaders = []string{"Name", "Description", "Permissions", "Users", "Created at", "Updated at"}
+
+func (p *AdminRolePresenter) ToRow() []string {
+	permissions := make([]string, len(p.Permissions))
+	for i, permission := range p.Permissions {
+		permissions[i] = permission.String()
+	}
+	row := []string{
+		p.ID,
+		p.Description,
+		strings.Join(permissions, " "),
+		strings.Join(p.Users, ", "),
+		p.CreatedAt.String(),
+		p.UpdatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminRolePresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminRolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminRolePresenters []AdminRolePresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminRolePresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Roles\n")); err != nil {
+		return err
+	}
+	renderList(adminRolesTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListRoles renders all custom roles, their permissions and users
+func (s *Shell) ListRoles(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/roles", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminRolePresenters{})
+}
+
+// CreateRole creates a custom role with the permissions given by the permission flags
+func (s *Shell) CreateRole(c *cli.Context) (err error) {
+	requestData, err := roleRequest(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/roles", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully created new role")
+}
+
+// UpdateRole replaces the description and permissions of a custom role
+func (s *Shell) UpdateRole(c *cli.Context) (err error) {
+	requestData, err := roleRequest(c)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Put(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("name")), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully updated role")
+}
+
+// DeleteRole deletes a custom role by name
+func (s *Shell) DeleteRole(c *cli.Context) (err error) {
+	response, err := s.HTTP.Delete(s.ctx(), "/v2/roles/"+url.PathEscape(c.String("name")))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(response); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Role %s deleted\n", c.String("name"))
+	return nil
+}
+
+// AssignRole assigns a custom role to a user
+func (s *Shell) AssignRole(c *cli.Context) (err error) {
+	requestData, err := json.Marshal(struct {
+		Email string `json:"email"`
+	}{
+		Email: c.String("email"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), fmt.Sprintf("/v2/roles/%s/users", url.PathEscape(c.String("name"))), bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminRolePresenter{}, "Successfully assigned role")
+}
+
+// UnassignRole removes a custom role from a user
+func (s *Shell) UnassignRole(c *cli.Context) (err error) {
+	response, err := s.HTTP.Delete(s.ctx(), fmt.Sprintf("/v2/roles/%s/users/%s", url.PathEscape(c.String("name")), url.PathEscape(c.String("email"))))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	if _, err = s.parseResponse(response); err != nil {
+		return s.errorOut(err)
+	}
+
+	fmt.Printf("Role %s unassigned from %s\n", c.String("name"), c.String("email"))
+	return nil
+}
+
+// roleRequest builds the body of a request to create or update a custom role, from the role flags.
+func roleRequest(c *cli.Context) ([]byte, error) {
+	request := web.RoleRequest{
+		Name:        c.String("name"),
+		Description: c.String("description"),
+		Permissions: []rbac.Permission{},
+	}
+	for _, s := range c.StringSlice("permission") {
+		permission, err := rbac.ParsePermission(s)
+		if err != nil {
+			return nil, err
+		}
+		request.Permissions = append(request.Permissions, permission)
+	}
+	return json.Marshal(request)
+}
+
+type AdminAPITokenPresenter struct {
+	JAID
+	presenters.APITokenResource
+}
+
+var adminAPITokensTableHeaders = []string{"ID", "Name", "User", "Permissions", "Status", "Expires at", "Last used", "Created at"}
+
+func (p *AdminAPITokenPresenter) ToRow() []string {
+	permissions := make([]string, len(p.Permissions))
+	for i, permission := range p.Permissions {
+		permissions[i] = permission.String()
+	}
+	optionalTime := func(t *time.Time) string {
+		if t == nil {
+			return ""
+		}
+		return t.String()
+	}
+	row := []string{
+		p.ID,
+		p.Name,
+		p.UserEmail,
+		strings.Join(permissions, " "),
+		string(p.Status),
+		optionalTime(p.ExpiresAt),
+		optionalTime(p.LastUsed),
+		p.CreatedAt.String(),
+	}
+	return row
+}
+
+// RenderTable implements TableRenderer, and renders the credentials of a token which was just created.
+func (p *AdminAPITokenPresenter) RenderTable(rt RendererTable) error {
+	rows := [][]string{p.ToRow()}
+
+	renderList(adminAPITokensTableHeaders, rows, rt.Writer)
+
+	if p.Secret != "" {
+		credentials := fmt.Sprintf("\nAccess key: %s\nSecret: %s\nStore the secret now, it can not be shown again.\n", p.AccessKey, p.Secret)
+		if _, err := rt.Write([]byte(credentials)); err != nil {
+			return err
+		}
+	}
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminAPITokenPresenters []AdminAPITokenPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminAPITokenPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("API tokens\n")); err != nil {
+		return err
+	}
+	renderList(adminAPITokensTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAPITokens renders your named API tokens, or those of all users
+func (s *Shell) ListAPITokens(c *cli.Context) (err error) {
+	path := "/v2/user/tokens"
+	if c.Bool("all-users") {
+		path = "/v2/api_tokens"
+	}
+	resp, err := s.HTTP.Get(s.ctx(), path, nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &AdminAPITokenPresenters{})
+}
+
+// CreateAPIToken creates a named API token, after prompting for your password
+func (s *Shell) CreateAPIToken(c *cli.Context) (err error) {
+	request := web.CreateAPITokenRequest{
+		Name:        c.String("name"),
+		Permissions: []rbac.Permission{},
+		ReadOnly:    c.Bool("read-only"),
+	}
+	for _, p := range c.StringSlice("permission") {
+		permission, perr := rbac.ParsePermission(p)
+		if perr != nil {
+			return s.errorOut(perr)
+		}
+		request.Permissions = append(request.Permissions, permission)
+	}
+	if expiresIn := c.Duration("expires-in"); expiresIn > 0 {
+		expiresAt := time.Now().Add(expiresIn)
+		request.ExpiresAt = &expiresAt
+	}
+
+	fmt.Println("Password:")
+	request.Password = s.PasswordPrompter.Prompt()
+
+	requestData, err := json.Marshal(request)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	response, err := s.HTTP.Post(s.ctx(), "/v2/user/tokens", bytes.NewBuffer(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminAPITokenPresenter{}, "Successfully created API token")
+}
+
+// RevokeAPIToken revokes one of your named API tokens, or a token of any user
+func (s *Shell) RevokeAPIToken(c *cli.Context) (err error) {
+	path := fmt.Sprintf("/v2/user/tokens/%d", c.Int64("id"))
+	if c.Bool("any-user") {
+		path = fmt.Sprintf("/v2/api_tokens/%d", c.Int64("id"))
+	}
+	response, err := s.HTTP.Delete(s.ctx(), path)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := response.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(response, &AdminAPITokenPresenter{}, "Successfully revoked API token")
+}
+
 // Status will display the health of various services
 func (s *Shell) Status(c *cli.Context) error {
 	resp, err := s.HTTP.Get(s.ctx(), "/health?full=1", nil)
@@ -419,3 +924,104 @@ func (s *Shell) Profile(c *cli.Context) error {
 	}
 	return nil
 }
+
+type AdminAuditLogRecordPresenter struct {
+	JAID
+	presenters.AuditLogRecordResource
+}
+
+var adminAuditLogRecordsTableHeaders = []string{"ID", "Event ID", "User", "Data", "Created at", "Hash"}
+
+func (p *AdminAuditLogRecordPresenter) ToRow() []string {
+	return []string{
+		p.ID,
+		p.EventID,
+		p.UserEmail,
+		string(p.Data),
+		p.CreatedAt.String(),
+		p.Hash,
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminAuditLogRecordPresenter) RenderTable(rt RendererTable) error {
+	renderList(adminAuditLogRecordsTableHeaders, [][]string{p.ToRow()}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminAuditLogRecordPresenters []AdminAuditLogRecordPresenter
+
+// RenderTable implements TableRenderer
+func (ps AdminAuditLogRecordPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	if _, err := rt.Write([]byte("Audit log\n")); err != nil {
+		return err
+	}
+	renderList(adminAuditLogRecordsTableHeaders, rows, rt.Writer)
+
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+type AdminAuditLogVerificationPresenter struct {
+	JAID
+	presenters.AuditLogVerificationResource
+}
+
+// RenderTable implements TableRenderer
+func (p *AdminAuditLogVerificationPresenter) RenderTable(rt RendererTable) error {
+	headers := []string{"Valid", "Records", "Head ID", "Head hash"}
+	row := []string{fmt.Sprintf("%t", p.Valid), fmt.Sprintf("%d", p.Records), fmt.Sprintf("%d", p.HeadID), p.HeadHash}
+	if !p.Valid {
+		headers = append(headers, "Tampered ID", "Reason")
+		row = append(row, fmt.Sprintf("%d", p.TamperedID), p.Reason)
+	}
+	renderList(headers, [][]string{row}, rt.Writer)
+	return cutils.JustError(rt.Write([]byte("\n")))
+}
+
+// ListAuditLogRecords lists the audit log records matching the filters
+func (s *Shell) ListAuditLogRecords(c *cli.Context) (err error) {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"user":           "user",
+		"event-id":       "eventID",
+		"created-after":  "createdAfter",
+		"created-before": "createdBefore",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	uri := "/v2/audit_logs"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &AdminAuditLogRecordPresenters{})
+}
+
+// VerifyAuditLog verifies the hash chain of the audit log, and returns an error if a record has been tampered with
+func (s *Shell) VerifyAuditLog(_ *cli.Context) (err error) {
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/audit_logs/verify", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	var verification AdminAuditLogVerificationPresenter
+	if err = s.renderAPIResponse(resp, &verification); err != nil {
+		return err
+	}
+	if !verification.Valid {
+		return s.errorOut(fmt.Errorf("audit log record %d has been tampered with: %s", verification.TamperedID, verification.Reason))
+	}
+	return nil
+}
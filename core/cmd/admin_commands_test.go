@@ -17,7 +17,10 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -208,3 +211,211 @@ func (t *testRenderer) Render(i interface{}, s ...string) error {
 	t.presenters = *adminPresenters
 	return nil
 }
+
+func TestShell_Roles(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, app.AuthenticationProvider().CreateUser(ctx, &user))
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateRole, set, "")
+	require.NoError(t, set.Set("name", "bridge-operator"))
+	require.NoError(t, set.Set("permission", "bridges:read,create"))
+	require.NoError(t, set.Set("permission", "nope:read"))
+	assert.ErrorContains(t, client.CreateRole(cli.NewContext(nil, set, nil)), `unknown resource "nope"`)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateRole, set, "")
+	require.NoError(t, set.Set("name", "bridge-operator"))
+	require.NoError(t, set.Set("permission", "bridges:read,create"))
+	require.NoError(t, set.Set("permission", "jobs:read:offchainreporting2"))
+	require.NoError(t, client.CreateRole(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.AssignRole, set, "")
+	require.NoError(t, set.Set("name", "bridge-operator"))
+	require.NoError(t, set.Set("email", user.Email))
+	require.NoError(t, client.AssignRole(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListRoles, set, "")
+	require.NoError(t, client.ListRoles(cli.NewContext(nil, set, nil)))
+	roles := *r.Renders[len(r.Renders)-1].(*cmd.AdminRolePresenters)
+	require.Len(t, roles, 1)
+	assert.Equal(t, "bridge-operator", roles[0].Name)
+	assert.Equal(t, []string{user.Email}, roles[0].Users)
+	require.Len(t, roles[0].Permissions, 2)
+	assert.Equal(t, "jobs:read:offchainreporting2", roles[0].Permissions[1].String())
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.UnassignRole, set, "")
+	require.NoError(t, set.Set("name", "bridge-operator"))
+	require.NoError(t, set.Set("email", user.Email))
+	require.NoError(t, client.UnassignRole(cli.NewContext(nil, set, nil)))
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.DeleteRole, set, "")
+	require.NoError(t, set.Set("name", "bridge-operator"))
+	require.NoError(t, client.DeleteRole(cli.NewContext(nil, set, nil)))
+	assert.Error(t, client.DeleteRole(cli.NewContext(nil, set, nil)))
+}
+
+func TestAdminRolePresenter_RenderTable(t *testing.T) {
+	presenter := cmd.AdminRolePresenter{
+		JAID: cmd.JAID{ID: "bridge-operator"},
+		RoleResource: presenters.RoleResource{
+			JAID:        presenters.JAID{ID: "bridge-operator"},
+			Name:        "bridge-operator",
+			Description: "Manages bridges",
+			Permissions: []rbac.Permission{
+				{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}},
+				{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{"offchainreporting2"}},
+			},
+			Users:     []string{"foo@bar.com"},
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	require.NoError(t, presenter.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "bridge-operator")
+	assert.Contains(t, output, "Manages bridges")
+	assert.Contains(t, output, "bridges:read,create jobs:read:offchainreporting2")
+	assert.Contains(t, output, "foo@bar.com")
+}
+
+func TestShell_APITokens(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	client.PasswordPrompter = cltest.MockPasswordPrompter{
+		Password: cltest.Password,
+	}
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.CreateAPIToken, set, "")
+	require.NoError(t, set.Set("name", "ci"))
+	require.NoError(t, set.Set("permission", "jobs:read,run"))
+	require.NoError(t, set.Set("expires-in", "24h"))
+	require.NoError(t, client.CreateAPIToken(cli.NewContext(nil, set, nil)))
+	created := r.Renders[len(r.Renders)-1].(*cmd.AdminAPITokenPresenter)
+	assert.Equal(t, "ci", created.Name)
+	assert.NotEmpty(t, created.Secret)
+	require.NotNil(t, created.ExpiresAt)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListAPITokens, set, "")
+	require.NoError(t, client.ListAPITokens(cli.NewContext(nil, set, nil)))
+	tokens := *r.Renders[len(r.Renders)-1].(*cmd.AdminAPITokenPresenters)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, "jobs:read,run", tokens[0].Permissions[0].String())
+	assert.Empty(t, tokens[0].Secret)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.RevokeAPIToken, set, "")
+	require.NoError(t, set.Set("id", tokens[0].ID))
+	require.NoError(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+	revoked := r.Renders[len(r.Renders)-1].(*cmd.AdminAPITokenPresenter)
+	assert.Equal(t, apitokens.StatusRevoked, revoked.Status)
+	assert.Error(t, client.RevokeAPIToken(cli.NewContext(nil, set, nil)))
+}
+
+func TestAdminAPITokenPresenter_RenderTable(t *testing.T) {
+	expiresAt := time.Now().Add(time.Hour)
+	presenter := cmd.AdminAPITokenPresenter{
+		JAID: cmd.JAID{ID: "1"},
+		APITokenResource: presenters.APITokenResource{
+			JAID:        presenters.JAID{ID: "1"},
+			Name:        "ci",
+			UserEmail:   "foo@bar.com",
+			Permissions: rbac.ReadOnly()[:1],
+			Status:      apitokens.StatusActive,
+			ExpiresAt:   &expiresAt,
+			CreatedAt:   time.Now(),
+			AccessKey:   "access-key",
+			Secret:      "secret",
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	require.NoError(t, presenter.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "foo@bar.com")
+	assert.Contains(t, output, "users:read")
+	assert.Contains(t, output, expiresAt.String())
+	assert.Contains(t, output, "Access key: access-key")
+	assert.Contains(t, output, "Secret: secret")
+}
+
+func TestShell_AuditLog(t *testing.T) {
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+	ctx := testutils.Context(t)
+
+	_, err := app.AuditLogORM().AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "foo@bar.com"})
+	require.NoError(t, err)
+	_, err = app.AuditLogORM().AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "baz@bar.com"})
+	require.NoError(t, err)
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ListAuditLogRecords, set, "")
+	require.NoError(t, set.Set("user", "foo@bar.com"))
+	require.NoError(t, client.ListAuditLogRecords(cli.NewContext(nil, set, nil)))
+	records := *r.Renders[len(r.Renders)-1].(*cmd.AdminAuditLogRecordPresenters)
+	require.Len(t, records, 1)
+	assert.Equal(t, "foo@bar.com", records[0].UserEmail)
+
+	set = flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.VerifyAuditLog, set, "")
+	require.NoError(t, client.VerifyAuditLog(cli.NewContext(nil, set, nil)))
+	verification := r.Renders[len(r.Renders)-1].(*cmd.AdminAuditLogVerificationPresenter)
+	assert.True(t, verification.Valid)
+	assert.Equal(t, int64(2), verification.Records)
+}
+
+func TestAdminAuditLogPresenters_RenderTable(t *testing.T) {
+	record := cmd.AdminAuditLogRecordPresenter{
+		JAID: cmd.JAID{ID: "1"},
+		AuditLogRecordResource: presenters.AuditLogRecordResource{
+			JAID:      presenters.JAID{ID: "1"},
+			EventID:   string(audit.AuthLoginFailedEmail),
+			UserEmail: "foo@bar.com",
+			Data:      []byte(`{"email":"foo@bar.com"}`),
+			CreatedAt: time.Now(),
+			Hash:      "abc123",
+		},
+	}
+
+	buffer := bytes.NewBufferString("")
+	r := cmd.RendererTable{Writer: buffer}
+
+	require.NoError(t, cmd.AdminAuditLogRecordPresenters{record}.RenderTable(r))
+	output := buffer.String()
+	assert.Contains(t, output, "AUTH_LOGIN_FAILED_EMAIL")
+	assert.Contains(t, output, `{"email":"foo@bar.com"}`)
+	assert.Contains(t, output, "abc123")
+
+	buffer.Reset()
+	verification := cmd.AdminAuditLogVerificationPresenter{
+		AuditLogVerificationResource: presenters.AuditLogVerificationResource{
+			Records:    4,
+			HeadID:     4,
+			HeadHash:   "def456",
+			TamperedID: 5,
+			Reason:     "hash does not match the contents of the record",
+		},
+	}
+	require.NoError(t, verification.RenderTable(r))
+	output = buffer.String()
+	assert.Contains(t, output, "def456")
+	assert.Contains(t, output, "hash does not match the contents of the record")
+}
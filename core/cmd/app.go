@@ -203,6 +203,8 @@ func NewApp(s *Shell) *cli.App {
 				keysCommand("Aptos", NewAptosKeysClient(s)),
 
 				initVRFKeysSubCmd(s),
+
+				changeKeystorePasswordCommand(s),
 			},
 		},
 		{
@@ -315,6 +317,11 @@ func NewApp(s *Shell) *cli.App {
 			Usage:       "Commands for managing forwarder addresses.",
 			Subcommands: initFowardersSubCmds(s),
 		},
+		{
+			Name:        "workflows",
+			Usage:       "Commands for inspecting workflows",
+			Subcommands: initWorkflowsSubCmds(s),
+		},
 		{
 			Name:  "help-all",
 			Usage: "Shows a list of all commands and sub-commands",
@@ -34,6 +34,31 @@ func initBlocksSubCmds(s *Shell) []cli.Command {
 					Usage:    "Chain ID of the EVM-based blockchain",
 					Required: false,
 				},
+				cli.StringFlag{
+					Name:  "filter",
+					Usage: "Name of the log poller filter to replay. Only the logs of this filter are replayed, in the background",
+				},
+				cli.Int64Flag{
+					Name:  "to-block",
+					Usage: "Block number to replay the filter up to. Defaults to the latest block",
+				},
+			},
+		},
+		{
+			Name:   "filter-replay",
+			Usage:  "Shows the progress of the latest replay of a log poller filter",
+			Action: s.ShowFilterReplay,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "filter",
+					Usage:    "Name of the log poller filter",
+					Required: true,
+				},
+				cli.Int64Flag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain",
+					Required: false,
+				},
 			},
 		},
 		{
@@ -65,6 +90,15 @@ func (s *Shell) ReplayFromBlock(c *cli.Context) (err error) {
 		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
 	}
 
+	if c.IsSet("filter") {
+		v.Add("filter", c.String("filter"))
+		if c.IsSet("to-block") {
+			v.Add("toBlock", fmt.Sprintf("%d", c.Int64("to-block")))
+		}
+	} else if c.IsSet("to-block") {
+		return s.errorOut(errors.New("'--to-block' can only be used with '--filter'"))
+	}
+
 	buf := bytes.NewBufferString("{}")
 	resp, err := s.HTTP.Post(s.ctx(),
 		fmt.Sprintf(
@@ -86,10 +120,72 @@ func (s *Shell) ReplayFromBlock(c *cli.Context) (err error) {
 	if err != nil {
 		return s.errorOut(err)
 	}
+	if c.IsSet("filter") {
+		fmt.Printf("Replay of filter %s started\n", c.String("filter"))
+		return nil
+	}
 	fmt.Println("Replay started")
 	return nil
 }
 
+// FilterReplayPresenter implements TableRenderer for a FilterReplayResponse.
+type FilterReplayPresenter struct {
+	web.FilterReplayResponse
+}
+
+// ToRow presents the FilterReplayResponse as a slice of strings.
+func (p *FilterReplayPresenter) ToRow() []string {
+	toBlock := "latest"
+	if p.ToBlock != 0 {
+		toBlock = strconv.FormatInt(p.ToBlock, 10)
+	}
+	return []string{
+		p.EVMChainID.String(),
+		p.FilterName,
+		strconv.FormatInt(p.FromBlock, 10),
+		toBlock,
+		strconv.FormatInt(p.NextBlock, 10),
+		p.State,
+		p.Error,
+		p.UpdatedAt.String(),
+	}
+}
+
+// RenderTable implements TableRenderer
+// Just renders a single row
+func (p FilterReplayPresenter) RenderTable(rt RendererTable) error {
+	renderList([]string{"ChainID", "Filter", "From Block", "To Block", "Next Block", "State", "Error", "Updated At"}, [][]string{p.ToRow()}, rt.Writer)
+
+	return nil
+}
+
+// ShowFilterReplay shows the progress of the latest replay of a log poller filter.
+func (s *Shell) ShowFilterReplay(c *cli.Context) (err error) {
+	v := url.Values{}
+
+	if c.IsSet("evm-chain-id") {
+		v.Add("evmChainID", fmt.Sprintf("%d", c.Int64("evm-chain-id")))
+	}
+
+	resp, err := s.HTTP.Get(s.ctx(),
+		fmt.Sprintf(
+			"/v2/filter_replays/%s?%s",
+			url.PathEscape(c.String("filter")),
+			v.Encode(),
+		))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &FilterReplayPresenter{}, "Filter Replay")
+}
+
 // LCAPresenter implements TableRenderer for an LCAResponse.
 type LCAPresenter struct {
 	web.LCAResponse
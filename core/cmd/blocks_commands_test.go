@@ -40,6 +40,47 @@ func Test_ReplayFromBlock(t *testing.T) {
 	require.NoError(t, set.Set("evm-chain-id", "5"))
 	c = cli.NewContext(nil, set, nil)
 	require.NoError(t, client.ReplayFromBlock(c))
+
+	// To block without a filter
+	require.NoError(t, set.Set("to-block", "10"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ReplayFromBlock(c), "'--to-block' can only be used with '--filter'")
+
+	// Filter replays can't be forced
+	require.NoError(t, set.Set("filter", "my-filter"))
+	require.NoError(t, set.Set("force", "true"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ReplayFromBlock(c), "'force' query string param cannot be used with 'filter'")
+
+	// Filter replays require the log poller
+	require.NoError(t, set.Set("force", "false"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ReplayFromBlock(c), "log poller disabled")
+}
+
+func Test_ShowFilterReplay(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].ChainID = (*ubig.Big)(big.NewInt(5))
+		c.EVM[0].Enabled = ptr(true)
+	})
+
+	client, _ := app.NewShellAndRenderer()
+
+	set := flag.NewFlagSet("test", 0)
+	flagSetApplyFromAction(client.ShowFilterReplay, set, "")
+
+	// Incorrect chain ID
+	require.NoError(t, set.Set("filter", "my-filter"))
+	require.NoError(t, set.Set("evm-chain-id", "1"))
+	c := cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowFilterReplay(c), "does not match any local chains")
+
+	// Filter replays require the log poller
+	require.NoError(t, set.Set("evm-chain-id", "5"))
+	c = cli.NewContext(nil, set, nil)
+	require.ErrorContains(t, client.ShowFilterReplay(c), "log poller disabled")
 }
 
 func Test_FindLCA(t *testing.T) {
@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -38,6 +39,11 @@ func initBrideSubCmds(s *Shell) []cli.Command {
 			Usage:  "Show a Bridge's details",
 			Action: s.ShowBridge,
 		},
+		{
+			Name:   "update",
+			Usage:  "Update a Bridge's URL, confirmations, minimum payment or auth config",
+			Action: s.UpdateBridge,
+		},
 	}
 }
 
@@ -50,14 +56,23 @@ func (p *BridgePresenter) FriendlyConfirmations() string {
 	return strconv.FormatUint(uint64(p.Confirmations), 10)
 }
 
+// FriendlyAuthType returns the auth scheme of the bridge, or "none"
+func (p *BridgePresenter) FriendlyAuthType() string {
+	if p.AuthType == "" {
+		return string(bridges.AuthTypeNone)
+	}
+	return string(p.AuthType)
+}
+
 // RenderTable implements TableRenderer
 func (p *BridgePresenter) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token"})
+	table := rt.newTable([]string{"Name", "URL", "Default Confirmations", "Outgoing Token", "Auth"})
 	table.Append([]string{
 		p.Name,
 		p.URL,
 		p.FriendlyConfirmations(),
 		p.OutgoingToken,
+		p.FriendlyAuthType(),
 	})
 	render("Bridge", table)
 	return nil
@@ -67,12 +82,13 @@ type BridgePresenters []BridgePresenter
 
 // RenderTable implements TableRenderer
 func (ps BridgePresenters) RenderTable(rt RendererTable) error {
-	table := rt.newTable([]string{"Name", "URL", "Confirmations"})
+	table := rt.newTable([]string{"Name", "URL", "Confirmations", "Auth"})
 	for _, p := range ps {
 		table.Append([]string{
 			p.Name,
 			p.URL,
 			p.FriendlyConfirmations(),
+			p.FriendlyAuthType(),
 		})
 	}
 
@@ -128,6 +144,32 @@ func (s *Shell) CreateBridge(c *cli.Context) (err error) {
 	return s.renderAPIResponse(resp, &BridgePresenter{})
 }
 
+// UpdateBridge updates an existing bridge. The auth config is left unchanged
+// unless authConfig is given.
+func (s *Shell) UpdateBridge(c *cli.Context) (err error) {
+	if c.NArg() != 2 {
+		return s.errorOut(errors.New("must pass the name of the bridge and its parameters [JSON blob | JSON filepath]"))
+	}
+	bridgeName := c.Args().First()
+
+	buf, err := getBufferFromJSON(c.Args().Get(1))
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/bridge_types/"+bridgeName, buf)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &BridgePresenter{})
+}
+
 // RemoveBridge removes a specific Bridge by name.
 func (s *Shell) RemoveBridge(c *cli.Context) (err error) {
 	if !c.Args().Present() {
@@ -62,6 +62,11 @@ func initEVMTxSubCmd(s *Shell) cli.Command {
 				Usage:  "get information on a specific Ethereum Transaction",
 				Action: s.ShowTransaction,
 			},
+			{
+				Name:   "cancel",
+				Usage:  "Cancel a pending Ethereum Transaction, given its hash or ID, by replacing it with a zero value self-send at the same nonce with a bumped fee",
+				Action: s.CancelTransaction,
+			},
 		},
 	}
 }
@@ -131,6 +136,27 @@ func (s *Shell) ShowTransaction(c *cli.Context) (err error) {
 	return err
 }
 
+// CancelTransaction cancels the transaction with the given hash or ID, if it has
+// not been confirmed yet
+func (s *Shell) CancelTransaction(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass the hash or the ID of the transaction"))
+	}
+	hashOrID := c.Args().First()
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/transactions/evm/"+hashOrID+"/cancel", nil)
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	err = s.renderAPIResponse(resp, &EthTxPresenter{})
+	return err
+}
+
 // SendEther transfers ETH from the node's account to a specified address.
 func (s *Shell) SendEther(c *cli.Context) (err error) {
 	if c.NArg() < 3 {
@@ -40,6 +40,21 @@ func initJobsSubCmds(s *Shell) []cli.Command {
 			Usage:  "Create a job",
 			Action: s.CreateJob,
 		},
+		{
+			Name:   "dry-run",
+			Usage:  "Execute a job's pipeline without side effects and show every task's result",
+			Action: s.DryRunJob,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "vars",
+					Usage: "JSON object of pipeline vars, e.g. '{\"jobRun\": {\"requestBody\": \"...\"}}'",
+				},
+				cli.StringFlag{
+					Name:  "stubs",
+					Usage: "JSON object mapping the dot IDs of side-effecting tasks to the values they should return",
+				},
+			},
+		},
 		{
 			Name:   "delete",
 			Usage:  "Delete a job",
@@ -276,6 +291,80 @@ func (s *Shell) CreateJob(c *cli.Context) (err error) {
 	return err
 }
 
+// PipelineDryRunPresenter wraps the JSONAPI dry run resource and adds rendering functionality
+type PipelineDryRunPresenter struct {
+	JAID
+	presenters.PipelineDryRunResource
+}
+
+// RenderTable implements TableRenderer
+func (p *PipelineDryRunPresenter) RenderTable(rt RendererTable) error {
+	table := rt.newTable([]string{"Task", "Type", "Stubbed", "Attempts", "Elapsed", "Output", "Error"})
+	for _, tr := range p.TaskRuns {
+		var output, errString string
+		if tr.Output != nil {
+			output = *tr.Output
+		}
+		if tr.Error != nil {
+			errString = *tr.Error
+		}
+		table.Append([]string{
+			tr.DotID,
+			tr.Type.String(),
+			fmt.Sprintf("%t", tr.Stubbed),
+			fmt.Sprintf("%d", tr.Attempts),
+			(time.Duration(tr.ElapsedMs) * time.Millisecond).String(),
+			output,
+			errString,
+		})
+	}
+
+	render("Pipeline Dry Run", table)
+	return nil
+}
+
+// DryRunJob executes the pipeline of a job spec without side effects.
+// Valid input is a TOML string or a path to TOML file
+func (s *Shell) DryRunJob(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must pass in TOML or filepath"))
+	}
+
+	tomlString, err := getTOMLString(c.Args().First())
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	dryRunRequest := web.DryRunJobRequest{TOML: tomlString}
+	if v := c.String("vars"); v != "" {
+		if err = json.Unmarshal([]byte(v), &dryRunRequest.Vars); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid vars"))
+		}
+	}
+	if v := c.String("stubs"); v != "" {
+		if err = json.Unmarshal([]byte(v), &dryRunRequest.Stubs); err != nil {
+			return s.errorOut(errors.Wrap(err, "invalid stubs"))
+		}
+	}
+
+	request, err := json.Marshal(dryRunRequest)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	resp, err := s.HTTP.Post(s.ctx(), "/v2/jobs/dry_run", bytes.NewReader(request))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &PipelineDryRunPresenter{})
+}
+
 // DeleteJob deletes a job
 func (s *Shell) DeleteJob(c *cli.Context) error {
 	if !c.Args().Present() {
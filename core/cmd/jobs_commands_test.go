@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
+	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -403,6 +404,43 @@ func TestShell_CreateJobV2(t *testing.T) {
 	assert.Equal(t, "0x27548a32b9aD5D64c5945EaE9Da5337bc3169D15", output.OffChainReportingSpec.ContractAddress.String())
 }
 
+func TestShell_DryRunJob(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplicationV2(t, nil)
+	client, r := app.NewShellAndRenderer()
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	spec := testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String())
+
+	t.Run("invalid stubs", func(t *testing.T) {
+		fs := flag.NewFlagSet("", flag.ExitOnError)
+		flagSetApplyFromAction(client.DryRunJob, fs, "")
+		require.NoError(t, fs.Parse([]string{"--stubs", "[]", spec}))
+
+		err := client.DryRunJob(cli.NewContext(nil, fs, nil))
+		require.ErrorContains(t, err, "invalid stubs")
+	})
+
+	t.Run("success", func(t *testing.T) {
+		fs := flag.NewFlagSet("", flag.ExitOnError)
+		flagSetApplyFromAction(client.DryRunJob, fs, "")
+		require.NoError(t, fs.Parse([]string{"--stubs", `{"fetch": "{\"data\": {\"result\": \"3\"}}", "submit": "submitted"}`, spec}))
+
+		require.NoError(t, client.DryRunJob(cli.NewContext(nil, fs, nil)))
+		requireJobsCount(t, app.JobORM(), 0)
+
+		output := *r.Renders[len(r.Renders)-1].(*cmd.PipelineDryRunPresenter)
+		require.Len(t, output.Outputs, 1)
+		assert.Equal(t, "submitted", *output.Outputs[0])
+		require.Len(t, output.TaskRuns, 4)
+		for _, tr := range output.TaskRuns {
+			assert.Equal(t, tr.Type == "bridge", tr.Stubbed, tr.DotID)
+		}
+	})
+}
+
 func TestShell_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
 )
 
 // KeysClient is a generic client interface for any type of key.
@@ -86,6 +88,97 @@ func keysCommand(typ string, c KeysClient) cli.Command {
 	}
 }
 
+// changeKeystorePasswordCommand returns the cli.Command re-encrypting all the keys with a new keystore password.
+func changeKeystorePasswordCommand(s *Shell) cli.Command {
+	return cli.Command{
+		Name:  "change-password",
+		Usage: "Re-encrypt all the keys with a new keystore password or stronger scrypt parameters",
+		Description: format(`Re-encrypts all the keys of the keystore, and the bridge auth configs encrypted with it,
+			with a new keystore password. Either all of them are re-encrypted or none. Update the keystore password
+			of the node before restarting it. The keys are re-encrypted with the given scrypt parameters, which must
+			not be weaker than the current ones, and the new password may be the current one when they are given.`),
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "old-password, oldpassword",
+				Usage: "`FILE` containing the current keystore password (required)",
+			},
+			cli.StringFlag{
+				Name:  "new-password, newpassword",
+				Usage: "`FILE` containing the new keystore password (required)",
+			},
+			cli.IntFlag{
+				Name:  "scrypt-n",
+				Usage: "scrypt `N` cost parameter, a power of 2 (defaults to the current one)",
+			},
+			cli.IntFlag{
+				Name:  "scrypt-p",
+				Usage: "scrypt `P` parallelization parameter (defaults to the current one)",
+			},
+			cli.BoolFlag{
+				Name:  "yes, y",
+				Usage: "skip the confirmation prompt",
+			},
+		},
+		Action: s.ChangeKeystorePassword,
+	}
+}
+
+// ChangeKeystorePassword re-encrypts all the keys with a new keystore password or stronger scrypt parameters
+func (s *Shell) ChangeKeystorePassword(c *cli.Context) (err error) {
+	oldPasswordFile := c.String("old-password")
+	if len(oldPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --old-password flag"))
+	}
+	newPasswordFile := c.String("new-password")
+	if len(newPasswordFile) == 0 {
+		return s.errorOut(errors.New("Must specify --new-password flag"))
+	}
+	oldPassword, err := utils.PasswordFromFile(oldPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read old password file"))
+	}
+	newPassword, err := utils.PasswordFromFile(newPasswordFile)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "Could not read new password file"))
+	}
+	if err = utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return s.errorOut(err)
+	}
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	requestData, err := json.Marshal(web.ChangeKeystorePasswordRequest{
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+		ScryptN:     c.Int("scrypt-n"),
+		ScryptP:     c.Int("scrypt-p"),
+	})
+	if err != nil {
+		return s.errorOut(err)
+	}
+	resp, err := s.HTTP.Patch(s.ctx(), "/v2/keys/password", bytes.NewReader(requestData))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	switch resp.StatusCode {
+	case http.StatusNoContent:
+		fmt.Println("Keystore password changed. Update the keystore password of the node before restarting it.")
+	case http.StatusConflict:
+		return s.errorOut(errors.New("Old keystore password did not match, the keystore was left unchanged"))
+	default:
+		return s.errorOut(fmt.Errorf("error changing keystore password, the keystore was left unchanged: %w", httpError(resp)))
+	}
+	return nil
+}
+
 type keysClient[K keystore.Key, P TableRenderer, P2 ~[]P] struct {
 	*Shell
 	typ  string
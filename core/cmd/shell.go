@@ -212,6 +212,16 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, errors.Wrap(err, "error authenticating keystore")
 	}
 
+	if signerCfg := cfg.ExternalSigner(); signerCfg.Enabled() {
+		signer, err2 := keystore.NewRemoteEthSigner(ctx, signerCfg.URL(), signerCfg.Timeout())
+		if err2 != nil {
+			return nil, errors.Wrap(err2, "failed to connect to external signer")
+		}
+		if err2 = keyStore.Eth().UseSigner(ctx, signer); err2 != nil {
+			return nil, errors.Wrap(err2, "failed to use external signer")
+		}
+	}
+
 	err = keyStore.CSA().EnsureKey(ctx)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to ensure CSA key")
@@ -299,8 +309,8 @@ func (n ChainlinkAppFactory) NewApplication(ctx context.Context, cfg chainlink.G
 		return nil, err
 	}
 
-	// Configure and optionally start the audit log forwarder service
-	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger())
+	// Configure and optionally start the audit log forwarder and store service
+	auditLogger, err := audit.NewAuditLogger(appLggr, cfg.AuditLogger(), ds)
 	if err != nil {
 		return nil, err
 	}
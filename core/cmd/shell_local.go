@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	crand "crypto/rand"
 	"database/sql"
@@ -33,11 +34,15 @@ import (
 
 	"github.com/jmoiron/sqlx"
 
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	cutils "github.com/smartcontractkit/chainlink-common/pkg/utils"
 
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
+	evmconfig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config"
+	evmcfg "github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/headtracker"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
@@ -279,6 +284,35 @@ func initLocalSubCmds(s *Shell, safe bool) []cli.Command {
 				},
 			},
 		},
+		{
+			Name:   "simulate-gas-estimator",
+			Usage:  "Replays recorded blocks through gas estimator configurations, and reports the fees paid, inclusion delay and bumps of the transactions each would have sent. Does not connect to any RPC.",
+			Action: s.SimulateGasEstimator,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "evm-chain-id",
+					Usage:    "Chain ID of the EVM-based blockchain, whose configuration is simulated",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "blocks",
+					Usage: "JSON file holding the blocks to replay, either as recorded blocks ({number, baseFee, tips}) or as returned by eth_getBlockByNumber with full transactions. If not set, the heads stored by the head tracker are replayed, which have no transactions, so only base fees are simulated",
+				},
+				cli.StringSliceFlag{
+					Name:  "estimator",
+					Usage: "TOML file with chain settings, e.g. a [GasEstimator] table, applied on top of the chain configuration. Each file is simulated separately. If not set, the chain configuration is simulated",
+				},
+				cli.IntFlag{
+					Name:  "tx-interval",
+					Usage: "number of blocks between simulated transactions",
+					Value: 1,
+				},
+				cli.IntFlag{
+					Name:  "warmup",
+					Usage: "number of blocks replayed before the first transaction. Defaults to the BlockHistorySize of the estimator",
+				},
+			},
+		},
 	}
 }
 
@@ -1286,3 +1320,112 @@ func (s *Shell) RemoveBlocks(c *cli.Context) error {
 
 	return nil
 }
+
+// SimulateGasEstimator replays recorded blocks through gas estimator configurations.
+func (s *Shell) SimulateGasEstimator(c *cli.Context) error {
+	ctx := s.ctx()
+	chainID := c.String("evm-chain-id")
+	var chainCfg *evmcfg.EVMConfig
+	for _, cfg := range s.Config.EVMConfigs() {
+		if cfg.ChainID.String() == chainID {
+			chainCfg = cfg
+		}
+	}
+	if chainCfg == nil {
+		return s.errorOut(fmt.Errorf("no configuration found for EVM chain %s", chainID))
+	}
+
+	var blocks []gas.HistoricalBlock
+	if path := c.String("blocks"); path != "" {
+		f, err := os.Open(path)
+		if err != nil {
+			return s.errorOut(err)
+		}
+		defer f.Close()
+		if blocks, err = gas.LoadHistoricalBlocks(f); err != nil {
+			return s.errorOut(err)
+		}
+	} else {
+		db, err := newConnection(ctx, s.Config.Database())
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "opening db"))
+		}
+		defer db.Close()
+		heads, err := headtracker.NewORM(*chainCfg.ChainID.ToInt(), db).LatestHeads(ctx, 0)
+		if err != nil {
+			return s.errorOut(errors.Wrap(err, "failed to load heads"))
+		}
+		blocks = gas.HistoricalBlocksFromHeads(heads)
+	}
+
+	estimators := c.StringSlice("estimator")
+	if len(estimators) == 0 {
+		estimators = []string{""}
+	}
+	opts := gas.SimulationOptions{TxInterval: c.Int("tx-interval"), Warmup: c.Int("warmup")}
+	lggr := logger.Sugared(s.Logger.Named("SimulateGasEstimator"))
+
+	var presenters GasSimulationPresenters
+	for _, path := range estimators {
+		cfg := &evmcfg.EVMConfig{}
+		cfg.SetFrom(chainCfg)
+		name := "chain configuration"
+		if path != "" {
+			name = filepath.Base(path)
+			b, err := os.ReadFile(path)
+			if err != nil {
+				return s.errorOut(err)
+			}
+			var override evmcfg.Chain
+			if err = commonconfig.DecodeTOML(bytes.NewReader(b), &override); err != nil {
+				return s.errorOut(fmt.Errorf("failed to decode %s: %w", path, err))
+			}
+			cfg.Chain.SetFrom(&override)
+		}
+
+		geCfg := evmconfig.NewTOMLChainScopedConfig(cfg, lggr).EVM().GasEstimator()
+		result, err := gas.SimulateEstimator(ctx, lggr, geCfg, blocks, opts)
+		if err != nil {
+			return s.errorOut(fmt.Errorf("failed to simulate %s: %w", name, err))
+		}
+		presenters = append(presenters, GasSimulationPresenter{Name: name, Mode: geCfg.Mode(), SimulationResult: result})
+	}
+	return s.errorOut(s.Render(presenters))
+}
+
+// GasSimulationPresenter implements TableRenderer for the result of a gas estimator simulation.
+type GasSimulationPresenter struct {
+	Name string
+	Mode string
+	gas.SimulationResult
+}
+
+var gasSimulationHeaders = []string{"Estimator", "Mode", "Transactions", "Included", "Avg Delay", "Max Delay", "Bumps", "Avg Gas Price", "Total Fees"}
+
+// ToRow presents the GasSimulationPresenter as a slice of strings.
+func (p *GasSimulationPresenter) ToRow() []string {
+	return []string{
+		p.Name,
+		p.Mode,
+		strconv.Itoa(p.Transactions),
+		strconv.Itoa(p.Included),
+		strconv.FormatFloat(p.AverageDelay(), 'f', 2, 64),
+		strconv.Itoa(p.MaxDelay),
+		strconv.Itoa(p.Bumps),
+		p.AverageGasPrice().String(),
+		p.TotalFees.String(),
+	}
+}
+
+// GasSimulationPresenters implements TableRenderer for a slice of GasSimulationPresenter.
+type GasSimulationPresenters []GasSimulationPresenter
+
+// RenderTable implements TableRenderer
+func (ps GasSimulationPresenters) RenderTable(rt RendererTable) error {
+	rows := [][]string{}
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+	renderList(gasSimulationHeaders, rows, rt.Writer)
+	return nil
+}
@@ -1,11 +1,14 @@
 package cmd_test
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +17,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/cmd"
 	cmdMocks "github.com/smartcontractkit/chainlink/v2/core/cmd/mocks"
@@ -570,3 +574,66 @@ func TestShell_RemoveBlocks(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestShell_SimulateGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewGeneralConfig(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+		c.EVM[0].GasEstimator.Mode = ptr("FixedPrice")
+		c.EVM[0].GasEstimator.PriceDefault = assets.GWei(20)
+	})
+	var out bytes.Buffer
+	shell := cmd.Shell{
+		Config:   cfg,
+		Logger:   logger.TestLogger(t),
+		Renderer: cmd.RendererTable{Writer: &out},
+	}
+
+	dir := t.TempDir()
+	blocksFile := filepath.Join(dir, "blocks.json")
+	var blocks []string
+	for i := 0; i < 20; i++ {
+		blocks = append(blocks, fmt.Sprintf(`{"number": %d, "baseFee": "10 gwei", "tips": ["1 gwei"]}`, i))
+	}
+	require.NoError(t, os.WriteFile(blocksFile, []byte("["+strings.Join(blocks, ",")+"]"), 0600))
+	estimatorFile := filepath.Join(dir, "cheap.toml")
+	require.NoError(t, os.WriteFile(estimatorFile, []byte("[GasEstimator]\nPriceDefault = '5 gwei'\nPriceMax = '5 gwei'\n"), 0600))
+
+	t.Run("simulates the chain configuration", func(t *testing.T) {
+		out.Reset()
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.SimulateGasEstimator, set, "")
+		require.NoError(t, set.Set("evm-chain-id", testutils.FixtureChainID.String()))
+		require.NoError(t, set.Set("blocks", blocksFile))
+		require.NoError(t, set.Set("warmup", "5"))
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.SimulateGasEstimator(c))
+
+		assert.Contains(t, out.String(), "chain configuration")
+		assert.Contains(t, out.String(), "20 gwei")
+	})
+
+	t.Run("simulates each estimator override", func(t *testing.T) {
+		out.Reset()
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.SimulateGasEstimator, set, "")
+		require.NoError(t, set.Set("evm-chain-id", testutils.FixtureChainID.String()))
+		require.NoError(t, set.Set("blocks", blocksFile))
+		require.NoError(t, set.Set("warmup", "5"))
+		require.NoError(t, set.Set("estimator", estimatorFile))
+		c := cli.NewContext(nil, set, nil)
+		require.NoError(t, shell.SimulateGasEstimator(c))
+
+		assert.NotContains(t, out.String(), "chain configuration")
+		assert.Contains(t, out.String(), "cheap.toml")
+	})
+
+	t.Run("fails for an unknown chain", func(t *testing.T) {
+		set := flag.NewFlagSet("test", 0)
+		flagSetApplyFromAction(shell.SimulateGasEstimator, set, "")
+		require.NoError(t, set.Set("evm-chain-id", "424242"))
+		require.NoError(t, set.Set("blocks", blocksFile))
+		c := cli.NewContext(nil, set, nil)
+		require.ErrorContains(t, shell.SimulateGasEstimator(c), "no configuration found for EVM chain 424242")
+	})
+}
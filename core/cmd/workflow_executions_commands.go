@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func initWorkflowsSubCmds(s *Shell) []cli.Command {
+	return []cli.Command{
+		{
+			Name:  "executions",
+			Usage: "Commands for inspecting workflow executions",
+			Subcommands: []cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List workflow executions, most recent first",
+					Action: s.ListWorkflowExecutions,
+					Flags: []cli.Flag{
+						cli.IntFlag{
+							Name:  "page",
+							Usage: "page of results to display",
+						},
+						cli.StringFlag{
+							Name:  "workflow-id",
+							Usage: "only list executions of this workflow",
+						},
+						cli.StringFlag{
+							Name:  "status",
+							Usage: "only list executions with this status, options: [started, errored, timeout, completed, completed_early_exit]",
+						},
+						cli.StringFlag{
+							Name:  "created-after",
+							Usage: "only list executions created at or after this RFC3339 timestamp",
+						},
+						cli.StringFlag{
+							Name:  "created-before",
+							Usage: "only list executions created before this RFC3339 timestamp",
+						},
+					},
+				},
+				{
+					Name:   "show",
+					Usage:  "Show the timeline of a workflow execution's steps",
+					Action: s.ShowWorkflowExecution,
+				},
+			},
+		},
+		{
+			Name:   "simulate",
+			Usage:  "Run a WASM workflow locally against recorded trigger events and capability responses, and print the trace of every step. Does not connect to a node",
+			Action: s.SimulateWorkflow,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:     "wasm",
+					Usage:    "path to the workflow binary, either a .wasm file or a brotli compressed .br file",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "config",
+					Usage: "path to the workflow configuration file",
+				},
+				cli.StringFlag{
+					Name:     "fixtures",
+					Usage:    "JSON file of the recorded trigger events ({\"triggers\": {capabilityID: [outputs]}}), consensus and action responses ({\"responses\": {ref: [{outputs, error}]}}), compute fetches ({\"fetches\": [{method, url, statusCode, body}]}) and secrets",
+					Required: true,
+				},
+				cli.StringFlag{
+					Name:  "owner",
+					Usage: "hex address of the workflow owner",
+					Value: "0x0000000000000000000000000000000000000000",
+				},
+				cli.StringFlag{
+					Name:  "name",
+					Usage: "name of the workflow",
+					Value: "simulated",
+				},
+				cli.DurationFlag{
+					Name:  "timeout",
+					Usage: "maximum duration of the initialization of the workflow and of each execution",
+					Value: time.Minute,
+				},
+			},
+		},
+	}
+}
+
+// WorkflowExecutionPresenter wraps the JSONAPI WorkflowExecution Resource and adds rendering functionality
+type WorkflowExecutionPresenter struct {
+	JAID // This is needed to render the id for a JSONAPI Resource as normal JSON
+	presenters.WorkflowExecutionResource
+}
+
+var workflowExecutionHeaders = []string{"ID", "Workflow ID", "Status", "Created At", "Finished At"}
+
+// ToRow presents the WorkflowExecutionResource as a slice of strings.
+func (p *WorkflowExecutionPresenter) ToRow() []string {
+	return []string{
+		p.GetID(),
+		p.WorkflowID,
+		p.Status,
+		formatOptionalTime(p.CreatedAt),
+		formatOptionalTime(p.FinishedAt),
+	}
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowExecutionPresenter) RenderTable(rt RendererTable) error {
+	renderList(workflowExecutionHeaders, [][]string{p.ToRow()}, rt.Writer)
+
+	table := rt.newTable([]string{"Step", "Status", "DON ID", "Start Offset", "Latency", "Error"})
+	for _, step := range p.Steps {
+		var donID, offset, latency, errString string
+		if step.CapabilityDONID != 0 {
+			donID = fmt.Sprintf("%d", step.CapabilityDONID)
+		}
+		if step.StartOffsetMs != nil {
+			offset = (time.Duration(*step.StartOffsetMs) * time.Millisecond).String()
+		}
+		if step.LatencyMs != nil {
+			latency = (time.Duration(*step.LatencyMs) * time.Millisecond).String()
+		}
+		if step.Error != nil {
+			errString = *step.Error
+		}
+		table.Append([]string{step.Ref, step.Status, donID, offset, latency, errString})
+	}
+
+	render("Steps", table)
+	return nil
+}
+
+// WorkflowExecutionPresenters implements TableRenderer for a slice of WorkflowExecutionPresenter.
+type WorkflowExecutionPresenters []WorkflowExecutionPresenter
+
+// RenderTable implements TableRenderer
+func (ps WorkflowExecutionPresenters) RenderTable(rt RendererTable) error {
+	var rows [][]string
+	for _, p := range ps {
+		rows = append(rows, p.ToRow())
+	}
+
+	renderList(workflowExecutionHeaders, rows, rt.Writer)
+	return nil
+}
+
+// ListWorkflowExecutions lists the workflow executions matching the filters
+func (s *Shell) ListWorkflowExecutions(c *cli.Context) (err error) {
+	q := url.Values{}
+	for flag, param := range map[string]string{
+		"workflow-id":    "workflowID",
+		"status":         "status",
+		"created-after":  "createdAfter",
+		"created-before": "createdBefore",
+	} {
+		if v := c.String(flag); v != "" {
+			q.Set(param, v)
+		}
+	}
+	uri := "/v2/workflows/executions"
+	if len(q) > 0 {
+		uri += "?" + q.Encode()
+	}
+	return s.getPage(uri, c.Int("page"), &WorkflowExecutionPresenters{})
+}
+
+// ShowWorkflowExecution displays a workflow execution and the timeline of its steps
+func (s *Shell) ShowWorkflowExecution(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return s.errorOut(errors.New("must provide the id of the workflow execution"))
+	}
+	resp, err := s.HTTP.Get(s.ctx(), "/v2/workflows/executions/"+url.PathEscape(c.Args().First()))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return s.renderAPIResponse(resp, &WorkflowExecutionPresenter{})
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
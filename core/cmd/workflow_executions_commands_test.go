@@ -0,0 +1,71 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		id         = "execution-1"
+		workflowID = "workflow-1"
+		createdAt  = time.Now()
+		offsetMs   = int64(1500)
+		latencyMs  = int64(250)
+		errMsg     = "capability timed out"
+		buffer     = bytes.NewBufferString("")
+		r          = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowExecutionPresenter{
+		JAID: cmd.JAID{ID: id},
+		WorkflowExecutionResource: presenters.WorkflowExecutionResource{
+			JAID:       presenters.NewJAID(id),
+			WorkflowID: workflowID,
+			Status:     "errored",
+			CreatedAt:  &createdAt,
+			Steps: []presenters.WorkflowExecutionStepResource{
+				{
+					Ref:             "read_price",
+					Status:          "errored",
+					CapabilityDONID: 7,
+					Error:           &errMsg,
+					StartOffsetMs:   &offsetMs,
+					LatencyMs:       &latencyMs,
+				},
+			},
+		},
+	}
+
+	// Render a single resource
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, workflowID)
+	assert.Contains(t, output, createdAt.Format(time.RFC3339))
+	assert.Contains(t, output, "read_price")
+	assert.Contains(t, output, "1.5s")
+	assert.Contains(t, output, "250ms")
+	assert.Contains(t, output, errMsg)
+
+	// Render many resources
+	buffer.Reset()
+	ps := cmd.WorkflowExecutionPresenters{p}
+	require.NoError(t, ps.RenderTable(r))
+
+	output = buffer.String()
+	assert.Contains(t, output, id)
+	assert.Contains(t, output, workflowID)
+	assert.Contains(t, output, "errored")
+	assert.NotContains(t, output, "read_price")
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+)
+
+// SimulateWorkflow runs a WASM workflow against recorded fixtures, and renders the trace of its executions.
+// It returns an error if any execution didn't complete, so that it can be used in CI.
+func (s *Shell) SimulateWorkflow(c *cli.Context) error {
+	ctx := s.ctx()
+
+	configPath := c.String("config")
+	if configPath == "" {
+		configPath = os.DevNull
+	}
+	spec, binary, _, err := job.WasmFileSpecFactory{}.Spec(ctx, c.String("wasm"), configPath)
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to load workflow"))
+	}
+	config, err := job.WasmFileSpecFactory{}.Config(ctx, configPath)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	f, err := os.Open(c.String("fixtures"))
+	if err != nil {
+		return s.errorOut(err)
+	}
+	defer f.Close()
+	fixtures, err := workflows.LoadSimulationFixtures(f)
+	if err != nil {
+		return s.errorOut(err)
+	}
+
+	trace, err := workflows.Simulate(ctx, workflows.SimulatorConfig{
+		Lggr:             s.Logger,
+		Workflow:         spec,
+		WorkflowOwner:    c.String("owner"),
+		WorkflowName:     c.String("name"),
+		Binary:           binary,
+		Config:           config,
+		Fixtures:         fixtures,
+		ExecutionTimeout: c.Duration("timeout"),
+	})
+	if err != nil {
+		return s.errorOut(errors.Wrap(err, "failed to simulate workflow"))
+	}
+
+	if err = s.Render(&WorkflowSimulationPresenter{SimulationTrace: trace}); err != nil {
+		return s.errorOut(err)
+	}
+	if trace.Failed() {
+		return s.errorOut(errors.New("not all the executions of the workflow completed"))
+	}
+	return nil
+}
+
+// WorkflowSimulationPresenter implements TableRenderer for the trace of a workflow simulation.
+type WorkflowSimulationPresenter struct {
+	workflows.SimulationTrace
+}
+
+// RenderTable implements TableRenderer
+func (p *WorkflowSimulationPresenter) RenderTable(rt RendererTable) error {
+	for _, e := range p.Executions {
+		table := rt.newTable([]string{"Step", "Capability", "Status", "Outputs", "Error"})
+		for _, step := range e.Steps {
+			table.Append([]string{step.Ref, step.CapabilityID, step.Status, traceJSON(step.Outputs), step.Error})
+		}
+		render(fmt.Sprintf("Execution %s (%s): %s", e.EventID, e.ExecutionID, e.Status), table)
+	}
+
+	table := rt.newTable([]string{"Execution", "Step", "Receiver", "Inputs"})
+	for _, w := range p.Writes {
+		table.Append([]string{w.ExecutionID, w.Ref, w.Receiver, traceJSON(w.Inputs)})
+	}
+	render("Writes", table)
+	return nil
+}
+
+func traceJSON(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
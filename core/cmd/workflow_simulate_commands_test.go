@@ -0,0 +1,47 @@
+package cmd_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/cmd"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+)
+
+func TestWorkflowSimulationPresenter_RenderTable(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buffer = bytes.NewBufferString("")
+		r      = cmd.RendererTable{Writer: buffer}
+	)
+
+	p := cmd.WorkflowSimulationPresenter{
+		SimulationTrace: workflows.SimulationTrace{
+			Executions: []workflows.SimulatedExecution{{
+				ExecutionID: "execution-1",
+				EventID:     "simulated_event_0",
+				Status:      "errored",
+				Steps: []workflows.SimulatedStep{
+					{Ref: "trigger", CapabilityID: "mercury-trigger@1.0.0", Status: "completed", Outputs: map[string]any{"price": 100}},
+					{Ref: "evm_median", CapabilityID: "offchain_reporting@1.0.0", Status: "errored", Error: "not enough observations"},
+				},
+			}},
+			Writes: []workflows.SimulatedWrite{
+				{ExecutionID: "execution-1", Ref: "write", Receiver: "0x54e220867af6683aE6DcBF535B4f952cB5116510", Inputs: map[string]any{"report": "0x01"}},
+			},
+		},
+	}
+
+	require.NoError(t, p.RenderTable(r))
+
+	output := buffer.String()
+	assert.Contains(t, output, "mercury-trigger@1.0.0")
+	assert.Contains(t, output, `{"price":100}`)
+	assert.Contains(t, output, "not enough observations")
+	assert.Contains(t, output, "0x54e220867af6683aE6DcBF535B4f952cB5116510")
+	assert.Contains(t, output, `{"report":"0x01"}`)
+}
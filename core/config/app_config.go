@@ -37,7 +37,9 @@ type AppConfig interface {
 	AutoPprof() AutoPprof
 	Capabilities() Capabilities
 	Database() Database
+	ExternalSigner() ExternalSigner
 	Feature() Feature
+	FeedsManager() FeedsManager
 	FluxMonitor() FluxMonitor
 	Insecure() Insecure
 	JobPipeline() JobPipeline
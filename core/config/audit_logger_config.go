@@ -11,4 +11,5 @@ type AuditLogger interface {
 	Environment() string
 	JsonWrapperKey() string
 	Headers() (models.ServiceHeaders, error)
+	Persist() bool
 }
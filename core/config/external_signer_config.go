@@ -0,0 +1,12 @@
+package config
+
+import (
+	"net/url"
+	"time"
+)
+
+type ExternalSigner interface {
+	Enabled() bool
+	URL() *url.URL
+	Timeout() time.Duration
+}
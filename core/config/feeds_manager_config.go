@@ -0,0 +1,22 @@
+package config
+
+// Actions and conditions of FeedsManager.ApprovalPolicies.
+const (
+	ApprovalActionAutoApprove   = "AutoApprove"
+	ApprovalActionRequireManual = "RequireManual"
+
+	ApprovalConditionOnlyAdapterURLsChanged = "OnlyAdapterURLsChanged"
+	ApprovalConditionOnlyPipelineChanged    = "OnlyPipelineChanged"
+	ApprovalConditionNewContractAddress     = "NewContractAddress"
+)
+
+type FeedsManager interface {
+	ApprovalPolicies() []FeedsManagerApprovalPolicy
+}
+
+type FeedsManagerApprovalPolicy interface {
+	Name() string
+	Action() string
+	Condition() string
+	JobTypes() []string
+}
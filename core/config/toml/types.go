@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
@@ -41,6 +42,7 @@ type Core struct {
 	ShutdownGracePeriod *commonconfig.Duration
 
 	Feature          Feature          `toml:",omitempty"`
+	FeedsManager     FeedsManager     `toml:",omitempty"`
 	Database         Database         `toml:",omitempty"`
 	TelemetryIngress TelemetryIngress `toml:",omitempty"`
 	AuditLogger      AuditLogger      `toml:",omitempty"`
@@ -60,6 +62,7 @@ type Core struct {
 	Mercury          Mercury          `toml:",omitempty"`
 	Capabilities     Capabilities     `toml:",omitempty"`
 	Telemetry        Telemetry        `toml:",omitempty"`
+	ExternalSigner   ExternalSigner   `toml:",omitempty"`
 }
 
 // SetFrom updates c with any non-nil values from f. (currently TOML field only!)
@@ -75,6 +78,7 @@ func (c *Core) SetFrom(f *Core) {
 	}
 
 	c.Feature.setFrom(&f.Feature)
+	c.FeedsManager.setFrom(&f.FeedsManager)
 	c.Database.setFrom(&f.Database)
 	c.TelemetryIngress.setFrom(&f.TelemetryIngress)
 	c.AuditLogger.SetFrom(&f.AuditLogger)
@@ -97,6 +101,7 @@ func (c *Core) SetFrom(f *Core) {
 	c.Insecure.setFrom(&f.Insecure)
 	c.Tracing.setFrom(&f.Tracing)
 	c.Telemetry.setFrom(&f.Telemetry)
+	c.ExternalSigner.setFrom(&f.ExternalSigner)
 }
 
 func (c *Core) ValidateConfig() (err error) {
@@ -335,6 +340,53 @@ func (f *Feature) setFrom(f2 *Feature) {
 	}
 }
 
+type FeedsManager struct {
+	ApprovalPolicies []FeedsManagerApprovalPolicy `toml:",omitempty"`
+}
+
+type FeedsManagerApprovalPolicy struct {
+	Name      *string
+	Action    *string
+	Condition *string
+	JobTypes  *[]string
+}
+
+func (m *FeedsManager) setFrom(f *FeedsManager) {
+	if v := f.ApprovalPolicies; v != nil {
+		m.ApprovalPolicies = v
+	}
+}
+
+func (m *FeedsManager) ValidateConfig() (err error) {
+	names := make(map[string]struct{}, len(m.ApprovalPolicies))
+	for i, p := range m.ApprovalPolicies {
+		if p.Name == nil || *p.Name == "" {
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("ApprovalPolicies.%d.Name", i), Msg: "required for all policies"})
+		} else if _, ok := names[*p.Name]; ok {
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("ApprovalPolicies.%d.Name", i), Value: *p.Name, Msg: "duplicate policy name"})
+		} else {
+			names[*p.Name] = struct{}{}
+		}
+
+		switch {
+		case p.Action == nil:
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("ApprovalPolicies.%d.Action", i), Msg: "required for all policies"})
+		case *p.Action != config.ApprovalActionAutoApprove && *p.Action != config.ApprovalActionRequireManual:
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("ApprovalPolicies.%d.Action", i), Value: *p.Action,
+				Msg: fmt.Sprintf("must be one of %s or %s", config.ApprovalActionAutoApprove, config.ApprovalActionRequireManual)})
+		}
+
+		switch {
+		case p.Condition == nil:
+			err = multierr.Append(err, configutils.ErrMissing{Name: fmt.Sprintf("ApprovalPolicies.%d.Condition", i), Msg: "required for all policies"})
+		case !slices.Contains([]string{config.ApprovalConditionOnlyAdapterURLsChanged, config.ApprovalConditionOnlyPipelineChanged, config.ApprovalConditionNewContractAddress}, *p.Condition):
+			err = multierr.Append(err, configutils.ErrInvalid{Name: fmt.Sprintf("ApprovalPolicies.%d.Condition", i), Value: *p.Condition,
+				Msg: fmt.Sprintf("must be one of %s, %s or %s", config.ApprovalConditionOnlyAdapterURLsChanged, config.ApprovalConditionOnlyPipelineChanged, config.ApprovalConditionNewContractAddress)})
+		}
+	}
+	return
+}
+
 type Database struct {
 	DefaultIdleInTxSessionTimeout *commonconfig.Duration
 	DefaultLockTimeout            *commonconfig.Duration
@@ -504,6 +556,7 @@ type AuditLogger struct {
 	ForwardToUrl   *commonconfig.URL
 	JsonWrapperKey *string
 	Headers        *[]models.ServiceHeader
+	Persist        *bool
 }
 
 func (p *AuditLogger) SetFrom(f *AuditLogger) {
@@ -519,6 +572,9 @@ func (p *AuditLogger) SetFrom(f *AuditLogger) {
 	if v := f.Headers; v != nil {
 		p.Headers = v
 	}
+	if v := f.Persist; v != nil {
+		p.Persist = v
+	}
 }
 
 // LogLevel replaces dpanic with crit/CRIT
@@ -611,6 +667,7 @@ type WebServer struct {
 	ListenIP                *net.IP
 
 	LDAP      WebServerLDAP      `toml:",omitempty"`
+	OIDC      WebServerOIDC      `toml:",omitempty"`
 	MFA       WebServerMFA       `toml:",omitempty"`
 	RateLimit WebServerRateLimit `toml:",omitempty"`
 	TLS       WebServerTLS       `toml:",omitempty"`
@@ -655,12 +712,18 @@ func (w *WebServer) setFrom(f *WebServer) {
 	}
 
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	w.MFA.setFrom(&f.MFA)
 	w.RateLimit.setFrom(&f.RateLimit)
 	w.TLS.setFrom(&f.TLS)
 }
 
 func (w *WebServer) ValidateConfig() (err error) {
+	// Validate OIDC fields when authentication method is OIDCAuth
+	if *w.AuthenticationMethod == string(sessions.OIDCAuth) {
+		return w.OIDC.validateConfig()
+	}
+
 	// Validate LDAP fields when authentication method is LDAPAuth
 	if *w.AuthenticationMethod != string(sessions.LDAPAuth) {
 		return
@@ -837,6 +900,90 @@ func (w *WebServerLDAP) setFrom(f *WebServerLDAP) {
 	}
 }
 
+type WebServerOIDC struct {
+	IssuerURL      *commonconfig.URL
+	ClientID       *string
+	RedirectURL    *commonconfig.URL
+	Scopes         *[]string
+	EmailClaim     *string
+	GroupsClaim    *string
+	AdminGroup     *string
+	EditGroup      *string
+	RunGroup       *string
+	ReadGroup      *string
+	SessionTimeout *commonconfig.Duration
+	RequestTimeout *commonconfig.Duration
+}
+
+func (w *WebServerOIDC) setFrom(f *WebServerOIDC) {
+	if v := f.IssuerURL; v != nil {
+		w.IssuerURL = v
+	}
+	if v := f.ClientID; v != nil {
+		w.ClientID = v
+	}
+	if v := f.RedirectURL; v != nil {
+		w.RedirectURL = v
+	}
+	if v := f.Scopes; v != nil {
+		w.Scopes = v
+	}
+	if v := f.EmailClaim; v != nil {
+		w.EmailClaim = v
+	}
+	if v := f.GroupsClaim; v != nil {
+		w.GroupsClaim = v
+	}
+	if v := f.AdminGroup; v != nil {
+		w.AdminGroup = v
+	}
+	if v := f.EditGroup; v != nil {
+		w.EditGroup = v
+	}
+	if v := f.RunGroup; v != nil {
+		w.RunGroup = v
+	}
+	if v := f.ReadGroup; v != nil {
+		w.ReadGroup = v
+	}
+	if v := f.SessionTimeout; v != nil {
+		w.SessionTimeout = v
+	}
+	if v := f.RequestTimeout; v != nil {
+		w.RequestTimeout = v
+	}
+}
+
+func (w *WebServerOIDC) validateConfig() (err error) {
+	if w.IssuerURL == nil || w.IssuerURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.IssuerURL", Msg: "required when AuthenticationMethod is oidc"})
+	}
+	if w.ClientID == nil || *w.ClientID == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ClientID", Msg: "required when AuthenticationMethod is oidc"})
+	}
+	if w.RedirectURL == nil || w.RedirectURL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.RedirectURL", Msg: "required when AuthenticationMethod is oidc"})
+	}
+	if w.Scopes == nil {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.Scopes", Msg: "must include openid"})
+	} else if !slices.Contains(*w.Scopes, "openid") {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "OIDC.Scopes", Value: *w.Scopes, Msg: "must include openid"})
+	}
+	if w.AdminGroup == nil || *w.AdminGroup == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.AdminGroup", Msg: "OIDC AdminGroup can not be empty"})
+	}
+	if w.EditGroup == nil || *w.EditGroup == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.EditGroup", Msg: "OIDC EditGroup can not be empty"})
+	}
+	if w.RunGroup == nil || *w.RunGroup == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.RunGroup", Msg: "OIDC RunGroup can not be empty"})
+	}
+	if w.ReadGroup == nil || *w.ReadGroup == "" {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "OIDC.ReadGroup", Msg: "OIDC ReadGroup can not be empty"})
+	}
+	return err
+}
+
 type WebServerLDAPSecrets struct {
 	ServerAddress     *models.SecretURL
 	ReadOnlyUserLogin *models.Secret
@@ -855,12 +1002,24 @@ func (w *WebServerLDAPSecrets) setFrom(f *WebServerLDAPSecrets) {
 	}
 }
 
+type WebServerOIDCSecrets struct {
+	ClientSecret *models.Secret
+}
+
+func (w *WebServerOIDCSecrets) setFrom(f *WebServerOIDCSecrets) {
+	if v := f.ClientSecret; v != nil {
+		w.ClientSecret = v
+	}
+}
+
 type WebServerSecrets struct {
 	LDAP WebServerLDAPSecrets `toml:",omitempty"`
+	OIDC WebServerOIDCSecrets `toml:",omitempty"`
 }
 
 func (w *WebServerSecrets) SetFrom(f *WebServerSecrets) error {
 	w.LDAP.setFrom(&f.LDAP)
+	w.OIDC.setFrom(&f.OIDC)
 	return nil
 }
 
@@ -1740,6 +1899,37 @@ func (b *Telemetry) ValidateConfig() (err error) {
 	return err
 }
 
+type ExternalSigner struct {
+	Enabled *bool
+	URL     *commonconfig.URL
+	Timeout *commonconfig.Duration
+}
+
+func (e *ExternalSigner) setFrom(f *ExternalSigner) {
+	if v := f.Enabled; v != nil {
+		e.Enabled = v
+	}
+	if v := f.URL; v != nil {
+		e.URL = v
+	}
+	if v := f.Timeout; v != nil {
+		e.Timeout = v
+	}
+}
+
+func (e *ExternalSigner) ValidateConfig() (err error) {
+	if e.Enabled == nil || !*e.Enabled {
+		return nil
+	}
+	if e.URL == nil || e.URL.IsZero() {
+		err = multierr.Append(err, configutils.ErrMissing{Name: "URL", Msg: "must be set when ExternalSigner is enabled"})
+	}
+	if e.Timeout != nil && e.Timeout.Duration() <= 0 {
+		err = multierr.Append(err, configutils.ErrInvalid{Name: "Timeout", Value: e.Timeout.String(), Msg: "must be positive"})
+	}
+	return err
+}
+
 var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9-]+(\.[a-zA-Z0-9-]+)*$`)
 
 // Validates uri is valid external or local URI
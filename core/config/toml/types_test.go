@@ -579,3 +579,61 @@ func TestMercuryTLS_ValidateTLSCertPath(t *testing.T) {
 
 // ptr is a utility function for converting a value to a pointer to the value.
 func ptr[T any](t T) *T { return &t }
+
+func TestWebServer_ValidateConfig_OIDC(t *testing.T) {
+	valid := func() *WebServer {
+		return &WebServer{
+			AuthenticationMethod: ptr("oidc"),
+			OIDC: WebServerOIDC{
+				IssuerURL:   commonconfig.MustParseURL("https://accounts.example.com"),
+				ClientID:    ptr("chainlink-node"),
+				RedirectURL: commonconfig.MustParseURL("https://node.example.com/oidc/callback"),
+				Scopes:      &[]string{"openid", "email"},
+				AdminGroup:  ptr("NodeAdmins"),
+				EditGroup:   ptr("NodeEditors"),
+				RunGroup:    ptr("NodeRunners"),
+				ReadGroup:   ptr("NodeReadOnly"),
+			},
+		}
+	}
+	assert.NoError(t, valid().ValidateConfig())
+
+	w := valid()
+	w.OIDC.IssuerURL = new(commonconfig.URL)
+	w.OIDC.ClientID = ptr("")
+	w.OIDC.Scopes = &[]string{"email"}
+	w.OIDC.ReadGroup = ptr("")
+	err := w.ValidateConfig()
+	assert.ErrorContains(t, err, "OIDC.IssuerURL: missing: required when AuthenticationMethod is oidc")
+	assert.ErrorContains(t, err, "OIDC.ClientID: missing: required when AuthenticationMethod is oidc")
+	assert.ErrorContains(t, err, "OIDC.Scopes: invalid value ([email]): must include openid")
+	assert.ErrorContains(t, err, "OIDC.ReadGroup: missing: OIDC ReadGroup can not be empty")
+	assert.NotContains(t, err.Error(), "OIDC.RedirectURL")
+
+	// OIDC fields are not required for other authentication methods
+	w.AuthenticationMethod = ptr("local")
+	assert.NoError(t, w.ValidateConfig())
+}
+
+func TestFeedsManager_ValidateConfig(t *testing.T) {
+	policy := func(name, action, condition string) FeedsManagerApprovalPolicy {
+		return FeedsManagerApprovalPolicy{Name: ptr(name), Action: ptr(action), Condition: ptr(condition)}
+	}
+	m := FeedsManager{ApprovalPolicies: []FeedsManagerApprovalPolicy{
+		policy("adapter-urls", "AutoApprove", "OnlyAdapterURLsChanged"),
+		policy("new-contracts", "RequireManual", "NewContractAddress"),
+	}}
+	assert.NoError(t, m.ValidateConfig())
+
+	m.ApprovalPolicies = append(m.ApprovalPolicies,
+		policy("adapter-urls", "Approve", "OnlyPipelineChanged"),
+		FeedsManagerApprovalPolicy{Condition: ptr("AnyChange")},
+	)
+	err := m.ValidateConfig()
+	assert.ErrorContains(t, err, "ApprovalPolicies.2.Name: invalid value (adapter-urls): duplicate policy name")
+	assert.ErrorContains(t, err, "ApprovalPolicies.2.Action: invalid value (Approve): must be one of AutoApprove or RequireManual")
+	assert.ErrorContains(t, err, "ApprovalPolicies.3.Name: missing: required for all policies")
+	assert.ErrorContains(t, err, "ApprovalPolicies.3.Action: missing: required for all policies")
+	assert.ErrorContains(t, err, "ApprovalPolicies.3.Condition: invalid value (AnyChange): must be one of OnlyAdapterURLsChanged, OnlyPipelineChanged or NewContractAddress")
+	assert.NotContains(t, err.Error(), "ApprovalPolicies.2.Condition")
+}
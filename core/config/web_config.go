@@ -55,6 +55,22 @@ type LDAP interface {
 	UpstreamSyncRateLimit() commonconfig.Duration
 }
 
+type OIDC interface {
+	IssuerURL() *url.URL
+	ClientID() string
+	ClientSecret() string
+	RedirectURL() *url.URL
+	Scopes() []string
+	EmailClaim() string
+	GroupsClaim() string
+	AdminGroup() string
+	EditGroup() string
+	RunGroup() string
+	ReadGroup() string
+	SessionTimeout() commonconfig.Duration
+	RequestTimeout() time.Duration
+}
+
 type WebServer interface {
 	AuthenticationMethod() string
 	AllowOrigins() string
@@ -74,4 +90,5 @@ type WebServer interface {
 	RateLimit() RateLimit
 	MFA() MFA
 	LDAP() LDAP
+	OIDC() OIDC
 }
@@ -29,6 +29,10 @@ import (
 
 	pipeline "github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 
+	apitokens "github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+
+	rbac "github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+
 	plugins "github.com/smartcontractkit/chainlink/v2/plugins"
 
 	services "github.com/smartcontractkit/chainlink/v2/core/services"
@@ -37,10 +41,14 @@ import (
 
 	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+
 	txmgr "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 
 	types "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
+
 	uuid "github.com/google/uuid"
 
 	webhook "github.com/smartcontractkit/chainlink/v2/core/services/webhook"
@@ -61,6 +69,53 @@ func (_m *Application) EXPECT() *Application_Expecter {
 	return &Application_Expecter{mock: &_m.Mock}
 }
 
+// APITokenORM provides a mock function with given fields:
+func (_m *Application) APITokenORM() apitokens.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for APITokenORM")
+	}
+
+	var r0 apitokens.ORM
+	if rf, ok := ret.Get(0).(func() apitokens.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apitokens.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_APITokenORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'APITokenORM'
+type Application_APITokenORM_Call struct {
+	*mock.Call
+}
+
+// APITokenORM is a helper method to define mock.On call
+func (_e *Application_Expecter) APITokenORM() *Application_APITokenORM_Call {
+	return &Application_APITokenORM_Call{Call: _e.mock.On("APITokenORM")}
+}
+
+func (_c *Application_APITokenORM_Call) Run(run func()) *Application_APITokenORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_APITokenORM_Call) Return(_a0 apitokens.ORM) *Application_APITokenORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_APITokenORM_Call) RunAndReturn(run func() apitokens.ORM) *Application_APITokenORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddJobV2 provides a mock function with given fields: ctx, _a1
 func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	ret := _m.Called(ctx, _a1)
@@ -108,6 +163,53 @@ func (_c *Application_AddJobV2_Call) RunAndReturn(run func(context.Context, *job
 	return _c
 }
 
+// AuditLogORM provides a mock function with given fields:
+func (_m *Application) AuditLogORM() audit.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for AuditLogORM")
+	}
+
+	var r0 audit.ORM
+	if rf, ok := ret.Get(0).(func() audit.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_AuditLogORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuditLogORM'
+type Application_AuditLogORM_Call struct {
+	*mock.Call
+}
+
+// AuditLogORM is a helper method to define mock.On call
+func (_e *Application_Expecter) AuditLogORM() *Application_AuditLogORM_Call {
+	return &Application_AuditLogORM_Call{Call: _e.mock.On("AuditLogORM")}
+}
+
+func (_c *Application_AuditLogORM_Call) Run(run func()) *Application_AuditLogORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_AuditLogORM_Call) Return(_a0 audit.ORM) *Application_AuditLogORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_AuditLogORM_Call) RunAndReturn(run func() audit.ORM) *Application_AuditLogORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AuthenticationProvider provides a mock function with given fields:
 func (_m *Application) AuthenticationProvider() sessions.AuthenticationProvider {
 	ret := _m.Called()
@@ -249,6 +351,55 @@ func (_c *Application_BridgeORM_Call) RunAndReturn(run func() bridges.ORM) *Appl
 	return _c
 }
 
+// ChangeKeystorePassword provides a mock function with given fields: ctx, oldPassword, newPassword, scryptParams
+func (_m *Application) ChangeKeystorePassword(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams) error {
+	ret := _m.Called(ctx, oldPassword, newPassword, scryptParams)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeKeystorePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword, scryptParams)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Application_ChangeKeystorePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeKeystorePassword'
+type Application_ChangeKeystorePassword_Call struct {
+	*mock.Call
+}
+
+// ChangeKeystorePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+func (_e *Application_Expecter) ChangeKeystorePassword(ctx interface{}, oldPassword interface{}, newPassword interface{}, scryptParams interface{}) *Application_ChangeKeystorePassword_Call {
+	return &Application_ChangeKeystorePassword_Call{Call: _e.mock.On("ChangeKeystorePassword", ctx, oldPassword, newPassword, scryptParams)}
+}
+
+func (_c *Application_ChangeKeystorePassword_Call) Run(run func(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams)) *Application_ChangeKeystorePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams))
+	})
+	return _c
+}
+
+func (_c *Application_ChangeKeystorePassword_Call) Return(_a0 error) *Application_ChangeKeystorePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_ChangeKeystorePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams) error) *Application_ChangeKeystorePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteJob provides a mock function with given fields: ctx, jobID
 func (_m *Application) DeleteJob(ctx context.Context, jobID int32) error {
 	ret := _m.Called(ctx, jobID)
@@ -1200,6 +1351,53 @@ func (_c *Application_PipelineORM_Call) RunAndReturn(run func() pipeline.ORM) *A
 	return _c
 }
 
+// PipelineRunner provides a mock function with given fields:
+func (_m *Application) PipelineRunner() pipeline.Runner {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for PipelineRunner")
+	}
+
+	var r0 pipeline.Runner
+	if rf, ok := ret.Get(0).(func() pipeline.Runner); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(pipeline.Runner)
+		}
+	}
+
+	return r0
+}
+
+// Application_PipelineRunner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PipelineRunner'
+type Application_PipelineRunner_Call struct {
+	*mock.Call
+}
+
+// PipelineRunner is a helper method to define mock.On call
+func (_e *Application_Expecter) PipelineRunner() *Application_PipelineRunner_Call {
+	return &Application_PipelineRunner_Call{Call: _e.mock.On("PipelineRunner")}
+}
+
+func (_c *Application_PipelineRunner_Call) Run(run func()) *Application_PipelineRunner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_PipelineRunner_Call) Return(_a0 pipeline.Runner) *Application_PipelineRunner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_PipelineRunner_Call) RunAndReturn(run func() pipeline.Runner) *Application_PipelineRunner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ReplayFromBlock provides a mock function with given fields: chainID, number, forceBroadcast
 func (_m *Application) ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error {
 	ret := _m.Called(chainID, number, forceBroadcast)
@@ -1296,6 +1494,53 @@ func (_c *Application_ResumeJobV2_Call) RunAndReturn(run func(context.Context, u
 	return _c
 }
 
+// RoleORM provides a mock function with given fields:
+func (_m *Application) RoleORM() rbac.ORM {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for RoleORM")
+	}
+
+	var r0 rbac.ORM
+	if rf, ok := ret.Get(0).(func() rbac.ORM); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(rbac.ORM)
+		}
+	}
+
+	return r0
+}
+
+// Application_RoleORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RoleORM'
+type Application_RoleORM_Call struct {
+	*mock.Call
+}
+
+// RoleORM is a helper method to define mock.On call
+func (_e *Application_Expecter) RoleORM() *Application_RoleORM_Call {
+	return &Application_RoleORM_Call{Call: _e.mock.On("RoleORM")}
+}
+
+func (_c *Application_RoleORM_Call) Run(run func()) *Application_RoleORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_RoleORM_Call) Return(_a0 rbac.ORM) *Application_RoleORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_RoleORM_Call) RunAndReturn(run func() rbac.ORM) *Application_RoleORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunJobV2 provides a mock function with given fields: ctx, jobID, meta
 func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error) {
 	ret := _m.Called(ctx, jobID, meta)
@@ -1676,6 +1921,53 @@ func (_c *Application_WakeSessionReaper_Call) RunAndReturn(run func()) *Applicat
 	return _c
 }
 
+// WorkflowORM provides a mock function with given fields:
+func (_m *Application) WorkflowORM() store.Store {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for WorkflowORM")
+	}
+
+	var r0 store.Store
+	if rf, ok := ret.Get(0).(func() store.Store); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(store.Store)
+		}
+	}
+
+	return r0
+}
+
+// Application_WorkflowORM_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WorkflowORM'
+type Application_WorkflowORM_Call struct {
+	*mock.Call
+}
+
+// WorkflowORM is a helper method to define mock.On call
+func (_e *Application_Expecter) WorkflowORM() *Application_WorkflowORM_Call {
+	return &Application_WorkflowORM_Call{Call: _e.mock.On("WorkflowORM")}
+}
+
+func (_c *Application_WorkflowORM_Call) Run(run func()) *Application_WorkflowORM_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) Return(_a0 store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Application_WorkflowORM_Call) RunAndReturn(run func() store.Store) *Application_WorkflowORM_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // NewApplication creates a new instance of Application. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewApplication(t interface {
@@ -11,10 +11,12 @@ import (
 	"net/http"
 	"net/url"
 	"os"
+	"sync"
 	"time"
 
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink-common/pkg/services"
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -23,6 +25,9 @@ import (
 const bufferCapacity = 2048
 const webRequestTimeout = 10
 
+// storeTimeout bounds the time to store each event, and the remaining events when the logger is closed.
+const storeTimeout = 5 * time.Second
+
 type Data = map[string]any
 
 type AuditLogger interface {
@@ -45,10 +50,12 @@ type AuditLoggerService struct {
 	hostname        string                   // The self-reported hostname of the machine
 	localIP         string                   // A non-loopback IP address as reported by the machine
 	loggingClient   HTTPAuditLoggerInterface // Abstract type for sending logs onward
+	orm             ORM                      // The local audit log, if logs are persisted
 
 	loggingChannel chan wrappedAuditLog
+	storeChannel   chan wrappedAuditLog
 	chStop         services.StopChan
-	chDone         chan struct{}
+	wg             sync.WaitGroup
 }
 
 type wrappedAuditLog struct {
@@ -63,7 +70,11 @@ var NoopLogger AuditLogger = &AuditLoggerService{}
 // Parses and validates the AUDIT_LOGS_* environment values and returns an enabled
 // AuditLogger instance. If the environment variables are not set, the logger
 // is disabled and short circuits execution via enabled flag.
-func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogger, error) {
+//
+// If the config persists logs, events are also appended to the local audit log
+// in ds. Events are stored and forwarded independently, so that they are
+// stored while the HTTP log service is unavailable.
+func NewAuditLogger(logger logger.Logger, config config.AuditLogger, ds sqlutil.DataSource) (AuditLogger, error) {
 	// If the unverified config is nil, then we assume this came from the
 	// configuration system and return a nil logger.
 	if config == nil || !config.Enabled() {
@@ -85,7 +96,10 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		return &AuditLoggerService{}, nil
 	}
 
-	loggingChannel := make(chan wrappedAuditLog, bufferCapacity)
+	var orm ORM
+	if config.Persist() && ds != nil {
+		orm = NewORM(ds)
+	}
 
 	// Create new AuditLoggerService
 	auditLogger := AuditLoggerService{
@@ -98,10 +112,11 @@ func NewAuditLogger(logger logger.Logger, config config.AuditLogger) (AuditLogge
 		hostname:        hostname,
 		localIP:         getLocalIP(),
 		loggingClient:   &http.Client{Timeout: time.Second * webRequestTimeout},
+		orm:             orm,
 
-		loggingChannel: loggingChannel,
+		loggingChannel: make(chan wrappedAuditLog, bufferCapacity),
+		storeChannel:   make(chan wrappedAuditLog, bufferCapacity),
 		chStop:         make(chan struct{}),
-		chDone:         make(chan struct{}),
 	}
 
 	return &auditLogger, nil
@@ -126,11 +141,25 @@ func (l *AuditLoggerService) Audit(eventID EventID, data Data) {
 		data:    data,
 	}
 
-	select {
-	case l.loggingChannel <- wrappedLog:
-	default:
-		l.logger.Errorf("buffer is full. Dropping log with eventID: %s", eventID)
+	if l.forwarding() {
+		select {
+		case l.loggingChannel <- wrappedLog:
+		default:
+			l.logger.Errorf("buffer is full. Dropping log with eventID: %s", eventID)
+		}
 	}
+	if l.orm != nil {
+		select {
+		case l.storeChannel <- wrappedLog:
+		default:
+			l.logger.Errorf("store buffer is full. Dropping log with eventID: %s", eventID)
+		}
+	}
+}
+
+// forwarding returns true if logs are forwarded to an HTTP log service.
+func (l *AuditLoggerService) forwarding() bool {
+	return (*url.URL)(&l.forwardToUrl).String() != ""
 }
 
 // Start the audit logger and begin processing logs on the channel
@@ -139,7 +168,14 @@ func (l *AuditLoggerService) Start(context.Context) error {
 		return errors.New("The audit logger is not enabled")
 	}
 
-	go l.runLoop()
+	if l.forwarding() {
+		l.wg.Add(1)
+		go l.runLoop()
+	}
+	if l.orm != nil {
+		l.wg.Add(1)
+		go l.storeLoop()
+	}
 	return nil
 }
 
@@ -151,7 +187,7 @@ func (l *AuditLoggerService) Close() error {
 
 	l.logger.Warnf("Disabled the audit logger service")
 	close(l.chStop)
-	<-l.chDone
+	l.wg.Wait()
 
 	return nil
 }
@@ -166,6 +202,8 @@ func (l *AuditLoggerService) HealthReport() map[string]error {
 		err = errors.New("the audit logger is not enabled")
 	} else if len(l.loggingChannel) == bufferCapacity {
 		err = errors.New("buffer is full")
+	} else if len(l.storeChannel) == bufferCapacity {
+		err = errors.New("store buffer is full")
 	}
 	return map[string]error{l.Name(): err}
 }
@@ -183,7 +221,7 @@ func (l *AuditLoggerService) Ready() error {
 //
 // This function calls postLogToLogService which blocks.
 func (l *AuditLoggerService) runLoop() {
-	defer close(l.chDone)
+	defer l.wg.Done()
 
 	for {
 		select {
@@ -196,6 +234,41 @@ func (l *AuditLoggerService) runLoop() {
 	}
 }
 
+// Entrypoint for our log storing goroutine. This waits on the channel and appends
+// logs to the local audit log as they come in. Logs which are still buffered when
+// the logger is closed are stored before it returns, so that they are not lost.
+func (l *AuditLoggerService) storeLoop() {
+	defer l.wg.Done()
+
+	for {
+		select {
+		case <-l.chStop:
+			for {
+				select {
+				case event := <-l.storeChannel:
+					l.storeLog(event.eventID, event.data)
+				default:
+					return
+				}
+			}
+		case event := <-l.storeChannel:
+			l.storeLog(event.eventID, event.data)
+		}
+	}
+}
+
+// storeLog appends an event to the local audit log. The chain of records is
+// serialized by the database, so this blocks until the record is stored.
+func (l *AuditLoggerService) storeLog(eventID EventID, data Data) {
+	// Not derived from chStop, so that the logs remaining on close are stored.
+	ctx, cancel := context.WithTimeout(context.Background(), storeTimeout)
+	defer cancel()
+
+	if _, err := l.orm.AppendRecord(ctx, eventID, data); err != nil {
+		l.logger.Errorw("failed to store audit log", "err", err, "eventID", eventID, "data", data)
+	}
+}
+
 // Takes an EventID and associated data and sends it to the configured logging
 // endpoint. This function blocks on the send by timesout after a period of
 // several seconds. This helps us prevent getting stuck on a single log
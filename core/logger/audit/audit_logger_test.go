@@ -2,6 +2,7 @@ package audit_test
 
 import (
 	"encoding/json"
+	"errors"
 	"flag"
 	"io"
 	"net/http"
@@ -15,6 +16,7 @@ import (
 	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
@@ -82,6 +84,24 @@ func (c Config) JsonWrapperKey() string {
 	return ""
 }
 
+func (c Config) Persist() bool {
+	return false
+}
+
+type PersistConfig struct {
+	Config
+}
+
+func (c PersistConfig) Persist() bool {
+	return true
+}
+
+type UnavailableHTTPClient struct{}
+
+func (UnavailableHTTPClient) Do(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
+
 func TestCheckLoginAuditLog(t *testing.T) {
 	t.Parallel()
 
@@ -100,7 +120,7 @@ func TestCheckLoginAuditLog(t *testing.T) {
 	auditLoggerTestConfig := Config{}
 
 	// Create new AuditLoggerService
-	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig)
+	auditLogger, err := audit.NewAuditLogger(logger.Named("AuditLogger"), &auditLoggerTestConfig, nil)
 	assert.NoError(t, err)
 
 	// Cast to concrete type so we can swap out the internals
@@ -144,3 +164,25 @@ func TestCheckLoginAuditLog(t *testing.T) {
 
 	assert.True(t, false)
 }
+
+func TestAuditLogger_PersistsWhileForwarderUnavailable(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+
+	auditLogger, err := audit.NewAuditLogger(logger.TestLogger(t), PersistConfig{}, db)
+	require.NoError(t, err)
+	auditLogger.(*audit.AuditLoggerService).SetLoggingClient(UnavailableHTTPClient{})
+	require.NoError(t, auditLogger.Start(ctx))
+
+	auditLogger.Audit(audit.AuthLoginFailedEmail, audit.Data{"email": cltest.APIEmailAdmin})
+	auditLogger.Audit(audit.AuthLoginSuccessNo2FA, audit.Data{"email": cltest.APIEmailAdmin})
+	// Buffered logs are stored when the logger is closed.
+	require.NoError(t, auditLogger.Close())
+
+	records, count, err := audit.NewORM(db).ListRecords(ctx, audit.RecordsFilter{UserEmail: cltest.APIEmailAdmin}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	assert.Equal(t, audit.AuthLoginSuccessNo2FA, records[0].EventID)
+	assert.Equal(t, audit.AuthLoginFailedEmail, records[1].EventID)
+}
@@ -19,6 +19,15 @@ const (
 	APITokenCreated                       EventID = "API_TOKEN_CREATED"
 	APITokenDeleteAttemptPasswordMismatch EventID = "API_TOKEN_DELETE_ATTEMPT_PASSWORD_MISMATCH"
 	APITokenDeleted                       EventID = "API_TOKEN_DELETED"
+	APITokenRevoked                       EventID = "API_TOKEN_REVOKED"
+	APITokenUsed                          EventID = "API_TOKEN_USED"
+	APITokenRejected                      EventID = "API_TOKEN_REJECTED"
+
+	RoleCreated    EventID = "ROLE_CREATED"
+	RoleUpdated    EventID = "ROLE_UPDATED"
+	RoleDeleted    EventID = "ROLE_DELETED"
+	RoleAssigned   EventID = "ROLE_ASSIGNED"
+	RoleUnassigned EventID = "ROLE_UNASSIGNED"
 
 	FeedsManCreated EventID = "FEEDS_MAN_CREATED"
 	FeedsManUpdated EventID = "FEEDS_MAN_UPDATED"
@@ -51,7 +60,11 @@ const (
 	KeyExported EventID = "KEY_EXPORTED"
 	KeyDeleted  EventID = "KEY_DELETED"
 
+	KeystorePasswordChangeAttemptFailedMismatch EventID = "KEYSTORE_PASSWORD_CHANGE_ATTEMPT_FAILED_MISMATCH"
+	KeystorePasswordChanged                     EventID = "KEYSTORE_PASSWORD_CHANGED"
+
 	EthTransactionCreated    EventID = "ETH_TRANSACTION_CREATED"
+	EthTransactionCancelled  EventID = "ETH_TRANSACTION_CANCELLED"
 	CosmosTransactionCreated EventID = "COSMOS_TRANSACTION_CREATED"
 	SolanaTransactionCreated EventID = "SOLANA_TRANSACTION_CREATED"
 
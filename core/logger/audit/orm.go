@@ -0,0 +1,127 @@
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// verifyBatchSize is the number of records read at once to verify the chain.
+const verifyBatchSize = 1000
+
+// ORM stores the local audit log.
+type ORM interface {
+	// AppendRecord appends an event to the log, chaining it to the last record.
+	AppendRecord(ctx context.Context, eventID EventID, data Data) (Record, error)
+	// ListRecords returns a page of the records matching the filter, most recent first, and the total number of
+	// records matching the filter.
+	ListRecords(ctx context.Context, filter RecordsFilter, offset, limit int) ([]Record, int, error)
+	// Verify verifies the hash chain of the whole log.
+	Verify(ctx context.Context) (VerifyResult, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+func (o *orm) withDataSource(ds sqlutil.DataSource) *orm {
+	return &orm{ds: ds}
+}
+
+func (o *orm) AppendRecord(ctx context.Context, eventID EventID, data Data) (record Record, err error) {
+	err = sqlutil.Transact(ctx, o.withDataSource, o.ds, nil, func(tx *orm) error {
+		// The lock conflicts with itself, so that appends are serialized and each chains to the last record, without
+		// blocking readers.
+		if _, err = tx.ds.ExecContext(ctx, `LOCK TABLE audit_log_records IN SHARE ROW EXCLUSIVE MODE`); err != nil {
+			return fmt.Errorf("failed to lock audit log: %w", err)
+		}
+		var last Record
+		err = tx.ds.GetContext(ctx, &last, `SELECT * FROM audit_log_records ORDER BY id DESC LIMIT 1`)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("failed to find last audit log record: %w", err)
+		}
+		record, err = NewRecord(last, eventID, data, time.Now())
+		if err != nil {
+			return err
+		}
+		stmt := `INSERT INTO audit_log_records (id, event_id, user_email, data, created_at, prev_hash, hash)
+			VALUES (:id, :event_id, :user_email, :data, :created_at, :prev_hash, :hash)`
+		if _, err = tx.ds.NamedExecContext(ctx, stmt, record); err != nil {
+			return fmt.Errorf("failed to append audit log record: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+func (o *orm) ListRecords(ctx context.Context, filter RecordsFilter, offset, limit int) ([]Record, int, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if filter.UserEmail != "" {
+		args = append(args, filter.UserEmail)
+		where = append(where, fmt.Sprintf("lower(user_email) = lower($%d)", len(args)))
+	}
+	if filter.EventID != "" {
+		args = append(args, filter.EventID)
+		where = append(where, fmt.Sprintf("event_id = $%d", len(args)))
+	}
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		where = append(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	conditions := "TRUE"
+	if len(where) > 0 {
+		conditions = strings.Join(where, " AND ")
+	}
+
+	var count int
+	if err := o.ds.GetContext(ctx, &count, `SELECT count(*) FROM audit_log_records WHERE `+conditions, args...); err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit log records: %w", err)
+	}
+
+	var records []Record
+	stmt := fmt.Sprintf(`SELECT * FROM audit_log_records WHERE %s ORDER BY id DESC LIMIT $%d OFFSET $%d`,
+		conditions, len(args)+1, len(args)+2)
+	if err := o.ds.SelectContext(ctx, &records, stmt, append(args, limit, offset)...); err != nil {
+		return nil, 0, fmt.Errorf("failed to list audit log records: %w", err)
+	}
+	return records, count, nil
+}
+
+// Verify reads the log in batches, in the order of the chain, and stops at the first tampered record.
+func (o *orm) Verify(ctx context.Context) (VerifyResult, error) {
+	var head Record
+	for {
+		var records []Record
+		stmt := `SELECT * FROM audit_log_records WHERE id > $1 ORDER BY id ASC LIMIT $2`
+		if err := o.ds.SelectContext(ctx, &records, stmt, head.ID, verifyBatchSize); err != nil {
+			return VerifyResult{}, fmt.Errorf("failed to read audit log records: %w", err)
+		}
+		next, err := VerifyChain(head, records)
+		head = next
+		var tampered *TamperError
+		if errors.As(err, &tampered) {
+			return VerifyResult{Records: head.ID, HeadID: head.ID, HeadHash: head.Hash, Tampered: tampered}, nil
+		}
+		if len(records) < verifyBatchSize {
+			return VerifyResult{Records: head.ID, HeadID: head.ID, HeadHash: head.Hash}, nil
+		}
+	}
+}
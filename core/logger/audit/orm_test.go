@@ -0,0 +1,98 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func TestORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	result, err := orm.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Zero(t, result.Records)
+
+	start := time.Now()
+	first, err := orm.AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "Admin@example.com"})
+	require.NoError(t, err)
+	second, err := orm.AppendRecord(ctx, audit.RoleAssigned, audit.Data{"user": "other@example.com", "role": "operator"})
+	require.NoError(t, err)
+	third, err := orm.AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "admin@example.com"})
+	require.NoError(t, err)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.Equal(t, second.Hash, third.PrevHash)
+
+	records, count, err := orm.ListRecords(ctx, audit.RecordsFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, records, 2)
+	assert.Equal(t, third.ID, records[0].ID)
+	assert.Equal(t, third.Hash, records[0].Hash)
+	assert.JSONEq(t, third.Data.String(), records[0].Data.String())
+
+	records, count, err = orm.ListRecords(ctx, audit.RecordsFilter{UserEmail: "admin@example.com", EventID: audit.AuthLoginFailedEmail}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.Len(t, records, 2)
+
+	after := start.Add(-time.Minute)
+	before := start.Add(time.Minute)
+	_, count, err = orm.ListRecords(ctx, audit.RecordsFilter{CreatedAfter: &after, CreatedBefore: &before}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	_, count, err = orm.ListRecords(ctx, audit.RecordsFilter{CreatedAfter: &before}, 0, 10)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	result, err = orm.Verify(ctx)
+	require.NoError(t, err)
+	assert.True(t, result.Valid())
+	assert.Equal(t, int64(3), result.Records)
+	assert.Equal(t, third.Hash, result.HeadHash)
+
+	// Tampering requires disabling the triggers which make the table append-only.
+	_, err = db.ExecContext(ctx, `ALTER TABLE audit_log_records DISABLE TRIGGER USER`)
+	require.NoError(t, err)
+	_, err = db.ExecContext(ctx, `UPDATE audit_log_records SET data = '{"email":"admin@example.com","role":"admin"}' WHERE id = 2`)
+	require.NoError(t, err)
+
+	result, err = orm.Verify(ctx)
+	require.NoError(t, err)
+	require.False(t, result.Valid())
+	assert.Equal(t, int64(2), result.Tampered.ID)
+	assert.Equal(t, int64(1), result.Records)
+	assert.Equal(t, first.Hash, result.HeadHash)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM audit_log_records WHERE id = 2`)
+	require.NoError(t, err)
+
+	result, err = orm.Verify(ctx)
+	require.NoError(t, err)
+	require.False(t, result.Valid())
+	assert.Equal(t, int64(2), result.Tampered.ID)
+	assert.Contains(t, result.Tampered.Reason, "missing")
+}
+
+func TestORM_AppendOnly(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db := pgtest.NewSqlxDB(t)
+	orm := audit.NewORM(db)
+
+	_, err := orm.AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "admin@example.com"})
+	require.NoError(t, err)
+
+	_, err = db.ExecContext(ctx, `DELETE FROM audit_log_records`)
+	require.ErrorContains(t, err, "append-only")
+}
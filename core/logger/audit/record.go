@@ -0,0 +1,130 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// Record is an audit event persisted in the local audit log. Records form a hash chain: the hash of each record covers
+// its fields and the hash of the previous record, so that modifying, removing or reordering records breaks the chain.
+type Record struct {
+	// ID is the position of the record in the chain, starting at 1 without gaps.
+	ID      int64
+	EventID EventID
+	// UserEmail is the user the event is about, if any, for filtering.
+	UserEmail string
+	Data      sqlutil.JSON
+	CreatedAt time.Time
+	// PrevHash is the hash of the previous record, which is empty for the first record.
+	PrevHash string
+	Hash     string
+}
+
+// NewRecord returns the record of an event which follows prev, the zero Record for the first record of the log.
+func NewRecord(prev Record, eventID EventID, data Data, now time.Time) (Record, error) {
+	if data == nil {
+		data = Data{}
+	}
+	b, err := json.Marshal(data)
+	if err != nil {
+		return Record{}, fmt.Errorf("failed to serialize audit event %s: %w", eventID, err)
+	}
+	r := Record{
+		ID:        prev.ID + 1,
+		EventID:   eventID,
+		UserEmail: userEmail(data),
+		Data:      b,
+		// Postgres stores microseconds, so the time is truncated to hash the value which is read back.
+		CreatedAt: now.UTC().Truncate(time.Microsecond),
+		PrevHash:  prev.Hash,
+	}
+	r.Hash = r.ComputeHash()
+	return r, nil
+}
+
+// userEmail returns the user of the event, which events name by "user" or "email".
+func userEmail(data Data) string {
+	for _, key := range []string{"user", "email"} {
+		if email, ok := data[key].(string); ok {
+			return email
+		}
+	}
+	return ""
+}
+
+// ComputeHash returns the hash of the record, which is the hex encoded SHA-256 of its fields and the previous hash.
+// Each field is prefixed with its length, so that no two records hash the same fields.
+func (r Record) ComputeHash() string {
+	h := sha256.New()
+	writeField(h, []byte(r.PrevHash))
+	writeField(h, binary.BigEndian.AppendUint64(nil, uint64(r.ID)))
+	writeField(h, []byte(r.EventID))
+	writeField(h, []byte(r.UserEmail))
+	writeField(h, binary.BigEndian.AppendUint64(nil, uint64(r.CreatedAt.UnixMicro())))
+	writeField(h, r.Data)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeField(h hash.Hash, b []byte) {
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(b))))
+	h.Write(b)
+}
+
+// TamperError reports the first record of the log which breaks the hash chain.
+type TamperError struct {
+	ID     int64
+	Reason string
+}
+
+func (e *TamperError) Error() string {
+	return fmt.Sprintf("audit log record %d has been tampered with: %s", e.ID, e.Reason)
+}
+
+// VerifyChain verifies that records, ordered by ID, continue the chain after head, the zero Record for the start of
+// the log. It returns the last record, which is the head to verify the following records with, or a TamperError.
+func VerifyChain(head Record, records []Record) (Record, error) {
+	for _, r := range records {
+		switch {
+		case r.ID != head.ID+1:
+			return head, &TamperError{ID: head.ID + 1, Reason: fmt.Sprintf("record is missing, next record is %d", r.ID)}
+		case r.PrevHash != head.Hash:
+			return head, &TamperError{ID: r.ID, Reason: "previous hash does not match the previous record"}
+		case r.Hash != r.ComputeHash():
+			return head, &TamperError{ID: r.ID, Reason: "hash does not match the contents of the record"}
+		}
+		head = r
+	}
+	return head, nil
+}
+
+// RecordsFilter filters audit log records. Empty fields match all records.
+type RecordsFilter struct {
+	UserEmail     string
+	EventID       EventID
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+// VerifyResult is the result of verifying the hash chain of the audit log.
+type VerifyResult struct {
+	// Records is the number of records verified, up to the first tampered record.
+	Records int64
+	// HeadID and HeadHash identify the last verified record. Operators may keep the head to detect the removal of the
+	// records which follow it, which the chain alone can not detect.
+	HeadID   int64
+	HeadHash string
+	// Tampered is the first record which breaks the chain, if any.
+	Tampered *TamperError
+}
+
+// Valid returns true if no record breaks the chain.
+func (r VerifyResult) Valid() bool {
+	return r.Tampered == nil
+}
@@ -0,0 +1,89 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+func newChain(t *testing.T, n int) []audit.Record {
+	var (
+		records []audit.Record
+		prev    audit.Record
+	)
+	for i := 0; i < n; i++ {
+		r, err := audit.NewRecord(prev, audit.AuthLoginFailedEmail, audit.Data{"email": "user@example.com", "attempt": i}, time.Now())
+		require.NoError(t, err)
+		records = append(records, r)
+		prev = r
+	}
+	return records
+}
+
+func TestNewRecord(t *testing.T) {
+	t.Parallel()
+
+	first, err := audit.NewRecord(audit.Record{}, audit.RoleAssigned, audit.Data{"user": "admin@example.com"}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.ID)
+	assert.Empty(t, first.PrevHash)
+	assert.Equal(t, "admin@example.com", first.UserEmail)
+	assert.Equal(t, first.ComputeHash(), first.Hash)
+
+	second, err := audit.NewRecord(first, audit.GlobalLogLevelSet, nil, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), second.ID)
+	assert.Equal(t, first.Hash, second.PrevHash)
+	assert.Empty(t, second.UserEmail)
+	assert.Equal(t, `{}`, second.Data.String())
+	assert.NotEqual(t, first.Hash, second.Hash)
+}
+
+func TestVerifyChain(t *testing.T) {
+	t.Parallel()
+
+	records := newChain(t, 4)
+	head, err := audit.VerifyChain(audit.Record{}, records[:2])
+	require.NoError(t, err)
+	head, err = audit.VerifyChain(head, records[2:])
+	require.NoError(t, err)
+	assert.Equal(t, records[3], head)
+
+	tests := []struct {
+		name   string
+		tamper func([]audit.Record) []audit.Record
+		wantID int64
+		reason string
+	}{
+		{"modified data", func(rs []audit.Record) []audit.Record {
+			rs[1].Data = []byte(`{"email":"user@example.com","attempt":7}`)
+			return rs
+		}, 2, "hash does not match"},
+		{"modified and rehashed", func(rs []audit.Record) []audit.Record {
+			rs[1].UserEmail = "other@example.com"
+			rs[1].Hash = rs[1].ComputeHash()
+			return rs
+		}, 3, "previous hash does not match"},
+		{"removed", func(rs []audit.Record) []audit.Record {
+			return append(rs[:1], rs[2:]...)
+		}, 2, "record is missing"},
+		{"reordered", func(rs []audit.Record) []audit.Record {
+			rs[1], rs[2] = rs[2], rs[1]
+			return rs
+		}, 2, "record is missing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tampered := tt.tamper(newChain(t, 4))
+			_, err := audit.VerifyChain(audit.Record{}, tampered)
+			var tamperErr *audit.TamperError
+			require.ErrorAs(t, err, &tamperErr)
+			assert.Equal(t, tt.wantID, tamperErr.ID)
+			assert.Contains(t, tamperErr.Reason, tt.reason)
+		})
+	}
+}
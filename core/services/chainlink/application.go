@@ -74,9 +74,13 @@ import (
 	workflowstore "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/syncer"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/ldapauth"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
+	clutils "github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 )
 
@@ -104,10 +108,15 @@ type Application interface {
 	JobORM() job.ORM
 	EVMORM() evmtypes.Configs
 	PipelineORM() pipeline.ORM
+	PipelineRunner() pipeline.Runner
 	BridgeORM() bridges.ORM
 	BasicAdminUsersORM() sessions.BasicAdminUsersORM
 	AuthenticationProvider() sessions.AuthenticationProvider
+	RoleORM() rbac.ORM
+	APITokenORM() apitokens.ORM
+	AuditLogORM() audit.ORM
 	TxmStorageService() txmgr.EvmTxStore
+	WorkflowORM() workflowstore.Store
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
 	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta jsonserializable.JSONSerializable) (int64, error)
@@ -122,6 +131,11 @@ type Application interface {
 	// set to true, consumers will reprocess data even if it has already been processed.
 	ReplayFromBlock(chainID *big.Int, number uint64, forceBroadcast bool) error
 
+	// ChangeKeystorePassword re-encrypts the keystore, and the bridge auth configs encrypted with the keystore
+	// password, with newPassword in a single transaction. The keystore is re-encrypted with scryptParams, zero
+	// fields keeping its current values.
+	ChangeKeystorePassword(ctx context.Context, oldPassword, newPassword string, scryptParams clutils.ScryptParams) error
+
 	// ID is unique to this particular application instance
 	ID() uuid.UUID
 
@@ -143,9 +157,14 @@ type ChainlinkApplication struct {
 	pipelineORM              pipeline.ORM
 	pipelineRunner           pipeline.Runner
 	bridgeORM                bridges.ORM
+	bridgeAuthCipher         *bridges.AuthCipher
 	localAdminUsersORM       sessions.BasicAdminUsersORM
 	authenticationProvider   sessions.AuthenticationProvider
+	roleORM                  rbac.ORM
+	apiTokenORM              apitokens.ORM
+	auditLogORM              audit.ORM
 	txmStorageService        txmgr.EvmTxStore
+	workflowORM              workflowstore.Store
 	FeedsService             feeds.Service
 	webhookJobRunner         webhook.JobRunner
 	Config                   GeneralConfig
@@ -405,7 +424,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	localAdminUsersORM := localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 
 	// Initialize Sessions ORM based on environment configured authenticator
-	// localDB auth or remote LDAP auth
+	// localDB auth, remote LDAP auth or OIDC identity provider auth
 	authMethod := cfg.WebServer().AuthenticationMethod()
 	var authenticationProvider sessions.AuthenticationProvider
 	var sessionReaper *utils.SleeperTask
@@ -422,22 +441,32 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		syncer := ldapauth.NewLDAPServerStateSyncer(opts.DS, cfg.WebServer().LDAP(), globalLogger)
 		srvcs = append(srvcs, syncer)
 		sessionReaper = utils.NewSleeperTaskCtx(syncer)
+	case sessions.OIDCAuth:
+		var err error
+		authenticationProvider, err = oidcauth.NewOIDCAuthenticator(
+			context.Background(), opts.DS, cfg.WebServer().OIDC(), localAdminUsersORM, cfg.Insecure().DevWebServer(), globalLogger, auditLogger,
+		)
+		if err != nil {
+			return nil, errors.Wrap(err, "NewApplication: failed to initialize OIDC Authentication module")
+		}
+		sessionReaper = oidcauth.NewSessionReaper(opts.DS, cfg.WebServer(), cfg.WebServer().OIDC(), globalLogger)
 	case sessions.LocalAuth:
 		authenticationProvider = localauth.NewORM(opts.DS, cfg.WebServer().SessionTimeout().Duration(), globalLogger, auditLogger)
 		sessionReaper = localauth.NewSessionReaper(opts.DS, cfg.WebServer(), globalLogger)
 	default:
-		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth)
+		return nil, errors.Errorf("NewApplication: Unexpected 'AuthenticationMethod': %s supported values: %s, %s, %s", authMethod, sessions.LocalAuth, sessions.LDAPAuth, sessions.OIDCAuth)
 	}
 
 	var (
-		pipelineORM    = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
-		bridgeORM      = bridges.NewORM(opts.DS)
-		mercuryORM     = mercury.NewORM(opts.DS)
-		pipelineRunner = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
-		jobORM         = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
-		txmORM         = txmgr.NewTxStore(opts.DS, globalLogger)
-		streamRegistry = streams.NewRegistry(globalLogger, pipelineRunner)
-		workflowORM    = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
+		pipelineORM      = pipeline.NewORM(opts.DS, globalLogger, cfg.JobPipeline().MaxSuccessfulRuns())
+		bridgeAuthCipher = bridges.NewAuthCipher(cfg.Password().Keystore(), clutils.GetScryptParams(cfg))
+		bridgeORM        = bridges.NewORMWithAuthCipher(opts.DS, bridgeAuthCipher)
+		mercuryORM       = mercury.NewORM(opts.DS)
+		pipelineRunner   = pipeline.NewRunner(pipelineORM, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyEVMChains, keyStore.Eth(), keyStore.VRF(), keyStore.CSA(), globalLogger, restrictedHTTPClient, unrestrictedHTTPClient)
+		jobORM           = job.NewORM(opts.DS, pipelineORM, bridgeORM, keyStore, globalLogger)
+		txmORM           = txmgr.NewTxStore(opts.DS, globalLogger)
+		streamRegistry   = streams.NewRegistry(globalLogger, pipelineRunner)
+		workflowORM      = workflowstore.NewDBStore(opts.DS, globalLogger, clockwork.NewRealClock())
 	)
 	srvcs = append(srvcs, workflowORM)
 
@@ -660,6 +689,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			keyStore,
 			cfg,
 			cfg.Feature(),
+			cfg.FeedsManager(),
 			cfg.Insecure(),
 			cfg.JobPipeline(),
 			cfg.OCR(),
@@ -689,9 +719,14 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		pipelineRunner:           pipelineRunner,
 		pipelineORM:              pipelineORM,
 		bridgeORM:                bridgeORM,
+		bridgeAuthCipher:         bridgeAuthCipher,
 		localAdminUsersORM:       localAdminUsersORM,
 		authenticationProvider:   authenticationProvider,
+		roleORM:                  rbac.NewORM(opts.DS),
+		apiTokenORM:              apitokens.NewORM(opts.DS),
+		auditLogORM:              audit.NewORM(opts.DS),
 		txmStorageService:        txmORM,
+		workflowORM:              workflowORM,
 		FeedsService:             feedsService,
 		Config:                   cfg,
 		webhookJobRunner:         webhookJobRunner,
@@ -877,15 +912,35 @@ func (app *ChainlinkApplication) AuthenticationProvider() sessions.Authenticatio
 	return app.authenticationProvider
 }
 
+func (app *ChainlinkApplication) RoleORM() rbac.ORM {
+	return app.roleORM
+}
+
+func (app *ChainlinkApplication) APITokenORM() apitokens.ORM {
+	return app.apiTokenORM
+}
+
+func (app *ChainlinkApplication) AuditLogORM() audit.ORM {
+	return app.auditLogORM
+}
+
 // TODO BCF-2516 remove this all together remove EVM specifics
 func (app *ChainlinkApplication) EVMORM() evmtypes.Configs {
 	return app.GetRelayers().LegacyEVMChains().ChainNodeConfigs()
 }
 
+func (app *ChainlinkApplication) WorkflowORM() workflowstore.Store {
+	return app.workflowORM
+}
+
 func (app *ChainlinkApplication) PipelineORM() pipeline.ORM {
 	return app.pipelineORM
 }
 
+func (app *ChainlinkApplication) PipelineRunner() pipeline.Runner {
+	return app.pipelineRunner
+}
+
 func (app *ChainlinkApplication) TxmStorageService() txmgr.EvmTxStore {
 	return app.txmStorageService
 }
@@ -1015,6 +1070,20 @@ func (app *ChainlinkApplication) ReplayFromBlock(chainID *big.Int, number uint64
 	return nil
 }
 
+// ChangeKeystorePassword implements the Application interface. The bridge auth configs are re-encrypted in the
+// transaction saving the keystore, so that both are rolled back together. The new password only takes effect on
+// success, and bridge auth configs aren't written until it does.
+func (app *ChainlinkApplication) ChangeKeystorePassword(ctx context.Context, oldPassword, newPassword string, scryptParams clutils.ScryptParams) error {
+	// The bridge auth key is derived with the configured scrypt parameters, which aren't stored
+	newCipher := bridges.NewAuthCipher(newPassword, clutils.GetScryptParams(app.Config))
+	reencryptBridges := func(tx sqlutil.DataSource) error {
+		return app.bridgeORM.WithDataSource(tx).ReencryptAuthConfigs(ctx, newCipher)
+	}
+	return app.bridgeAuthCipher.ChangePassword(newPassword, func() error {
+		return app.KeyStore.ChangePassword(ctx, oldPassword, newPassword, scryptParams, reencryptBridges)
+	})
+}
+
 func (app *ChainlinkApplication) GetRelayers() RelayerChainInteroperators {
 	return app.relayers
 }
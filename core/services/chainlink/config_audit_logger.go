@@ -33,3 +33,7 @@ func (a auditLoggerConfig) JsonWrapperKey() string {
 func (a auditLoggerConfig) Headers() (models.ServiceHeaders, error) {
 	return *a.c.Headers, nil
 }
+
+func (a auditLoggerConfig) Persist() bool {
+	return *a.c.Persist
+}
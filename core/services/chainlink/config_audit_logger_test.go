@@ -17,6 +17,7 @@ func TestAuditLoggerConfig(t *testing.T) {
 
 	require.Equal(t, true, auditConfig.Enabled())
 	require.Equal(t, "event", auditConfig.JsonWrapperKey())
+	require.Equal(t, true, auditConfig.Persist())
 
 	fUrl, err := auditConfig.ForwardToUrl()
 	require.NoError(t, err)
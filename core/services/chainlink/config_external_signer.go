@@ -0,0 +1,27 @@
+package chainlink
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+type externalSignerConfig struct {
+	c toml.ExternalSigner
+}
+
+func (e *externalSignerConfig) Enabled() bool {
+	return *e.c.Enabled
+}
+
+func (e *externalSignerConfig) URL() *url.URL {
+	if e.c.URL == nil || e.c.URL.IsZero() {
+		return nil
+	}
+	return e.c.URL.URL()
+}
+
+func (e *externalSignerConfig) Timeout() time.Duration {
+	return e.c.Timeout.Duration()
+}
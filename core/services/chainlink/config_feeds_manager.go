@@ -0,0 +1,45 @@
+package chainlink
+
+import (
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
+)
+
+var _ config.FeedsManager = (*feedsManagerConfig)(nil)
+
+type feedsManagerConfig struct {
+	c toml.FeedsManager
+}
+
+type feedsManagerApprovalPolicyConfig struct {
+	c toml.FeedsManagerApprovalPolicy
+}
+
+func (f *feedsManagerConfig) ApprovalPolicies() []config.FeedsManagerApprovalPolicy {
+	var policies []config.FeedsManagerApprovalPolicy
+	for _, p := range f.c.ApprovalPolicies {
+		policies = append(policies, &feedsManagerApprovalPolicyConfig{
+			c: p,
+		})
+	}
+	return policies
+}
+
+func (p *feedsManagerApprovalPolicyConfig) Name() string {
+	return *p.c.Name
+}
+
+func (p *feedsManagerApprovalPolicyConfig) Action() string {
+	return *p.c.Action
+}
+
+func (p *feedsManagerApprovalPolicyConfig) Condition() string {
+	return *p.c.Condition
+}
+
+func (p *feedsManagerApprovalPolicyConfig) JobTypes() []string {
+	if p.c.JobTypes == nil {
+		return nil
+	}
+	return *p.c.JobTypes
+}
@@ -0,0 +1,23 @@
+package chainlink
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedsManagerConfig(t *testing.T) {
+	opts := GeneralConfigOpts{
+		ConfigStrings: []string{fullTOML},
+	}
+	cfg, err := opts.New()
+	require.NoError(t, err)
+
+	policies := cfg.FeedsManager().ApprovalPolicies()
+	require.Len(t, policies, 1)
+	assert.Equal(t, "adapter-urls", policies[0].Name())
+	assert.Equal(t, "AutoApprove", policies[0].Action())
+	assert.Equal(t, "OnlyAdapterURLsChanged", policies[0].Condition())
+	assert.Equal(t, []string{"offchainreporting2"}, policies[0].JobTypes())
+}
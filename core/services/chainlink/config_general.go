@@ -278,6 +278,10 @@ func (g *generalConfig) Feature() coreconfig.Feature {
 	return &featureConfig{c: g.c.Feature}
 }
 
+func (g *generalConfig) FeedsManager() coreconfig.FeedsManager {
+	return &feedsManagerConfig{c: g.c.FeedsManager}
+}
+
 func (g *generalConfig) FeatureFeedsManager() bool {
 	return *g.c.Feature.FeedsManager
 }
@@ -501,6 +505,10 @@ func (g *generalConfig) Insecure() config.Insecure {
 	return &insecureConfig{c: g.c.Insecure}
 }
 
+func (g *generalConfig) ExternalSigner() coreconfig.ExternalSigner {
+	return &externalSignerConfig{c: g.c.ExternalSigner}
+}
+
 func (g *generalConfig) Sentry() coreconfig.Sentry {
 	return sentryConfig{g.c.Sentry}
 }
@@ -297,6 +297,7 @@ func TestConfig_Marshal(t *testing.T) {
 		ForwardToUrl:   mustURL("http://localhost:9898"),
 		Headers:        ptr(serviceHeaders),
 		JsonWrapperKey: ptr("event"),
+		Persist:        ptr(true),
 	}
 
 	full.Feature = toml.Feature{
@@ -306,6 +307,14 @@ func TestConfig_Marshal(t *testing.T) {
 		CCIP:               ptr(true),
 		MultiFeedsManagers: ptr(true),
 	}
+	full.FeedsManager = toml.FeedsManager{
+		ApprovalPolicies: []toml.FeedsManagerApprovalPolicy{{
+			Name:      ptr("adapter-urls"),
+			Action:    ptr("AutoApprove"),
+			Condition: ptr("OnlyAdapterURLsChanged"),
+			JobTypes:  &[]string{"offchainreporting2"},
+		}},
+	}
 	full.Database = toml.Database{
 		DefaultIdleInTxSessionTimeout: commoncfg.MustNewDuration(time.Minute),
 		DefaultLockTimeout:            commoncfg.MustNewDuration(time.Hour),
@@ -394,6 +403,20 @@ func TestConfig_Marshal(t *testing.T) {
 			UpstreamSyncInterval:        commoncfg.MustNewDuration(0 * time.Second),
 			UpstreamSyncRateLimit:       commoncfg.MustNewDuration(2 * time.Minute),
 		},
+		OIDC: toml.WebServerOIDC{
+			IssuerURL:      mustURL("https://accounts.example.com"),
+			ClientID:       ptr("chainlink-node"),
+			RedirectURL:    mustURL("https://node.example.com/oidc/callback"),
+			Scopes:         &[]string{"openid", "email", "profile", "offline_access"},
+			EmailClaim:     ptr("email"),
+			GroupsClaim:    ptr("groups"),
+			AdminGroup:     ptr("NodeAdmins"),
+			EditGroup:      ptr("NodeEditors"),
+			RunGroup:       ptr("NodeRunners"),
+			ReadGroup:      ptr("NodeReadOnly"),
+			SessionTimeout: commoncfg.MustNewDuration(15 * time.Minute),
+			RequestTimeout: commoncfg.MustNewDuration(10 * time.Second),
+		},
 		RateLimit: toml.WebServerRateLimit{
 			Authenticated:         ptr[int64](42),
 			AuthenticatedPeriod:   commoncfg.MustNewDuration(time.Second),
@@ -570,6 +593,11 @@ func TestConfig_Marshal(t *testing.T) {
 		EmitterBatchProcessor: ptr(true),
 		EmitterExportTimeout:  commoncfg.MustNewDuration(1 * time.Second),
 	}
+	full.ExternalSigner = toml.ExternalSigner{
+		Enabled: ptr(true),
+		URL:     mustURL("https://signer.example.com"),
+		Timeout: commoncfg.MustNewDuration(5 * time.Second),
+	}
 	full.EVM = []*evmcfg.EVMConfig{
 		{
 			ChainID: ubig.NewI(1),
@@ -626,6 +654,13 @@ func TestConfig_Marshal(t *testing.T) {
 					FeeHistory: evmcfg.FeeHistoryEstimator{
 						CacheTimeout: &second,
 					},
+					Forecast: evmcfg.ForecastEstimator{
+						CacheTimeout:     commoncfg.MustNewDuration(12 * time.Second),
+						BlockHistorySize: ptr[uint16](30),
+						TargetBlocks:     ptr[uint16](2),
+						Confidence:       ptr[uint8](80),
+						TxPoolEnabled:    ptr(true),
+					},
 				},
 
 				KeySpecific: []evmcfg.KeySpecific{
@@ -702,6 +737,11 @@ func TestConfig_Marshal(t *testing.T) {
 						ServiceUnavailable:                ptr[string]("(: |^)service unavailable"),
 						TooManyResults:                    ptr[string]("(: |^)too many results"),
 					},
+					CriticalReads: evmcfg.CriticalReads{
+						Mode:       ptr("Hedged"),
+						HedgeDelay: commoncfg.MustNewDuration(250 * time.Millisecond),
+						Quorum:     ptr[uint32](3),
+					},
 				},
 				OCR: evmcfg.OCR{
 					ContractConfirmations:              ptr[uint16](11),
@@ -881,6 +921,7 @@ Enabled = true
 ForwardToUrl = 'http://localhost:9898'
 JsonWrapperKey = 'event'
 Headers = ['Authorization: token', 'X-SomeOther-Header: value with spaces | and a bar+*']
+Persist = true
 `},
 		{"Feature", Config{Core: toml.Core{Feature: full.Feature}}, `[Feature]
 FeedsManager = true
@@ -888,6 +929,13 @@ LogPoller = true
 UICSAKeys = true
 CCIP = true
 MultiFeedsManagers = true
+`},
+		{"FeedsManager", Config{Core: toml.Core{FeedsManager: full.FeedsManager}}, `[FeedsManager]
+[[FeedsManager.ApprovalPolicies]]
+Name = 'adapter-urls'
+Action = 'AutoApprove'
+Condition = 'OnlyAdapterURLsChanged'
+JobTypes = ['offchainreporting2']
 `},
 		{"Database", Config{Core: toml.Core{Database: full.Database}}, `[Database]
 DefaultIdleInTxSessionTimeout = '1m0s'
@@ -974,6 +1022,20 @@ UserAPITokenDuration = '240h0m0s'
 UpstreamSyncInterval = '0s'
 UpstreamSyncRateLimit = '2m0s'
 
+[WebServer.OIDC]
+IssuerURL = 'https://accounts.example.com'
+ClientID = 'chainlink-node'
+RedirectURL = 'https://node.example.com/oidc/callback'
+Scopes = ['openid', 'email', 'profile', 'offline_access']
+EmailClaim = 'email'
+GroupsClaim = 'groups'
+AdminGroup = 'NodeAdmins'
+EditGroup = 'NodeEditors'
+RunGroup = 'NodeRunners'
+ReadGroup = 'NodeReadOnly'
+SessionTimeout = '15m0s'
+RequestTimeout = '10s'
+
 [WebServer.MFA]
 RPID = 'test-rpid'
 RPOrigin = 'test-rp-origin'
@@ -1089,6 +1151,11 @@ Debug = true
 DSN = 'sentry-dsn'
 Environment = 'dev'
 Release = 'v1.2.3'
+`},
+		{"ExternalSigner", Config{Core: toml.Core{ExternalSigner: full.ExternalSigner}}, `[ExternalSigner]
+Enabled = true
+URL = 'https://signer.example.com'
+Timeout = '5s'
 `},
 		{"EVM", Config{EVM: full.EVM}, `[[EVM]]
 ChainID = '1'
@@ -1169,6 +1236,13 @@ TransactionPercentile = 15
 [EVM.GasEstimator.FeeHistory]
 CacheTimeout = '1s'
 
+[EVM.GasEstimator.Forecast]
+CacheTimeout = '12s'
+BlockHistorySize = 30
+TargetBlocks = 2
+Confidence = 80
+TxPoolEnabled = true
+
 [EVM.HeadTracker]
 HistoryDepth = 15
 MaxBufferSize = 17
@@ -1212,6 +1286,11 @@ Fatal = '(: |^)fatal'
 ServiceUnavailable = '(: |^)service unavailable'
 TooManyResults = '(: |^)too many results'
 
+[EVM.NodePool.CriticalReads]
+Mode = 'Hedged'
+HedgeDelay = '250ms'
+Quorum = 3
+
 [EVM.OCR]
 ContractConfirmations = 11
 ContractTransmitterTransmitTimeout = '1m0s'
@@ -1448,7 +1527,7 @@ func TestConfig_Validate(t *testing.T) {
 		- LDAP.RunUserGroupCN: invalid value (<nil>): LDAP ReadUserGroupCN can not be empty
 		- LDAP.RunUserGroupCN: invalid value (<nil>): LDAP RunUserGroupCN can not be empty
 		- LDAP.ReadUserGroupCN: invalid value (<nil>): LDAP ReadUserGroupCN can not be empty
-	- EVM: 10 errors:
+	- EVM: 11 errors:
 		- 1.ChainID: invalid value (1): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (foo): duplicate - must be unique
 		- 3.Nodes.4.WSURL: invalid value (ws://dupe.com): duplicate - must be unique
@@ -1505,6 +1584,9 @@ func TestConfig_Validate(t *testing.T) {
 			- Nodes: missing: must have at least one node
 		- 5.Transactions.AutoPurge.DetectionApiUrl: invalid value (): must be set for scroll
 		- 6.Nodes: missing: 0th node (primary) must have a valid WSURL when http polling is disabled
+		- 7.NodePool.CriticalReads: 2 errors:
+					- Mode: invalid value (Fastest): must be one of Single, Hedged or Quorum
+					- Quorum: invalid value (0): must be at least 1
 	- Cosmos: 5 errors:
 		- 1.ChainID: invalid value (Malaga-420): duplicate - must be unique
 		- 0.Nodes.1.Name: invalid value (test): duplicate - must be unique
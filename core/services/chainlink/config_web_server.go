@@ -118,6 +118,10 @@ func (w *webServerConfig) LDAP() config.LDAP {
 	return &ldapConfig{c: w.c.LDAP, s: w.s.LDAP}
 }
 
+func (w *webServerConfig) OIDC() config.OIDC {
+	return &oidcConfig{c: w.c.OIDC, s: w.s.OIDC}
+}
+
 func (w *webServerConfig) AuthenticationMethod() string {
 	return *w.c.AuthenticationMethod
 }
@@ -313,3 +317,75 @@ func (l *ldapConfig) UpstreamSyncRateLimit() commonconfig.Duration {
 	}
 	return *l.c.UpstreamSyncRateLimit
 }
+
+type oidcConfig struct {
+	c toml.WebServerOIDC
+	s toml.WebServerOIDCSecrets
+}
+
+func (o *oidcConfig) IssuerURL() *url.URL {
+	if o.c.IssuerURL == nil || o.c.IssuerURL.IsZero() {
+		return nil
+	}
+	return o.c.IssuerURL.URL()
+}
+
+func (o *oidcConfig) ClientID() string {
+	if o.c.ClientID == nil {
+		return ""
+	}
+	return *o.c.ClientID
+}
+
+func (o *oidcConfig) ClientSecret() string {
+	if o.s.ClientSecret == nil {
+		return ""
+	}
+	return string(*o.s.ClientSecret)
+}
+
+func (o *oidcConfig) RedirectURL() *url.URL {
+	if o.c.RedirectURL == nil || o.c.RedirectURL.IsZero() {
+		return nil
+	}
+	return o.c.RedirectURL.URL()
+}
+
+func (o *oidcConfig) Scopes() []string {
+	if o.c.Scopes == nil {
+		return nil
+	}
+	return *o.c.Scopes
+}
+
+func (o *oidcConfig) EmailClaim() string {
+	return *o.c.EmailClaim
+}
+
+func (o *oidcConfig) GroupsClaim() string {
+	return *o.c.GroupsClaim
+}
+
+func (o *oidcConfig) AdminGroup() string {
+	return *o.c.AdminGroup
+}
+
+func (o *oidcConfig) EditGroup() string {
+	return *o.c.EditGroup
+}
+
+func (o *oidcConfig) RunGroup() string {
+	return *o.c.RunGroup
+}
+
+func (o *oidcConfig) ReadGroup() string {
+	return *o.c.ReadGroup
+}
+
+func (o *oidcConfig) SessionTimeout() commonconfig.Duration {
+	return *o.c.SessionTimeout
+}
+
+func (o *oidcConfig) RequestTimeout() time.Duration {
+	return o.c.RequestTimeout.Duration()
+}
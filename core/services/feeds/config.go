@@ -16,6 +16,10 @@ type FeatureConfig interface {
 	MultiFeedsManagers() bool
 }
 
+type FeedsManagerConfig interface {
+	ApprovalPolicies() []coreconfig.FeedsManagerApprovalPolicy
+}
+
 type JobConfig interface {
 	DefaultHTTPTimeout() commonconfig.Duration
 }
@@ -0,0 +1,265 @@
+package feeds
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// observationSourceField is the field of a job spec which holds its pipeline.
+const observationSourceField = "observationSource"
+
+// ChangeKind is the kind of change of an element of a spec.
+type ChangeKind string
+
+const (
+	ChangeKindAdded   ChangeKind = "added"
+	ChangeKindRemoved ChangeKind = "removed"
+	ChangeKindChanged ChangeKind = "changed"
+)
+
+// FieldChange is a change of a value, identified by its path. Old is empty
+// for added values, and New for removed ones.
+type FieldChange struct {
+	Path string
+	Kind ChangeKind
+	Old  string
+	New  string
+}
+
+// TaskChange is a change of a task of the pipeline, identified by its DOT ID.
+// Attributes holds the changed attributes of a changed task.
+type TaskChange struct {
+	ID         string
+	Type       string
+	Kind       ChangeKind
+	Attributes []FieldChange
+}
+
+// EdgeChange is an edge of the pipeline which was added or removed. Edges
+// which are implied by the variables a task refers to are not included, as
+// they follow from the attributes of the task.
+type EdgeChange struct {
+	From string
+	To   string
+	Kind ChangeKind
+}
+
+// SpecDiff is the structured difference between two job spec definitions.
+type SpecDiff struct {
+	// Fields are the changes of the fields of the spec, other than the
+	// observation source, by dotted path.
+	Fields []FieldChange
+	// Tasks and Edges are the changes of the pipeline DAG of the observation
+	// source.
+	Tasks []TaskChange
+	Edges []EdgeChange
+}
+
+// IsEmpty returns true if the specs are equivalent.
+func (d SpecDiff) IsEmpty() bool {
+	return len(d.Fields) == 0 && !d.PipelineChanged()
+}
+
+// PipelineChanged returns true if the observation source changed.
+func (d SpecDiff) PipelineChanged() bool {
+	return len(d.Tasks) > 0 || len(d.Edges) > 0
+}
+
+// DiffSpecs returns the difference from the running spec definition to the
+// proposed one. An empty running definition is diffed as an empty spec, so
+// that everything in the proposed spec is added.
+func DiffSpecs(running, proposed string) (SpecDiff, error) {
+	runningFields, runningSource, err := flattenSpec(running)
+	if err != nil {
+		return SpecDiff{}, errors.Wrap(err, "failed to parse running spec")
+	}
+	proposedFields, proposedSource, err := flattenSpec(proposed)
+	if err != nil {
+		return SpecDiff{}, errors.Wrap(err, "failed to parse proposed spec")
+	}
+
+	runningGraph, err := parsePipelineGraph(runningSource)
+	if err != nil {
+		return SpecDiff{}, errors.Wrap(err, "failed to parse running observation source")
+	}
+	proposedGraph, err := parsePipelineGraph(proposedSource)
+	if err != nil {
+		return SpecDiff{}, errors.Wrap(err, "failed to parse proposed observation source")
+	}
+
+	diff := SpecDiff{
+		Fields: diffValues(runningFields, proposedFields),
+		Edges:  diffEdges(runningGraph.edges, proposedGraph.edges),
+	}
+
+	for _, id := range sortedKeys(runningGraph.tasks, proposedGraph.tasks) {
+		old, inRunning := runningGraph.tasks[id]
+		cur, inProposed := proposedGraph.tasks[id]
+		switch {
+		case !inProposed:
+			diff.Tasks = append(diff.Tasks, TaskChange{ID: id, Type: old["type"], Kind: ChangeKindRemoved})
+		case !inRunning:
+			diff.Tasks = append(diff.Tasks, TaskChange{ID: id, Type: cur["type"], Kind: ChangeKindAdded})
+		default:
+			if attrs := diffValues(old, cur); len(attrs) > 0 {
+				diff.Tasks = append(diff.Tasks, TaskChange{ID: id, Type: cur["type"], Kind: ChangeKindChanged, Attributes: attrs})
+			}
+		}
+	}
+
+	return diff, nil
+}
+
+// flattenSpec parses a spec definition into its values by dotted path, and
+// its observation source.
+func flattenSpec(definition string) (map[string]string, string, error) {
+	var spec map[string]any
+	if err := toml.Unmarshal([]byte(definition), &spec); err != nil {
+		return nil, "", err
+	}
+
+	var source string
+	if v, ok := spec[observationSourceField]; ok {
+		s, isString := v.(string)
+		if !isString {
+			return nil, "", errors.Errorf("%s must be a string", observationSourceField)
+		}
+		source = s
+		delete(spec, observationSourceField)
+	}
+
+	fields := map[string]string{}
+	if err := flattenValues(fields, "", spec); err != nil {
+		return nil, "", err
+	}
+	return fields, source, nil
+}
+
+func flattenValues(fields map[string]string, prefix string, values map[string]any) error {
+	for k, v := range values {
+		path := k
+		if prefix != "" {
+			path = prefix + "." + k
+		}
+		switch v := v.(type) {
+		case map[string]any:
+			if err := flattenValues(fields, path, v); err != nil {
+				return err
+			}
+		case string:
+			fields[path] = v
+		case []any:
+			// Arrays are compared as a whole
+			b, err := json.Marshal(v)
+			if err != nil {
+				return errors.Wrapf(err, "failed to format %s", path)
+			}
+			fields[path] = string(b)
+		default:
+			fields[path] = fmt.Sprint(v)
+		}
+	}
+	return nil
+}
+
+// pipelineGraph is the DAG of an observation source, with the attributes of
+// each task by DOT ID and the explicit edges.
+type pipelineGraph struct {
+	tasks map[string]map[string]string
+	edges map[[2]string]struct{}
+}
+
+func parsePipelineGraph(source string) (pipelineGraph, error) {
+	pg := pipelineGraph{tasks: map[string]map[string]string{}, edges: map[[2]string]struct{}{}}
+	if strings.TrimSpace(source) == "" {
+		return pg, nil
+	}
+
+	g := pipeline.NewGraph()
+	if err := g.UnmarshalText([]byte(source)); err != nil {
+		return pg, err
+	}
+
+	for nodes := g.Nodes(); nodes.Next(); {
+		n := nodes.Node().(*pipeline.GraphNode)
+		attrs := map[string]string{}
+		for _, a := range n.Attributes() {
+			attrs[a.Key] = a.Value
+		}
+		pg.tasks[n.DOTID()] = attrs
+	}
+	for edges := g.Edges(); edges.Next(); {
+		e := edges.Edge().(*pipeline.GraphEdge)
+		if e.IsImplicit() {
+			continue
+		}
+		from := e.From().(*pipeline.GraphNode)
+		to := e.To().(*pipeline.GraphNode)
+		pg.edges[[2]string{from.DOTID(), to.DOTID()}] = struct{}{}
+	}
+
+	return pg, nil
+}
+
+// diffValues returns the changes from old to cur, sorted by path.
+func diffValues(old, cur map[string]string) []FieldChange {
+	var changes []FieldChange
+	for _, path := range sortedKeys(old, cur) {
+		o, inOld := old[path]
+		c, inCur := cur[path]
+		switch {
+		case !inCur:
+			changes = append(changes, FieldChange{Path: path, Kind: ChangeKindRemoved, Old: o})
+		case !inOld:
+			changes = append(changes, FieldChange{Path: path, Kind: ChangeKindAdded, New: c})
+		case o != c:
+			changes = append(changes, FieldChange{Path: path, Kind: ChangeKindChanged, Old: o, New: c})
+		}
+	}
+	return changes
+}
+
+// diffEdges returns the edges which were added or removed, sorted by the DOT
+// IDs of their tasks.
+func diffEdges(old, cur map[[2]string]struct{}) []EdgeChange {
+	var changes []EdgeChange
+	for e := range old {
+		if _, ok := cur[e]; !ok {
+			changes = append(changes, EdgeChange{From: e[0], To: e[1], Kind: ChangeKindRemoved})
+		}
+	}
+	for e := range cur {
+		if _, ok := old[e]; !ok {
+			changes = append(changes, EdgeChange{From: e[0], To: e[1], Kind: ChangeKindAdded})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].From != changes[j].From {
+			return changes[i].From < changes[j].From
+		}
+		return changes[i].To < changes[j].To
+	})
+	return changes
+}
+
+// sortedKeys returns the union of the keys of a and b, sorted.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
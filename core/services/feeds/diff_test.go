@@ -0,0 +1,96 @@
+package feeds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+const diffRunningSpec = `
+type            = "offchainreporting2"
+schemaVersion   = 1
+contractID      = "0x613a38AC1659769640aaE063C651F48E0250454C"
+maxTaskDuration = "1s"
+observationSource = """
+ds1          [type=http method=GET url="https://a.example.com"];
+ds1_parse    [type=jsonparse path="data,result"];
+ds2          [type=bridge name=voter_turnout];
+ds1 -> ds1_parse -> answer1;
+ds2 -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1337
+`
+
+func TestDiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("identical specs", func(t *testing.T) {
+		diff, err := feeds.DiffSpecs(diffRunningSpec, diffRunningSpec)
+		require.NoError(t, err)
+		assert.True(t, diff.IsEmpty())
+	})
+
+	t.Run("fields and pipeline", func(t *testing.T) {
+		proposed := `
+type            = "offchainreporting2"
+schemaVersion   = 1
+contractID      = "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"
+observationSource = """
+ds1          [type=http method=GET url="https://b.example.com"];
+ds1_parse    [type=jsonparse path="data,result" lax=true];
+ds3          [type=bridge name=election_winner];
+ds1 -> ds1_parse -> answer1;
+ds3 -> answer1;
+answer1      [type=median index=0];
+"""
+[relayConfig]
+chainID = 1338
+fromBlock = 100
+`
+		diff, err := feeds.DiffSpecs(diffRunningSpec, proposed)
+		require.NoError(t, err)
+
+		assert.Equal(t, []feeds.FieldChange{
+			{Path: "contractID", Kind: feeds.ChangeKindChanged, Old: "0x613a38AC1659769640aaE063C651F48E0250454C", New: "0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"},
+			{Path: "maxTaskDuration", Kind: feeds.ChangeKindRemoved, Old: "1s"},
+			{Path: "relayConfig.chainID", Kind: feeds.ChangeKindChanged, Old: "1337", New: "1338"},
+			{Path: "relayConfig.fromBlock", Kind: feeds.ChangeKindAdded, New: "100"},
+		}, diff.Fields)
+		assert.Equal(t, []feeds.TaskChange{
+			{ID: "ds1", Type: "http", Kind: feeds.ChangeKindChanged, Attributes: []feeds.FieldChange{
+				{Path: "url", Kind: feeds.ChangeKindChanged, Old: "https://a.example.com", New: "https://b.example.com"},
+			}},
+			{ID: "ds1_parse", Type: "jsonparse", Kind: feeds.ChangeKindChanged, Attributes: []feeds.FieldChange{
+				{Path: "lax", Kind: feeds.ChangeKindAdded, New: "true"},
+			}},
+			{ID: "ds2", Type: "bridge", Kind: feeds.ChangeKindRemoved},
+			{ID: "ds3", Type: "bridge", Kind: feeds.ChangeKindAdded},
+		}, diff.Tasks)
+		assert.Equal(t, []feeds.EdgeChange{
+			{From: "ds2", To: "answer1", Kind: feeds.ChangeKindRemoved},
+			{From: "ds3", To: "answer1", Kind: feeds.ChangeKindAdded},
+		}, diff.Edges)
+	})
+
+	t.Run("no running spec", func(t *testing.T) {
+		diff, err := feeds.DiffSpecs("", diffRunningSpec)
+		require.NoError(t, err)
+
+		for _, f := range diff.Fields {
+			assert.Equal(t, feeds.ChangeKindAdded, f.Kind)
+		}
+		assert.Len(t, diff.Fields, 5)
+		assert.Len(t, diff.Tasks, 4)
+		assert.Len(t, diff.Edges, 3)
+	})
+
+	t.Run("invalid spec", func(t *testing.T) {
+		_, err := feeds.DiffSpecs(diffRunningSpec, `observationSource = "ds1 -> "`)
+		require.ErrorContains(t, err, "failed to parse proposed observation source")
+	})
+}
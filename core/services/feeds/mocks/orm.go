@@ -523,6 +523,63 @@ func (_c *ORM_CreateManager_Call) RunAndReturn(run func(context.Context, *feeds.
 	return _c
 }
 
+// CreatePolicyDecision provides a mock function with given fields: ctx, decision
+func (_m *ORM) CreatePolicyDecision(ctx context.Context, decision feeds.JobProposalPolicyDecision) (int64, error) {
+	ret := _m.Called(ctx, decision)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreatePolicyDecision")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.JobProposalPolicyDecision) (int64, error)); ok {
+		return rf(ctx, decision)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, feeds.JobProposalPolicyDecision) int64); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, feeds.JobProposalPolicyDecision) error); ok {
+		r1 = rf(ctx, decision)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_CreatePolicyDecision_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePolicyDecision'
+type ORM_CreatePolicyDecision_Call struct {
+	*mock.Call
+}
+
+// CreatePolicyDecision is a helper method to define mock.On call
+//   - ctx context.Context
+//   - decision feeds.JobProposalPolicyDecision
+func (_e *ORM_Expecter) CreatePolicyDecision(ctx interface{}, decision interface{}) *ORM_CreatePolicyDecision_Call {
+	return &ORM_CreatePolicyDecision_Call{Call: _e.mock.On("CreatePolicyDecision", ctx, decision)}
+}
+
+func (_c *ORM_CreatePolicyDecision_Call) Run(run func(ctx context.Context, decision feeds.JobProposalPolicyDecision)) *ORM_CreatePolicyDecision_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(feeds.JobProposalPolicyDecision))
+	})
+	return _c
+}
+
+func (_c *ORM_CreatePolicyDecision_Call) Return(_a0 int64, _a1 error) *ORM_CreatePolicyDecision_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_CreatePolicyDecision_Call) RunAndReturn(run func(context.Context, feeds.JobProposalPolicyDecision) (int64, error)) *ORM_CreatePolicyDecision_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateSpec provides a mock function with given fields: ctx, spec
 func (_m *ORM) CreateSpec(ctx context.Context, spec feeds.JobProposalSpec) (int64, error) {
 	ret := _m.Called(ctx, spec)
@@ -1565,6 +1622,65 @@ func (_c *ORM_ListManagersByIDs_Call) RunAndReturn(run func(context.Context, []i
 	return _c
 }
 
+// ListPolicyDecisionsByJobProposalIDs provides a mock function with given fields: ctx, ids
+func (_m *ORM) ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]feeds.JobProposalPolicyDecision, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicyDecisionsByJobProposalIDs")
+	}
+
+	var r0 []feeds.JobProposalPolicyDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]feeds.JobProposalPolicyDecision, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []feeds.JobProposalPolicyDecision); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalPolicyDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ORM_ListPolicyDecisionsByJobProposalIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicyDecisionsByJobProposalIDs'
+type ORM_ListPolicyDecisionsByJobProposalIDs_Call struct {
+	*mock.Call
+}
+
+// ListPolicyDecisionsByJobProposalIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *ORM_Expecter) ListPolicyDecisionsByJobProposalIDs(ctx interface{}, ids interface{}) *ORM_ListPolicyDecisionsByJobProposalIDs_Call {
+	return &ORM_ListPolicyDecisionsByJobProposalIDs_Call{Call: _e.mock.On("ListPolicyDecisionsByJobProposalIDs", ctx, ids)}
+}
+
+func (_c *ORM_ListPolicyDecisionsByJobProposalIDs_Call) Run(run func(ctx context.Context, ids []int64)) *ORM_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *ORM_ListPolicyDecisionsByJobProposalIDs_Call) Return(_a0 []feeds.JobProposalPolicyDecision, _a1 error) *ORM_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ORM_ListPolicyDecisionsByJobProposalIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]feeds.JobProposalPolicyDecision, error)) *ORM_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSpecsByJobProposalIDs provides a mock function with given fields: ctx, ids
 func (_m *ORM) ListSpecsByJobProposalIDs(ctx context.Context, ids []int64) ([]feeds.JobProposalSpec, error) {
 	ret := _m.Called(ctx, ids)
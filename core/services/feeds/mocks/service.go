@@ -1037,6 +1037,65 @@ func (_c *Service_ListManagersByIDs_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListPolicyDecisionsByJobProposalIDs provides a mock function with given fields: ctx, ids
+func (_m *Service) ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]feeds.JobProposalPolicyDecision, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicyDecisionsByJobProposalIDs")
+	}
+
+	var r0 []feeds.JobProposalPolicyDecision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) ([]feeds.JobProposalPolicyDecision, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []feeds.JobProposalPolicyDecision); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalPolicyDecision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []int64) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Service_ListPolicyDecisionsByJobProposalIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicyDecisionsByJobProposalIDs'
+type Service_ListPolicyDecisionsByJobProposalIDs_Call struct {
+	*mock.Call
+}
+
+// ListPolicyDecisionsByJobProposalIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []int64
+func (_e *Service_Expecter) ListPolicyDecisionsByJobProposalIDs(ctx interface{}, ids interface{}) *Service_ListPolicyDecisionsByJobProposalIDs_Call {
+	return &Service_ListPolicyDecisionsByJobProposalIDs_Call{Call: _e.mock.On("ListPolicyDecisionsByJobProposalIDs", ctx, ids)}
+}
+
+func (_c *Service_ListPolicyDecisionsByJobProposalIDs_Call) Run(run func(ctx context.Context, ids []int64)) *Service_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]int64))
+	})
+	return _c
+}
+
+func (_c *Service_ListPolicyDecisionsByJobProposalIDs_Call) Return(_a0 []feeds.JobProposalPolicyDecision, _a1 error) *Service_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Service_ListPolicyDecisionsByJobProposalIDs_Call) RunAndReturn(run func(context.Context, []int64) ([]feeds.JobProposalPolicyDecision, error)) *Service_ListPolicyDecisionsByJobProposalIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListSpecsByJobProposalIDs provides a mock function with given fields: ctx, ids
 func (_m *Service) ListSpecsByJobProposalIDs(ctx context.Context, ids []int64) ([]feeds.JobProposalSpec, error) {
 	ret := _m.Called(ctx, ids)
@@ -270,6 +270,33 @@ func (s *JobProposalSpec) CanEditDefinition() bool {
 		s.Status == SpecStatusCancelled
 }
 
+// PolicyDecisionType is the outcome of evaluating the approval policies
+// against a proposed spec.
+type PolicyDecisionType string
+
+const (
+	// PolicyDecisionAutoApproved defines a decision which approved the spec.
+	PolicyDecisionAutoApproved PolicyDecisionType = "auto_approved"
+	// PolicyDecisionManualApproval defines a decision which left the spec
+	// pending for the node op to approve.
+	PolicyDecisionManualApproval PolicyDecisionType = "manual_approval"
+	// PolicyDecisionAutoApprovalFailed defines a decision to approve the spec
+	// which failed, leaving the spec pending.
+	PolicyDecisionAutoApprovalFailed PolicyDecisionType = "auto_approval_failed"
+)
+
+// JobProposalPolicyDecision records the decision of the approval policies
+// on a proposed spec.
+type JobProposalPolicyDecision struct {
+	ID                int64
+	JobProposalID     int64
+	JobProposalSpecID int64
+	Policy            string // Policy is the name of the deciding policy, empty if no policy matched.
+	Decision          PolicyDecisionType
+	Reason            string
+	CreatedAt         time.Time
+}
+
 // JobProposalCounts defines the counts for job proposals of each status.
 type JobProposalCounts struct {
 	Pending   int64
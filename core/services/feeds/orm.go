@@ -55,6 +55,9 @@ type ORM interface {
 	RevokeSpec(ctx context.Context, id int64) error
 	UpdateSpecDefinition(ctx context.Context, id int64, spec string) error
 
+	CreatePolicyDecision(ctx context.Context, decision JobProposalPolicyDecision) (int64, error)
+	ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalPolicyDecision, error)
+
 	IsJobManaged(ctx context.Context, jobID int64) (bool, error)
 
 	Transact(context.Context, func(ORM) error) error
@@ -851,6 +854,35 @@ WHERE id = $2;
 	return nil
 }
 
+// CreatePolicyDecision records the decision of the approval policies on a
+// job proposal spec.
+func (o *orm) CreatePolicyDecision(ctx context.Context, decision JobProposalPolicyDecision) (int64, error) {
+	stmt := `
+INSERT INTO job_proposal_policy_decisions (job_proposal_id, job_proposal_spec_id, policy, decision, reason, created_at)
+VALUES ($1, $2, $3, $4, $5, NOW())
+RETURNING id;
+`
+
+	var id int64
+	err := o.ds.GetContext(ctx, &id, stmt, decision.JobProposalID, decision.JobProposalSpecID, decision.Policy, decision.Decision, decision.Reason)
+
+	return id, errors.Wrap(err, "CreatePolicyDecision failed")
+}
+
+// ListPolicyDecisionsByJobProposalIDs lists the policy decisions on the specs
+// of any of the job proposal ids, most recent first.
+func (o *orm) ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalPolicyDecision, error) {
+	stmt := `
+SELECT id, job_proposal_id, job_proposal_spec_id, policy, decision, reason, created_at
+FROM job_proposal_policy_decisions
+WHERE job_proposal_id = ANY($1)
+ORDER BY id DESC
+`
+	var decisions []JobProposalPolicyDecision
+	err := o.ds.SelectContext(ctx, &decisions, stmt, ids)
+	return decisions, errors.Wrap(err, "ListPolicyDecisionsByJobProposalIDs failed")
+}
+
 // IsJobManaged determines if a job is managed by the feeds manager.
 func (o *orm) IsJobManaged(ctx context.Context, jobID int64) (exists bool, err error) {
 	stmt := `
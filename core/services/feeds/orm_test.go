@@ -1657,6 +1657,66 @@ func Test_ORM_UpdateSpecDefinition(t *testing.T) {
 	require.Error(t, err)
 }
 
+// Job Proposal Policy Decisions
+
+func Test_ORM_PolicyDecisions(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	var (
+		orm  = setupORM(t)
+		fmID = createFeedsManager(t, orm)
+
+		jp1ID   = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		jp2ID   = createJobProposal(t, orm, feeds.JobProposalStatusPending, fmID)
+		spec1ID = createJobSpec(t, orm, jp1ID)
+		spec2ID = createJobSpec(t, orm, jp2ID)
+	)
+
+	_, err := orm.CreatePolicyDecision(ctx, feeds.JobProposalPolicyDecision{
+		JobProposalID:     jp1ID,
+		JobProposalSpecID: spec1ID,
+		Decision:          feeds.PolicyDecisionManualApproval,
+		Reason:            "no approval policy matched",
+	})
+	require.NoError(t, err)
+	id, err := orm.CreatePolicyDecision(ctx, feeds.JobProposalPolicyDecision{
+		JobProposalID:     jp1ID,
+		JobProposalSpecID: spec1ID,
+		Policy:            "adapter-urls",
+		Decision:          feeds.PolicyDecisionAutoApproved,
+		Reason:            "only the URLs of http tasks changed",
+	})
+	require.NoError(t, err)
+	_, err = orm.CreatePolicyDecision(ctx, feeds.JobProposalPolicyDecision{
+		JobProposalID:     jp2ID,
+		JobProposalSpecID: spec2ID,
+		Decision:          feeds.PolicyDecisionManualApproval,
+		Reason:            "no approval policy matched",
+	})
+	require.NoError(t, err)
+
+	decisions, err := orm.ListPolicyDecisionsByJobProposalIDs(ctx, []int64{jp1ID})
+	require.NoError(t, err)
+	require.Len(t, decisions, 2)
+
+	actual := decisions[0]
+	assert.Equal(t, id, actual.ID)
+	assert.Equal(t, jp1ID, actual.JobProposalID)
+	assert.Equal(t, spec1ID, actual.JobProposalSpecID)
+	assert.Equal(t, "adapter-urls", actual.Policy)
+	assert.Equal(t, feeds.PolicyDecisionAutoApproved, actual.Decision)
+	assert.Equal(t, "only the URLs of http tasks changed", actual.Reason)
+	assert.False(t, actual.CreatedAt.IsZero())
+
+	assert.Empty(t, decisions[1].Policy)
+	assert.Equal(t, feeds.PolicyDecisionManualApproval, decisions[1].Decision)
+
+	decisions, err = orm.ListPolicyDecisionsByJobProposalIDs(ctx, []int64{jp1ID, jp2ID})
+	require.NoError(t, err)
+	require.Len(t, decisions, 3)
+}
+
 // Other
 
 func Test_ORM_IsJobManaged(t *testing.T) {
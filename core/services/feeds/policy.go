@@ -0,0 +1,97 @@
+package feeds
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+// contractAddressFields are the fields which identify the contract of a job,
+// compared case-insensitively with the last element of the path of a field.
+var contractAddressFields = []string{"contractaddress", "contractid"}
+
+// PolicyEvaluation is the outcome of evaluating the approval policies against
+// the diff of a proposed spec.
+type PolicyEvaluation struct {
+	// Policy is the name of the deciding policy, empty if no policy matched.
+	Policy      string
+	AutoApprove bool
+	Reason      string
+}
+
+// EvaluateApprovalPolicies evaluates the policies which apply to the job type
+// against the diff of a proposed spec. A matching RequireManual policy takes
+// precedence over AutoApprove policies, and specs which match no policy
+// require manual approval.
+func EvaluateApprovalPolicies(policies []coreconfig.FeedsManagerApprovalPolicy, jobType job.Type, diff SpecDiff) PolicyEvaluation {
+	var approve *PolicyEvaluation
+	for _, p := range policies {
+		if len(p.JobTypes()) > 0 && !slices.Contains(p.JobTypes(), string(jobType)) {
+			continue
+		}
+		matched, reason := matchCondition(p.Condition(), diff)
+		if !matched {
+			continue
+		}
+		switch p.Action() {
+		case coreconfig.ApprovalActionRequireManual:
+			return PolicyEvaluation{Policy: p.Name(), Reason: reason}
+		case coreconfig.ApprovalActionAutoApprove:
+			if approve == nil {
+				approve = &PolicyEvaluation{Policy: p.Name(), AutoApprove: true, Reason: reason}
+			}
+		}
+	}
+	if approve != nil {
+		return *approve
+	}
+	return PolicyEvaluation{Reason: "no approval policy matched"}
+}
+
+// matchCondition returns whether the condition holds for the diff, and the
+// reason it holds.
+func matchCondition(condition string, diff SpecDiff) (bool, string) {
+	switch condition {
+	case coreconfig.ApprovalConditionOnlyAdapterURLsChanged:
+		return onlyAdapterURLsChanged(diff), "only the URLs of http tasks changed"
+	case coreconfig.ApprovalConditionOnlyPipelineChanged:
+		return len(diff.Fields) == 0 && diff.PipelineChanged(), "only the observation source changed"
+	case coreconfig.ApprovalConditionNewContractAddress:
+		for _, f := range diff.Fields {
+			if f.Kind != ChangeKindRemoved && isContractAddressField(f.Path) {
+				return true, fmt.Sprintf("the contract address %s is new", f.Path)
+			}
+		}
+		return false, ""
+	default:
+		return false, ""
+	}
+}
+
+// onlyAdapterURLsChanged returns true if the only changes are to the url of
+// existing http tasks.
+func onlyAdapterURLsChanged(diff SpecDiff) bool {
+	if len(diff.Fields) > 0 || len(diff.Edges) > 0 || len(diff.Tasks) == 0 {
+		return false
+	}
+	for _, t := range diff.Tasks {
+		if t.Kind != ChangeKindChanged || t.Type != string(pipeline.TaskTypeHTTP) {
+			return false
+		}
+		for _, a := range t.Attributes {
+			if a.Path != "url" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isContractAddressField(path string) bool {
+	name := path[strings.LastIndex(path, ".")+1:]
+	return slices.Contains(contractAddressFields, strings.ToLower(name))
+}
@@ -0,0 +1,111 @@
+package feeds_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+)
+
+type approvalPolicy struct {
+	name, action, condition string
+	jobTypes                []string
+}
+
+func (p approvalPolicy) Name() string       { return p.name }
+func (p approvalPolicy) Action() string     { return p.action }
+func (p approvalPolicy) Condition() string  { return p.condition }
+func (p approvalPolicy) JobTypes() []string { return p.jobTypes }
+
+func TestEvaluateApprovalPolicies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		adapterURLs = approvalPolicy{name: "adapter-urls", action: coreconfig.ApprovalActionAutoApprove, condition: coreconfig.ApprovalConditionOnlyAdapterURLsChanged}
+		pipelines   = approvalPolicy{name: "pipelines", action: coreconfig.ApprovalActionAutoApprove, condition: coreconfig.ApprovalConditionOnlyPipelineChanged,
+			jobTypes: []string{string(job.FluxMonitor)}}
+		newContracts = approvalPolicy{name: "new-contracts", action: coreconfig.ApprovalActionRequireManual, condition: coreconfig.ApprovalConditionNewContractAddress}
+
+		urlChanged = feeds.SpecDiff{Tasks: []feeds.TaskChange{{ID: "ds1", Type: "http", Kind: feeds.ChangeKindChanged, Attributes: []feeds.FieldChange{
+			{Path: "url", Kind: feeds.ChangeKindChanged, Old: "https://a.example.com", New: "https://b.example.com"},
+		}}}}
+		methodChanged = feeds.SpecDiff{Tasks: []feeds.TaskChange{{ID: "ds1", Type: "http", Kind: feeds.ChangeKindChanged, Attributes: []feeds.FieldChange{
+			{Path: "method", Kind: feeds.ChangeKindChanged, Old: "GET", New: "POST"},
+		}}}}
+		contractChanged = feeds.SpecDiff{
+			Fields: []feeds.FieldChange{{Path: "contractAddress", Kind: feeds.ChangeKindChanged, Old: "0x1", New: "0x2"}},
+			Tasks:  urlChanged.Tasks,
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		policies []coreconfig.FeedsManagerApprovalPolicy
+		jobType  job.Type
+		diff     feeds.SpecDiff
+		want     feeds.PolicyEvaluation
+	}{
+		{
+			name: "no policies",
+			diff: urlChanged,
+			want: feeds.PolicyEvaluation{Reason: "no approval policy matched"},
+		},
+		{
+			name:     "adapter URL changed",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{adapterURLs, newContracts},
+			jobType:  job.OffchainReporting2,
+			diff:     urlChanged,
+			want:     feeds.PolicyEvaluation{Policy: "adapter-urls", AutoApprove: true, Reason: "only the URLs of http tasks changed"},
+		},
+		{
+			name:     "other task attribute changed",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{adapterURLs},
+			jobType:  job.OffchainReporting2,
+			diff:     methodChanged,
+			want:     feeds.PolicyEvaluation{Reason: "no approval policy matched"},
+		},
+		{
+			name:     "pipeline changed for another job type",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{pipelines},
+			jobType:  job.OffchainReporting2,
+			diff:     methodChanged,
+			want:     feeds.PolicyEvaluation{Reason: "no approval policy matched"},
+		},
+		{
+			name:     "pipeline changed for the job type",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{pipelines},
+			jobType:  job.FluxMonitor,
+			diff:     methodChanged,
+			want:     feeds.PolicyEvaluation{Policy: "pipelines", AutoApprove: true, Reason: "only the observation source changed"},
+		},
+		{
+			name:     "require manual takes precedence",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{adapterURLs, newContracts},
+			jobType:  job.FluxMonitor,
+			diff:     feeds.SpecDiff{Fields: contractChanged.Fields},
+			want:     feeds.PolicyEvaluation{Policy: "new-contracts", Reason: "the contract address contractAddress is new"},
+		},
+		{
+			name:     "new contract with a pipeline change",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{pipelines, newContracts},
+			jobType:  job.FluxMonitor,
+			diff:     contractChanged,
+			want:     feeds.PolicyEvaluation{Policy: "new-contracts", Reason: "the contract address contractAddress is new"},
+		},
+		{
+			name:     "no changes",
+			policies: []coreconfig.FeedsManagerApprovalPolicy{adapterURLs, pipelines},
+			jobType:  job.FluxMonitor,
+			want:     feeds.PolicyEvaluation{Reason: "no approval policy matched"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, feeds.EvaluateApprovalPolicies(tc.policies, tc.jobType, tc.diff))
+		})
+	}
+}
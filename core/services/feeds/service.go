@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	coreconfig "github.com/smartcontractkit/chainlink/v2/core/config"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/plugins"
 
@@ -106,6 +107,7 @@ type Service interface {
 	CountJobProposalsByStatus(ctx context.Context) (*JobProposalCounts, error)
 	GetJobProposal(ctx context.Context, id int64) (*JobProposal, error)
 	ListJobProposalsByManagersIDs(ctx context.Context, ids []int64) ([]JobProposal, error)
+	ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalPolicyDecision, error)
 
 	ApproveSpec(ctx context.Context, id int64, force bool) error
 	CancelSpec(ctx context.Context, id int64) error
@@ -132,6 +134,7 @@ type service struct {
 	jobSpawner          job.Spawner
 	gCfg                GeneralConfig
 	featCfg             FeatureConfig
+	feedsManagerCfg     FeedsManagerConfig
 	insecureCfg         InsecureConfig
 	jobCfg              JobConfig
 	ocrCfg              OCRConfig
@@ -152,6 +155,7 @@ func NewService(
 	keyStore keystore.Master,
 	gCfg GeneralConfig,
 	fCfg FeatureConfig,
+	fmCfg FeedsManagerConfig,
 	insecureCfg InsecureConfig,
 	jobCfg JobConfig,
 	ocrCfg OCRConfig,
@@ -174,6 +178,7 @@ func NewService(
 		workflowKeyStore:    keyStore.Workflow(),
 		gCfg:                gCfg,
 		featCfg:             fCfg,
+		feedsManagerCfg:     fmCfg,
 		insecureCfg:         insecureCfg,
 		jobCfg:              jobCfg,
 		ocrCfg:              ocrCfg,
@@ -478,6 +483,12 @@ func (s *service) ListJobProposalsByManagersIDs(ctx context.Context, ids []int64
 	return s.orm.ListJobProposalsByManagersIDs(ctx, ids)
 }
 
+// ListPolicyDecisionsByJobProposalIDs lists the approval policy decisions on
+// the specs of the job proposals.
+func (s *service) ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalPolicyDecision, error) {
+	return s.orm.ListPolicyDecisionsByJobProposalIDs(ctx, ids)
+}
+
 // DeleteJobArgs are the arguments to provide to the DeleteJob method.
 type DeleteJobArgs struct {
 	FeedsManagerID int64
@@ -703,6 +714,8 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	} else {
 		// Track the given job proposal request
 		promJobProposalRequest.Inc()
+
+		s.applyApprovalPolicies(ctx, logger, id, specID, args.Spec)
 	}
 
 	if err = s.observeJobProposalCounts(ctx); err != nil {
@@ -712,6 +725,76 @@ func (s *service) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64,
 	return id, nil
 }
 
+// applyApprovalPolicies evaluates the approval policies against the diff of
+// the proposed spec from the running spec of the job proposal, approves the
+// spec if the policies allow it, and records the decision. Failures leave the
+// spec pending for manual approval rather than failing the proposal.
+func (s *service) applyApprovalPolicies(ctx context.Context, logger logger.Logger, jpID int64, specID int64, definition string) {
+	policies := s.feedsManagerCfg.ApprovalPolicies()
+	if len(policies) == 0 {
+		return
+	}
+
+	decision := JobProposalPolicyDecision{
+		JobProposalID:     jpID,
+		JobProposalSpecID: specID,
+		Decision:          PolicyDecisionManualApproval,
+	}
+
+	evaluation, hasApprovedSpec, err := s.evaluateApprovalPolicies(ctx, policies, jpID, definition)
+	if err != nil {
+		logger.Errorw("Failed to evaluate approval policies", "err", err)
+		decision.Reason = fmt.Sprintf("failed to evaluate approval policies: %v", err)
+	} else {
+		decision.Policy = evaluation.Policy
+		decision.Reason = evaluation.Reason
+	}
+
+	if err == nil && evaluation.AutoApprove {
+		// Only replace the running job of this proposal, so that a job
+		// created outside of it is never removed without the node op.
+		if err = s.ApproveSpec(ctx, specID, hasApprovedSpec); err != nil {
+			logger.Errorw("Failed to auto approve job proposal spec", "policy", evaluation.Policy, "err", err)
+			decision.Decision = PolicyDecisionAutoApprovalFailed
+			decision.Reason = fmt.Sprintf("%s, but approval failed: %v", evaluation.Reason, err)
+		} else {
+			logger.Infow("Auto approved job proposal spec", "policy", evaluation.Policy)
+			decision.Decision = PolicyDecisionAutoApproved
+		}
+	}
+
+	if _, err = s.orm.CreatePolicyDecision(ctx, decision); err != nil {
+		logger.Errorw("Failed to record approval policy decision", "err", err)
+	}
+}
+
+// evaluateApprovalPolicies evaluates the policies against the diff of the
+// proposed spec from the approved spec of the job proposal, if any, and
+// returns whether there is an approved spec.
+func (s *service) evaluateApprovalPolicies(ctx context.Context, policies []coreconfig.FeedsManagerApprovalPolicy, jpID int64, definition string) (PolicyEvaluation, bool, error) {
+	jobType, err := job.ValidateSpec(definition)
+	if err != nil {
+		return PolicyEvaluation{}, false, errors.Wrap(err, "invalid job spec")
+	}
+
+	var running string
+	approved, err := s.orm.GetApprovedSpec(ctx, jpID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return PolicyEvaluation{}, false, err
+		}
+	} else {
+		running = approved.Definition
+	}
+
+	diff, err := DiffSpecs(running, definition)
+	if err != nil {
+		return PolicyEvaluation{}, false, err
+	}
+
+	return EvaluateApprovalPolicies(policies, jobType, diff), running != "", nil
+}
+
 func isWFSpec(lggr logger.Logger, spec string) bool {
 	jobType, err := job.ValidateSpec(spec)
 	if err != nil {
@@ -1603,6 +1686,9 @@ func (ns NullService) ListJobProposals(ctx context.Context) ([]JobProposal, erro
 func (ns NullService) ListJobProposalsByManagersIDs(ctx context.Context, ids []int64) ([]JobProposal, error) {
 	return nil, ErrFeedsManagerDisabled
 }
+func (ns NullService) ListPolicyDecisionsByJobProposalIDs(ctx context.Context, ids []int64) ([]JobProposalPolicyDecision, error) {
+	return nil, ErrFeedsManagerDisabled
+}
 func (ns NullService) ProposeJob(ctx context.Context, args *ProposeJobArgs) (int64, error) {
 	return 0, ErrFeedsManagerDisabled
 }
@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"math/big"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	evmbig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
+	"github.com/smartcontractkit/chainlink/v2/core/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
@@ -233,7 +235,7 @@ func setupTestServiceCfg(t *testing.T, overrideCfg func(c *chainlink.Config, s *
 	keyStore.On("OCR").Return(ocr1Keystore)
 	keyStore.On("OCR2").Return(ocr2Keystore)
 	keyStore.On("Workflow").Return(workflowKeystore)
-	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Feature(), gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), legacyChains, lggr, "1.0.0", nil)
+	svc := feeds.NewService(orm, jobORM, db, spawner, keyStore, gcfg, gcfg.Feature(), gcfg.FeedsManager(), gcfg.Insecure(), gcfg.JobPipeline(), gcfg.OCR(), gcfg.OCR2(), legacyChains, lggr, "1.0.0", nil)
 	svc.SetConnectionsManager(connMgr)
 
 	return &TestService{
@@ -1318,6 +1320,110 @@ func Test_Service_ProposeJob(t *testing.T) {
 	}
 }
 
+func Test_Service_ProposeJob_ApprovalPolicies(t *testing.T) {
+	t.Parallel()
+
+	var (
+		jpID          = int64(1)
+		specID        = int64(100)
+		remoteUUID    = uuid.New()
+		externalJobID = uuid.New()
+		withURL       = func(url string) string {
+			spec := fmt.Sprintf(OCR2TestSpecTemplate, externalJobID, externalJobID)
+			return strings.Replace(spec, "ds1          [type=bridge name=voter_turnout];",
+				fmt.Sprintf(`ds1          [type=http method=GET url="%s"];`, url), 1)
+		}
+		runningSpec = feeds.JobProposalSpec{
+			ID:            99,
+			Definition:    withURL("https://a.example.com"),
+			Status:        feeds.SpecStatusApproved,
+			Version:       1,
+			JobProposalID: jpID,
+		}
+		jp = feeds.JobProposal{
+			ID:             jpID,
+			FeedsManagerID: 1,
+			Name:           null.StringFrom(externalJobID.String()),
+			RemoteUUID:     remoteUUID,
+			Status:         feeds.JobProposalStatusPending,
+		}
+	)
+
+	testCases := []struct {
+		name         string
+		spec         string
+		before       func(svc *TestService)
+		wantDecision feeds.JobProposalPolicyDecision
+	}{
+		{
+			name: "new contract requires manual approval",
+			spec: withURL("https://a.example.com"),
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, remoteUUID).Return(new(feeds.JobProposal), sql.ErrNoRows)
+				svc.orm.On("GetApprovedSpec", mock.Anything, jpID).Return(new(feeds.JobProposalSpec), errors.Wrap(sql.ErrNoRows, "GetApprovedSpec failed"))
+			},
+			wantDecision: feeds.JobProposalPolicyDecision{
+				Policy:   "new-contracts",
+				Decision: feeds.PolicyDecisionManualApproval,
+				Reason:   "the contract address contractID is new",
+			},
+		},
+		{
+			name: "adapter URL change is auto approved",
+			spec: withURL("https://b.example.com"),
+			before: func(svc *TestService) {
+				svc.orm.On("GetJobProposalByRemoteUUID", mock.Anything, remoteUUID).Return(&jp, nil)
+				svc.orm.On("ExistsSpecByJobProposalIDAndVersion", mock.Anything, jpID, int32(2)).Return(false, nil)
+				svc.orm.On("GetApprovedSpec", mock.Anything, jpID).Return(&runningSpec, nil)
+				svc.orm.On("GetSpec", mock.Anything, specID).Return(nil, errors.New("orm error"))
+			},
+			wantDecision: feeds.JobProposalPolicyDecision{
+				Policy:   "adapter-urls",
+				Decision: feeds.PolicyDecisionAutoApprovalFailed,
+				Reason:   "only the URLs of http tasks changed, but approval failed: orm: job proposal spec: orm error",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			svc := setupTestServiceCfg(t, func(c *chainlink.Config, s *chainlink.Secrets) {
+				c.OCR2.Enabled = testutils.Ptr(true)
+				c.FeedsManager.ApprovalPolicies = []toml.FeedsManagerApprovalPolicy{
+					{Name: testutils.Ptr("adapter-urls"), Action: testutils.Ptr("AutoApprove"), Condition: testutils.Ptr("OnlyAdapterURLsChanged")},
+					{Name: testutils.Ptr("new-contracts"), Action: testutils.Ptr("RequireManual"), Condition: testutils.Ptr("NewContractAddress")},
+				}
+			})
+			tc.before(svc)
+			svc.orm.On("UpsertJobProposal", mock.Anything, mock.Anything).Return(jpID, nil)
+			svc.orm.On("CreateSpec", mock.Anything, mock.Anything).Return(specID, nil)
+			svc.orm.On("CountJobProposalsByStatus", mock.Anything).Return(&feeds.JobProposalCounts{}, nil)
+			transactCall := svc.orm.On("Transact", mock.Anything, mock.Anything)
+			transactCall.Run(func(args mock.Arguments) {
+				fn := args[1].(func(orm feeds.ORM) error)
+				transactCall.ReturnArguments = mock.Arguments{fn(svc.orm)}
+			})
+			want := tc.wantDecision
+			want.JobProposalID = jpID
+			want.JobProposalSpecID = specID
+			svc.orm.On("CreatePolicyDecision", mock.Anything, want).Return(int64(1), nil)
+
+			actual, err := svc.ProposeJob(testutils.Context(t), &feeds.ProposeJobArgs{
+				FeedsManagerID: 1,
+				RemoteUUID:     remoteUUID,
+				Spec:           tc.spec,
+				Version:        2,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, jpID, actual)
+		})
+	}
+}
+
 func Test_Service_DeleteJob(t *testing.T) {
 	t.Parallel()
 
@@ -1086,6 +1086,19 @@ func Test_FindJobs(t *testing.T) {
 			assert.Equal(t, exp.ID, jobs[i].ID)
 		}
 	})
+
+	t.Run("jobs are filtered by type", func(t *testing.T) {
+		jobs, count, err2 := orm.FindJobsByTypes(testutils.Context(t), []job.Type{job.OffchainReporting}, 0, 2)
+		require.NoError(t, err2)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, 1, count)
+		assert.Equal(t, jb1.ID, jobs[0].ID)
+
+		jobs, count, err2 = orm.FindJobsByTypes(testutils.Context(t), []job.Type{job.Cron}, 0, 2)
+		require.NoError(t, err2)
+		require.Empty(t, jobs)
+		assert.Equal(t, 0, count)
+	})
 }
 
 func Test_FindJob(t *testing.T) {
@@ -1683,6 +1696,7 @@ func Test_FindPipelineRunByID(t *testing.T) {
 		// Test preloaded pipeline spec
 		assert.Equal(t, jb.PipelineSpec.ID, actualRun.PipelineSpec.ID)
 		assert.Equal(t, jb.ID, actualRun.PipelineSpec.JobID)
+		assert.Equal(t, string(jb.Type), actualRun.PipelineSpec.JobType)
 	})
 }
 
@@ -957,6 +957,74 @@ func (_c *ORM_FindJobsByPipelineSpecIDs_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// FindJobsByTypes provides a mock function with given fields: ctx, types, offset, limit
+func (_m *ORM) FindJobsByTypes(ctx context.Context, types []job.Type, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(ctx, types, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindJobsByTypes")
+	}
+
+	var r0 []job.Job
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []job.Type, int, int) ([]job.Job, int, error)); ok {
+		return rf(ctx, types, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []job.Type, int, int) []job.Job); ok {
+		r0 = rf(ctx, types, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []job.Type, int, int) int); ok {
+		r1 = rf(ctx, types, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []job.Type, int, int) error); ok {
+		r2 = rf(ctx, types, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ORM_FindJobsByTypes_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindJobsByTypes'
+type ORM_FindJobsByTypes_Call struct {
+	*mock.Call
+}
+
+// FindJobsByTypes is a helper method to define mock.On call
+//   - ctx context.Context
+//   - types []job.Type
+//   - offset int
+//   - limit int
+func (_e *ORM_Expecter) FindJobsByTypes(ctx interface{}, types interface{}, offset interface{}, limit interface{}) *ORM_FindJobsByTypes_Call {
+	return &ORM_FindJobsByTypes_Call{Call: _e.mock.On("FindJobsByTypes", ctx, types, offset, limit)}
+}
+
+func (_c *ORM_FindJobsByTypes_Call) Run(run func(ctx context.Context, types []job.Type, offset int, limit int)) *ORM_FindJobsByTypes_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]job.Type), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *ORM_FindJobsByTypes_Call) Return(_a0 []job.Job, _a1 int, _a2 error) *ORM_FindJobsByTypes_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *ORM_FindJobsByTypes_Call) RunAndReturn(run func(context.Context, []job.Type, int, int) ([]job.Job, int, error)) *ORM_FindJobsByTypes_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindOCR2JobIDByAddress provides a mock function with given fields: ctx, contractID, feedID
 func (_m *ORM) FindOCR2JobIDByAddress(ctx context.Context, contractID string, feedID *common.Hash) (int32, error) {
 	ret := _m.Called(ctx, contractID, feedID)
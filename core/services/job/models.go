@@ -229,6 +229,22 @@ func (j *Job) SetID(value string) error {
 	return nil
 }
 
+// DryRunPipelineSpec returns the pipeline spec of a job which has not been
+// saved, for executing its pipeline in memory.
+func (j Job) DryRunPipelineSpec() pipeline.Spec {
+	spec := pipeline.Spec{
+		DotDagSource:      j.Pipeline.Source,
+		MaxTaskDuration:   j.MaxTaskDuration,
+		JobName:           j.Name.ValueOrZero(),
+		JobType:           string(j.Type),
+		ForwardingAllowed: j.ForwardingAllowed,
+	}
+	if j.GasLimit.Valid {
+		spec.GasLimit = &j.GasLimit.Uint32
+	}
+	return spec
+}
+
 type PipelineSpec struct {
 	JobID          int32 `json:"-"`
 	PipelineSpecID int32 `json:"-"`
@@ -46,6 +46,8 @@ type ORM interface {
 	InsertJob(ctx context.Context, job *Job) error
 	CreateJob(ctx context.Context, jb *Job) error
 	FindJobs(ctx context.Context, offset, limit int) ([]Job, int, error)
+	// FindJobsByTypes is like FindJobs, but only finds jobs of the given types.
+	FindJobsByTypes(ctx context.Context, types []Type, offset, limit int) ([]Job, int, error)
 	FindJob(ctx context.Context, id int32) (Job, error)
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
 	FindJobIDByAddress(ctx context.Context, address evmtypes.EIP55Address, evmChainID *big.Big) (int32, error)
@@ -872,6 +874,38 @@ func (o *orm) FindJobs(ctx context.Context, offset, limit int) (jobs []Job, coun
 	return jobs, count, err
 }
 
+func (o *orm) FindJobsByTypes(ctx context.Context, types []Type, offset, limit int) (jobs []Job, count int, err error) {
+	typeNames := make([]string, len(types))
+	for i, t := range types {
+		typeNames[i] = t.String()
+	}
+	err = o.transact(ctx, false, func(tx *orm) error {
+		sql := `SELECT count(*) FROM jobs WHERE type = ANY($1);`
+		err = tx.ds.QueryRowxContext(ctx, sql, pq.Array(typeNames)).Scan(&count)
+		if err != nil {
+			return fmt.Errorf("failed to query jobs count: %w", err)
+		}
+
+		sql = `SELECT jobs.*, job_pipeline_specs.pipeline_spec_id as pipeline_spec_id
+			FROM jobs
+			    JOIN job_pipeline_specs ON (jobs.id = job_pipeline_specs.job_id)
+			WHERE jobs.type = ANY($1)
+			ORDER BY jobs.created_at DESC, jobs.id DESC OFFSET $2 LIMIT $3;`
+		err = tx.ds.SelectContext(ctx, &jobs, sql, pq.Array(typeNames), offset, limit)
+		if err != nil {
+			return fmt.Errorf("failed to select jobs: %w", err)
+		}
+
+		err = tx.loadAllJobsTypes(ctx, jobs)
+		if err != nil {
+			return fmt.Errorf("failed to load job types: %w", err)
+		}
+
+		return nil
+	})
+	return jobs, count, err
+}
+
 func LoadDefaultVRFPollPeriod(vrfs VRFSpec) *VRFSpec {
 	if vrfs.PollPeriod == 0 {
 		vrfs.PollPeriod = 5 * time.Second
@@ -1386,7 +1420,7 @@ func (o *orm) loadPipelineRunsRelations(ctx context.Context, runs []pipeline.Run
 	for specID := range specM {
 		specIDs = append(specIDs, specID)
 	}
-	stmt := `SELECT pipeline_specs.*, job_pipeline_specs.job_id AS job_id FROM pipeline_specs JOIN job_pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id WHERE pipeline_specs.id = ANY($1);`
+	stmt := `SELECT pipeline_specs.*, job_pipeline_specs.job_id AS job_id, jobs.type AS job_type FROM pipeline_specs JOIN job_pipeline_specs ON pipeline_specs.id = job_pipeline_specs.pipeline_spec_id JOIN jobs ON jobs.id = job_pipeline_specs.job_id WHERE pipeline_specs.id = ANY($1);`
 	var specs []pipeline.Spec
 	if err := o.ds.SelectContext(ctx, &specs, stmt, specIDs); err != nil {
 		return nil, errors.Wrap(err, "error loading specs")
@@ -86,7 +86,7 @@ func TestRunner(t *testing.T) {
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, Client: ethClient, GeneralConfig: config, KeyStore: ethKeyStore})
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
 
-	runner := pipeline.NewRunner(pipelineORM, btORM, config.JobPipeline(), config.WebServer(), legacyChains, nil, nil, logger.TestLogger(t), c, c)
+	runner := pipeline.NewRunner(pipelineORM, btORM, config.JobPipeline(), config.WebServer(), legacyChains, nil, nil, nil, logger.TestLogger(t), c, c)
 	jobORM := NewTestORM(t, db, pipelineORM, btORM, keyStore)
 	t.Cleanup(func() { assert.NoError(t, jobORM.Close()) })
 
@@ -34,6 +34,7 @@ type Eth interface {
 	Add(ctx context.Context, address common.Address, chainID *big.Int) error
 
 	EnsureKeys(ctx context.Context, chainIDs ...*big.Int) error
+	UseSigner(ctx context.Context, signer EthSigner) error
 	SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, unsub func())
 
 	SignTx(ctx context.Context, fromAddress common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error)
@@ -59,6 +60,8 @@ type eth struct {
 	ds            sqlutil.DataSource
 	subscribers   [](chan struct{})
 	subscribersMu *sync.RWMutex
+	// signer signs with the external keys, see UseSigner
+	signer EthSigner
 }
 
 var _ Eth = &eth{}
@@ -135,6 +138,16 @@ func (ks *eth) EnsureKeys(ctx context.Context, chainIDs ...*big.Int) (err error)
 		if len(keys) > 0 {
 			continue
 		}
+		if externalKeys := ks.externalKeys(); len(externalKeys) > 0 {
+			// Keys are kept in the external signer, rather than created in the keystore
+			for _, key := range externalKeys {
+				if err = ks.addKey(ctx, nil, key.Address, chainID); err != nil {
+					return fmt.Errorf("failed to add external key %s for chain %s: %w", key.Address, chainID, err)
+				}
+				ks.logger.Infow(fmt.Sprintf("Added external EVM key with ID %s", key.Address.Hex()), "address", key.Address.Hex(), "evmChainID", chainID)
+			}
+			continue
+		}
 		newKey, err := ethkey.NewV2()
 		if err != nil {
 			return err
@@ -149,6 +162,45 @@ func (ks *eth) EnsureKeys(ctx context.Context, chainIDs ...*big.Int) (err error)
 	return nil
 }
 
+// UseSigner registers the keys held by the external signer, which signs for them. They are listed, enabled and
+// disabled like the keys of the keystore, but only their addresses are known, so they can be neither exported nor
+// deleted. The external keys aren't saved in the key ring, UseSigner must be called again each time the keystore is
+// unlocked.
+func (ks *eth) UseSigner(ctx context.Context, signer EthSigner) error {
+	addresses, err := signer.Accounts(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to list the keys of the external signer")
+	}
+	ks.lock.Lock()
+	defer ks.lock.Unlock()
+	if ks.isLocked() {
+		return ErrLocked
+	}
+	for _, address := range addresses {
+		if key, found := ks.keyRing.Eth[address.Hex()]; found && !key.IsExternal() {
+			return errors.Errorf("key %s is held by both the keystore and the external signer", address.Hex())
+		}
+	}
+	for _, address := range addresses {
+		ks.keyRing.Eth[address.Hex()] = ethkey.NewExternalV2(address)
+	}
+	ks.signer = signer
+	ks.notify()
+	ks.logger.Infow(fmt.Sprintf("Using external signer for %d EVM keys", len(addresses)), "addresses", addresses)
+	return nil
+}
+
+// caller must hold lock!
+func (ks *eth) externalKeys() (keys []ethkey.KeyV2) {
+	for _, key := range ks.keyRing.Eth {
+		if key.IsExternal() {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i].Cmp(keys[j]) < 0 })
+	return
+}
+
 func (ks *eth) Import(ctx context.Context, keyJSON []byte, password string, chainIDs ...*big.Int) (ethkey.KeyV2, error) {
 	ks.lock.Lock()
 	defer ks.lock.Unlock()
@@ -181,6 +233,9 @@ func (ks *eth) Export(ctx context.Context, id string, password string) ([]byte,
 	if err != nil {
 		return nil, err
 	}
+	if key.IsExternal() {
+		return nil, ErrExternalKey
+	}
 	return key.ToEncryptedJSON(password, ks.scryptParams)
 }
 
@@ -281,6 +336,9 @@ func (ks *eth) Delete(ctx context.Context, id string) (ethkey.KeyV2, error) {
 	if err != nil {
 		return ethkey.KeyV2{}, err
 	}
+	if key.IsExternal() {
+		return ethkey.KeyV2{}, ErrExternalKey
+	}
 	err = ks.safeRemoveKey(ctx, key, func(ds sqlutil.DataSource) error {
 		_, err2 := ds.ExecContext(ctx, `DELETE FROM evm.key_states WHERE address = $1`, key.Address)
 		return err2
@@ -311,17 +369,49 @@ func (ks *eth) SubscribeToKeyChanges(ctx context.Context) (ch chan struct{}, uns
 }
 
 func (ks *eth) SignTx(ctx context.Context, address common.Address, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	key, externalSigner, err := ks.getSigningKey(address)
+	if err != nil {
+		return nil, err
+	}
+	signer := types.LatestSignerForChainID(chainID)
+	if !key.IsExternal() {
+		return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	}
+	sig, err := signHashExternal(ctx, externalSigner, address, signer.Hash(tx))
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// getSigningKey returns the key of address, and the external signer for external keys. The lock is only held while
+// looking up the key, so that slow external signers don't hold up the keystore.
+func (ks *eth) getSigningKey(address common.Address) (ethkey.KeyV2, EthSigner, error) {
 	ks.lock.RLock()
 	defer ks.lock.RUnlock()
 	if ks.isLocked() {
-		return nil, ErrLocked
+		return ethkey.KeyV2{}, nil, ErrLocked
 	}
-	key, err := ks.getByID(address.String())
+	key, err := ks.getByID(address.Hex())
+	if err != nil {
+		return ethkey.KeyV2{}, nil, err
+	}
+	return key, ks.signer, nil
+}
+
+// signHashExternal signs hash with the external key of address, checking the signature.
+func signHashExternal(ctx context.Context, signer EthSigner, address common.Address, hash common.Hash) ([]byte, error) {
+	if signer == nil {
+		return nil, errors.Errorf("no external signer for key %s", address.Hex())
+	}
+	sig, err := signer.SignHash(ctx, address, hash)
 	if err != nil {
 		return nil, err
 	}
-	signer := types.LatestSignerForChainID(chainID)
-	return types.SignTx(tx, signer, key.ToEcdsaPrivKey())
+	if err = verifyEthSignature(address, hash, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
 }
 
 // EnabledKeysForChain returns all keys that are enabled for the given chain
@@ -538,15 +628,13 @@ func (ks *eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 // SignMessage signs the provided message using the private key associated with the given address,
 // following the EIP-191 specific identifier (e.g., keccak256("\x19Ethereum Signed Message:\n"${message length}${message}))
 func (ks *eth) SignMessage(ctx context.Context, address common.Address, data []byte) ([]byte, error) {
-	ks.lock.RLock()
-	defer ks.lock.RUnlock()
-	if ks.isLocked() {
-		return nil, ErrLocked
-	}
-	key, err := ks.getByID(address.Hex())
+	key, externalSigner, err := ks.getSigningKey(address)
 	if err != nil {
 		return nil, err
 	}
+	if key.IsExternal() {
+		return signHashExternal(ctx, externalSigner, address, common.BytesToHash(accounts.TextHash(data)))
+	}
 	signature, err := crypto.Sign(accounts.TextHash(data), key.ToEcdsaPrivKey())
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to sign data")
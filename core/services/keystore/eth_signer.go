@@ -0,0 +1,87 @@
+package keystore
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+// EthSigner is a backend signing with Ethereum keys held outside the keystore, such as in a KMS or an HSM. The
+// private keys never enter the node, the Eth keystore only knows their addresses.
+type EthSigner interface {
+	// Accounts returns the addresses of the keys held by the signer.
+	Accounts(ctx context.Context) ([]common.Address, error)
+	// SignHash returns the 65 byte [R || S || V] secp256k1 signature of hash by the key of address, with V being 0
+	// or 1, as with crypto.Sign.
+	SignHash(ctx context.Context, address common.Address, hash common.Hash) ([]byte, error)
+}
+
+// LocalEthSigner is an EthSigner holding its keys in memory. It stands in for an external signer in development and
+// tests, and can be served with the remote signer protocol, see NewEthSignerServer.
+type LocalEthSigner struct {
+	mu   sync.RWMutex
+	keys map[common.Address]ethkey.KeyV2
+}
+
+var _ EthSigner = (*LocalEthSigner)(nil)
+
+func NewLocalEthSigner(keys ...ethkey.KeyV2) *LocalEthSigner {
+	s := &LocalEthSigner{keys: make(map[common.Address]ethkey.KeyV2, len(keys))}
+	for _, key := range keys {
+		s.keys[key.Address] = key
+	}
+	return s
+}
+
+// Create generates a new key held by the signer.
+func (s *LocalEthSigner) Create() (common.Address, error) {
+	key, err := ethkey.NewV2()
+	if err != nil {
+		return common.Address{}, err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys[key.Address] = key
+	return key.Address, nil
+}
+
+func (s *LocalEthSigner) Accounts(context.Context) ([]common.Address, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	addresses := make([]common.Address, 0, len(s.keys))
+	for address := range s.keys {
+		addresses = append(addresses, address)
+	}
+	return addresses, nil
+}
+
+func (s *LocalEthSigner) SignHash(_ context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	s.mu.RLock()
+	key, ok := s.keys[address]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, errors.Wrapf(ErrKeyNotFound, "address %s", address)
+	}
+	return crypto.Sign(hash.Bytes(), key.ToEcdsaPrivKey())
+}
+
+// verifyEthSignature checks that sig, as returned by EthSigner.SignHash, is a signature of hash by address, so that
+// a misbehaving signer can't get the node to send malformed or foreign transactions.
+func verifyEthSignature(address common.Address, hash common.Hash, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return errors.Errorf("external signer returned a signature of %d bytes, expected %d", len(sig), crypto.SignatureLength)
+	}
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return errors.Wrap(err, "external signer returned an invalid signature")
+	}
+	if signer := crypto.PubkeyToAddress(*pub); signer != address {
+		return errors.Errorf("external signer returned a signature by %s instead of %s", signer, address)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package keystore
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+)
+
+// The remote signer protocol is JSON-RPC 2.0 over HTTP, with the methods:
+//
+//	signer_accounts() -> [address]
+//	signer_signHash(address, hash) -> signature
+//
+// where the signature is hex encoded as 65 bytes [R || S || V], with V being 0 or 1. Signers such as KMS or HSM
+// gateways implement it, NewEthSignerServer implements it on top of any EthSigner.
+const ethSignerNamespace = "signer"
+
+type remoteEthSigner struct {
+	client  *rpc.Client
+	timeout time.Duration
+}
+
+var _ EthSigner = (*remoteEthSigner)(nil)
+
+// NewRemoteEthSigner returns an EthSigner forwarding to the remote signer at u, see ethSignerNamespace. Each request
+// times out after timeout.
+func NewRemoteEthSigner(ctx context.Context, u *url.URL, timeout time.Duration) (EthSigner, error) {
+	if u == nil {
+		return nil, errors.New("remote signer URL is required")
+	}
+	client, err := rpc.DialOptions(ctx, u.String(), rpc.WithHTTPClient(&http.Client{Timeout: timeout}))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to dial remote signer %s", u.Redacted())
+	}
+	return &remoteEthSigner{client: client, timeout: timeout}, nil
+}
+
+func (s *remoteEthSigner) Accounts(ctx context.Context) (addresses []common.Address, err error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	err = s.client.CallContext(ctx, &addresses, ethSignerNamespace+"_accounts")
+	return addresses, errors.Wrap(err, "remote signer failed to list accounts")
+}
+
+func (s *remoteEthSigner) SignHash(ctx context.Context, address common.Address, hash common.Hash) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	var sig hexutil.Bytes
+	if err := s.client.CallContext(ctx, &sig, ethSignerNamespace+"_signHash", address, hash); err != nil {
+		return nil, errors.Wrapf(err, "remote signer failed to sign with %s", address)
+	}
+	return sig, nil
+}
+
+// NewEthSignerServer returns an rpc.Server, which is an http.Handler, serving signer with the remote signer protocol,
+// see ethSignerNamespace. Together with a LocalEthSigner, it stands in for a remote signer in development and tests.
+func NewEthSignerServer(signer EthSigner) (*rpc.Server, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName(ethSignerNamespace, &ethSignerService{signer: signer}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// ethSignerService exposes the methods of the remote signer protocol.
+type ethSignerService struct {
+	signer EthSigner
+}
+
+func (s *ethSignerService) Accounts(ctx context.Context) ([]common.Address, error) {
+	return s.signer.Accounts(ctx)
+}
+
+func (s *ethSignerService) SignHash(ctx context.Context, address common.Address, hash common.Hash) (hexutil.Bytes, error) {
+	return s.signer.SignHash(ctx, address, hash)
+}
@@ -0,0 +1,78 @@
+package keystore_test
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
+)
+
+func TestLocalEthSigner(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	signer := keystore.NewLocalEthSigner(key)
+	created, err := signer.Create()
+	require.NoError(t, err)
+
+	addresses, err := signer.Accounts(ctx)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []common.Address{key.Address, created}, addresses)
+
+	hash := common.HexToHash("0x1234")
+	sig, err := signer.SignHash(ctx, key.Address, hash)
+	require.NoError(t, err)
+	require.Len(t, sig, crypto.SignatureLength)
+	pub, err := crypto.SigToPub(hash.Bytes(), sig)
+	require.NoError(t, err)
+	assert.Equal(t, key.Address, crypto.PubkeyToAddress(*pub))
+
+	_, err = signer.SignHash(ctx, testutils.NewAddress(), hash)
+	require.ErrorIs(t, err, keystore.ErrKeyNotFound)
+}
+
+func TestRemoteEthSigner(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	key, err := ethkey.NewV2()
+	require.NoError(t, err)
+	server, err := keystore.NewEthSignerServer(keystore.NewLocalEthSigner(key))
+	require.NoError(t, err)
+	t.Cleanup(server.Stop)
+	ts := httptest.NewServer(server)
+	t.Cleanup(ts.Close)
+	u, err := url.Parse(ts.URL)
+	require.NoError(t, err)
+
+	_, err = keystore.NewRemoteEthSigner(ctx, nil, time.Second)
+	require.EqualError(t, err, "remote signer URL is required")
+
+	signer, err := keystore.NewRemoteEthSigner(ctx, u, time.Second)
+	require.NoError(t, err)
+
+	addresses, err := signer.Accounts(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []common.Address{key.Address}, addresses)
+
+	hash := common.HexToHash("0x1234")
+	sig, err := signer.SignHash(ctx, key.Address, hash)
+	require.NoError(t, err)
+	expected, err := crypto.Sign(hash.Bytes(), key.ToEcdsaPrivKey())
+	require.NoError(t, err)
+	assert.Equal(t, expected, sig)
+
+	_, err = signer.SignHash(ctx, testutils.NewAddress(), hash)
+	require.ErrorContains(t, err, "remote signer failed to sign with")
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -876,3 +877,79 @@ func Test_EthKeyStore_Disable(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+// misbehavingEthSigner signs with a key other than the requested one
+type misbehavingEthSigner struct {
+	*keystore.LocalEthSigner
+	other common.Address
+}
+
+func (s misbehavingEthSigner) SignHash(ctx context.Context, _ common.Address, hash common.Hash) ([]byte, error) {
+	return s.LocalEthSigner.SignHash(ctx, s.other, hash)
+}
+
+func Test_EthKeyStore_UseSigner(t *testing.T) {
+	t.Parallel()
+
+	ctx := testutils.Context(t)
+
+	db := pgtest.NewSqlxDB(t)
+	keyStore := cltest.NewKeyStore(t, db)
+	ethKeyStore := keyStore.Eth()
+	chainID := testutils.FixtureChainID
+
+	signer := keystore.NewLocalEthSigner()
+	address, err := signer.Create()
+	require.NoError(t, err)
+
+	t.Run("rejects a key held by both", func(t *testing.T) {
+		k, _ := cltest.MustInsertRandomKey(t, ethKeyStore)
+		err := ethKeyStore.UseSigner(ctx, keystore.NewLocalEthSigner(k))
+		require.ErrorContains(t, err, "is held by both the keystore and the external signer")
+		_, err = ethKeyStore.Delete(ctx, k.ID())
+		require.NoError(t, err)
+	})
+
+	require.NoError(t, ethKeyStore.UseSigner(ctx, signer))
+
+	t.Run("creates key states instead of local keys", func(t *testing.T) {
+		require.NoError(t, ethKeyStore.EnsureKeys(ctx, chainID))
+		keys, err := ethKeyStore.EnabledKeysForChain(ctx, chainID)
+		require.NoError(t, err)
+		require.Len(t, keys, 1)
+		assert.Equal(t, address, keys[0].Address)
+		assert.True(t, keys[0].IsExternal())
+	})
+
+	t.Run("signs transactions and messages", func(t *testing.T) {
+		tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		signed, err := ethKeyStore.SignTx(ctx, address, tx, chainID)
+		require.NoError(t, err)
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signed)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+
+		message := []byte("this is a message")
+		sig, err := ethKeyStore.SignMessage(ctx, address, message)
+		require.NoError(t, err)
+		pub, err := crypto.SigToPub(accounts.TextHash(message), sig)
+		require.NoError(t, err)
+		assert.Equal(t, address, crypto.PubkeyToAddress(*pub))
+	})
+
+	t.Run("refuses to export or delete external keys", func(t *testing.T) {
+		_, err := ethKeyStore.Export(ctx, address.Hex(), cltest.Password)
+		require.ErrorIs(t, err, keystore.ErrExternalKey)
+		_, err = ethKeyStore.Delete(ctx, address.Hex())
+		require.ErrorIs(t, err, keystore.ErrExternalKey)
+	})
+
+	t.Run("rejects signatures by another key", func(t *testing.T) {
+		other, err := signer.Create()
+		require.NoError(t, err)
+		require.NoError(t, ethKeyStore.UseSigner(ctx, misbehavingEthSigner{LocalEthSigner: signer, other: other}))
+		tx := cltest.NewLegacyTransaction(0, testutils.NewAddress(), big.NewInt(53), 21000, big.NewInt(1000000000), []byte{1, 2, 3, 4})
+		_, err = ethKeyStore.SignTx(ctx, address, tx, chainID)
+		require.ErrorContains(t, err, "external signer returned a signature by")
+	})
+}
@@ -46,6 +46,7 @@ type KeyV2 struct {
 	Address      common.Address
 	EIP55Address types.EIP55Address
 	privateKey   *ecdsa.PrivateKey
+	external     bool
 }
 
 func NewV2() (KeyV2, error) {
@@ -66,6 +67,21 @@ func FromPrivateKey(privKey *ecdsa.PrivateKey) (key KeyV2) {
 	}
 }
 
+// NewExternalV2 returns a key held by an external signer, such as a KMS or an HSM. Only its address is known, it
+// has no private key.
+func NewExternalV2(address common.Address) KeyV2 {
+	return KeyV2{
+		Address:      address,
+		EIP55Address: types.EIP55AddressFromAddress(address),
+		external:     true,
+	}
+}
+
+// IsExternal returns whether the key is held by an external signer, see NewExternalV2.
+func (key KeyV2) IsExternal() bool {
+	return key.external
+}
+
 func (key KeyV2) ID() string {
 	return key.Address.Hex()
 }
@@ -29,6 +29,10 @@ var (
 	ErrLocked      = errors.New("Keystore is locked")
 	ErrKeyNotFound = errors.New("Key not found")
 	ErrKeyExists   = errors.New("Key already exists")
+
+	ErrPasswordMismatch    = errors.New("Keystore password does not match")
+	ErrExternalKey         = errors.New("Key is held by the external signer")
+	ErrInvalidScryptParams = errors.New("Invalid scrypt parameters")
 )
 
 // DefaultEVMChainIDFunc is a func for getting a default evm chain ID -
@@ -48,6 +52,8 @@ type Master interface {
 	VRF() VRF
 	Workflow() Workflow
 	Unlock(ctx context.Context, password string) error
+	// ChangePassword re-encrypts all the keys with newPassword and scryptParams, see keyManager.ChangePassword.
+	ChangePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams, callbacks ...func(sqlutil.DataSource) error) error
 	IsEmpty(ctx context.Context) (bool, error)
 }
 
@@ -185,6 +191,10 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	}
 	kr.logPubKeys(km.logger)
 	km.keyRing = kr
+	// Keep the stronger scrypt parameters the key ring was re-encrypted with, see ChangePassword
+	if params, ok := ekr.scryptParams(); ok && params.N >= km.scryptParams.N && params.P >= km.scryptParams.P {
+		km.scryptParams = params
+	}
 
 	ks, err := km.keystateORM.loadKeyStates(ctx)
 	if err != nil {
@@ -196,6 +206,75 @@ func (km *keyManager) Unlock(ctx context.Context, password string) error {
 	return nil
 }
 
+// ChangePassword re-encrypts the key ring, and so the keys of every type, with newPassword and scryptParams, which
+// must not be weaker than the current ones. Zero scryptParams fields keep the current values, and newPassword may only be
+// oldPassword when scryptParams are stronger. The re-encrypted key ring is verified to decrypt with newPassword to the
+// same keys before it is saved. The callbacks run in the same transaction, to re-encrypt anything else protected by
+// the keystore password. On failure the transaction is rolled back, and the keystore keeps using oldPassword.
+func (km *keyManager) ChangePassword(ctx context.Context, oldPassword, newPassword string, scryptParams utils.ScryptParams, callbacks ...func(sqlutil.DataSource) error) error {
+	km.lock.Lock()
+	defer km.lock.Unlock()
+	if km.isLocked() {
+		return ErrLocked
+	}
+	if oldPassword != km.password {
+		return ErrPasswordMismatch
+	}
+	if scryptParams.N == 0 {
+		scryptParams.N = km.scryptParams.N
+	}
+	if scryptParams.P == 0 {
+		scryptParams.P = km.scryptParams.P
+	}
+	if err := validateScryptParams(scryptParams, km.scryptParams); err != nil {
+		return err
+	}
+	if newPassword == oldPassword && scryptParams == km.scryptParams {
+		return errors.New("new keystore password must differ from the current one, or the scrypt parameters must be stronger")
+	}
+	if err := utils.VerifyPasswordComplexity(newPassword); err != nil {
+		return err
+	}
+
+	ekr, err := km.keyRing.Encrypt(newPassword, scryptParams)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt keyRing")
+	}
+	kr, err := ekr.Decrypt(newPassword)
+	if err != nil {
+		return errors.Wrap(err, "unable to decrypt keyRing with the new password")
+	}
+	// External keys aren't saved, compare with the keys as saved
+	saved, err := km.keyRing.raw().keys()
+	if err != nil {
+		return err
+	}
+	if !kr.hasSameKeys(saved) {
+		return errors.New("re-encrypted keyRing does not hold the same keys")
+	}
+	if err = km.orm.saveEncryptedKeyRing(ctx, &ekr, callbacks...); err != nil {
+		return errors.Wrap(err, "failed to change keystore password")
+	}
+	km.password = newPassword
+	km.scryptParams = scryptParams
+	km.logger.Infow("Keystore password changed", "scryptN", scryptParams.N, "scryptP", scryptParams.P)
+	return nil
+}
+
+// validateScryptParams checks params are valid scrypt parameters, and not weaker than current.
+func validateScryptParams(params, current utils.ScryptParams) error {
+	if params.N <= 1 || params.N&(params.N-1) != 0 {
+		return errors.Wrapf(ErrInvalidScryptParams, "N must be a power of 2 greater than 1, got %d", params.N)
+	}
+	if params.P < 1 {
+		return errors.Wrapf(ErrInvalidScryptParams, "P must be at least 1, got %d", params.P)
+	}
+	if params.N < current.N || params.P < current.P {
+		return errors.Wrapf(ErrInvalidScryptParams, "N=%d P=%d are weaker than the current N=%d P=%d", params.N, params.P, current.N, current.P)
+	}
+	return nil
+}
+
 // caller must hold lock!
 func (km *keyManager) save(ctx context.Context, callbacks ...func(sqlutil.DataSource) error) error {
 	ekb, err := km.keyRing.Encrypt(km.password, km.scryptParams)
@@ -1,15 +1,18 @@
 package keystore_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 func TestMasterKeystore_Unlock_Save(t *testing.T) {
@@ -64,3 +67,92 @@ func TestMasterKeystore_Unlock_Save(t *testing.T) {
 		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
 	})
 }
+
+func TestMasterKeystore_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewSqlxDB(t)
+
+	keyStore := keystore.ExposedNewMaster(t, db)
+	const newPassword = "n3wP4sSw0rd-16char!@#_"
+	reset := func() {
+		keyStore.ResetXXXTestOnly()
+		_, err := db.Exec("DELETE FROM encrypted_key_rings")
+		require.NoError(t, err)
+	}
+
+	t.Run("re-encrypts all the keys with the new password", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+		p2pKey, err := keyStore.P2P().Create(ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, keyStore.ChangePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{}))
+
+		keyStore.ResetXXXTestOnly()
+		require.Error(t, keyStore.Unlock(ctx, cltest.Password))
+		require.NoError(t, keyStore.Unlock(ctx, newPassword))
+		_, err = keyStore.Eth().Get(ctx, ethKey.Address.Hex())
+		require.NoError(t, err)
+		_, err = keyStore.P2P().Get(p2pKey.PeerID())
+		require.NoError(t, err)
+	})
+
+	t.Run("rolls back if a callback fails", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+		err := keyStore.ChangePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{}, func(sqlutil.DataSource) error {
+			return errors.New("boom")
+		})
+		require.ErrorContains(t, err, "boom")
+
+		// still usable with the old password, in memory and in the DB
+		_, err = keyStore.P2P().Create(ctx)
+		require.NoError(t, err)
+		keyStore.ResetXXXTestOnly()
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+	})
+
+	t.Run("errors if the old password does not match", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		require.ErrorIs(t, keyStore.ChangePassword(ctx, newPassword, cltest.Password, utils.ScryptParams{}), keystore.ErrPasswordMismatch)
+	})
+
+	t.Run("errors if the new password is too weak", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		require.Error(t, keyStore.ChangePassword(ctx, cltest.Password, "password", utils.ScryptParams{}))
+	})
+
+	t.Run("re-encrypts the keys with stronger scrypt parameters", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.NoError(t, keyStore.Unlock(ctx, cltest.Password))
+		ethKey, _ := cltest.MustInsertRandomKey(t, keyStore.Eth())
+
+		stronger := utils.ScryptParams{N: utils.FastScryptParams.N * 2, P: utils.FastScryptParams.P}
+		require.ErrorIs(t, keyStore.ChangePassword(ctx, cltest.Password, cltest.Password, utils.ScryptParams{N: 3}), keystore.ErrInvalidScryptParams)
+		require.NoError(t, keyStore.ChangePassword(ctx, cltest.Password, cltest.Password, stronger))
+
+		// the stronger parameters are kept after a restart, and can't be lowered
+		restarted := keystore.ExposedNewMaster(t, db)
+		require.NoError(t, restarted.Unlock(ctx, cltest.Password))
+		_, err := restarted.Eth().Get(ctx, ethKey.Address.Hex())
+		require.NoError(t, err)
+		require.ErrorIs(t, restarted.ChangePassword(ctx, cltest.Password, newPassword, utils.FastScryptParams), keystore.ErrInvalidScryptParams)
+	})
+
+	t.Run("errors if the keystore is locked", func(t *testing.T) {
+		defer reset()
+		ctx := testutils.Context(t)
+		require.ErrorIs(t, keyStore.ChangePassword(ctx, cltest.Password, newPassword, utils.ScryptParams{}), keystore.ErrLocked)
+	})
+}
@@ -10,6 +10,8 @@ import (
 
 	ethkey "github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/ethkey"
 
+	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+
 	mock "github.com/stretchr/testify/mock"
 
 	types "github.com/ethereum/go-ethereum/core/types"
@@ -1263,6 +1265,53 @@ func (_c *Eth_SubscribeToKeyChanges_Call) RunAndReturn(run func(context.Context)
 	return _c
 }
 
+// UseSigner provides a mock function with given fields: ctx, signer
+func (_m *Eth) UseSigner(ctx context.Context, signer keystore.EthSigner) error {
+	ret := _m.Called(ctx, signer)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UseSigner")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, keystore.EthSigner) error); ok {
+		r0 = rf(ctx, signer)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Eth_UseSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UseSigner'
+type Eth_UseSigner_Call struct {
+	*mock.Call
+}
+
+// UseSigner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signer keystore.EthSigner
+func (_e *Eth_Expecter) UseSigner(ctx interface{}, signer interface{}) *Eth_UseSigner_Call {
+	return &Eth_UseSigner_Call{Call: _e.mock.On("UseSigner", ctx, signer)}
+}
+
+func (_c *Eth_UseSigner_Call) Run(run func(ctx context.Context, signer keystore.EthSigner)) *Eth_UseSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(keystore.EthSigner))
+	})
+	return _c
+}
+
+func (_c *Eth_UseSigner_Call) Return(_a0 error) *Eth_UseSigner_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Eth_UseSigner_Call) RunAndReturn(run func(context.Context, keystore.EthSigner) error) *Eth_UseSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // XXXTestingOnlyAdd provides a mock function with given fields: ctx, key
 func (_m *Eth) XXXTestingOnlyAdd(ctx context.Context, key ethkey.KeyV2) {
 	_m.Called(ctx, key)
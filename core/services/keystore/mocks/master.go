@@ -5,8 +5,12 @@ package mocks
 import (
 	context "context"
 
+	sqlutil "github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
 	keystore "github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	mock "github.com/stretchr/testify/mock"
+
+	utils "github.com/smartcontractkit/chainlink/v2/core/utils"
 )
 
 // Master is an autogenerated mock type for the Master type
@@ -116,6 +120,70 @@ func (_c *Master_CSA_Call) RunAndReturn(run func() keystore.CSA) *Master_CSA_Cal
 	return _c
 }
 
+// ChangePassword provides a mock function with given fields: ctx, oldPassword, newPassword, scryptParams, callbacks
+func (_m *Master) ChangePassword(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams, callbacks ...func(sqlutil.DataSource) error) error {
+	_va := make([]interface{}, len(callbacks))
+	for _i := range callbacks {
+		_va[_i] = callbacks[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, oldPassword, newPassword, scryptParams)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, utils.ScryptParams, ...func(sqlutil.DataSource) error) error); ok {
+		r0 = rf(ctx, oldPassword, newPassword, scryptParams, callbacks...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Master_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type Master_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldPassword string
+//   - newPassword string
+//   - scryptParams utils.ScryptParams
+//   - callbacks ...func(sqlutil.DataSource) error
+func (_e *Master_Expecter) ChangePassword(ctx interface{}, oldPassword interface{}, newPassword interface{}, scryptParams interface{}, callbacks ...interface{}) *Master_ChangePassword_Call {
+	return &Master_ChangePassword_Call{Call: _e.mock.On("ChangePassword",
+		append([]interface{}{ctx, oldPassword, newPassword, scryptParams}, callbacks...)...)}
+}
+
+func (_c *Master_ChangePassword_Call) Run(run func(ctx context.Context, oldPassword string, newPassword string, scryptParams utils.ScryptParams, callbacks ...func(sqlutil.DataSource) error)) *Master_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]func(sqlutil.DataSource) error, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(func(sqlutil.DataSource) error)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(utils.ScryptParams), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *Master_ChangePassword_Call) Return(_a0 error) *Master_ChangePassword_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Master_ChangePassword_Call) RunAndReturn(run func(context.Context, string, string, utils.ScryptParams, ...func(sqlutil.DataSource) error) error) *Master_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Cosmos provides a mock function with given fields:
 func (_m *Master) Cosmos() keystore.Cosmos {
 	ret := _m.Called()
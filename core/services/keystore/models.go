@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"reflect"
 	"time"
 
 	gethkeystore "github.com/ethereum/go-ethereum/accounts/keystore"
@@ -30,6 +31,24 @@ type encryptedKeyRing struct {
 	EncryptedKeys []byte
 }
 
+// scryptParams returns the scrypt parameters the key ring was encrypted with, and false if it is empty or was not
+// encrypted with scrypt.
+func (ekr encryptedKeyRing) scryptParams() (utils.ScryptParams, bool) {
+	if len(ekr.EncryptedKeys) == 0 {
+		return utils.ScryptParams{}, false
+	}
+	var cryptoJSON gethkeystore.CryptoJSON
+	if err := json.Unmarshal(ekr.EncryptedKeys, &cryptoJSON); err != nil || cryptoJSON.KDF != "scrypt" {
+		return utils.ScryptParams{}, false
+	}
+	n, nOK := cryptoJSON.KDFParams["n"].(float64)
+	p, pOK := cryptoJSON.KDFParams["p"].(float64)
+	if !nOK || !pOK {
+		return utils.ScryptParams{}, false
+	}
+	return utils.ScryptParams{N: int(n), P: int(p)}, true
+}
+
 func (ekr encryptedKeyRing) Decrypt(password string) (*keyRing, error) {
 	if len(ekr.EncryptedKeys) == 0 {
 		return newKeyRing(), nil
@@ -179,6 +198,26 @@ func newKeyRing() *keyRing {
 	}
 }
 
+// hasSameKeys returns whether both key rings hold keys with the same IDs, of every type.
+func (kr *keyRing) hasSameKeys(other *keyRing) bool {
+	v, ov := reflect.ValueOf(kr).Elem(), reflect.ValueOf(other).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		keys, otherKeys := v.Field(i), ov.Field(i)
+		if keys.Kind() != reflect.Map {
+			continue
+		}
+		if keys.Len() != otherKeys.Len() {
+			return false
+		}
+		for _, id := range keys.MapKeys() {
+			if !otherKeys.MapIndex(id).IsValid() {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func (kr *keyRing) Encrypt(password string, scryptParams utils.ScryptParams) (ekr encryptedKeyRing, err error) {
 	marshalledRawKeyRingJson, err := json.Marshal(kr.raw())
 	if err != nil {
@@ -213,6 +252,10 @@ func (kr *keyRing) raw() (rawKeys rawKeyRing) {
 		rawKeys.CSA = append(rawKeys.CSA, csaKey.Raw())
 	}
 	for _, ethKey := range kr.Eth {
+		// External keys have no private key, they are held by the external signer
+		if ethKey.IsExternal() {
+			continue
+		}
 		rawKeys.Eth = append(rawKeys.Eth, ethKey.Raw())
 	}
 	for _, ocrKey := range kr.OCR {
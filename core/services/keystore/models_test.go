@@ -53,6 +53,17 @@ func TestKeyRing_Encrypt_Decrypt(t *testing.T) {
 	originalKeyRing, kerr := originalKeyRingRaw.keys()
 	require.NoError(t, kerr)
 
+	t.Run("records the scrypt parameters", func(t *testing.T) {
+		_, ok := encryptedKeyRing{}.scryptParams()
+		require.False(t, ok)
+		params := utils.ScryptParams{N: 4, P: 2}
+		encryptedKr, err := originalKeyRing.Encrypt(password, params)
+		require.NoError(t, err)
+		got, ok := encryptedKr.scryptParams()
+		require.True(t, ok)
+		require.Equal(t, params, got)
+	})
+
 	t.Run("test encrypt/decrypt", func(t *testing.T) {
 		encryptedKr, err := originalKeyRing.Encrypt(password, utils.FastScryptParams)
 		require.NoError(t, err)
@@ -140,6 +140,7 @@ func (t *transmitter) CreateEthTransaction(ctx context.Context, toAddress common
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         types.TxPriorityHigh,
 	})
 	return errors.Wrap(err, "skipped OCR transmission")
 }
@@ -177,6 +178,7 @@ func (t *ocr2FeedsTransmitter) CreateEthTransaction(ctx context.Context, toAddre
 		Strategy:         t.strategy,
 		Checker:          t.checker,
 		Meta:             txMeta,
+		Priority:         types.TxPriorityHigh,
 	})
 
 	return errors.Wrap(err, "skipped OCR transmission")
@@ -253,6 +255,7 @@ func (t *ocr2FeedsDualTransmission) CreateEthTransaction(ctx context.Context, to
 		Strategy:       t.transmitter.strategy,
 		Checker:        t.transmitter.checker,
 		Meta:           txMeta,
+		Priority:       types.TxPriorityHigh,
 	})
 
 	errSecondary = errors.Wrap(errSecondary, "skipped secondary transmission")
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commontxmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	commontxmmocks "github.com/smartcontractkit/chainlink/v2/common/txmgr/types/mocks"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -60,6 +61,7 @@ func Test_DefaultTransmitter_CreateEthTransaction(t *testing.T) {
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         commontxmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
 }
@@ -101,6 +103,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         commontxmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	txm.On("CreateTransaction", mock.Anything, txmgr.TxRequest{
 		FromAddress:      fromAddress2,
@@ -110,6 +113,7 @@ func Test_DefaultTransmitter_Forwarding_Enabled_CreateEthTransaction(t *testing.
 		ForwarderAddress: common.Address{},
 		Meta:             nil,
 		Strategy:         strategy,
+		Priority:         commontxmgrtypes.TxPriorityHigh,
 	}).Return(txmgr.Tx{}, nil).Once()
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
 	require.NoError(t, transmitter.CreateEthTransaction(testutils.Context(t), toAddress, payload, nil))
@@ -225,12 +229,14 @@ func Test_DualTransmitter(t *testing.T) {
 			// Primary transmission
 			assert.Equal(t, tx.ToAddress, toAddress, "unexpected primary toAddress")
 			assert.Nil(t, tx.Meta, "Meta should be empty")
+			assert.Equal(t, commontxmgrtypes.TxPriorityHigh, tx.Priority)
 			primaryTxConfirmed = true
 		case secondaryFromAddress:
 			// Secondary transmission
 			assert.Equal(t, tx.ToAddress, secondaryContractAddress, "unexpected secondary toAddress")
 			assert.True(t, *tx.Meta.DualBroadcast, "DualBroadcast should be true")
 			assert.Equal(t, "key1=value1&key2=value2&key2=value3&key3=value4&key3=value5&key3=value6", *tx.Meta.DualBroadcastParams, "DualBroadcastParams not equal")
+			assert.Equal(t, commontxmgrtypes.TxPriorityHigh, tx.Priority)
 			secondaryTxConfirmed = true
 		default:
 			// Should never be reached
@@ -108,6 +108,8 @@ const (
 type RunInfo struct {
 	IsRetryable bool
 	IsPending   bool
+	// ErrorClass categorises the error, if any, for the task's retryOn policy
+	ErrorClass ErrorClass
 }
 
 // retryableMeta should be returned if the error is non-deterministic; i.e. a
@@ -214,6 +216,14 @@ func (result *TaskRunResult) IsPending() bool {
 	return !result.FinishedAt.Valid && result.Result == Result{}
 }
 
+// Retries returns the number of times the task was retried, i.e. attempts beyond the first.
+func (result *TaskRunResult) Retries() uint {
+	if result.Attempts == 0 {
+		return 0
+	}
+	return result.Attempts - 1
+}
+
 func (result *TaskRunResult) IsTerminal() bool {
 	return len(result.Task.Outputs()) == 0
 }
@@ -309,6 +319,8 @@ const (
 	TaskTypeETHCall          TaskType = "ethcall"
 	TaskTypeETHTx            TaskType = "ethtx"
 	TaskTypeEstimateGasLimit TaskType = "estimategaslimit"
+	TaskTypeExpression       TaskType = "expression"
+	TaskTypeForEach          TaskType = "foreach"
 	TaskTypeHTTP             TaskType = "http"
 	TaskTypeHexDecode        TaskType = "hexdecode"
 	TaskTypeHexEncode        TaskType = "hexencode"
@@ -417,6 +429,10 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &UppercaseTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeConditional:
 		task = &ConditionalTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeForEach:
+		task = &ForEachTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeExpression:
+		task = &ExpressionTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeHexDecode:
 		task = &HexDecodeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeHexEncode:
@@ -458,6 +474,17 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		return nil, err
 	}
 
+	if err = task.Base().initRetryPolicy(); err != nil {
+		return nil, err
+	}
+
+	// tasks with nested programs are checked when the spec is parsed, rather than on every run
+	if v, ok := task.(interface{ validate() error }); ok {
+		if err = v.validate(); err != nil {
+			return nil, err
+		}
+	}
+
 	// valid explicit index values are 0-based
 	for _, key := range metadata.Keys {
 		if key == "index" {
@@ -0,0 +1,397 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"sort"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/builtin"
+	"github.com/expr-lang/expr/vm/runtime"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+)
+
+// expr has no decimal type, so the expression task replaces the arithmetic and
+// comparison operators, and the numeric builtins, with functions that evaluate
+// numbers as decimals. This keeps the precision of values above 2^53, like token
+// amounts in wei. Integer operands keep producing integers while they fit in an
+// int64, so that they can still be used as indexes.
+
+const (
+	// expressionMaxSteps bounds the number of predicate evaluations, e.g. of map or filter, per run.
+	expressionMaxSteps = 1_000_000
+	// expressionMaxDigits bounds the number of digits of a decimal value.
+	expressionMaxDigits = 256
+	// expressionMaxExponent bounds the exponent of the ** operator.
+	expressionMaxExponent = 1024
+	// expressionBudgetKey is the name under which the run budget is exposed to the step function.
+	expressionBudgetKey = "__budget"
+	expressionStepFunc  = "__step"
+)
+
+type expressionOperator struct {
+	name string
+	// integer is true if the operator returns an integer for integer operands.
+	integer bool
+	// decimal evaluates the operator on numbers.
+	decimal func(a, b decimal.Decimal) (interface{}, error)
+	// fallback evaluates the operator on any other operands, like strings.
+	fallback func(a, b interface{}) interface{}
+}
+
+var expressionOperators = map[string]expressionOperator{
+	"+":  {"__add", true, func(a, b decimal.Decimal) (interface{}, error) { return a.Add(b), nil }, func(a, b interface{}) interface{} { return runtime.Add(a, b) }},
+	"-":  {"__sub", true, func(a, b decimal.Decimal) (interface{}, error) { return a.Sub(b), nil }, func(a, b interface{}) interface{} { return runtime.Subtract(a, b) }},
+	"*":  {"__mul", true, func(a, b decimal.Decimal) (interface{}, error) { return a.Mul(b), nil }, func(a, b interface{}) interface{} { return runtime.Multiply(a, b) }},
+	"/":  {"__div", false, decimalDiv, func(a, b interface{}) interface{} { return runtime.Divide(a, b) }},
+	"%":  {"__mod", true, decimalMod, func(a, b interface{}) interface{} { return runtime.Modulo(a, b) }},
+	"**": {"__pow", false, decimalPow, func(a, b interface{}) interface{} { return runtime.Exponent(a, b) }},
+	"^":  {"__pow", false, decimalPow, func(a, b interface{}) interface{} { return runtime.Exponent(a, b) }},
+	"<":  {"__lt", false, func(a, b decimal.Decimal) (interface{}, error) { return a.LessThan(b), nil }, func(a, b interface{}) interface{} { return runtime.Less(a, b) }},
+	">":  {"__gt", false, func(a, b decimal.Decimal) (interface{}, error) { return a.GreaterThan(b), nil }, func(a, b interface{}) interface{} { return runtime.More(a, b) }},
+	"<=": {"__le", false, func(a, b decimal.Decimal) (interface{}, error) { return a.LessThanOrEqual(b), nil }, func(a, b interface{}) interface{} { return runtime.LessOrEqual(a, b) }},
+	">=": {"__ge", false, func(a, b decimal.Decimal) (interface{}, error) { return a.GreaterThanOrEqual(b), nil }, func(a, b interface{}) interface{} { return runtime.MoreOrEqual(a, b) }},
+	"==": {"__eq", false, func(a, b decimal.Decimal) (interface{}, error) { return a.Equal(b), nil }, func(a, b interface{}) interface{} { return runtime.Equal(a, b) }},
+	"!=": {"__ne", false, func(a, b decimal.Decimal) (interface{}, error) { return !a.Equal(b), nil }, func(a, b interface{}) interface{} { return !runtime.Equal(a, b) }},
+}
+
+func decimalDiv(a, b decimal.Decimal) (interface{}, error) {
+	if b.IsZero() {
+		return nil, errors.New("division by zero")
+	}
+	return a.Div(b), nil
+}
+
+func decimalMod(a, b decimal.Decimal) (interface{}, error) {
+	if b.IsZero() {
+		return nil, errors.New("division by zero")
+	}
+	return a.Mod(b), nil
+}
+
+func decimalPow(a, b decimal.Decimal) (interface{}, error) {
+	if b.Abs().GreaterThan(decimal.NewFromInt(expressionMaxExponent)) {
+		return nil, errors.Errorf("exponent %s out of range", b)
+	}
+	return a.PowWithPrecision(b, int32(decimal.DivisionPrecision))
+}
+
+// call evaluates the operator, in decimal if both operands are numbers.
+func (op expressionOperator) call(params ...interface{}) (interface{}, error) {
+	a, aInt, aOk := toDecimal(params[0])
+	b, bInt, bOk := toDecimal(params[1])
+	if !aOk || !bOk {
+		return op.fallback(params[0], params[1]), nil
+	}
+	result, err := op.decimal(a, b)
+	if err != nil {
+		return nil, err
+	}
+	if d, ok := result.(decimal.Decimal); ok {
+		return fromDecimal(d, op.integer && aInt && bInt)
+	}
+	return result, nil
+}
+
+// toDecimal converts a number to a decimal, and reports whether it is an integer type.
+func toDecimal(v interface{}) (d decimal.Decimal, integer bool, ok bool) {
+	switch v := v.(type) {
+	case decimal.Decimal:
+		return v, false, true
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return d, false, false
+		}
+		return decimal.NewFromFloat(v), false, true
+	case float32:
+		if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+			return d, false, false
+		}
+		return decimal.NewFromFloat32(v), false, true
+	case int:
+		return decimal.NewFromInt(int64(v)), true, true
+	case int8:
+		return decimal.NewFromInt(int64(v)), true, true
+	case int16:
+		return decimal.NewFromInt(int64(v)), true, true
+	case int32:
+		return decimal.NewFromInt(int64(v)), true, true
+	case int64:
+		return decimal.NewFromInt(v), true, true
+	case uint:
+		return decimal.NewFromUint64(uint64(v)), true, true
+	case uint8:
+		return decimal.NewFromUint64(uint64(v)), true, true
+	case uint16:
+		return decimal.NewFromUint64(uint64(v)), true, true
+	case uint32:
+		return decimal.NewFromUint64(uint64(v)), true, true
+	case uint64:
+		return decimal.NewFromUint64(v), true, true
+	default:
+		return d, false, false
+	}
+}
+
+// fromDecimal returns d as an int if integer is set and d fits, and as a decimal otherwise.
+func fromDecimal(d decimal.Decimal, integer bool) (interface{}, error) {
+	if d.NumDigits() > expressionMaxDigits {
+		return nil, errors.Errorf("number out of range: more than %d digits", expressionMaxDigits)
+	}
+	if integer && d.IsInteger() {
+		if i := d.IntPart(); decimal.NewFromInt(i).Equal(d) && i >= math.MinInt && i <= math.MaxInt {
+			return int(i), nil
+		}
+	}
+	return d, nil
+}
+
+// expressionPatcher replaces operators with calls to their decimal functions, and
+// counts each predicate evaluation against the run budget.
+type expressionPatcher struct{}
+
+func (expressionPatcher) Visit(node *ast.Node) {
+	switch n := (*node).(type) {
+	case *ast.BinaryNode:
+		if op, ok := expressionOperators[n.Operator]; ok {
+			ast.Patch(node, &ast.CallNode{
+				Callee:    &ast.IdentifierNode{Value: op.name},
+				Arguments: []ast.Node{n.Left, n.Right},
+			})
+		}
+	case *ast.UnaryNode:
+		switch n.Operator {
+		case "-":
+			ast.Patch(node, &ast.CallNode{
+				Callee:    &ast.IdentifierNode{Value: expressionOperators["-"].name},
+				Arguments: []ast.Node{&ast.IntegerNode{Value: 0}, n.Node},
+			})
+		case "+":
+			ast.Patch(node, n.Node)
+		}
+	case *ast.PredicateNode:
+		n.Node = &ast.CallNode{
+			Callee:    &ast.IdentifierNode{Value: expressionStepFunc},
+			Arguments: []ast.Node{&ast.IdentifierNode{Value: expressionBudgetKey}, n.Node},
+		}
+	}
+}
+
+// expressionBudget limits the evaluation of a single run.
+type expressionBudget struct {
+	ctx   context.Context
+	steps int
+}
+
+func (b *expressionBudget) step() error {
+	if err := b.ctx.Err(); err != nil {
+		return ErrTimeout
+	}
+	b.steps++
+	if b.steps > expressionMaxSteps {
+		return errors.Errorf("step limit of %d exceeded", expressionMaxSteps)
+	}
+	return nil
+}
+
+// expressionOptions returns the options which evaluate expressions in decimal and within budget.
+func expressionOptions() []expr.Option {
+	opts := []expr.Option{
+		expr.Patch(expressionPatcher{}),
+		expr.Function(expressionStepFunc, func(params ...interface{}) (interface{}, error) {
+			budget, ok := params[0].(*expressionBudget)
+			if !ok {
+				return nil, errors.New("missing budget")
+			}
+			if err := budget.step(); err != nil {
+				return nil, err
+			}
+			return params[1], nil
+		}),
+	}
+	registered := map[string]bool{}
+	for _, op := range expressionOperators {
+		if !registered[op.name] {
+			registered[op.name] = true
+			opts = append(opts, expr.Function(op.name, op.call))
+		}
+	}
+
+	for name, fn := range map[string]func(decimal.Decimal) decimal.Decimal{
+		"abs":   decimal.Decimal.Abs,
+		"ceil":  decimal.Decimal.Ceil,
+		"floor": decimal.Decimal.Floor,
+		"round": func(d decimal.Decimal) decimal.Decimal { return d.Round(0) },
+	} {
+		name, fn := name, fn
+		opts = append(opts, expr.Function(name, func(params ...interface{}) (interface{}, error) {
+			if len(params) != 1 {
+				return nil, errors.Errorf("%s expects 1 argument, got %d", name, len(params))
+			}
+			d, integer, ok := toDecimal(params[0])
+			if !ok {
+				return nil, errors.Errorf("invalid argument for %s (type %T)", name, params[0])
+			}
+			return fromDecimal(fn(d), integer)
+		}))
+	}
+
+	opts = append(opts,
+		expr.Function("int", func(params ...interface{}) (interface{}, error) {
+			if len(params) != 1 {
+				return nil, errors.Errorf("int expects 1 argument, got %d", len(params))
+			}
+			d, err := parseExpressionNumber("int", params[0])
+			if err != nil {
+				return nil, err
+			}
+			return fromDecimal(d.Truncate(0), true)
+		}),
+		expr.Function("float", func(params ...interface{}) (interface{}, error) {
+			if len(params) != 1 {
+				return nil, errors.Errorf("float expects 1 argument, got %d", len(params))
+			}
+			d, err := parseExpressionNumber("float", params[0])
+			if err != nil {
+				return nil, err
+			}
+			return fromDecimal(d, false)
+		}),
+		expressionAggregate("max", func(numbers []decimal.Decimal) (decimal.Decimal, error) {
+			return decimal.Max(numbers[0], numbers[1:]...), nil
+		}, true),
+		expressionAggregate("min", func(numbers []decimal.Decimal) (decimal.Decimal, error) {
+			return decimal.Min(numbers[0], numbers[1:]...), nil
+		}, true),
+		expressionAggregate("sum", func(numbers []decimal.Decimal) (decimal.Decimal, error) {
+			return decimal.Sum(decimal.Zero, numbers...), nil
+		}, true),
+		expressionAggregate("mean", func(numbers []decimal.Decimal) (decimal.Decimal, error) {
+			return decimal.Avg(numbers[0], numbers[1:]...), nil
+		}, false),
+		expressionAggregate("median", func(numbers []decimal.Decimal) (decimal.Decimal, error) {
+			sort.Slice(numbers, func(i, j int) bool { return numbers[i].LessThan(numbers[j]) })
+			n := len(numbers)
+			if n%2 == 1 {
+				return numbers[n/2], nil
+			}
+			return numbers[n/2-1].Add(numbers[n/2]).Div(decimal.NewFromInt(2)), nil
+		}, false),
+		expr.Function("sort", expressionSort),
+	)
+	return opts
+}
+
+// parseExpressionNumber converts a number or numeric string to a decimal.
+func parseExpressionNumber(name string, v interface{}) (decimal.Decimal, error) {
+	if d, _, ok := toDecimal(v); ok {
+		return d, nil
+	}
+	if s, ok := v.(string); ok {
+		d, err := decimal.NewFromString(s)
+		return d, errors.Wrapf(err, "invalid argument for %s", name)
+	}
+	return decimal.Decimal{}, errors.Errorf("invalid argument for %s (type %T)", name, v)
+}
+
+// expressionAggregate returns a builtin over numbers and arrays of numbers. The result is
+// an integer if integer is set and all numbers are integers.
+func expressionAggregate(name string, fn func([]decimal.Decimal) (decimal.Decimal, error), integer bool) expr.Option {
+	return expr.Function(name, func(params ...interface{}) (interface{}, error) {
+		numbers, integers, err := flattenExpressionNumbers(name, params)
+		if err != nil {
+			return nil, err
+		}
+		if len(numbers) == 0 {
+			if name == "sum" {
+				return 0, nil
+			}
+			return nil, errors.Errorf("%s requires at least one number", name)
+		}
+		d, err := fn(numbers)
+		if err != nil {
+			return nil, err
+		}
+		return fromDecimal(d, integer && integers)
+	})
+}
+
+func flattenExpressionNumbers(name string, params []interface{}) (numbers []decimal.Decimal, integers bool, err error) {
+	integers = true
+	for _, param := range params {
+		d, integer, ok := toDecimal(param)
+		if ok {
+			numbers = append(numbers, d)
+			integers = integers && integer
+			continue
+		}
+		v := reflect.ValueOf(param)
+		if v.Kind() != reflect.Slice && v.Kind() != reflect.Array {
+			return nil, false, errors.Errorf("invalid argument for %s (type %T)", name, param)
+		}
+		elems := make([]interface{}, v.Len())
+		for i := range elems {
+			elems[i] = v.Index(i).Interface()
+		}
+		nested, nestedIntegers, err := flattenExpressionNumbers(name, elems)
+		if err != nil {
+			return nil, false, err
+		}
+		numbers = append(numbers, nested...)
+		integers = integers && nestedIntegers
+	}
+	return numbers, integers, nil
+}
+
+var expressionBuiltinSort = builtin.Builtins[builtin.Index["sort"]].Safe
+
+// expressionSort sorts arrays of numbers as decimals, and any other arrays with the sort builtin.
+func expressionSort(params ...interface{}) (interface{}, error) {
+	if len(params) == 0 {
+		return nil, errors.New("sort expects 1 or 2 arguments, got 0")
+	}
+	array, ok := params[0].([]interface{})
+	if !ok {
+		result, _, err := expressionBuiltinSort(params...)
+		return result, err
+	}
+	numbers := make([]decimal.Decimal, len(array))
+	for i, elem := range array {
+		d, _, ok := toDecimal(elem)
+		if !ok {
+			result, _, err := expressionBuiltinSort(params...)
+			return result, err
+		}
+		numbers[i] = d
+	}
+	desc := false
+	if len(params) == 2 {
+		switch params[1] {
+		case "asc":
+		case "desc":
+			desc = true
+		default:
+			return nil, errors.Errorf("invalid order %v, expected asc or desc", params[1])
+		}
+	} else if len(params) > 2 {
+		return nil, errors.Errorf("sort expects 1 or 2 arguments, got %d", len(params))
+	}
+	indexes := make([]int, len(array))
+	for i := range indexes {
+		indexes[i] = i
+	}
+	sort.SliceStable(indexes, func(i, j int) bool {
+		if desc {
+			return numbers[indexes[i]].GreaterThan(numbers[indexes[j]])
+		}
+		return numbers[indexes[i]].LessThan(numbers[indexes[j]])
+	})
+	sorted := make([]interface{}, len(array))
+	for i, index := range indexes {
+		sorted[i] = array[index]
+	}
+	return sorted, nil
+}
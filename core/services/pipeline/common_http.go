@@ -14,6 +14,8 @@ import (
 	clhttp "github.com/smartcontractkit/chainlink/v2/core/utils/http"
 )
 
+var errHTTPRequestInterrupted = errors.New("http request timed out or interrupted")
+
 func makeHTTPRequest(
 	ctx context.Context,
 	lggr logger.Logger,
@@ -55,7 +57,7 @@ func makeHTTPRequest(
 	start := time.Now()
 	responseBytes, statusCode, respHeaders, err := httpRequest.SendRequest()
 	if ctx.Err() != nil {
-		return nil, 0, nil, 0, errors.New("http request timed out or interrupted")
+		return nil, 0, nil, 0, errHTTPRequestInterrupted
 	}
 	if err != nil {
 		return nil, 0, nil, 0, errors.Wrapf(err, "error making http request")
@@ -69,6 +71,47 @@ func makeHTTPRequest(
 	return responseBytes, statusCode, respHeaders, elapsed, nil
 }
 
+// makeCachedHTTPRequest is makeHTTPRequest, serving the response from the shared
+// cache when enabled. unrestricted must be true if client may reach local and
+// private networks, and scope must identify the credentials client authenticates
+// with, if any. The returned bool is true if the response was not fetched by this
+// request.
+func makeCachedHTTPRequest(
+	ctx context.Context,
+	lggr logger.Logger,
+	cache *httpResponseCache,
+	taskType TaskType,
+	cacheTTL time.Duration,
+	scope string,
+	method StringParam,
+	url URLParam,
+	reqHeaders []string,
+	requestData MapParam,
+	client *http.Client,
+	unrestricted bool,
+	httpLimit int64,
+	adjust func(*httpResponse),
+) (httpResponse, bool, error) {
+	fetch := func() (httpResponse, error) {
+		var resp httpResponse
+		var err error
+		resp.body, resp.statusCode, resp.headers, resp.elapsed, err = makeHTTPRequest(ctx, lggr, method, url, reqHeaders, requestData, client, httpLimit)
+		if adjust != nil {
+			adjust(&resp)
+		}
+		return resp, err
+	}
+	if cache == nil {
+		resp, err := fetch()
+		return resp, false, err
+	}
+	key, err := httpResponseCacheKey(scope, method, url, reqHeaders, requestData, unrestricted)
+	if err != nil {
+		return httpResponse{}, false, err
+	}
+	return cache.Do(ctx, taskType, key, cacheTTL, fetch)
+}
+
 type PossibleErrorResponses struct {
 	Error        string `json:"error"`
 	ErrorMessage string `json:"errorMessage"`
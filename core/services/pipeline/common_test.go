@@ -100,6 +100,13 @@ func TestRetryUnmarshal(t *testing.T) {
 			time.Second,
 			time.Minute * 30,
 		},
+		{
+			"only maxBackoff set",
+			`ds1 [type=http retries=10 maxBackoff="30m"];`,
+			10,
+			time.Second * 5,
+			time.Minute * 30,
+		},
 	}
 
 	for _, test := range tests {
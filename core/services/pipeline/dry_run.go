@@ -0,0 +1,96 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// DryRunStubs maps a task's dot ID to the value it should return during a dry run
+// instead of executing. Tasks without a stub return a nil value.
+type DryRunStubs map[string]interface{}
+
+// IsSideEffecting returns true for task types that write to a chain, sign with a
+// node key or call out to an external adapter. These tasks are never executed
+// during a dry run, nor are http tasks that may change state on the remote server,
+// see isSideEffecting.
+func IsSideEffecting(taskType TaskType) bool {
+	switch taskType {
+	case TaskTypeETHTx, TaskTypeBridge, TaskTypeVRF, TaskTypeVRFV2, TaskTypeVRFV2Plus:
+		return true
+	default:
+		return false
+	}
+}
+
+// isSideEffecting is IsSideEffecting, also counting http tasks with any method
+// other than GET, including a method only known at run time.
+func isSideEffecting(task Task) bool {
+	if httpTask, ok := task.(*HTTPTask); ok {
+		method := strings.TrimSpace(httpTask.Method)
+		return method != "" && !strings.EqualFold(method, http.MethodGet)
+	}
+	return IsSideEffecting(task.Type())
+}
+
+// dryRunTask wraps a side-effecting task, recording the inputs it receives and
+// returning a stubbed value instead of running the wrapped task.
+type dryRunTask struct {
+	Task
+	stub interface{}
+
+	mu       sync.Mutex
+	recorded [][]Result
+}
+
+var _ Task = (*dryRunTask)(nil)
+
+func (t *dryRunTask) Run(_ context.Context, lggr logger.Logger, _ Vars, inputs []Result) (Result, RunInfo) {
+	t.mu.Lock()
+	t.recorded = append(t.recorded, inputs)
+	t.mu.Unlock()
+
+	lggr.Debugw("Dry run: skipping side-effecting task", "dotID", t.DotID(), "type", t.Type())
+	return Result{Value: t.stub}, RunInfo{}
+}
+
+// RecordedInputs returns the inputs received by each attempt of the stubbed task.
+func (t *dryRunTask) RecordedInputs() [][]Result {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.recorded
+}
+
+// IsDryRunStub returns true if the task was stubbed out during a dry run, along
+// with the inputs it received on each attempt.
+func IsDryRunStub(task Task) (bool, [][]Result) {
+	t, ok := task.(*dryRunTask)
+	if !ok {
+		return false, nil
+	}
+	return true, t.RecordedInputs()
+}
+
+// stubSideEffects replaces all side-effecting tasks in the pipeline with recorders.
+// Task IDs are positional, so the scheduler picks up the stubs from p.Tasks while
+// the input/output edges of the other tasks keep working by ID.
+func (p *Pipeline) stubSideEffects(stubs DryRunStubs) {
+	if stubs == nil {
+		stubs = DryRunStubs{}
+	}
+	p.dryRun = true
+	for i, task := range p.Tasks {
+		if forEach, ok := task.(*ForEachTask); ok {
+			// nested pipelines are stubbed when they are initialized
+			forEach.stubs = stubs
+			continue
+		}
+		if !isSideEffecting(task) {
+			continue
+		}
+		p.Tasks[i] = &dryRunTask{Task: task, stub: stubs[task.DotID()]}
+	}
+}
@@ -160,6 +160,12 @@ type Pipeline struct {
 	Tasks  []Task
 	tree   *Graph
 	Source string
+	// dotIDPrefix prefixes the DOT IDs of the tasks of a nested pipeline in
+	// circuit breaker keys, so that they don't collide with the enclosing one
+	dotIDPrefix string
+	// dryRun is set once the side-effecting tasks are stubbed, dry runs don't
+	// share the circuit breakers of job runs
+	dryRun bool
 }
 
 func (p *Pipeline) UnmarshalText(bs []byte) (err error) {
@@ -51,6 +51,14 @@ func (t *HTTPTask) HelperSetDependencies(config Config, restrictedHTTPClient, un
 	t.unrestrictedHTTPClient = unrestrictedHTTPClient
 }
 
+func NewTestHTTPResponseCache(maxBytes int) *httpResponseCache {
+	return newHTTPResponseCache(maxBytes)
+}
+
+func (t *HTTPTask) HelperSetResponseCache(cache *httpResponseCache) {
+	t.responseCache = cache
+}
+
 func (t *ETHCallTask) HelperSetDependencies(legacyChains legacyevm.LegacyChainContainer, config Config, specGasLimit *uint32, jobType string) {
 	t.legacyChains = legacyChains
 	t.config = config
@@ -0,0 +1,204 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"golang.org/x/sync/singleflight"
+)
+
+var (
+	promHTTPResponseCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_response_cache_hits_total",
+		Help: "Number of http/bridge task requests served from the shared response cache, or shared with an identical in-flight request",
+	},
+		[]string{"task_type"},
+	)
+	promHTTPResponseCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_http_response_cache_misses_total",
+		Help: "Number of http/bridge task requests with caching enabled that were sent to the remote endpoint",
+	},
+		[]string{"task_type"},
+	)
+)
+
+// defaultResponseCacheMaxBytes bounds the total size of response bodies held by the shared cache.
+const defaultResponseCacheMaxBytes = 32 * 1024 * 1024
+
+// httpResponse is a response returned by makeHTTPRequest.
+type httpResponse struct {
+	body       []byte
+	statusCode int
+	headers    http.Header
+	elapsed    time.Duration
+}
+
+type httpResponseCacheEntry struct {
+	key       string
+	response  httpResponse
+	expiresAt time.Time
+}
+
+// httpResponseCache is a size-bounded LRU cache of successful http/bridge task
+// responses, shared by all jobs of a runner. Concurrent identical requests are
+// collapsed into a single request.
+type httpResponseCache struct {
+	maxBytes int
+
+	mu      sync.Mutex
+	bytes   int
+	order   *list.List // front is most recently used
+	entries map[string]*list.Element
+
+	group singleflight.Group
+}
+
+func newHTTPResponseCache(maxBytes int) *httpResponseCache {
+	return &httpResponseCache{
+		maxBytes: maxBytes,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// httpResponseCacheKey derives a cache key from the request method, URL, headers and body,
+// and from whether the request may reach local and private networks, so that responses
+// fetched by an unrestricted client are never served to a restricted request. scope
+// separates requests made with different credentials, see bridgeResponseCacheScope.
+func httpResponseCacheKey(scope string, method StringParam, url URLParam, reqHeaders []string, requestData MapParam, unrestricted bool) (string, error) {
+	body, err := json.Marshal(requestData)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode request body as JSON")
+	}
+	h := sha256.New()
+	for _, part := range append([]string{scope, strconv.FormatBool(unrestricted), strings.ToUpper(string(method)), url.String(), string(body)}, reqHeaders...) {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// Do returns the cached response for key if it is fresh. Otherwise it calls
+// fetch, or waits for an identical in-flight fetch, and caches a successful
+// response for ttl, or for the lifetime permitted by its Cache-Control header if
+// ttl is zero. Responses are never cached for longer than stalenessCap.
+func (c *httpResponseCache) Do(ctx context.Context, taskType TaskType, key string, ttl time.Duration, fetch func() (httpResponse, error)) (httpResponse, bool, error) {
+	if resp, ok := c.get(key); ok {
+		promHTTPResponseCacheHits.WithLabelValues(string(taskType)).Inc()
+		return resp, true, nil
+	}
+
+	ch := c.group.DoChan(key, func() (interface{}, error) {
+		promHTTPResponseCacheMisses.WithLabelValues(string(taskType)).Inc()
+		resp, err := fetch()
+		if err == nil {
+			c.put(key, resp, ttl)
+		}
+		return resp, err
+	})
+
+	select {
+	case <-ctx.Done():
+		return httpResponse{}, false, errHTTPRequestInterrupted
+	case res := <-ch:
+		resp, _ := res.Val.(httpResponse)
+		if res.Shared && errors.Is(res.Err, errHTTPRequestInterrupted) && ctx.Err() == nil {
+			// the request was made with another task's context, which was cancelled before ours
+			resp, err := fetch()
+			return resp, false, err
+		}
+		if res.Shared {
+			promHTTPResponseCacheHits.WithLabelValues(string(taskType)).Inc()
+		}
+		return resp, res.Shared, res.Err
+	}
+}
+
+func (c *httpResponseCache) get(key string) (httpResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	elem, ok := c.entries[key]
+	if !ok {
+		return httpResponse{}, false
+	}
+	entry := elem.Value.(*httpResponseCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(elem)
+		return httpResponse{}, false
+	}
+	c.order.MoveToFront(elem)
+	return entry.response, true
+}
+
+func (c *httpResponseCache) put(key string, resp httpResponse, ttl time.Duration) {
+	if ttl == 0 {
+		ttl = cacheControlTTL(resp.headers)
+	}
+	if ttl > stalenessCap {
+		ttl = stalenessCap
+	}
+	size := len(resp.body)
+	if ttl <= 0 || resp.statusCode < 200 || resp.statusCode >= 300 || size > c.maxBytes {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.order.Back())
+	}
+	c.entries[key] = c.order.PushFront(&httpResponseCacheEntry{key: key, response: resp, expiresAt: time.Now().Add(ttl)})
+	c.bytes += size
+}
+
+// remove must be called with mu held.
+func (c *httpResponseCache) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*httpResponseCacheEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= len(entry.response.body)
+}
+
+// cacheControlTTL returns how long a response may be cached according to its
+// Cache-Control header, or zero if it must not be cached.
+func cacheControlTTL(headers http.Header) time.Duration {
+	var maxAge, sharedMaxAge time.Duration = -1, -1
+	for _, directive := range strings.Split(headers.Get("Cache-Control"), ",") {
+		name, value, _ := strings.Cut(strings.ToLower(strings.TrimSpace(directive)), "=")
+		switch name {
+		case "no-store", "no-cache", "private":
+			return 0
+		case "max-age", "s-maxage":
+			seconds, err := strconv.ParseUint(strings.Trim(value, `"`), 10, 32)
+			if err != nil {
+				return 0
+			}
+			if name == "max-age" {
+				maxAge = time.Duration(seconds) * time.Second
+			} else {
+				sharedMaxAge = time.Duration(seconds) * time.Second
+			}
+		}
+	}
+	// the cache is shared between jobs, so s-maxage takes precedence
+	if sharedMaxAge >= 0 {
+		return sharedMaxAge
+	}
+	if maxAge >= 0 {
+		return maxAge
+	}
+	return 0
+}
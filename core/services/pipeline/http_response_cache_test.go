@@ -0,0 +1,164 @@
+package pipeline
+
+import (
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func TestCacheControlTTL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header string
+		want   time.Duration
+	}{
+		{"", 0},
+		{"max-age=30", 30 * time.Second},
+		{"public, max-age=30, s-maxage=10", 10 * time.Second},
+		{"max-age=30, no-cache", 0},
+		{"private, max-age=30", 0},
+		{"no-store", 0},
+		{"max-age=abc", 0},
+	}
+	for _, test := range tests {
+		h := http.Header{}
+		h.Set("Cache-Control", test.header)
+		assert.Equal(t, test.want, cacheControlTTL(h), test.header)
+	}
+}
+
+func TestHTTPResponseCache(t *testing.T) {
+	t.Parallel()
+
+	ok := func(body string) func() (httpResponse, error) {
+		return func() (httpResponse, error) {
+			return httpResponse{body: []byte(body), statusCode: http.StatusOK}, nil
+		}
+	}
+
+	t.Run("evicts least recently used entries over the size bound", func(t *testing.T) {
+		c := newHTTPResponseCache(10)
+		ctx := testutils.Context(t)
+		for _, key := range []string{"a", "b"} {
+			_, _, err := c.Do(ctx, TaskTypeHTTP, key, time.Minute, ok("1234"))
+			require.NoError(t, err)
+		}
+		_, hit := c.get("a")
+		require.True(t, hit)
+
+		_, _, err := c.Do(ctx, TaskTypeHTTP, "c", time.Minute, ok("1234"))
+		require.NoError(t, err)
+		_, hit = c.get("b")
+		assert.False(t, hit)
+		_, hit = c.get("a")
+		assert.True(t, hit)
+		assert.Equal(t, 8, c.bytes)
+
+		_, _, err = c.Do(ctx, TaskTypeHTTP, "big", time.Minute, ok("12345678901"))
+		require.NoError(t, err)
+		_, hit = c.get("big")
+		assert.False(t, hit)
+	})
+
+	t.Run("does not cache failures or expired entries", func(t *testing.T) {
+		c := newHTTPResponseCache(1024)
+		ctx := testutils.Context(t)
+		_, _, err := c.Do(ctx, TaskTypeHTTP, "err", time.Minute, func() (httpResponse, error) {
+			return httpResponse{statusCode: http.StatusInternalServerError}, assert.AnError
+		})
+		require.ErrorIs(t, err, assert.AnError)
+		_, hit := c.get("err")
+		assert.False(t, hit)
+
+		_, _, err = c.Do(ctx, TaskTypeHTTP, "status", time.Minute, func() (httpResponse, error) {
+			return httpResponse{statusCode: http.StatusAccepted + 100}, nil
+		})
+		require.NoError(t, err)
+		_, hit = c.get("status")
+		assert.False(t, hit)
+
+		c.put("expired", httpResponse{body: []byte("x"), statusCode: http.StatusOK}, time.Nanosecond)
+		time.Sleep(time.Millisecond)
+		_, hit = c.get("expired")
+		assert.False(t, hit)
+		assert.Equal(t, 0, c.bytes)
+	})
+
+	t.Run("deduplicates concurrent identical requests", func(t *testing.T) {
+		c := newHTTPResponseCache(1024)
+		ctx := testutils.Context(t)
+		var fetches atomic.Int32
+		release := make(chan struct{})
+		fetch := func() (httpResponse, error) {
+			fetches.Add(1)
+			<-release
+			return httpResponse{body: []byte("shared"), statusCode: http.StatusOK, headers: http.Header{"Cache-Control": []string{"no-store"}}}, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				resp, _, err := c.Do(ctx, TaskTypeBridge, "key", 0, fetch)
+				assert.NoError(t, err)
+				assert.Equal(t, "shared", string(resp.body))
+			}()
+		}
+		require.Eventually(t, func() bool { return fetches.Load() == 1 }, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), fetches.Load())
+		_, hit := c.get("key")
+		assert.False(t, hit, "no-store responses are shared in flight but not cached")
+	})
+
+	t.Run("does not share responses across bridges with the same URL", func(t *testing.T) {
+		c := newHTTPResponseCache(1024)
+		ctx := testutils.Context(t)
+		url := URLParam(*testutils.MustParseURL(t, "https://adapter.example.com"))
+		bridge := func(name, token string) bridges.BridgeType {
+			return bridges.BridgeType{
+				Name:       bridges.MustParseBridgeName(name),
+				AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: token}},
+			}
+		}
+		key := func(bt bridges.BridgeType) string {
+			scope, err := bridgeResponseCacheScope(bt)
+			require.NoError(t, err)
+			key, err := httpResponseCacheKey(scope, "POST", url, nil, MapParam{"data": "x"}, true)
+			require.NoError(t, err)
+			return key
+		}
+
+		a, b := bridge("bridge-a", "token-a"), bridge("bridge-b", "token-b")
+		resp, cached, err := c.Do(ctx, TaskTypeBridge, key(a), time.Minute, ok("a"))
+		require.NoError(t, err)
+		assert.False(t, cached)
+		assert.Equal(t, "a", string(resp.body))
+
+		resp, cached, err = c.Do(ctx, TaskTypeBridge, key(b), time.Minute, ok("b"))
+		require.NoError(t, err)
+		assert.False(t, cached)
+		assert.Equal(t, "b", string(resp.body))
+
+		resp, cached, err = c.Do(ctx, TaskTypeBridge, key(a), time.Minute, ok("other"))
+		require.NoError(t, err)
+		assert.True(t, cached)
+		assert.Equal(t, "a", string(resp.body))
+
+		// new credentials of a bridge don't get the responses fetched with the old ones
+		assert.NotEqual(t, key(a), key(bridge("bridge-a", "rotated")))
+	})
+}
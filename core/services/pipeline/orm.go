@@ -66,6 +66,7 @@ const KeepersObservationSource = `
                                  evmChainID="$(jobSpec.evmChainID)"
                                  data="$(encode_perform_upkeep_tx)"
                                  gasLimit="$(jobSpec.performUpkeepGasLimit)"
+                                 priority="low"
                                  txMeta="{\"jobID\":$(jobSpec.jobID),\"upkeepID\":$(jobSpec.prettyID)}"]
     encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> calculate_perform_data_len -> perform_data_lessthan_limit -> check_perform_data_limit -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> decode_check_perform_tx -> check_success -> perform_upkeep_tx
 `
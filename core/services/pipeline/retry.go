@@ -0,0 +1,199 @@
+package pipeline
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	pkgerrors "github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// ErrorClass categorises task errors so that a task's retry policy can be
+// scoped to specific failure modes, e.g. retryOn="http5xx,timeout".
+type ErrorClass string
+
+const (
+	ErrorClassHTTP4xx ErrorClass = "http4xx"
+	ErrorClassHTTP5xx ErrorClass = "http5xx"
+	ErrorClassTimeout ErrorClass = "timeout"
+	ErrorClassRPC     ErrorClass = "rpc"
+	// ErrorClassOther is any error that doesn't fall into one of the classes above
+	ErrorClassOther ErrorClass = "other"
+)
+
+var errorClasses = map[ErrorClass]struct{}{
+	ErrorClassHTTP4xx: {},
+	ErrorClassHTTP5xx: {},
+	ErrorClassTimeout: {},
+	ErrorClassRPC:     {},
+	ErrorClassOther:   {},
+}
+
+// defaultCircuitBreakerCooldown is used when a task sets circuitBreakerThreshold
+// without circuitBreakerCooldown.
+const defaultCircuitBreakerCooldown = time.Minute
+
+var (
+	promPipelineTaskRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_retries",
+		Help: "The total number of retries scheduled for each pipeline task, by error class",
+	},
+		[]string{"job_id", "job_name", "task_id", "task_type", "error_class"},
+	)
+	promPipelineTaskCircuitBreakerOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "pipeline_task_circuit_breaker_open",
+		Help: "Whether retries are currently suspended for a pipeline task (1) or not (0)",
+	},
+		[]string{"job_id", "job_name", "task_id", "task_type"},
+	)
+)
+
+// httpRunInfo returns the RunInfo for a failed HTTP request, classifying the
+// error by status code.
+func httpRunInfo(statusCode int, err error) RunInfo {
+	info := RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+	switch {
+	case statusCode >= 500:
+		info.ErrorClass = ErrorClassHTTP5xx
+	case statusCode >= 400:
+		info.ErrorClass = ErrorClassHTTP4xx
+	}
+	return info
+}
+
+// rpcRunInfo should be returned when an RPC call to a chain node fails.
+func rpcRunInfo() RunInfo {
+	return RunInfo{IsRetryable: true, ErrorClass: ErrorClassRPC}
+}
+
+// classifyError returns the class of a task error. Tasks report the class via
+// RunInfo when they know it; otherwise timeouts are detected from the error.
+func classifyError(err error, runInfo RunInfo) ErrorClass {
+	if runInfo.ErrorClass != "" {
+		return runInfo.ErrorClass
+	}
+	if isTimeoutError(err) {
+		return ErrorClassTimeout
+	}
+	return ErrorClassOther
+}
+
+func isTimeoutError(err error) bool {
+	if errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded) || errors.Is(err, errHTTPRequestInterrupted) {
+		return true
+	}
+	cause := pkgerrors.Cause(err)
+	if cause == ErrTimeout || cause == errHTTPRequestInterrupted {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func parseErrorClasses(s string) (map[ErrorClass]struct{}, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	classes := make(map[ErrorClass]struct{})
+	for _, part := range strings.Split(s, ",") {
+		class := ErrorClass(strings.ToLower(strings.TrimSpace(part)))
+		if _, ok := errorClasses[class]; !ok {
+			return nil, pkgerrors.Errorf("unknown error class %q in retryOn, expected one of: http4xx, http5xx, timeout, rpc, other", class)
+		}
+		classes[class] = struct{}{}
+	}
+	return classes, nil
+}
+
+// maxRetryBreakers bounds the number of tasks with failures tracked by
+// retryBreakers. Tasks of deleted or updated jobs never record a success that
+// would remove their breaker, so the least recently failed ones are evicted.
+const maxRetryBreakers = 10_000
+
+// retryBreakers suspends retries for tasks that keep failing after exhausting
+// their retries. State is kept per job and task across runs.
+type retryBreakers struct {
+	mu       sync.Mutex
+	max      int
+	breakers map[string]*list.Element
+	// lru orders the breakers by their latest failure, most recent first
+	lru *list.List
+}
+
+type retryBreaker struct {
+	key string
+	// failures is the number of consecutive runs in which the task failed
+	// after exhausting its retries
+	failures uint32
+	openedAt time.Time
+}
+
+func newRetryBreakers() *retryBreakers {
+	return &retryBreakers{max: maxRetryBreakers, breakers: make(map[string]*list.Element), lru: list.New()}
+}
+
+func retryBreakerKey(spec Spec, p *Pipeline, task Task) string {
+	return fmt.Sprintf("%d/%d/%s%s", spec.JobID, spec.ID, p.dotIDPrefix, task.DotID())
+}
+
+// allow returns false if the breaker for key is open. Once the cooldown has
+// elapsed, retries are allowed again until the next terminal failure.
+func (b *retryBreakers) allow(key string, threshold uint32, cooldown time.Duration) bool {
+	if b == nil || threshold == 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, ok := b.breakers[key]
+	if !ok {
+		return true
+	}
+	br := e.Value.(*retryBreaker)
+	if br.failures < threshold {
+		return true
+	}
+	return time.Since(br.openedAt) >= cooldown
+}
+
+// record tracks the final outcome of a task in a run and returns whether the
+// breaker is open afterwards.
+func (b *retryBreakers) record(key string, threshold uint32, failed bool) (open bool) {
+	if b == nil || threshold == 0 {
+		return false
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e, ok := b.breakers[key]
+	if !failed {
+		if ok {
+			b.lru.Remove(e)
+			delete(b.breakers, key)
+		}
+		return false
+	}
+	if ok {
+		b.lru.MoveToFront(e)
+	} else {
+		e = b.lru.PushFront(&retryBreaker{key: key})
+		b.breakers[key] = e
+		if b.lru.Len() > b.max {
+			oldest := b.lru.Back()
+			b.lru.Remove(oldest)
+			delete(b.breakers, oldest.Value.(*retryBreaker).key)
+		}
+	}
+	br := e.Value.(*retryBreaker)
+	br.failures++
+	if br.failures >= threshold {
+		br.openedAt = time.Now()
+		return true
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+package pipeline
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClassifyError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		runInfo  RunInfo
+		expected ErrorClass
+	}{
+		{"http 5xx", errors.New("bad gateway"), httpRunInfo(http.StatusBadGateway, errors.New("bad gateway")), ErrorClassHTTP5xx},
+		{"http 4xx", errors.New("not found"), httpRunInfo(http.StatusNotFound, errors.New("not found")), ErrorClassHTTP4xx},
+		{"rpc", errors.New("execution reverted"), rpcRunInfo(), ErrorClassRPC},
+		{"pipeline timeout", errors.Wrap(ErrTimeout, "task"), RunInfo{}, ErrorClassTimeout},
+		{"context deadline", errors.Wrap(context.DeadlineExceeded, "task"), RunInfo{}, ErrorClassTimeout},
+		{"interrupted http request", errHTTPRequestInterrupted, httpRunInfo(0, errHTTPRequestInterrupted), ErrorClassTimeout},
+		{"other", ErrTaskRunFailed, RunInfo{}, ErrorClassOther},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, classifyError(test.err, test.runInfo))
+		})
+	}
+}
+
+func TestBaseTask_RetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse(`a [type=median retries=3 retryOn="http5xx,bogus"]`)
+	require.ErrorContains(t, err, `unknown error class "bogus"`)
+
+	_, err = Parse(`a [type=median retries=3 minBackoff="1m" maxBackoff="1s"]`)
+	require.ErrorContains(t, err, "maxBackoff")
+
+	p, err := Parse(`a [type=median retries=3 retryOn=" HTTP5xx , rpc" jitter=true circuitBreakerThreshold=2]`)
+	require.NoError(t, err)
+	base := p.Tasks[0].Base()
+	assert.True(t, base.Jitter)
+	assert.Equal(t, map[ErrorClass]struct{}{ErrorClassHTTP5xx: {}, ErrorClassRPC: {}}, base.retryOn)
+	assert.True(t, base.retriesErrorClass(ErrorClassHTTP5xx))
+	assert.True(t, base.retriesErrorClass(ErrorClassRPC))
+	assert.False(t, base.retriesErrorClass(ErrorClassTimeout))
+	assert.Equal(t, uint32(2), base.CircuitBreakerThreshold)
+	assert.Equal(t, defaultCircuitBreakerCooldown, base.TaskCircuitBreakerCooldown())
+
+	p, err = Parse(`a [type=median retries=3]`)
+	require.NoError(t, err)
+	assert.Nil(t, p.Tasks[0].Base().retryOn)
+	assert.True(t, p.Tasks[0].Base().retriesErrorClass(ErrorClassOther))
+}
+
+func TestRetryBreakers(t *testing.T) {
+	t.Parallel()
+
+	b := newRetryBreakers()
+	const key, threshold = "1/1/ds", 2
+
+	assert.True(t, b.allow(key, threshold, time.Hour))
+	assert.False(t, b.record(key, threshold, true))
+	assert.True(t, b.allow(key, threshold, time.Hour))
+	assert.True(t, b.record(key, threshold, true))
+	// open
+	assert.False(t, b.allow(key, threshold, time.Hour))
+	// cooldown elapsed: half-open
+	assert.True(t, b.allow(key, threshold, 0))
+	// success resets
+	assert.False(t, b.record(key, threshold, false))
+	assert.True(t, b.allow(key, threshold, time.Hour))
+
+	// disabled breakers always allow
+	var nilBreakers *retryBreakers
+	assert.True(t, nilBreakers.allow(key, threshold, time.Hour))
+	assert.True(t, b.allow(key, 0, time.Hour))
+}
+
+func TestRetryBreakers_Bounded(t *testing.T) {
+	t.Parallel()
+
+	b := newRetryBreakers()
+	b.max = 2
+	const threshold = 1
+
+	assert.True(t, b.record("1/1/a", threshold, true))
+	assert.True(t, b.record("1/1/b", threshold, true))
+	// a failed more recently than b
+	assert.True(t, b.record("1/1/a", threshold, true))
+	assert.True(t, b.record("2/2/c", threshold, true))
+
+	assert.Len(t, b.breakers, 2)
+	assert.Equal(t, 2, b.lru.Len())
+	assert.False(t, b.allow("1/1/a", threshold, time.Hour))
+	assert.False(t, b.allow("2/2/c", threshold, time.Hour))
+	// evicted
+	assert.True(t, b.allow("1/1/b", threshold, time.Hour))
+
+	assert.False(t, b.record("1/1/a", threshold, false))
+	assert.Len(t, b.breakers, 1)
+	assert.Equal(t, 1, b.lru.Len())
+}
+
+func TestRetryBreakerKey_ForEach(t *testing.T) {
+	t.Parallel()
+
+	spec := Spec{ID: 2, JobID: 1, DotDagSource: `
+out [type=foreach input=<[1]> pipeline="inner [type=foreach input=<[1]> pipeline=<out [type=fail msg=failed]>]"]
+`}
+	r := &runner{}
+	p, err := r.InitializePipeline(spec)
+	require.NoError(t, err)
+	assert.Equal(t, "1/2/out", retryBreakerKey(spec, p, p.Tasks[0]))
+
+	nested, nestedSpec, err := p.Tasks[0].(*ForEachTask).initializePipeline()
+	require.NoError(t, err)
+	assert.Equal(t, "1/2/out.inner", retryBreakerKey(nestedSpec, nested, nested.Tasks[0]))
+
+	innermost, innermostSpec, err := nested.Tasks[0].(*ForEachTask).initializePipeline()
+	require.NoError(t, err)
+	assert.Equal(t, "1/2/out.inner.out", retryBreakerKey(innermostSpec, innermost, innermost.Tasks[0]))
+}
@@ -40,6 +40,9 @@ type Runner interface {
 	// ExecuteRun executes a new run in-memory according to a spec and returns the results.
 	// We expect spec.JobID and spec.JobName to be set for logging/prometheus.
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars) (run *Run, trrs TaskRunResults, err error)
+	// ExecuteDryRun is like ExecuteRun, except that side-effecting tasks (see IsSideEffecting) are not executed.
+	// They record their inputs and return the value from stubs for their dot ID instead. Nothing is persisted.
+	ExecuteDryRun(ctx context.Context, spec Spec, vars Vars, stubs DryRunStubs) (run *Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	// ds is an optional override, for example when executing a transaction.
 	InsertFinishedRun(ctx context.Context, ds sqlutil.DataSource, run *Run, saveSuccessfulTaskRuns bool) error
@@ -65,6 +68,9 @@ type runner struct {
 	ethKeyStore            ETHKeyStore
 	vrfKeyStore            VRFKeyStore
 	runReaperWorker        *commonutils.SleeperTask
+	retryBreakers          *retryBreakers
+	responseCache          *httpResponseCache
+	bridgeAuthenticator    *bridges.Authenticator
 	lggr                   logger.Logger
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
@@ -114,6 +120,7 @@ func NewRunner(
 	legacyChains legacyevm.LegacyChainContainer,
 	ethks ETHKeyStore,
 	vrfks VRFKeyStore,
+	csaks bridges.CSAKeyStore,
 	lggr logger.Logger,
 	httpClient, unrestrictedHTTPClient *http.Client,
 ) *runner {
@@ -130,6 +137,9 @@ func NewRunner(
 		chStop:                 make(chan struct{}),
 		wgDone:                 sync.WaitGroup{},
 		runFinished:            func(*Run) {},
+		retryBreakers:          newRetryBreakers(),
+		responseCache:          newHTTPResponseCache(defaultResponseCacheMaxBytes),
+		bridgeAuthenticator:    bridges.NewAuthenticator(csaks),
 		lggr:                   lggr,
 		httpClient:             httpClient,
 		unrestrictedHTTPClient: unrestrictedHTTPClient,
@@ -322,6 +332,19 @@ func (r *runner) ExecuteRun(ctx context.Context, spec Spec, vars Vars) (*Run, Ta
 	return run, taskRunResults, nil
 }
 
+func (r *runner) ExecuteDryRun(ctx context.Context, spec Spec, vars Vars, stubs DryRunStubs) (*Run, TaskRunResults, error) {
+	// always parse a fresh pipeline, since stubbing mutates the task list
+	spec.Pipeline = nil
+	pipeline, err := r.InitializePipeline(spec)
+	if err != nil {
+		return nil, nil, err
+	}
+	pipeline.stubSideEffects(stubs)
+	spec.Pipeline = pipeline
+
+	return r.ExecuteRun(ctx, spec, vars)
+}
+
 func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 	pipeline, err = spec.GetOrParsePipeline()
 	if err != nil {
@@ -337,6 +360,7 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*HTTPTask).config = r.config
 			task.(*HTTPTask).httpClient = r.httpClient
 			task.(*HTTPTask).unrestrictedHTTPClient = r.unrestrictedHTTPClient
+			task.(*HTTPTask).responseCache = r.responseCache
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).bridgeConfig = r.bridgeConfig
@@ -347,6 +371,8 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			// must use the unrestrictedHTTPClient because some node operators
 			// may run external adapters on their own hardware
 			task.(*BridgeTask).httpClient = r.unrestrictedHTTPClient
+			task.(*BridgeTask).responseCache = r.responseCache
+			task.(*BridgeTask).authenticator = r.bridgeAuthenticator
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).legacyChains = r.legacyEVMChains
 			task.(*ETHCallTask).config = r.config
@@ -368,6 +394,9 @@ func (r *runner) InitializePipeline(spec Spec) (pipeline *Pipeline, err error) {
 			task.(*ETHTxTask).specGasLimit = spec.GasLimit
 			task.(*ETHTxTask).jobType = spec.JobType
 			task.(*ETHTxTask).forwardingAllowed = spec.ForwardingAllowed
+		case TaskTypeForEach:
+			task.(*ForEachTask).runner = r
+			task.(*ForEachTask).spec = spec
 		default:
 		}
 	}
@@ -380,6 +409,9 @@ func (r *runner) run(ctx context.Context, pipeline *Pipeline, run *Run, vars Var
 	l.Debug("Initiating tasks for pipeline run of spec")
 
 	scheduler := newScheduler(pipeline, run, vars, l)
+	if !pipeline.dryRun {
+		scheduler.breakers = r.retryBreakers
+	}
 	go scheduler.Run()
 
 	// This is "just in case" for cleaning up any stray reports.
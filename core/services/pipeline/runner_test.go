@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
@@ -42,7 +43,7 @@ func newRunner(t testing.TB, db *sqlx.DB, bridgeORM bridges.ORM, cfg chainlink.G
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	orm := mocks.NewORM(t)
 	c := clhttptest.NewTestLocalOnlyHTTPClient()
-	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, logger.TestLogger(t), c, c)
+	r := pipeline.NewRunner(orm, bridgeORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, logger.TestLogger(t), c, c)
 	return r, orm
 }
 
@@ -510,7 +511,7 @@ func Test_PipelineRunner_HandleFaultsPersistRun(t *testing.T) {
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		ID: 1,
@@ -549,7 +550,7 @@ func Test_PipelineRunner_ExecuteAndInsertFinishedRun_SavingTheSpec(t *testing.T)
 	ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 	legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 	lggr := logger.TestLogger(t)
-	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+	r := pipeline.NewRunner(orm, btORM, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 	spec := pipeline.Spec{
 		DotDagSource: `
@@ -1018,7 +1019,7 @@ func Test_PipelineRunner_ExecuteRun(t *testing.T) {
 		ethKeyStore := cltest.NewKeyStore(t, db).Eth()
 		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg, KeyStore: ethKeyStore})
 		lggr := logger.TestLogger(t)
-		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, lggr, nil, nil)
+		r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), legacyChains, ethKeyStore, nil, nil, lggr, nil, nil)
 
 		template := `
 succeed             [type=memo value=%d]
@@ -1049,3 +1050,129 @@ succeed;
 		assert.Equal(t, "1", trrs[0].Result.Value.(pipeline.ObjectParam).DecimalValue.Decimal().String())
 	})
 }
+
+func Test_PipelineRunner_ExecuteDryRun(t *testing.T) {
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	spec := pipeline.Spec{DotDagSource: `
+ds          [type=bridge name="adapter" requestData=<{"data": $(input)}>]
+parse       [type=jsonparse path="data,result"]
+multiply    [type=multiply times=10]
+encode      [type=ethabiencode abi="report(uint256 value)" data=<{"value": $(multiply)}>]
+submit      [type=ethtx to="0x613a38AC1659769640aaE063C651F48E0250454C" data="$(encode)"]
+ds -> parse -> multiply -> encode -> submit;
+`}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"input": 42})
+	stubs := pipeline.DryRunStubs{"ds": `{"data": {"result": 3}}`}
+
+	run, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, vars, stubs)
+	require.NoError(t, err)
+	require.Len(t, trrs, 5)
+	assert.False(t, run.HasErrors())
+	// the spec itself is never mutated
+	require.Nil(t, spec.Pipeline)
+
+	byDotID := map[string]pipeline.TaskRunResult{}
+	for _, trr := range trrs {
+		byDotID[trr.Task.DotID()] = trr
+	}
+
+	stubbed, recorded := pipeline.IsDryRunStub(byDotID["ds"].Task)
+	assert.True(t, stubbed)
+	assert.Len(t, recorded, 1)
+	assert.Equal(t, pipeline.TaskTypeBridge, byDotID["ds"].Task.Type())
+	assert.Equal(t, "30", byDotID["multiply"].Result.Value.(decimal.Decimal).String())
+
+	stubbed, recorded = pipeline.IsDryRunStub(byDotID["submit"].Task)
+	assert.True(t, stubbed)
+	require.Len(t, recorded, 1)
+	require.Len(t, recorded[0], 1)
+	assert.Equal(t, byDotID["encode"].Result.Value, recorded[0][0].Value)
+	assert.Nil(t, byDotID["submit"].Result.Value)
+
+	stubbed, _ = pipeline.IsDryRunStub(byDotID["parse"].Task)
+	assert.False(t, stubbed)
+}
+
+func Test_PipelineRunner_ExecuteDryRun_HTTP(t *testing.T) {
+	var methods []string
+	var mu sync.Mutex
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		methods = append(methods, req.Method)
+		mu.Unlock()
+		res.WriteHeader(http.StatusOK)
+		_, err := res.Write([]byte(`{"result":10}`))
+		assert.NoError(t, err)
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, logger.TestLogger(t), c, c)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch  [type=http url="%[1]s"]
+parse  [type=jsonparse path="result"]
+post   [type=http method=POST url="%[1]s" requestData=<{"result": $(parse)}>]
+method [type=http method="$(method)" url="%[1]s"]
+fetch -> parse -> post -> method;
+`, s.URL)}
+	vars := pipeline.NewVarsFrom(map[string]interface{}{"method": "DELETE"})
+
+	run, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, vars, pipeline.DryRunStubs{"post": `{"ok": true}`})
+	require.NoError(t, err)
+	require.Len(t, trrs, 4)
+	assert.False(t, run.HasErrors())
+
+	byDotID := map[string]pipeline.TaskRunResult{}
+	for _, trr := range trrs {
+		byDotID[trr.Task.DotID()] = trr
+	}
+
+	stubbed, _ := pipeline.IsDryRunStub(byDotID["fetch"].Task)
+	assert.False(t, stubbed)
+	stubbed, recorded := pipeline.IsDryRunStub(byDotID["post"].Task)
+	assert.True(t, stubbed)
+	require.Len(t, recorded, 1)
+	assert.Equal(t, `{"ok": true}`, byDotID["post"].Result.Value)
+	stubbed, _ = pipeline.IsDryRunStub(byDotID["method"].Task)
+	assert.True(t, stubbed)
+
+	// only the GET request reached the server
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []string{http.MethodGet}, methods)
+}
+
+func Test_PipelineRunner_ExecuteDryRun_CircuitBreaker(t *testing.T) {
+	var requests int
+	var mu sync.Mutex
+	s := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		res.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer s.Close()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, logger.TestLogger(t), c, c)
+
+	spec := pipeline.Spec{DotDagSource: fmt.Sprintf(`
+fetch [type=http url="%s" retries=2 minBackoff="1ms" maxBackoff="1ms" circuitBreakerThreshold=1]
+`, s.URL)}
+
+	// every dry run retries, the failures of earlier dry runs don't open a breaker
+	for i := 1; i <= 3; i++ {
+		run, _, err := r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, err)
+		assert.True(t, run.HasErrors())
+
+		mu.Lock()
+		assert.Equal(t, 2*i, requests)
+		mu.Unlock()
+	}
+}
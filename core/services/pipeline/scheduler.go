@@ -2,6 +2,7 @@ package pipeline
 
 import (
 	"context"
+	"fmt"
 	"sort"
 	"time"
 
@@ -60,6 +61,9 @@ type scheduler struct {
 	pending bool
 	exiting bool
 
+	// breakers is shared across runs, it may be nil
+	breakers *retryBreakers
+
 	taskCh   chan *memoryTaskRun
 	resultCh chan TaskRunResult
 }
@@ -220,13 +224,14 @@ func (s *scheduler) Run() {
 		}
 
 		// if task hasn't reached it's max retry count yet, we schedule it again
-		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil {
+		if result.Attempts < uint(result.Task.TaskRetries()) && result.Result.Error != nil && s.shouldRetry(result) {
 			// we immediately increase the in-flight counter so the pipeline doesn't terminate
 			// while we wait for the next retry
 			s.waiting++
 
 			backoff := backoff.Backoff{
 				Factor: 2,
+				Jitter: result.Task.Base().Jitter,
 				Min:    result.Task.TaskMinBackoff(),
 				Max:    result.Task.TaskMaxBackoff(),
 			}
@@ -255,6 +260,8 @@ func (s *scheduler) Run() {
 			continue
 		}
 
+		s.recordOutcome(result)
+
 		for _, output := range result.Task.Outputs() {
 			id := output.ID()
 			s.dependencies[id]--
@@ -274,6 +281,40 @@ func (s *scheduler) Run() {
 	close(s.taskCh)
 }
 
+// shouldRetry checks the task's retryOn policy and circuit breaker for a failed result.
+func (s *scheduler) shouldRetry(result TaskRunResult) bool {
+	base := result.Task.Base()
+	class := classifyError(result.Result.Error, result.runInfo)
+	if !base.retriesErrorClass(class) {
+		s.logger.Tracew("not retrying task: error class is not covered by retryOn", "dot_id", result.Task.DotID(), "errorClass", class, "retryOn", base.RetryOn)
+		return false
+	}
+	key := retryBreakerKey(s.run.PipelineSpec, s.pipeline, result.Task)
+	if !s.breakers.allow(key, base.CircuitBreakerThreshold, base.TaskCircuitBreakerCooldown()) {
+		s.logger.Debugw("not retrying task: circuit breaker is open", "dot_id", result.Task.DotID())
+		return false
+	}
+
+	spec := s.run.PipelineSpec
+	promPipelineTaskRetries.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, result.Task.DotID(), string(result.Task.Type()), string(class)).Inc()
+	return true
+}
+
+// recordOutcome feeds the final result of a task into its circuit breaker, if it has one.
+func (s *scheduler) recordOutcome(result TaskRunResult) {
+	threshold := result.Task.Base().CircuitBreakerThreshold
+	if s.breakers == nil || threshold == 0 {
+		return
+	}
+	spec := s.run.PipelineSpec
+	open := s.breakers.record(retryBreakerKey(spec, s.pipeline, result.Task), threshold, result.Result.Error != nil)
+	var v float64
+	if open {
+		v = 1
+	}
+	promPipelineTaskCircuitBreakerOpen.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, result.Task.DotID(), string(result.Task.Type())).Set(v)
+}
+
 func (s *scheduler) markRemaining(err error) {
 	now := time.Now()
 	for _, task := range s.pipeline.Tasks {
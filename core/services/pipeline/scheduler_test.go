@@ -104,6 +104,34 @@ func TestScheduler(t *testing.T) {
 				require.Equal(t, uint(2), result.Attempts)
 			},
 		},
+		{
+			name: "retry task: only retry error classes in retryOn",
+			spec: `
+			a [type=median retries=3 minBackoff="1us" maxBackoff="1us" retryOn="http5xx,timeout"]
+			b [type=median index=0]
+			a -> b`,
+			events: []event{
+				{
+					expected: "a",
+					result:   Result{Error: ErrTimeout},
+				},
+				{
+					expected: "a",
+					result:   Result{Error: ErrTaskRunFailed},
+				},
+				// ErrTaskRunFailed is not retried
+				{
+					expected: "b",
+					result:   Result{Value: 1},
+				},
+			},
+			assertion: func(t *testing.T, p Pipeline, results map[int]TaskRunResult) {
+				result := results[p.ByDotID("a").ID()]
+				require.Equal(t, uint(2), result.Attempts)
+				require.Equal(t, uint(1), result.Retries())
+				require.Equal(t, ErrTaskRunFailed, result.Result.Error)
+			},
+		},
 		{
 			name: "retry task + failEarly: cancel pending retries",
 			spec: `
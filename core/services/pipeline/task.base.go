@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/null"
 )
@@ -21,6 +22,17 @@ type BaseTask struct {
 	Retries    null.Uint32   `mapstructure:"retries"`
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
 	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
+	// RetryOn is a comma separated list of error classes to retry, e.g. "http5xx,timeout".
+	// If empty, any error is retried.
+	RetryOn string `mapstructure:"retryOn"`
+	// Jitter randomises each backoff between MinBackoff and the exponential delay.
+	Jitter bool `mapstructure:"jitter"`
+	// CircuitBreakerThreshold suspends retries after this many consecutive runs in which
+	// the task failed after exhausting its retries, until CircuitBreakerCooldown has elapsed.
+	CircuitBreakerThreshold uint32        `mapstructure:"circuitBreakerThreshold"`
+	CircuitBreakerCooldown  time.Duration `mapstructure:"circuitBreakerCooldown"`
+	// retryOn is RetryOn parsed when the task is unmarshaled, nil if any error is retried.
+	retryOn map[ErrorClass]struct{}
 
 	Tags string `mapstructure:"tags" json:"-"`
 
@@ -74,12 +86,39 @@ func (t BaseTask) TaskMinBackoff() time.Duration {
 }
 
 func (t BaseTask) TaskMaxBackoff() time.Duration {
-	if t.MinBackoff > 0 {
+	if t.MaxBackoff > 0 {
 		return t.MaxBackoff
 	}
 	return time.Minute
 }
 
+func (t BaseTask) TaskCircuitBreakerCooldown() time.Duration {
+	if t.CircuitBreakerCooldown > 0 {
+		return t.CircuitBreakerCooldown
+	}
+	return defaultCircuitBreakerCooldown
+}
+
+// retriesErrorClass returns true if the task's retryOn policy covers the given error class.
+func (t BaseTask) retriesErrorClass(class ErrorClass) bool {
+	if t.retryOn == nil {
+		return true
+	}
+	_, ok := t.retryOn[class]
+	return ok
+}
+
+// initRetryPolicy validates the retry policy of the task and parses its retryOn error classes.
+func (t *BaseTask) initRetryPolicy() (err error) {
+	if t.retryOn, err = parseErrorClasses(t.RetryOn); err != nil {
+		return err
+	}
+	if t.MinBackoff > 0 && t.MaxBackoff > 0 && t.MaxBackoff < t.MinBackoff {
+		return errors.Errorf("maxBackoff (%s) must not be less than minBackoff (%s)", t.MaxBackoff, t.MinBackoff)
+	}
+	return nil
+}
+
 func (t BaseTask) TaskTags() string {
 	return t.Tags
 }
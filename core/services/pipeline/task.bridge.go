@@ -2,7 +2,9 @@ package pipeline
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
+	"encoding/hex"
 	"encoding/json"
 	"net/http"
 	"net/url"
@@ -63,12 +65,18 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 	CacheTTL          string `json:"cacheTTL"`
 	Headers           string `json:"headers"`
-
-	specId       int32
-	orm          bridges.ORM
-	config       Config
-	bridgeConfig BridgeConfig
-	httpClient   *http.Client
+	// ResponseCache enables the shared response cache, see httpResponseCache.
+	// Unlike CacheTTL, cached responses are served without calling the adapter.
+	ResponseCache    string `json:"responseCache"`
+	ResponseCacheTTL string `json:"responseCacheTTL"`
+
+	specId        int32
+	orm           bridges.ORM
+	config        Config
+	bridgeConfig  BridgeConfig
+	httpClient    *http.Client
+	responseCache *httpResponseCache
+	authenticator *bridges.Authenticator
 }
 
 var _ Task = (*BridgeTask)(nil)
@@ -93,6 +101,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		includeInputAtKey StringParam
 		cacheTTL          Uint64Param
 		reqHeaders        StringSliceParam
+		responseCache     BoolParam
+		responseCacheTTL  Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&name, From(NonemptyString(t.Name))), "name"),
@@ -100,6 +110,8 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		errors.Wrap(ResolveParam(&includeInputAtKey, From(t.IncludeInputAtKey)), "includeInputAtKey"),
 		errors.Wrap(ResolveParam(&cacheTTL, From(ValidDurationInSeconds(t.CacheTTL), t.bridgeConfig.BridgeCacheTTL().Seconds())), "cacheTTL"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&responseCache, From(NonemptyString(t.ResponseCache), false)), "responseCache"),
+		errors.Wrap(ResolveParam(&responseCacheTTL, From(ValidDurationInSeconds(t.ResponseCacheTTL), 0)), "responseCacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -112,10 +124,18 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 	overtimeCtx, cancel := overtimeContext(ctx)
 	defer cancel()
 
-	url, err := t.getBridgeURLFromName(overtimeCtx, name)
+	bt, err := t.getBridgeTypeFromName(overtimeCtx, name)
 	if err != nil {
 		return Result{Error: err}, runInfo
 	}
+	url := URLParam(bt.URL)
+	httpClient := t.httpClient
+	if t.authenticator != nil {
+		httpClient, err = t.authenticator.Client(bt, t.httpClient)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
 
 	var metaMap MapParam
 
@@ -169,14 +189,26 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		cacheDuration = stalenessCap
 	}
 
-	var cachedResponse bool
-	responseBytes, statusCode, headers, elapsed, err := makeHTTPRequest(requestCtx, lggr, "POST", url, reqHeaders, requestData, t.httpClient, t.config.DefaultHTTPLimit())
-
-	// check for external adapter response object status
-	if code, ok := eautils.BestEffortExtractEAStatus(responseBytes); ok {
-		statusCode = code
+	// async requests carry a unique responseURL, so there is nothing to share
+	var sharedCache *httpResponseCache
+	var cacheScope string
+	if responseCache && t.Async != "true" {
+		sharedCache = t.responseCache
+		cacheScope, err = bridgeResponseCacheScope(bt)
+		if err != nil {
+			return Result{Error: err}, runInfo
+		}
 	}
 
+	var cachedResponse bool
+	resp, sharedResponse, err := makeCachedHTTPRequest(requestCtx, lggr, sharedCache, t.Type(), time.Duration(responseCacheTTL)*time.Second, cacheScope, "POST", url, reqHeaders, requestData, httpClient, true, t.config.DefaultHTTPLimit(), func(resp *httpResponse) {
+		// check for external adapter response object status
+		if code, ok := eautils.BestEffortExtractEAStatus(resp.body); ok {
+			resp.statusCode = code
+		}
+	})
+	responseBytes, statusCode, headers, elapsed := resp.body, resp.statusCode, resp.headers, resp.elapsed
+
 	if err != nil || statusCode != http.StatusOK {
 		if adapterErr := eautils.BestEffortExtractEAError(responseBytes); adapterErr != nil {
 			err = adapterErr
@@ -184,7 +216,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 
 		promBridgeErrors.WithLabelValues(t.Name).Inc()
 		if cacheTTL == 0 {
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, httpRunInfo(statusCode, err)
 		}
 
 		var cacheErr error
@@ -197,7 +229,7 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 					"url", url.String(),
 				)
 			}
-			return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+			return Result{Error: err}, httpRunInfo(statusCode, err)
 		}
 		promBridgeCacheHits.WithLabelValues(t.Name).Inc()
 		lggr.Debugw("Bridge task: request failed, falling back to cache",
@@ -244,16 +276,29 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 		"url", url.String(),
 		"dotID", t.DotID(),
 		"cached", cachedResponse,
+		"sharedResponse", sharedResponse,
 	)
 	return result, runInfo
 }
 
-func (t *BridgeTask) getBridgeURLFromName(ctx context.Context, name StringParam) (URLParam, error) {
+// bridgeResponseCacheScope scopes the shared response cache to the bridge and its auth config, so that
+// responses fetched with the credentials of a bridge are never served to a task calling another bridge
+// with the same URL, nor after the credentials of the bridge changed.
+func bridgeResponseCacheScope(bt bridges.BridgeType) (string, error) {
+	authConfig, err := json.Marshal(bt.AuthConfig)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to encode bridge auth config")
+	}
+	authHash := sha256.Sum256(authConfig)
+	return bt.Name.String() + ":" + hex.EncodeToString(authHash[:]), nil
+}
+
+func (t *BridgeTask) getBridgeTypeFromName(ctx context.Context, name StringParam) (bridges.BridgeType, error) {
 	bt, err := t.orm.FindBridge(ctx, bridges.BridgeName(name))
 	if err != nil {
-		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
+		return bridges.BridgeType{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
-	return URLParam(bt.URL), nil
+	return bt, nil
 }
 
 func withRunInfo(request MapParam, meta MapParam) MapParam {
@@ -13,6 +13,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	"go.uber.org/multierr"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
@@ -35,6 +36,7 @@ type ETHCallTask struct {
 	ExtractRevertReason bool   `json:"extractRevertReason"`
 	EVMChainID          string `json:"evmChainID" mapstructure:"evmChainID"`
 	Block               string `json:"block"`
+	ReadMode            string `json:"readMode"`
 
 	specGasLimit *uint32
 	legacyChains legacyevm.LegacyChainContainer
@@ -81,6 +83,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		gasUnlimited BoolParam
 		chainID      StringParam
 		block        StringParam
+		readMode     StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&contractAddr, From(VarExpr(t.Contract, vars), NonemptyString(t.Contract))), "contract"),
@@ -93,6 +96,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&chainID, From(VarExpr(t.getEvmChainID(), vars), NonemptyString(t.getEvmChainID()), "")), "evmChainID"),
 		errors.Wrap(ResolveParam(&gasUnlimited, From(VarExpr(t.GasUnlimited, vars), NonemptyString(t.GasUnlimited), false)), "gasUnlimited"),
 		errors.Wrap(ResolveParam(&block, From(VarExpr(t.Block, vars), t.Block)), "block"),
+		errors.Wrap(ResolveParam(&readMode, From(VarExpr(t.ReadMode, vars), t.ReadMode)), "readMode"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -134,6 +138,11 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		With("gasTipCap", call.GasTipCap).
 		With("gasFeeCap", call.GasFeeCap)
 
+	// calls of OCR jobs, or with an explicit readMode, are critical reads spread across nodes as configured for the chain
+	if readMode != "" || t.jobType == OffchainReportingJobType || t.jobType == OffchainReporting2JobType {
+		ctx = commonclient.CtxWithReadOpts(ctx, commonclient.ReadOpts{Mode: string(readMode)})
+	}
+
 	start := time.Now()
 
 	var resp []byte
@@ -157,7 +166,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 			}
 		}
 
-		return Result{Error: err}, retryableRunInfo()
+		return Result{Error: err}, rpcRunInfo()
 	}
 
 	promETHCallTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"context"
 	"math/big"
 	"testing"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	evmclimocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client/mocks"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -338,3 +340,50 @@ func TestETHCallTask(t *testing.T) {
 		})
 	}
 }
+
+func TestETHCallTask_ReadMode(t *testing.T) {
+	t.Parallel()
+
+	contractAddr := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+	tests := []struct {
+		name     string
+		readMode string
+		jobType  string
+		expected *commonclient.ReadOpts
+	}{
+		{"not critical", "", pipeline.DirectRequestJobType, nil},
+		{"critical for OCR", "", pipeline.OffchainReportingJobType, &commonclient.ReadOpts{}},
+		{"critical for OCR2", "", pipeline.OffchainReporting2JobType, &commonclient.ReadOpts{}},
+		{"explicit read mode", commonclient.ReadModeQuorum, pipeline.DirectRequestJobType, &commonclient.ReadOpts{Mode: commonclient.ReadModeQuorum}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			task := pipeline.ETHCallTask{
+				BaseTask:   pipeline.NewBaseTask(0, "ethcall", nil, nil, 0),
+				Contract:   contractAddr.Hex(),
+				Data:       "$(foo)",
+				EVMChainID: "0",
+				ReadMode:   test.readMode,
+			}
+
+			ethClient := evmclimocks.NewClient(t)
+			ethClient.
+				On("CallContract", mock.MatchedBy(func(ctx context.Context) bool {
+					opts, ok := commonclient.CtxReadOpts(ctx)
+					if test.expected == nil {
+						return !ok
+					}
+					return ok && opts == *test.expected
+				}), mock.Anything, (*big.Int)(nil)).
+				Return([]byte("baz quux"), nil)
+			cfg := configtest.NewGeneralConfig(t, nil)
+			legacyChains := cltest.NewLegacyChainsWithMockChain(t, ethClient, cfg)
+			task.HelperSetDependencies(legacyChains, cfg.JobPipeline(), nil, test.jobType)
+
+			vars := pipeline.NewVarsFrom(map[string]interface{}{"foo": []byte("foo bar")})
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), vars, nil)
+			require.NoError(t, result.Error)
+			require.Equal(t, []byte("baz quux"), result.Value)
+		})
+	}
+}
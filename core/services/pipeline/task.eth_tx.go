@@ -17,6 +17,7 @@ import (
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/legacyevm"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
@@ -39,6 +40,8 @@ type ETHTxTask struct {
 	FailOnRevert    string `json:"failOnRevert"`
 	EVMChainID      string `json:"evmChainID" mapstructure:"evmChainID"`
 	TransmitChecker string `json:"transmitChecker"`
+	// Priority is the priority class of the transaction, one of low, normal (the default) or high
+	Priority string `json:"priority"`
 
 	forwardingAllowed bool
 	specGasLimit      *uint32
@@ -95,6 +98,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		maybeMinConfirmations MaybeUint64Param
 		transmitCheckerMap    MapParam
 		failOnRevert          BoolParam
+		priority              StringParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -105,6 +109,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(VarExpr(t.MinConfirmations, vars), NonemptyString(t.MinConfirmations), "")), "minConfirmations"),
 		errors.Wrap(ResolveParam(&transmitCheckerMap, From(VarExpr(t.TransmitChecker, vars), JSONWithVarExprs(t.TransmitChecker, vars, false), MapParam{})), "transmitChecker"),
 		errors.Wrap(ResolveParam(&failOnRevert, From(NonemptyString(t.FailOnRevert), false)), "failOnRevert"),
+		errors.Wrap(ResolveParam(&priority, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority), "")), "priority"),
 	)
 	if err != nil {
 		return Result{Error: err}, RunInfo{}
@@ -123,6 +128,11 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		return Result{Error: err}, RunInfo{}
 	}
 
+	txPriority, err := txmgrtypes.ParseTxPriority(string(priority))
+	if err != nil {
+		return Result{Error: errors.Wrapf(ErrBadInput, "priority: %v", err)}, RunInfo{}
+	}
+
 	fromAddr, err := t.keyStore.GetRoundRobinAddress(ctx, chain.ID(), fromAddrs...)
 	if err != nil {
 		err = errors.Wrap(err, "ETHTxTask failed to get fromAddress")
@@ -151,6 +161,7 @@ func (t *ETHTxTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inpu
 		ForwarderAddress: forwarderAddress,
 		Strategy:         strategy,
 		Checker:          transmitChecker,
+		Priority:         txPriority,
 		SignalCallback:   true,
 	}
 
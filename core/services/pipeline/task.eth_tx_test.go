@@ -12,6 +12,7 @@ import (
 
 	clnull "github.com/smartcontractkit/chainlink-common/pkg/utils/null"
 	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
@@ -603,4 +604,49 @@ func TestETHTxTask(t *testing.T) {
 	}
 }
 
+func TestETHTxTask_Priority(t *testing.T) {
+	from := common.HexToAddress("0x882969652440ccf14a5dbb9bd53eb21cb1e11e5c")
+	to := common.HexToAddress("0xDeaDbeefdEAdbeefdEadbEEFdeadbeEFdEaDbeeF")
+
+	run := func(t *testing.T, priority string, setupMocks func(keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager)) pipeline.Result {
+		task := pipeline.ETHTxTask{
+			BaseTask:         pipeline.NewBaseTask(0, "ethtx", nil, nil, 0),
+			From:             from.String(),
+			To:               to.String(),
+			Data:             "foobar",
+			GasLimit:         "12345",
+			MinConfirmations: "0",
+			EVMChainID:       "0",
+			Priority:         priority,
+		}
+		keyStore := keystoremocks.NewEth(t)
+		txManager := txmmocks.NewMockEvmTxManager(t)
+		db := pgtest.NewSqlxDB(t)
+		cfg := configtest.NewGeneralConfig(t, nil)
+		legacyChains := evmtest.NewLegacyChains(t, evmtest.TestChainOpts{DB: db, GeneralConfig: cfg,
+			TxManager: txManager, KeyStore: keyStore})
+		setupMocks(keyStore, txManager)
+		task.HelperSetDependencies(legacyChains, keyStore, nil, pipeline.DirectRequestJobType)
+
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		return result
+	}
+
+	t.Run("sets priority on the transaction", func(t *testing.T) {
+		result := run(t, "high", func(keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {
+			keyStore.On("GetRoundRobinAddress", mock.Anything, testutils.FixtureChainID, from).Return(from, nil)
+			txManager.On("CreateTransaction", mock.Anything, mock.MatchedBy(func(txRequest txmgr.TxRequest) bool {
+				return txRequest.Priority == txmgrtypes.TxPriorityHigh
+			})).Return(txmgr.Tx{}, nil)
+		})
+		require.NoError(t, result.Error)
+	})
+
+	t.Run("rejects unknown priority", func(t *testing.T) {
+		result := run(t, "urgent", func(keyStore *keystoremocks.Eth, txManager *txmmocks.MockEvmTxManager) {})
+		require.Equal(t, pipeline.ErrBadInput, errors.Cause(result.Error))
+		require.ErrorContains(t, result.Error, `invalid transaction priority "urgent"`)
+	})
+}
+
 func ptr[T any](t T) *T { return &t }
@@ -0,0 +1,216 @@
+package pipeline
+
+import (
+	"context"
+	"math"
+	"math/big"
+
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/vm"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ExpressionTask evaluates a sandboxed expr-lang expression over the task inputs
+// and pipeline vars. Vars are available by name and inputs as `inputs`. Numbers
+// are evaluated as decimals and numeric results are returned as decimals. The
+// expression is compiled once, when the spec is parsed, and each run is bounded
+// by a step limit on predicates, e.g. of map or filter, and by the task timeout.
+//
+// e.g.
+//
+//	spread [type=expression expression="ask - bid > 0.5 ? (ask + bid) / 2 : ask"]
+//	scaled [type=expression expression="inputs[0] * 10 ** 18"]
+//
+// Return types:
+//
+//	decimal.Decimal
+//	bool
+//	string
+//	[]interface{}
+//	map[string]interface{}
+//	nil
+type ExpressionTask struct {
+	BaseTask   `mapstructure:",squash"`
+	Expression string `json:"expression"`
+
+	// program is compiled once, when the task is parsed
+	program *vm.Program
+}
+
+var _ Task = (*ExpressionTask)(nil)
+
+const (
+	// expressionMaxNodes bounds the size, and so the evaluation cost, of an expression.
+	expressionMaxNodes = 1000
+	// expressionMemoryBudget bounds the number of elements an expression may allocate.
+	expressionMemoryBudget = 100_000
+	// expressionInputsKey is the name under which task inputs are exposed to the expression.
+	expressionInputsKey = "inputs"
+)
+
+// expressionDisabledBuiltins are non-deterministic or return values that are not JSON compatible.
+var expressionDisabledBuiltins = []string{"now", "date", "duration", "timezone", "keys", "values", "toPairs"}
+
+func (t *ExpressionTask) Type() TaskType {
+	return TaskTypeExpression
+}
+
+func (t *ExpressionTask) validate() (err error) {
+	t.program, err = t.compile()
+	return err
+}
+
+func (t *ExpressionTask) compile() (*vm.Program, error) {
+	if t.Expression == "" {
+		return nil, errors.Wrap(ErrParameterEmpty, "expression")
+	}
+	opts := append([]expr.Option{expr.AllowUndefinedVariables(), expr.MaxNodes(expressionMaxNodes)}, expressionOptions()...)
+	for _, name := range expressionDisabledBuiltins {
+		opts = append(opts, expr.DisableBuiltin(name))
+	}
+	program, err := expr.Compile(t.Expression, opts...)
+	return program, errors.Wrap(err, "expression")
+}
+
+func (t *ExpressionTask) Run(ctx context.Context, _ logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	program := t.program
+	if program == nil {
+		var err error
+		if program, err = t.compile(); err != nil {
+			return Result{Error: err}, runInfo
+		}
+	}
+
+	env := make(map[string]interface{}, len(vars.vars)+2)
+	for k, v := range vars.vars {
+		env[k] = toExpressionValue(v)
+	}
+	inputValues := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		if input.Error == nil {
+			inputValues[i] = toExpressionValue(input.Value)
+		}
+	}
+	env[expressionInputsKey] = inputValues
+	env[expressionBudgetKey] = &expressionBudget{ctx: ctx}
+
+	machine := vm.VM{MemoryBudget: expressionMemoryBudget}
+	out, err := machine.Run(program, env)
+	if ctx.Err() != nil {
+		return Result{Error: errors.Wrap(ErrTimeout, "expression")}, runInfo
+	}
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+
+	value, err := fromExpressionValue(out)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "expression")}, runInfo
+	}
+	return Result{Value: value}, runInfo
+}
+
+// toExpressionValue converts pipeline values into types expr can operate on.
+func toExpressionValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case *decimal.Decimal:
+		if v == nil {
+			return nil
+		}
+		return *v
+	case *big.Int:
+		if v == nil {
+			return nil
+		}
+		return decimal.NewFromBigInt(v, 0)
+	case ObjectParam:
+		switch v.Type {
+		case BoolType:
+			return bool(v.BoolValue)
+		case DecimalType:
+			return v.DecimalValue.Decimal()
+		case StringType:
+			return string(v.StringValue)
+		case SliceType:
+			return toExpressionValue([]interface{}(v.SliceValue))
+		case MapType:
+			return toExpressionValue(map[string]interface{}(v.MapValue))
+		default:
+			return nil
+		}
+	case error:
+		return nil
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, elem := range v {
+			out[i] = toExpressionValue(elem)
+		}
+		return out
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, elem := range v {
+			out[k] = toExpressionValue(elem)
+		}
+		return out
+	default:
+		return v
+	}
+}
+
+// fromExpressionValue converts the result of an expression into a JSON compatible value,
+// with top level numbers returned as decimals so they compose with the math tasks.
+func fromExpressionValue(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case nil, bool, string:
+		return v, nil
+	case decimal.Decimal:
+		return v, nil
+	case int:
+		return decimal.NewFromInt(int64(v)), nil
+	case int64:
+		return decimal.NewFromInt(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return nil, errors.Errorf("result is not a finite number: %v", v)
+		}
+		return decimal.NewFromFloat(v), nil
+	case []interface{}, map[string]interface{}:
+		if err := checkJSONCompatible(v); err != nil {
+			return nil, err
+		}
+		return v, nil
+	default:
+		return nil, errors.Errorf("unsupported result type %T", v)
+	}
+}
+
+func checkJSONCompatible(v interface{}) error {
+	switch v := v.(type) {
+	case nil, bool, string, int, int64, decimal.Decimal:
+		return nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return errors.Errorf("result is not a finite number: %v", v)
+		}
+		return nil
+	case []interface{}:
+		for _, elem := range v {
+			if err := checkJSONCompatible(elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		for _, elem := range v {
+			if err := checkJSONCompatible(elem); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return errors.Errorf("unsupported result type %T", v)
+	}
+}
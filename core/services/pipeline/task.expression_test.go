@@ -0,0 +1,126 @@
+package pipeline_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestExpressionTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	vars := map[string]interface{}{
+		"bid":     decimal.RequireFromString("99.5"),
+		"ask":     "100.5",
+		"jobRun":  map[string]interface{}{"meta": map[string]interface{}{"threshold": 1}},
+		"feeds":   []interface{}{decimal.NewFromInt(3), decimal.NewFromInt(1), decimal.NewFromInt(2)},
+		"errored": assert.AnError,
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		inputs     []pipeline.Result
+		want       interface{}
+	}{
+		{"arithmetic on decimals", "(bid + float(ask)) / 2", nil, decimal.NewFromInt(100)},
+		{"inputs", "inputs[0] * inputs[1]", []pipeline.Result{{Value: decimal.NewFromInt(6)}, {Value: 7}}, decimal.NewFromInt(42)},
+		{"errored input is nil", "inputs[0] == nil", []pipeline.Result{{Error: assert.AnError}}, true},
+		{"errored var is nil", "errored ?? 5", nil, decimal.NewFromInt(5)},
+		{"nested vars", "float(ask) - bid > jobRun.meta.threshold", nil, false},
+		{"branching", `bid > 100 ? "high" : "low"`, nil, "low"},
+		{"builtins", "max(feeds) + len(feeds)", nil, decimal.NewFromInt(6)},
+		{"json result", `{"mid": median(feeds), "sorted": sort(feeds)}`, nil, map[string]interface{}{"mid": decimal.NewFromInt(2), "sorted": []interface{}{decimal.NewFromInt(1), decimal.NewFromInt(2), decimal.NewFromInt(3)}}},
+		{"precision above 2^53", "inputs[0] + 1", []pipeline.Result{{Value: decimal.RequireFromString("9007199254740993")}}, decimal.RequireFromString("9007199254740994")},
+		{"big int", "inputs[0] * 10 ** 18 / 3", []pipeline.Result{{Value: big.NewInt(3)}}, decimal.RequireFromString("1000000000000000000")},
+		{"integer index", "feeds[len(feeds) - 1]", nil, decimal.NewFromInt(2)},
+		{"unary minus", "-bid < 0", nil, true},
+		{"sum", "sum(feeds) + sum(map(feeds, # * 2))", nil, decimal.NewFromInt(18)},
+		{"undefined variable", "missing == nil", nil, true},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expression: test.expression}
+			result, runInfo := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(vars), test.inputs)
+			assert.False(t, runInfo.IsPending)
+			assert.False(t, runInfo.IsRetryable)
+			require.NoError(t, result.Error)
+			if want, ok := test.want.(decimal.Decimal); ok {
+				require.Equal(t, want.String(), result.Value.(decimal.Decimal).String())
+			} else {
+				require.Equal(t, test.want, result.Value)
+			}
+		})
+	}
+}
+
+func TestExpressionTask_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		expression string
+		wantErr    string
+	}{
+		{"empty", "", "parameter is empty"},
+		{"syntax error", "1 +", "unexpected token"},
+		{"division by zero", "1 / 0", "division by zero"},
+		{"nested division by zero", "[1, 1 / 0]", "division by zero"},
+		{"exponent out of range", "2 ** 2000", "out of range"},
+		{"step limit", "let xs = 1..1000; len(filter(xs, all(xs, all(xs, true))))", "step limit"},
+		{"non-deterministic builtin", "now()", "cannot call nil"},
+		{"memory budget", "map(1..1000000, # * 2)", "memory budget exceeded"},
+		{"runtime error", `"a" * 2`, "invalid operation"},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expression: test.expression}
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			require.Error(t, result.Error)
+			assert.Contains(t, result.Error.Error(), test.wantErr)
+		})
+	}
+}
+
+func TestExpressionTask_Timeout(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(testutils.Context(t))
+	cancel()
+	task := pipeline.ExpressionTask{BaseTask: pipeline.NewBaseTask(0, "task", nil, nil, 0), Expression: "len(filter(1..10, # > 0))"}
+	result, _ := task.Run(ctx, logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	require.ErrorIs(t, result.Error, pipeline.ErrTimeout)
+}
+
+func TestExpressionTask_Parse(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`a [type=expression expression="1 +"]`)
+	require.ErrorContains(t, err, "expression")
+
+	p, err := pipeline.Parse(`a [type=expression expression="inputs[0] > 1"]`)
+	require.NoError(t, err)
+	require.Len(t, p.Tasks, 1)
+	assert.Equal(t, pipeline.TaskTypeExpression, p.Tasks[0].Type())
+
+	// runs the program compiled by Parse
+	result, _ := p.Tasks[0].Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), []pipeline.Result{{Value: decimal.NewFromInt(2)}})
+	require.NoError(t, result.Error)
+	assert.Equal(t, true, result.Value)
+}
@@ -0,0 +1,193 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+)
+
+// ForEachTask runs a nested pipeline once per element of an array and returns
+// the array of results, in order. Each nested run sees the outer vars plus
+// $(item) and $(index). Failed elements are returned as errors, so downstream
+// median/mean tasks can apply their own allowedFaults. The nested pipeline must
+// have a single terminal task; it is double quoted since edges contain ">".
+//
+// e.g.
+//
+//	prices [type=foreach input="$(sources)" maxConcurrency=5 allowedFaults=1
+//	        pipeline="fetch [type=http method=GET url=\"$(item.url)\"]; parse [type=jsonparse path=price]; fetch -> parse"]
+//	median [type=median values="$(prices)"]
+//
+// Return types:
+//
+//	[]interface{}
+type ForEachTask struct {
+	BaseTask       `mapstructure:",squash"`
+	Input          string `json:"input"`
+	Pipeline       string `json:"pipeline"`
+	MaxConcurrency string `json:"maxConcurrency"`
+	AllowedFaults  string `json:"allowedFaults"`
+
+	runner *runner
+	spec   Spec
+	// stubs is non-nil during a dry run
+	stubs DryRunStubs
+	// dotIDPrefix is the dotIDPrefix of the pipeline the task belongs to
+	dotIDPrefix string
+}
+
+var _ Task = (*ForEachTask)(nil)
+
+// defaultForEachConcurrency bounds the number of nested runs in flight when maxConcurrency is unset.
+const defaultForEachConcurrency = 10
+
+const (
+	ForEachItemKey  = "item"
+	ForEachIndexKey = "index"
+)
+
+func (t *ForEachTask) Type() TaskType {
+	return TaskTypeForEach
+}
+
+func (t *ForEachTask) validate() error {
+	p, err := Parse(t.Pipeline)
+	if err != nil {
+		return errors.Wrap(err, "pipeline")
+	}
+	var terminals int
+	for _, task := range p.Tasks {
+		if len(task.Outputs()) == 0 {
+			terminals++
+		}
+	}
+	if terminals != 1 {
+		return errors.Errorf("pipeline must have exactly one terminal task, got %d", terminals)
+	}
+	return nil
+}
+
+func (t *ForEachTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	var (
+		items              SliceParam
+		maxConcurrency     MaybeUint64Param
+		maybeAllowedFaults MaybeUint64Param
+	)
+	err := multierr.Combine(
+		errors.Wrap(ResolveParam(&items, From(VarExpr(t.Input, vars), JSONWithVarExprs(t.Input, vars, false), Input(inputs, 0))), "input"),
+		errors.Wrap(ResolveParam(&maxConcurrency, From(t.MaxConcurrency)), "maxConcurrency"),
+		errors.Wrap(ResolveParam(&maybeAllowedFaults, From(t.AllowedFaults)), "allowedFaults"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if t.runner == nil {
+		return Result{Error: errors.New("foreach task was not initialized by a pipeline runner")}, runInfo
+	}
+
+	concurrency := defaultForEachConcurrency
+	if n, isSet := maxConcurrency.Uint64(); isSet && n > 0 {
+		concurrency = int(n)
+	}
+	allowedFaults := len(items) - 1
+	if n, isSet := maybeAllowedFaults.Uint64(); isSet {
+		allowedFaults = int(n)
+	}
+
+	// the nested pipeline is shared by the runs of all elements, like the
+	// pipeline of a job is shared by its runs
+	p, spec, err := t.initializePipeline()
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+
+	results := make([]interface{}, len(items))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	scheduled := 0
+schedule:
+	for i, item := range items {
+		select {
+		case <-ctx.Done():
+			break schedule
+		case sem <- struct{}{}:
+		}
+		scheduled++
+		wg.Add(1)
+		go func(i int, item interface{}) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			r := t.runItem(ctx, lggr, p, spec, vars, i, item)
+			if r.Error != nil {
+				results[i] = r.Error
+			} else {
+				results[i] = r.Value
+			}
+		}(i, item)
+	}
+	wg.Wait()
+	if scheduled < len(items) {
+		return Result{Error: errors.Wrapf(ctx.Err(), "foreach task stopped after %d of %d elements", scheduled, len(items))}, runInfo
+	}
+
+	var faults int
+	for _, r := range results {
+		if _, isErr := r.(error); isErr {
+			faults++
+		}
+	}
+	if len(items) > 0 && faults > allowedFaults {
+		return Result{Error: errors.Wrapf(ErrTooManyErrors, "number of faulty elements %v in foreach task > number allowed faults %v", faults, allowedFaults)}, runInfo
+	}
+
+	return Result{Value: results}, runInfo
+}
+
+// initializePipeline parses the nested pipeline, prefixing the DOT IDs of its
+// tasks with the DOT ID of the foreach task in circuit breaker keys.
+func (t *ForEachTask) initializePipeline() (*Pipeline, Spec, error) {
+	spec := t.spec
+	spec.DotDagSource = t.Pipeline
+	spec.Pipeline = nil
+	p, err := t.runner.InitializePipeline(spec)
+	if err != nil {
+		return nil, spec, err
+	}
+	p.dotIDPrefix = t.dotIDPrefix + t.DotID() + "."
+	for _, task := range p.Tasks {
+		if forEach, ok := task.(*ForEachTask); ok {
+			forEach.dotIDPrefix = p.dotIDPrefix
+		}
+	}
+	if t.stubs != nil {
+		p.stubSideEffects(t.stubs)
+	}
+	return p, spec, nil
+}
+
+func (t *ForEachTask) runItem(ctx context.Context, lggr logger.Logger, p *Pipeline, spec Spec, vars Vars, index int, item interface{}) Result {
+	itemVars := vars.Copy()
+	if err := multierr.Combine(itemVars.Set(ForEachItemKey, item), itemVars.Set(ForEachIndexKey, index)); err != nil {
+		return Result{Error: err}
+	}
+
+	run := NewRun(spec, itemVars)
+	trrs := t.runner.run(ctx, p, run, itemVars)
+	if run.Pending {
+		return Result{Error: errors.Errorf("foreach element %d: async tasks are not supported in a foreach pipeline", index)}
+	}
+
+	final, err := trrs.FinalResult().SingularResult()
+	if err != nil {
+		return Result{Error: err}
+	}
+	if final.Error != nil {
+		lggr.Debugw("foreach element failed", "dotID", t.DotID(), "index", index, "err", final.Error)
+		final.Error = errors.Wrapf(final.Error, "foreach element %d", index)
+	}
+	return final
+}
@@ -0,0 +1,110 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/configtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+)
+
+func TestForEachTask(t *testing.T) {
+	t.Parallel()
+
+	cfg := configtest.NewTestGeneralConfig(t)
+	r := pipeline.NewRunner(nil, nil, cfg.JobPipeline(), cfg.WebServer(), nil, nil, nil, nil, logger.TestLogger(t), nil, nil)
+
+	t.Run("maps each element and feeds median", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+prices [type=foreach input=<[1, 2, "x", 4]> maxConcurrency=2 pipeline=<double [type=multiply input="$(item)" times=2]>]
+median [type=median values="$(prices)" allowedFaults=1]
+prices -> median;
+`}
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.Len(t, trrs, 2)
+
+		var prices, median pipeline.TaskRunResult
+		for _, trr := range trrs {
+			switch trr.Task.DotID() {
+			case "prices":
+				prices = trr
+			case "median":
+				median = trr
+			}
+		}
+		results := prices.Result.Value.([]interface{})
+		require.Len(t, results, 4)
+		assert.Equal(t, "2", results[0].(decimal.Decimal).String())
+		assert.Equal(t, "4", results[1].(decimal.Decimal).String())
+		assert.ErrorContains(t, results[2].(error), "foreach element 2")
+		assert.Equal(t, "8", results[3].(decimal.Decimal).String())
+
+		assert.NoError(t, median.Result.Error)
+		assert.Equal(t, "4", median.Result.Value.(decimal.Decimal).String())
+	})
+
+	t.Run("exposes index and outer vars", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+out [type=foreach input="$(names)" pipeline="scaled [type=multiply input=\"$(index)\" times=\"$(factor)\"]"]
+`}
+		vars := pipeline.NewVarsFrom(map[string]interface{}{"names": []interface{}{"a", "b", "c"}, "factor": 10})
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, vars)
+		require.NoError(t, err)
+		require.NoError(t, trrs[0].Result.Error)
+
+		results := trrs[0].Result.Value.([]interface{})
+		require.Len(t, results, 3)
+		for i, result := range results {
+			assert.Equal(t, decimal.NewFromInt(int64(i*10)).String(), result.(decimal.Decimal).String())
+		}
+	})
+
+	t.Run("too many faults", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+out [type=foreach input=<["x", 1]> allowedFaults=0 pipeline=<double [type=multiply input="$(item)" times=2]>]
+`}
+		_, trrs, err := r.ExecuteRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		assert.ErrorIs(t, trrs[0].Result.Error, pipeline.ErrTooManyErrors)
+	})
+
+	t.Run("stops once the run is cancelled", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+out [type=foreach input=<[1, 2, 3]> maxConcurrency=1 pipeline=<double [type=multiply input="$(item)" times=2]>]
+`}
+		ctx, cancel := context.WithCancel(testutils.Context(t))
+		cancel()
+		_, trrs, err := r.ExecuteRun(ctx, spec, pipeline.NewVarsFrom(nil))
+		require.NoError(t, err)
+		require.Len(t, trrs, 1)
+		assert.ErrorIs(t, trrs[0].Result.Error, context.Canceled)
+		assert.ErrorContains(t, trrs[0].Result.Error, "foreach task stopped after")
+	})
+
+	t.Run("side effects are stubbed in nested pipelines during a dry run", func(t *testing.T) {
+		spec := pipeline.Spec{DotDagSource: `
+out [type=foreach input=<[1, 2]> pipeline="ds [type=bridge name=\"adapter\"]; parse [type=jsonparse path=\"price\"]; ds -> parse"]
+`}
+		_, trrs, err := r.ExecuteDryRun(testutils.Context(t), spec, pipeline.NewVarsFrom(nil), pipeline.DryRunStubs{"ds": `{"price": 7}`})
+		require.NoError(t, err)
+		require.NoError(t, trrs[0].Result.Error)
+		assert.Equal(t, []interface{}{int64(7), int64(7)}, trrs[0].Result.Value)
+	})
+}
+
+func TestForEachTask_Validation(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.Parse(`out [type=foreach input="$(x)" pipeline=<a [type=memo value=1]; b [type=memo value=2]>]`)
+	require.ErrorContains(t, err, "exactly one terminal task")
+
+	_, err = pipeline.Parse(`out [type=foreach input="$(x)"]`)
+	require.ErrorContains(t, err, "empty pipeline")
+}
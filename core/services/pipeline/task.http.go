@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"net/http"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -24,10 +25,14 @@ type HTTPTask struct {
 	RequestData                    string `json:"requestData"`
 	AllowUnrestrictedNetworkAccess string
 	Headers                        string
+	// ResponseCache enables the shared response cache, see httpResponseCache
+	ResponseCache    string `json:"responseCache"`
+	ResponseCacheTTL string `json:"responseCacheTTL"`
 
 	config                 Config
 	httpClient             *http.Client
 	unrestrictedHTTPClient *http.Client
+	responseCache          *httpResponseCache
 }
 
 var _ Task = (*HTTPTask)(nil)
@@ -63,6 +68,8 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		requestData                    MapParam
 		allowUnrestrictedNetworkAccess BoolParam
 		reqHeaders                     StringSliceParam
+		responseCache                  BoolParam
+		responseCacheTTL               Uint64Param
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&method, From(NonemptyString(t.Method), "GET")), "method"),
@@ -73,6 +80,8 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		// You must set allowUnrestrictedNetworkAccess=true on the task to enable variable-interpolated URLs to make restricted network requests
 		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
 		errors.Wrap(ResolveParam(&reqHeaders, From(NonemptyString(t.Headers), "[]")), "reqHeaders"),
+		errors.Wrap(ResolveParam(&responseCache, From(NonemptyString(t.ResponseCache), false)), "responseCache"),
+		errors.Wrap(ResolveParam(&responseCacheTTL, From(ValidDurationInSeconds(t.ResponseCacheTTL), 0)), "responseCacheTTL"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -103,12 +112,17 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 	} else {
 		client = t.httpClient
 	}
-	responseBytes, statusCode, respHeaders, elapsed, err := makeHTTPRequest(requestCtx, lggr, method, url, reqHeaders, requestData, client, t.config.DefaultHTTPLimit())
+	var cache *httpResponseCache
+	if responseCache {
+		cache = t.responseCache
+	}
+	resp, cached, err := makeCachedHTTPRequest(requestCtx, lggr, cache, t.Type(), time.Duration(responseCacheTTL)*time.Second, "", method, url, reqHeaders, requestData, client, bool(allowUnrestrictedNetworkAccess), t.config.DefaultHTTPLimit(), nil)
+	responseBytes, statusCode, respHeaders, elapsed := resp.body, resp.statusCode, resp.headers, resp.elapsed
 	if err != nil {
 		if errors.Is(errors.Cause(err), clhttp.ErrDisallowedIP) {
 			err = errors.Wrap(err, `connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess="true" in the pipeline task spec, e.g. fetch [type="http" method=GET url="$(decode_cbor.url)" allowUnrestrictedNetworkAccess="true"]`)
 		}
-		return Result{Error: err}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+		return Result{Error: err}, httpRunInfo(statusCode, err)
 	}
 
 	lggr.Debugw("HTTP task got response",
@@ -116,6 +130,7 @@ func (t *HTTPTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, input
 		"respHeaders", respHeaders,
 		"url", url.String(),
 		"dotID", t.DotID(),
+		"cached", cached,
 	)
 
 	promHTTPFetchTime.WithLabelValues(t.DotID()).Set(float64(elapsed))
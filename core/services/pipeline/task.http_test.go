@@ -6,6 +6,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -412,3 +413,86 @@ func TestHTTPTask_Headers(t *testing.T) {
 		assert.Equal(t, []string{"Content-Length", "38", "Content-Type", "footype", "User-Agent", "Go-http-client/1.1", "X-Header-1", "foo", "X-Header-2", "bar"}, allHeaders(headers))
 	})
 }
+
+func TestHTTPTask_ResponseCache(t *testing.T) {
+	t.Parallel()
+
+	config := configtest.NewTestGeneralConfig(t)
+	var requests atomic.Int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Cache-Control", r.URL.Query().Get("cache-control"))
+		_, _ = w.Write([]byte(`{"price": 100}`))
+	}))
+	defer s.Close()
+
+	cache := pipeline.NewTestHTTPResponseCache(1024)
+	c := clhttptest.NewTestLocalOnlyHTTPClient()
+	run := func(url, responseCache, ttl string) string {
+		task := pipeline.HTTPTask{
+			BaseTask:         pipeline.NewBaseTask(0, "http", nil, nil, 0),
+			Method:           "GET",
+			URL:              url,
+			ResponseCache:    responseCache,
+			ResponseCacheTTL: ttl,
+		}
+		task.HelperSetDependencies(config.JobPipeline(), c, c)
+		task.HelperSetResponseCache(cache)
+		result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+		require.NoError(t, result.Error)
+		return result.Value.(string)
+	}
+
+	t.Run("disabled by default", func(t *testing.T) {
+		url := s.URL + "/disabled?cache-control=max-age=60"
+		run(url, "", "")
+		run(url, "", "")
+		assert.Equal(t, int32(2), requests.Swap(0))
+	})
+
+	t.Run("per-task TTL", func(t *testing.T) {
+		url := s.URL + "/ttl?cache-control=no-store"
+		assert.Equal(t, `{"price": 100}`, run(url, "true", "1m"))
+		assert.Equal(t, `{"price": 100}`, run(url, "true", "1m"))
+		assert.Equal(t, int32(1), requests.Swap(0))
+	})
+
+	t.Run("Cache-Control max-age", func(t *testing.T) {
+		url := s.URL + "/max-age?cache-control=public,max-age=60"
+		run(url, "true", "")
+		run(url, "true", "")
+		assert.Equal(t, int32(1), requests.Swap(0))
+	})
+
+	t.Run("Cache-Control no-store", func(t *testing.T) {
+		url := s.URL + "/no-store?cache-control=no-store"
+		run(url, "true", "")
+		run(url, "true", "")
+		assert.Equal(t, int32(2), requests.Swap(0))
+	})
+
+	t.Run("unrestricted responses are not shared with restricted requests", func(t *testing.T) {
+		url := s.URL + "/unrestricted?cache-control=max-age=60"
+		r := clhttp.NewRestrictedHTTPClient(config.Database(), logger.TestLogger(t))
+		u := clhttp.NewUnrestrictedHTTPClient()
+		runWith := func(allowUnrestricted string) pipeline.Result {
+			task := pipeline.HTTPTask{
+				BaseTask:                       pipeline.NewBaseTask(0, "http", nil, nil, 0),
+				Method:                         "GET",
+				URL:                            url,
+				ResponseCache:                  "true",
+				AllowUnrestrictedNetworkAccess: allowUnrestricted,
+			}
+			task.HelperSetDependencies(config.JobPipeline(), r, u)
+			task.HelperSetResponseCache(cache)
+			result, _ := task.Run(testutils.Context(t), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+			return result
+		}
+
+		require.NoError(t, runWith("true").Error)
+		result := runWith("false")
+		require.Error(t, result.Error)
+		require.Contains(t, result.Error.Error(), "Connections to local/private and multicast networks are disabled")
+		assert.Equal(t, int32(1), requests.Swap(0))
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query"
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	evmclient "github.com/smartcontractkit/chainlink/v2/core/chains/evm/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
@@ -353,7 +354,13 @@ func (cr *chainReader) addMethod(
 		return err
 	}
 
-	if err = cr.bindings.AddReader(contractName, methodName, read.NewMethodBinding(contractName, methodName, cr.client, cr.ht, confirmations, cr.lggr)); err != nil {
+	switch chainReaderDefinition.ReadMode {
+	case "", commonclient.ReadModeSingle, commonclient.ReadModeHedged, commonclient.ReadModeQuorum:
+	default:
+		return fmt.Errorf("%w: unsupported read mode %q for method %s", commontypes.ErrInvalidConfig, chainReaderDefinition.ReadMode, methodName)
+	}
+
+	if err = cr.bindings.AddReader(contractName, methodName, read.NewMethodBinding(contractName, methodName, cr.client, cr.ht, confirmations, chainReaderDefinition.ReadMode, cr.lggr)); err != nil {
 		return err
 	}
 
@@ -16,6 +16,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/types/query/primitives"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay/evm/codec"
 
+	commonclient "github.com/smartcontractkit/chainlink/v2/common/client"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 
@@ -32,6 +33,8 @@ type MethodBinding struct {
 	ht                   logpoller.HeadTracker
 	lggr                 logger.Logger
 	confirmationsMapping map[primitives.ConfidenceLevel]evmtypes.Confirmations
+	// readMode is the commonclient read mode of the calls, empty for the chain's CriticalReads default
+	readMode string
 
 	// internal state properties
 	codec    commontypes.Codec
@@ -44,6 +47,7 @@ func NewMethodBinding(
 	client evmclient.Client,
 	heads logpoller.HeadTracker,
 	confs map[primitives.ConfidenceLevel]evmtypes.Confirmations,
+	readMode string,
 	lggr logger.Logger,
 ) *MethodBinding {
 	return &MethodBinding{
@@ -53,6 +57,7 @@ func NewMethodBinding(
 		ht:                   heads,
 		lggr:                 lggr,
 		confirmationsMapping: confs,
+		readMode:             readMode,
 		bindings:             make(map[common.Address]struct{}),
 	}
 }
@@ -158,6 +163,8 @@ func (b *MethodBinding) GetLatestValueWithHeadData(ctx context.Context, addr com
 		Data: data,
 	}
 
+	// contract reads are critical, so they are spread across nodes as configured for the chain or the method
+	ctx = commonclient.CtxWithReadOpts(ctx, commonclient.ReadOpts{Mode: b.readMode})
 	bytes, err := b.client.CallContract(ctx, callMsg, blockNum)
 	if err != nil {
 		callErr := newErrorFromCall(
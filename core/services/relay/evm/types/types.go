@@ -128,6 +128,9 @@ type chainReaderDefinitionFields struct {
 	// ConfidenceConfirmations is a mapping between a ConfidenceLevel and the confirmations associated. Confidence levels
 	// should be valid float values.
 	ConfidenceConfirmations map[string]int `json:"confidenceConfirmations,omitempty"`
+	// ReadMode is the mode of critical reads of a method, one of Single, Hedged or Quorum. Defaults to the
+	// NodePool.CriticalReads.Mode of the chain if empty.
+	ReadMode string `json:"readMode,omitempty"`
 }
 
 func (d *ChainReaderDefinition) HasPollingFilter() bool {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/jonboulle/clockwork"
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
@@ -15,6 +16,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
 	gatewayconnector "github.com/smartcontractkit/chainlink/v2/core/capabilities/gateway_connector"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/triggers/cron"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
 	webapitarget "github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/target"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi/trigger"
@@ -58,6 +60,7 @@ const (
 	commandOverrideForWebAPITrigger       = "__builtin_web-api-trigger"
 	commandOverrideForWebAPITarget        = "__builtin_web-api-target"
 	commandOverrideForCustomComputeAction = "__builtin_custom-compute-action"
+	commandOverrideForCronTrigger         = "__builtin_cron-trigger"
 )
 
 type NewOracleFactoryFn func(generic.OracleFactoryParams) (core.OracleFactory, error)
@@ -261,6 +264,18 @@ func (d *Delegate) ServicesForSpec(ctx context.Context, spec job.Job) ([]job.Ser
 		return []job.ServiceCtx{handler, computeSrvc}, nil
 	}
 
+	if spec.StandardCapabilitiesSpec.Command == commandOverrideForCronTrigger {
+		var cfg cron.Config
+		if len(spec.StandardCapabilitiesSpec.Config) > 0 {
+			err := toml.Unmarshal([]byte(spec.StandardCapabilitiesSpec.Config), &cfg)
+			if err != nil {
+				return nil, err
+			}
+		}
+		triggerSrvc := cron.NewTriggerService(cfg, d.registry, clockwork.NewRealClock(), log)
+		return []job.ServiceCtx{triggerSrvc}, nil
+	}
+
 	standardCapability := newStandardCapabilities(log, spec.StandardCapabilitiesSpec, d.cfg, telemetryService, kvStore, d.registry, errorLog,
 		pr, relayerSet, oracleFactory)
 
@@ -21,6 +21,7 @@ import (
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/exec"
 	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
 
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/idempotency"
 	"github.com/smartcontractkit/chainlink/v2/core/capabilities/transmission"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/platform"
@@ -31,6 +32,9 @@ const (
 	fifteenMinutesMs             = 15 * 60 * 1000
 	reservedFieldNameStepTimeout = "cre_step_timeout"
 	maxStepTimeoutOverrideSec    = 10 * 60 // 10 minutes
+	// reservedFieldNameIdempotencyKey is set in the config of requests to actions and targets, so that they can
+	// deduplicate a request executed again after a restart, see idempotency.Key.
+	reservedFieldNameIdempotencyKey = idempotency.ConfigField
 )
 
 type stepRequest struct {
@@ -362,15 +366,22 @@ var (
 	defaultOffset, defaultLimit = 0, 1_000
 )
 
+// resumeInProgressExecutions re-queues the steps of the unfinished executions which are ready to run.
+// Steps which were never started are resumed, and steps which were in flight when the node stopped
+// are executed again with the request ID persisted when they were started.
 func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
-	wipExecutions, err := e.executionStates.GetUnfinished(ctx, e.workflow.id, defaultOffset, defaultLimit)
-	if err != nil {
-		return err
-	}
-
-	// TODO: paginate properly
-	if len(wipExecutions) >= defaultLimit {
-		e.logger.Warnf("possible execution overflow during resumption, work in progress executions: %d >= %d", len(wipExecutions), defaultLimit)
+	// Load all the pages before queueing any step, since executions finishing
+	// while we resume would shift the following pages.
+	var wipExecutions []store.WorkflowExecution
+	for offset := defaultOffset; ; offset += defaultLimit {
+		page, err := e.executionStates.GetUnfinished(ctx, e.workflow.id, offset, defaultLimit)
+		if err != nil {
+			return err
+		}
+		wipExecutions = append(wipExecutions, page...)
+		if len(page) < defaultLimit {
+			break
+		}
 	}
 
 	// Cache the dependents associated with a step.
@@ -378,7 +389,9 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 	// need to calculate the dependents of a step once since
 	// they won't change.
 	refToDeps := map[string][]*step{}
+	var resumed, reexecuted int
 	for _, execution := range wipExecutions {
+		queued := map[string]bool{}
 		for _, step := range execution.Steps {
 			// NOTE: In order to determine what tasks need to be enqueued,
 			// we look at any completed steps, and for each dependent,
@@ -396,10 +409,21 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 					return err
 				}
 
+				refToDeps[step.Ref] = s
 				sds = s
 			}
 
 			for _, sd := range sds {
+				// A step can depend on several completed steps, but must only be queued once.
+				if queued[sd.Ref] {
+					continue
+				}
+				// Steps which already finished must not be executed again.
+				sdState, inFlight := execution.Steps[sd.Ref]
+				if inFlight && sdState.Status != store.StatusStarted {
+					continue
+				}
+
 				ch := make(chan store.WorkflowExecutionStep)
 				added := e.stepUpdatesChMap.add(execution.ExecutionID, stepUpdateChannel{
 					ch:          ch,
@@ -410,10 +434,21 @@ func (e *Engine) resumeInProgressExecutions(ctx context.Context) error {
 					e.wg.Add(1)
 					go e.stepUpdateLoop(ctx, execution.ExecutionID, ch, execution.CreatedAt)
 				}
-				e.queueIfReady(execution, sd)
+				if !e.queueIfReady(execution, sd) {
+					continue
+				}
+				queued[sd.Ref] = true
+				if inFlight {
+					reexecuted++
+					e.metrics.with(platform.KeyStepRef, sd.Ref).incrementWorkflowStepReexecutedCounter(ctx)
+				} else {
+					resumed++
+					e.metrics.with(platform.KeyStepRef, sd.Ref).incrementWorkflowStepResumedCounter(ctx)
+				}
 			}
 		}
 	}
+	e.logger.Infow("resumed in-progress executions", "executions", len(wipExecutions), "resumedSteps", resumed, "reexecutedSteps", reexecuted)
 	return nil
 }
 
@@ -521,6 +556,16 @@ func (e *Engine) stepUpdateLoop(ctx context.Context, executionID string, stepUpd
 	}
 }
 
+// stepRequestID returns the ID of the capability request of the step: the one persisted when the step
+// was started if it was interrupted, or one derived from the execution ID and the step ref otherwise.
+func stepRequestID(state store.WorkflowExecution, ref string) string {
+	if s, ok := state.Steps[ref]; ok && s.RequestID != "" {
+		return s.RequestID
+	}
+	h := sha256.Sum256([]byte(state.ExecutionID + "/" + ref))
+	return hex.EncodeToString(h[:])
+}
+
 func generateExecutionID(workflowID, eventID string) (string, error) {
 	s := sha256.New()
 	_, err := s.Write([]byte(workflowID))
@@ -642,7 +687,8 @@ func (e *Engine) handleStepUpdate(ctx context.Context, stepUpdate store.Workflow
 	return nil
 }
 
-func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
+// queueIfReady enqueues the step if all of its dependencies are completed, and returns whether it was enqueued.
+func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) bool {
 	// Check if all dependencies are completed for the current step
 	var waitingOnDependencies bool
 	for _, dr := range step.Vertex.Dependencies {
@@ -671,6 +717,7 @@ func (e *Engine) queueIfReady(state store.WorkflowExecution, step *step) {
 			stepRef: step.Ref,
 		}
 	}
+	return !waitingOnDependencies
 }
 
 func (e *Engine) finishExecution(ctx context.Context, cma custmsg.MessageEmitter, executionID string, status string) error {
@@ -775,12 +822,29 @@ func (e *Engine) workerForStepRequest(ctx context.Context, msg stepRequest) {
 		Outputs:     store.StepOutput{},
 		ExecutionID: msg.state.ExecutionID,
 		Ref:         msg.stepRef,
+		RequestID:   stepRequestID(msg.state, msg.stepRef),
+	}
+
+	// Persist the request ID before sending the request, so that a step which is
+	// in flight when the node stops is executed again with the same ID.
+	startedStep := store.WorkflowExecutionStep{
+		ExecutionID: stepState.ExecutionID,
+		Ref:         stepState.Ref,
+		Status:      store.StatusStarted,
+		RequestID:   stepState.RequestID,
+	}
+	if curStep, verr := e.workflow.Vertex(msg.stepRef); verr == nil {
+		startedStep.CapabilityDONID = e.donIDForStep(curStep)
+	}
+	_, err := e.executionStates.UpsertStep(ctx, &startedStep)
+	if err != nil {
+		l.Errorf("failed to persist started step; error %v", err)
 	}
 
 	logCustMsg(ctx, cma, "executing step", l)
 
 	stepExecutionStartTime := time.Now()
-	inputs, outputs, err := e.executeStep(ctx, l, msg)
+	inputs, outputs, err := e.executeStep(ctx, l, msg, stepState.RequestID)
 	stepExecutionDuration := time.Since(stepExecutionStartTime).Seconds()
 
 	curStepID := "UNSET"
@@ -864,6 +928,16 @@ func (e *Engine) interpolateEnvVars(config map[string]any, env exec.Env) (*value
 	return configMap, nil
 }
 
+// donIDForStep returns the ID of the DON of the capability of the step.
+func (e *Engine) donIDForStep(step *step) uint32 {
+	// If the capability info is missing a DON, then
+	// the capability is local, and we should use the localNode's DON ID.
+	if !step.info.IsLocal {
+		return step.info.DON.ID
+	}
+	return e.localNode.WorkflowDON.ID
+}
+
 // configForStep fetches the config for the step from the workflow registry (for secrets) and from the capabilities
 // registry (for capability-level configuration). It doesn't perform any caching of the config values, since
 // the two registries perform their own caching.
@@ -884,17 +958,7 @@ func (e *Engine) configForStep(ctx context.Context, lggr logger.Logger, step *st
 	}
 
 	ID := step.info.ID
-
-	// If the capability info is missing a DON, then
-	// the capability is local, and we should use the localNode's DON ID.
-	var donID uint32
-	if !step.info.IsLocal {
-		donID = step.info.DON.ID
-	} else {
-		donID = e.localNode.WorkflowDON.ID
-	}
-
-	capConfig, err := e.registry.ConfigForCapability(ctx, ID, donID)
+	capConfig, err := e.registry.ConfigForCapability(ctx, ID, e.donIDForStep(step))
 	if err != nil {
 		lggr.Warnw(fmt.Sprintf("could not retrieve config from remote registry: %s", err), "capabilityID", ID)
 		return config, nil
@@ -911,7 +975,8 @@ func (e *Engine) configForStep(ctx context.Context, lggr logger.Logger, step *st
 }
 
 // executeStep executes the referenced capability within a step and returns the result.
-func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRequest) (*values.Map, values.Value, error) {
+// The request ID is passed to actions and targets as an idempotency key.
+func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRequest, requestID string) (*values.Map, values.Value, error) {
 	curStep, err := e.workflow.Vertex(msg.stepRef)
 	if err != nil {
 		return nil, nil, err
@@ -953,6 +1018,11 @@ func (e *Engine) executeStep(ctx context.Context, lggr logger.Logger, msg stepRe
 		}
 	}
 
+	switch curStep.info.CapabilityType {
+	case capabilities.CapabilityTypeAction, capabilities.CapabilityTypeTarget:
+		config.Underlying[reservedFieldNameIdempotencyKey] = values.NewString(requestID)
+	}
+
 	tr := capabilities.CapabilityRequest{
 		Inputs: inputsMap,
 		Config: config,
@@ -1051,9 +1121,9 @@ func (e *Engine) isWorkflowFullyProcessed(ctx context.Context, state store.Workf
 	workflowProcessed := true
 	// Let's validate whether the workflow has been fully processed.
 	err = e.workflow.walkDo(workflows.KeywordTrigger, func(s *step) error {
-		// If the step is not part of the state, it is a pending step
-		// so we should consider the workflow as not fully processed.
-		if _, ok := statuses[s.Ref]; !ok {
+		// If the step is not part of the state, it is a pending step, and if it
+		// is started, it is in flight, so we should consider the workflow as not fully processed.
+		if status, ok := statuses[s.Ref]; !ok || status == store.StatusStarted {
 			workflowProcessed = false
 		}
 		return nil
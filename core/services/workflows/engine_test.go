@@ -680,6 +680,88 @@ func TestEngine_ResumesPendingExecutions(t *testing.T) {
 	assert.Equal(t, store.StatusCompleted, gotEx.Status)
 }
 
+func TestEngine_ResumesInFlightStepsWithSameRequestID(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	reg := coreCap.NewRegistry(logger.TestLogger(t))
+
+	trigger := mockNoopTrigger(t)
+	resp, err := values.NewMap(map[string]any{
+		"123": decimal.NewFromFloat(1.00),
+		"456": decimal.NewFromFloat(1.25),
+		"789": decimal.NewFromFloat(1.50),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, reg.Add(ctx, trigger))
+	require.NoError(t, reg.Add(ctx, mockConsensus("")))
+	require.NoError(t, reg.Add(ctx, mockTarget("")))
+
+	outputs, err := values.NewMap(map[string]any{"output": "foo"})
+	require.NoError(t, err)
+	idempotencyKeys := make(chan string, 1)
+	action := newMockCapability(
+		capabilities.MustNewCapabilityInfo(
+			"read_chain_action@1.0.0",
+			capabilities.CapabilityTypeAction,
+			"a read chain action",
+		),
+		func(req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+			var key string
+			if err := req.Config.Underlying[reservedFieldNameIdempotencyKey].UnwrapTo(&key); err != nil {
+				return capabilities.CapabilityResponse{}, err
+			}
+			idempotencyKeys <- key
+			return capabilities.CapabilityResponse{
+				Value: outputs,
+			}, nil
+		},
+	)
+	require.NoError(t, reg.Add(ctx, action))
+
+	dbstore := newTestDBStore(t, clockwork.NewFakeClock())
+	ec := &store.WorkflowExecution{
+		Steps: map[string]*store.WorkflowExecutionStep{
+			workflows.KeywordTrigger: {
+				Outputs: store.StepOutput{
+					Value: resp,
+				},
+				Status:      store.StatusCompleted,
+				ExecutionID: "<execution-ID>",
+				Ref:         workflows.KeywordTrigger,
+			},
+			// The action was in flight when the node stopped.
+			"read_chain_action": {
+				Status:      store.StatusStarted,
+				ExecutionID: "<execution-ID>",
+				Ref:         "read_chain_action",
+				RequestID:   "<request-ID>",
+			},
+		},
+		WorkflowID:  testWorkflowId,
+		ExecutionID: "<execution-ID>",
+		Status:      store.StatusStarted,
+	}
+	_, err = dbstore.Add(ctx, ec)
+	require.NoError(t, err)
+
+	eng, hooks := newTestEngineWithYAMLSpec(
+		t,
+		reg,
+		multiStepWorkflow,
+		func(c *Config) { c.Store = dbstore },
+	)
+	servicetest.Run(t, eng)
+
+	eid := getExecutionId(t, eng, hooks)
+	gotEx, err := dbstore.Get(ctx, eid)
+	require.NoError(t, err)
+	assert.Equal(t, store.StatusCompleted, gotEx.Status)
+	assert.Equal(t, "<request-ID>", <-idempotencyKeys)
+	assert.Equal(t, "<request-ID>", gotEx.Steps["read_chain_action"].RequestID)
+	assert.Equal(t, store.StatusCompleted, gotEx.Steps["read_chain_action"].Status)
+}
+
 func TestEngine_TimesOutOldExecutions(t *testing.T) {
 	t.Parallel()
 	ctx := testutils.Context(t)
@@ -32,6 +32,8 @@ type engineMetrics struct {
 	workflowErrorDurationSeconds       metric.Int64Histogram
 	workflowTimeoutDurationSeconds     metric.Int64Histogram
 	workflowStepDurationSeconds        metric.Int64Histogram
+	workflowStepResumedCounter         metric.Int64Counter
+	workflowStepReexecutedCounter      metric.Int64Counter
 }
 
 func initMonitoringResources() (em *engineMetrics, err error) {
@@ -133,6 +135,16 @@ func initMonitoringResources() (em *engineMetrics, err error) {
 		return nil, fmt.Errorf("failed to register step execution time histogram: %w", err)
 	}
 
+	em.workflowStepResumedCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_steps_resumed")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register workflow step resumed counter: %w", err)
+	}
+
+	em.workflowStepReexecutedCounter, err = beholder.GetMeter().Int64Counter("platform_engine_workflow_steps_reexecuted")
+	if err != nil {
+		return nil, fmt.Errorf("failed to register workflow step reexecuted counter: %w", err)
+	}
+
 	return em, nil
 }
 
@@ -257,3 +269,13 @@ func (c workflowsMetricLabeler) updateWorkflowStepDurationHistogram(ctx context.
 	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
 	c.em.workflowStepDurationSeconds.Record(ctx, duration, metric.WithAttributes(otelLabels...))
 }
+
+func (c workflowsMetricLabeler) incrementWorkflowStepResumedCounter(ctx context.Context) {
+	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+	c.em.workflowStepResumedCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
+
+func (c workflowsMetricLabeler) incrementWorkflowStepReexecutedCounter(ctx context.Context) {
+	otelLabels := monutils.KvMapToOtelAttributes(c.Labels)
+	c.em.workflowStepReexecutedCounter.Add(ctx, 1, metric.WithAttributes(otelLabels...))
+}
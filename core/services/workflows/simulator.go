@@ -0,0 +1,492 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/dominikbraun/graph"
+	"github.com/jonboulle/clockwork"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+	pkgworkflows "github.com/smartcontractkit/chainlink-common/pkg/workflows"
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/sdk"
+
+	coreCap "github.com/smartcontractkit/chainlink/v2/core/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/compute"
+	"github.com/smartcontractkit/chainlink/v2/core/capabilities/webapi"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	ghcapabilities "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/common"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const defaultSimulationExecutionTimeout = time.Minute
+
+// SimulationFixtures are the recorded payloads the stand-in capabilities of a simulation respond with.
+type SimulationFixtures struct {
+	// Triggers maps the ID of each trigger capability to the outputs of the events it emits. Each event starts an
+	// execution, in the order of the triggers of the workflow, then of their events.
+	Triggers map[string][]map[string]any `json:"triggers"`
+	// Responses maps the ref of each consensus and action step to its responses. The n-th execution of a step gets
+	// the n-th response, or the last one once they run out.
+	Responses map[string][]RecordedResponse `json:"responses"`
+	// Fetches are the responses to the HTTP requests sent by compute steps.
+	Fetches []RecordedFetch `json:"fetches"`
+	// Secrets are the secrets of the workflow, by name.
+	Secrets map[string]string `json:"secrets"`
+}
+
+// RecordedResponse is the response of a consensus or action step: either its outputs, or an error.
+type RecordedResponse struct {
+	Outputs map[string]any `json:"outputs"`
+	Error   string         `json:"error"`
+}
+
+// RecordedFetch is the response to the HTTP requests of compute steps with the given method and URL.
+type RecordedFetch struct {
+	Method     string `json:"method"`
+	URL        string `json:"url"`
+	StatusCode int    `json:"statusCode"`
+	Body       string `json:"body"`
+}
+
+func (f RecordedFetch) method() string {
+	if f.Method == "" {
+		return "GET"
+	}
+	return f.Method
+}
+
+// LoadSimulationFixtures decodes JSON simulation fixtures. Integers are decoded as int64, or as *big.Int if they
+// overflow it, so that they keep their precision and type when passed to the workflow.
+func LoadSimulationFixtures(r io.Reader) (SimulationFixtures, error) {
+	var fixtures SimulationFixtures
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	if err := dec.Decode(&fixtures); err != nil {
+		return SimulationFixtures{}, fmt.Errorf("failed to decode simulation fixtures: %w", err)
+	}
+
+	for _, events := range fixtures.Triggers {
+		for i := range events {
+			events[i] = normalizeJSONNumbers(events[i]).(map[string]any)
+		}
+	}
+	for _, responses := range fixtures.Responses {
+		for i := range responses {
+			if responses[i].Outputs != nil {
+				responses[i].Outputs = normalizeJSONNumbers(responses[i].Outputs).(map[string]any)
+			}
+		}
+	}
+	return fixtures, nil
+}
+
+func normalizeJSONNumbers(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		for k, e := range t {
+			t[k] = normalizeJSONNumbers(e)
+		}
+		return t
+	case []any:
+		for i, e := range t {
+			t[i] = normalizeJSONNumbers(e)
+		}
+		return t
+	case json.Number:
+		if i, err := t.Int64(); err == nil {
+			return i
+		}
+		if b, ok := new(big.Int).SetString(t.String(), 10); ok {
+			return b
+		}
+		f, _ := t.Float64()
+		return f
+	default:
+		return v
+	}
+}
+
+// SimulatorConfig configures the simulation of a workflow.
+type SimulatorConfig struct {
+	Lggr          logger.Logger
+	Workflow      sdk.WorkflowSpec
+	WorkflowOwner string
+	WorkflowName  string
+	// Binary is the brotli compressed WASM binary of the workflow, and Config its configuration, as returned by
+	// the WASM file spec factory.
+	Binary   []byte
+	Config   []byte
+	Fixtures SimulationFixtures
+	// ExecutionTimeout bounds the time taken to initialize the workflow and to run each execution.
+	ExecutionTimeout time.Duration
+}
+
+// SimulationTrace is the outcome of a simulation: every step of each execution, and the writes of its targets.
+type SimulationTrace struct {
+	WorkflowID string               `json:"workflowID"`
+	Executions []SimulatedExecution `json:"executions"`
+	Writes     []SimulatedWrite     `json:"writes"`
+}
+
+// Failed returns true if any execution didn't complete.
+func (t SimulationTrace) Failed() bool {
+	for _, e := range t.Executions {
+		if e.Status != store.StatusCompleted && e.Status != store.StatusCompletedEarlyExit {
+			return true
+		}
+	}
+	return false
+}
+
+// SimulatedExecution is an execution of the workflow, started by a recorded trigger event.
+type SimulatedExecution struct {
+	ExecutionID string `json:"executionID"`
+	TriggerID   string `json:"triggerID"`
+	EventID     string `json:"eventID"`
+	Status      string `json:"status"`
+	// Steps are ordered topologically, and by ref among independent steps.
+	Steps []SimulatedStep `json:"steps"`
+}
+
+// SimulatedStep is a step of an execution, with the native representation of its inputs and outputs.
+type SimulatedStep struct {
+	Ref          string `json:"ref"`
+	CapabilityID string `json:"capabilityID"`
+	Status       string `json:"status"`
+	Inputs       any    `json:"inputs"`
+	Outputs      any    `json:"outputs"`
+	Error        string `json:"error,omitempty"`
+}
+
+// SimulatedWrite is a write of a target step, recorded by the in-memory chain writer.
+type SimulatedWrite struct {
+	ExecutionID  string `json:"executionID"`
+	Ref          string `json:"ref"`
+	CapabilityID string `json:"capabilityID"`
+	// Receiver is the address the target is configured to write to, if any.
+	Receiver string `json:"receiver,omitempty"`
+	Inputs   any    `json:"inputs"`
+}
+
+// Simulate runs the workflow with an in-memory store, binding its triggers, consensus and action steps to the recorded
+// fixtures, and its targets to an in-memory chain writer. Compute steps run the workflow binary, answering its HTTP
+// requests with the recorded fetches. Executions are run one at a time, so the trace is deterministic for a given
+// workflow and fixtures.
+func Simulate(ctx context.Context, cfg SimulatorConfig) (SimulationTrace, error) {
+	if cfg.ExecutionTimeout == 0 {
+		cfg.ExecutionTimeout = defaultSimulationExecutionTimeout
+	}
+	lggr := cfg.Lggr.Named("WorkflowSimulator")
+
+	owner, err := hex.DecodeString(strings.TrimPrefix(cfg.WorkflowOwner, "0x"))
+	if err != nil {
+		return SimulationTrace{}, fmt.Errorf("invalid workflow owner %q: %w", cfg.WorkflowOwner, err)
+	}
+	wid, err := pkgworkflows.GenerateWorkflowID(owner, cfg.Binary, cfg.Config, "")
+	if err != nil {
+		return SimulationTrace{}, fmt.Errorf("failed to generate workflow ID: %w", err)
+	}
+	trace := SimulationTrace{WorkflowID: hex.EncodeToString(wid[:])}
+
+	reg := coreCap.NewRegistry(lggr)
+	reg.SetLocalRegistry(simulatedNode{})
+
+	var triggerIDs []string
+	triggers := map[string]*simulatedTrigger{}
+	for _, t := range cfg.Workflow.Triggers {
+		if _, ok := triggers[t.ID]; ok {
+			continue
+		}
+		triggerIDs = append(triggerIDs, t.ID)
+		triggers[t.ID] = newSimulatedTrigger(t.ID)
+		if err = reg.Add(ctx, triggers[t.ID]); err != nil {
+			return SimulationTrace{}, err
+		}
+	}
+
+	writer, usesCompute, err := addSimulatedCapabilities(ctx, reg, cfg.Workflow, cfg.Fixtures.Responses)
+	if err != nil {
+		return SimulationTrace{}, err
+	}
+	if usesCompute {
+		closeCompute, cerr := startSimulatedCompute(ctx, lggr, reg, cfg.Fixtures.Fetches)
+		if cerr != nil {
+			return SimulationTrace{}, cerr
+		}
+		defer closeCompute()
+	}
+
+	var events int
+	for _, triggerID := range triggerIDs {
+		events += len(cfg.Fixtures.Triggers[triggerID])
+	}
+	if events == 0 {
+		return SimulationTrace{}, errors.New("no recorded trigger events to simulate")
+	}
+
+	clock := clockwork.NewFakeClock()
+	initialized := make(chan bool, 1)
+	finished := make(chan string, events)
+	engine, err := NewEngine(ctx, Config{
+		Workflow:       cfg.Workflow,
+		WorkflowID:     trace.WorkflowID,
+		WorkflowOwner:  hex.EncodeToString(owner),
+		WorkflowName:   cfg.WorkflowName,
+		Lggr:           lggr,
+		Registry:       reg,
+		Store:          store.NewMemoryStore(clock),
+		Config:         cfg.Config,
+		Binary:         cfg.Binary,
+		SecretsFetcher: simulatedSecrets(cfg.Fixtures.Secrets),
+		maxRetries:     1,
+		retryMs:        100,
+		afterInit: func(success bool) {
+			initialized <- success
+		},
+		onExecutionFinished: func(weid string) {
+			finished <- weid
+		},
+		clock: clock,
+	})
+	if err != nil {
+		return SimulationTrace{}, fmt.Errorf("failed to create engine: %w", err)
+	}
+	if err = engine.Start(ctx); err != nil {
+		return SimulationTrace{}, err
+	}
+	defer engine.Close()
+
+	select {
+	case success := <-initialized:
+		if !success {
+			return SimulationTrace{}, errors.New("failed to initialize workflow")
+		}
+	case <-time.After(cfg.ExecutionTimeout):
+		return SimulationTrace{}, fmt.Errorf("workflow wasn't initialized within %s", cfg.ExecutionTimeout)
+	case <-ctx.Done():
+		return SimulationTrace{}, ctx.Err()
+	}
+
+	order, err := graph.StableTopologicalSort(engine.workflow.Graph, func(a, b string) bool { return a < b })
+	if err != nil {
+		return SimulationTrace{}, err
+	}
+
+	for _, triggerID := range triggerIDs {
+		for _, outputs := range cfg.Fixtures.Triggers[triggerID] {
+			eventID := fmt.Sprintf("simulated_event_%d", len(trace.Executions))
+			execution, serr := simulateEvent(ctx, engine, triggers[triggerID], eventID, outputs, finished, cfg.ExecutionTimeout)
+			if serr != nil {
+				return SimulationTrace{}, serr
+			}
+			execution.Steps = traceSteps(engine, order, triggerID, execution.state)
+			trace.Executions = append(trace.Executions, execution.SimulatedExecution)
+		}
+	}
+	trace.Writes = writer.Writes()
+	return trace, nil
+}
+
+// addSimulatedCapabilities registers stand-ins for the capabilities of the steps of the workflow, and returns the
+// chain writer its targets write to, and whether the workflow has compute steps, which aren't simulated.
+func addSimulatedCapabilities(ctx context.Context, reg *coreCap.Registry, spec sdk.WorkflowSpec, responses map[string][]RecordedResponse) (*simulatedChainWriter, bool, error) {
+	writer := &simulatedChainWriter{}
+	usesCompute := false
+	added := map[string]bool{}
+	add := func(steps []sdk.StepDefinition, capabilityType capabilities.CapabilityType) error {
+		for _, s := range steps {
+			if s.ID == compute.CapabilityIDCompute {
+				usesCompute = true
+				continue
+			}
+			if added[s.ID] {
+				continue
+			}
+			added[s.ID] = true
+
+			var cp capabilities.BaseCapability
+			if capabilityType == capabilities.CapabilityTypeTarget {
+				cp = newSimulatedTarget(s.ID, writer)
+			} else {
+				cp = newSimulatedCapability(s.ID, capabilityType, responses)
+			}
+			if err := reg.Add(ctx, cp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := add(spec.Actions, capabilities.CapabilityTypeAction); err != nil {
+		return nil, false, err
+	}
+	if err := add(spec.Consensus, capabilities.CapabilityTypeConsensus); err != nil {
+		return nil, false, err
+	}
+	if err := add(spec.Targets, capabilities.CapabilityTypeTarget); err != nil {
+		return nil, false, err
+	}
+	return writer, usesCompute, nil
+}
+
+// startSimulatedCompute starts the compute capability, with a gateway connector answering its HTTP requests with the
+// recorded fetches, and returns a function closing it.
+func startSimulatedCompute(ctx context.Context, lggr logger.Logger, reg *coreCap.Registry, fetches []RecordedFetch) (func(), error) {
+	cfg := compute.Config{
+		ServiceConfig: webapi.ServiceConfig{
+			RateLimiter: common.RateLimiterConfig{
+				GlobalRPS:      100.0,
+				GlobalBurst:    100,
+				PerSenderRPS:   100.0,
+				PerSenderBurst: 100,
+			},
+		},
+	}
+	handler, err := webapi.NewOutgoingConnectorHandler(newSimulatedGatewayConnector(fetches), cfg.ServiceConfig, ghcapabilities.MethodComputeAction, lggr)
+	if err != nil {
+		return nil, err
+	}
+	if err = handler.Start(ctx); err != nil {
+		return nil, err
+	}
+
+	var requests atomic.Int64
+	idGenerator := func() string {
+		return fmt.Sprintf("simulated_request_%d", requests.Add(1))
+	}
+	action, err := compute.NewAction(cfg, lggr, reg, handler, idGenerator)
+	if err != nil {
+		return nil, err
+	}
+	if err = action.Start(ctx); err != nil {
+		return nil, err
+	}
+	return func() {
+		if cerr := action.Close(); cerr != nil {
+			lggr.Errorw("failed to close compute capability", "err", cerr)
+		}
+		if cerr := handler.Close(); cerr != nil {
+			lggr.Errorw("failed to close outgoing connector handler", "err", cerr)
+		}
+	}, nil
+}
+
+type simulatedExecution struct {
+	SimulatedExecution
+	state store.WorkflowExecution
+}
+
+// simulateEvent emits a recorded event from the trigger, and waits for the execution it starts to finish.
+func simulateEvent(ctx context.Context, engine *Engine, trigger *simulatedTrigger, eventID string, outputs map[string]any, finished <-chan string, timeout time.Duration) (simulatedExecution, error) {
+	executionID, err := generateExecutionID(engine.workflow.id, eventID)
+	if err != nil {
+		return simulatedExecution{}, err
+	}
+	eventOutputs, err := values.NewMap(outputs)
+	if err != nil {
+		return simulatedExecution{}, fmt.Errorf("invalid recorded event of trigger %s: %w", trigger.ID, err)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	event := capabilities.TriggerResponse{
+		Event: capabilities.TriggerEvent{
+			TriggerType: trigger.ID,
+			ID:          eventID,
+			Outputs:     eventOutputs,
+		},
+	}
+	select {
+	case trigger.events <- event:
+	case <-timer.C:
+		return simulatedExecution{}, fmt.Errorf("trigger %s event %s wasn't consumed within %s", trigger.ID, eventID, timeout)
+	case <-ctx.Done():
+		return simulatedExecution{}, ctx.Err()
+	}
+
+	select {
+	case <-finished:
+	case <-timer.C:
+		return simulatedExecution{}, fmt.Errorf("execution %s didn't finish within %s", executionID, timeout)
+	case <-ctx.Done():
+		return simulatedExecution{}, ctx.Err()
+	}
+
+	state, err := engine.executionStates.Get(ctx, executionID)
+	if err != nil {
+		return simulatedExecution{}, err
+	}
+	return simulatedExecution{
+		SimulatedExecution: SimulatedExecution{
+			ExecutionID: executionID,
+			TriggerID:   trigger.ID,
+			EventID:     eventID,
+			Status:      state.Status,
+		},
+		state: state,
+	}, nil
+}
+
+// traceSteps returns the steps of the execution in the given order, skipping those which weren't executed.
+func traceSteps(engine *Engine, order []string, triggerID string, state store.WorkflowExecution) []SimulatedStep {
+	var steps []SimulatedStep
+	for _, ref := range order {
+		s, ok := state.Steps[ref]
+		if !ok {
+			continue
+		}
+
+		step := SimulatedStep{
+			Ref:          ref,
+			CapabilityID: triggerID,
+			Status:       s.Status,
+			Outputs:      unwrapTraceValue(s.Outputs.Value),
+		}
+		if ref != pkgworkflows.KeywordTrigger {
+			if v, err := engine.workflow.Vertex(ref); err == nil {
+				step.CapabilityID = v.ID
+			}
+		}
+		if s.Inputs != nil {
+			step.Inputs = unwrapTraceValue(s.Inputs)
+		}
+		if s.Outputs.Err != nil {
+			step.Error = s.Outputs.Err.Error()
+		}
+		steps = append(steps, step)
+	}
+	return steps
+}
+
+// unwrapTraceValue returns the native representation of v, or its string representation if it can't be unwrapped.
+func unwrapTraceValue(v values.Value) any {
+	if v == nil {
+		return nil
+	}
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return unwrapped
+}
+
+// simulatedSecrets is the secrets fetcher of a simulation, returning the same secrets for every workflow.
+type simulatedSecrets map[string]string
+
+func (s simulatedSecrets) SecretsFor(context.Context, string, string, string) (map[string]string, error) {
+	if s == nil {
+		return map[string]string{}, nil
+	}
+	return s, nil
+}
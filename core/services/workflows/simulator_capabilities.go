@@ -0,0 +1,279 @@
+package workflows
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/capabilities"
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/api"
+	"github.com/smartcontractkit/chainlink/v2/core/services/gateway/connector"
+	ghcapabilities "github.com/smartcontractkit/chainlink/v2/core/services/gateway/handlers/capabilities"
+	p2ptypes "github.com/smartcontractkit/chainlink/v2/core/services/p2p/types"
+	"github.com/smartcontractkit/chainlink/v2/core/services/registrysyncer"
+)
+
+// simulatedNode is the metadata registry of a simulation, in which the workflow DON consists of a single local node
+// and no capability has on-chain configuration.
+type simulatedNode struct {
+	peerID p2ptypes.PeerID
+}
+
+func (n simulatedNode) LocalNode(context.Context) (capabilities.Node, error) {
+	return capabilities.Node{
+		PeerID: &n.peerID,
+		WorkflowDON: capabilities.DON{
+			ID:      1,
+			Members: []p2ptypes.PeerID{n.peerID},
+		},
+	}, nil
+}
+
+func (n simulatedNode) ConfigForCapability(context.Context, string, uint32) (registrysyncer.CapabilityConfiguration, error) {
+	return registrysyncer.CapabilityConfiguration{}, nil
+}
+
+// simulatedTrigger is a stand-in for a trigger capability, which emits the recorded events it is sent by the simulator.
+type simulatedTrigger struct {
+	capabilities.CapabilityInfo
+	events chan capabilities.TriggerResponse
+}
+
+var _ capabilities.TriggerCapability = (*simulatedTrigger)(nil)
+
+func newSimulatedTrigger(id string) *simulatedTrigger {
+	return &simulatedTrigger{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(id, capabilities.CapabilityTypeTrigger, "simulated trigger"),
+		events:         make(chan capabilities.TriggerResponse),
+	}
+}
+
+func (t *simulatedTrigger) RegisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) (<-chan capabilities.TriggerResponse, error) {
+	return t.events, nil
+}
+
+func (t *simulatedTrigger) UnregisterTrigger(context.Context, capabilities.TriggerRegistrationRequest) error {
+	return nil
+}
+
+// simulatedCapability is a stand-in for consensus and action capabilities, which responds to each step with the
+// responses recorded for its ref, in order.
+type simulatedCapability struct {
+	capabilities.CapabilityInfo
+
+	mu        sync.Mutex
+	responses map[string][]RecordedResponse
+	calls     map[string]int
+}
+
+var _ capabilities.ExecutableCapability = (*simulatedCapability)(nil)
+
+func newSimulatedCapability(id string, capabilityType capabilities.CapabilityType, responses map[string][]RecordedResponse) *simulatedCapability {
+	return &simulatedCapability{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(id, capabilityType, "simulated "+string(capabilityType)),
+		responses:      responses,
+		calls:          map[string]int{},
+	}
+}
+
+func (c *simulatedCapability) Execute(_ context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	ref := req.Metadata.ReferenceID
+	c.mu.Lock()
+	responses := c.responses[ref]
+	call := c.calls[ref]
+	c.calls[ref]++
+	c.mu.Unlock()
+
+	if len(responses) == 0 {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("no recorded response for step %s (%s)", ref, c.ID)
+	}
+	resp := responses[min(call, len(responses)-1)]
+	if resp.Error != "" {
+		return capabilities.CapabilityResponse{}, errors.New(resp.Error)
+	}
+	outputs, err := values.NewMap(resp.Outputs)
+	if err != nil {
+		return capabilities.CapabilityResponse{}, fmt.Errorf("invalid recorded response for step %s: %w", ref, err)
+	}
+	return capabilities.CapabilityResponse{Value: outputs}, nil
+}
+
+func (c *simulatedCapability) RegisterToWorkflow(context.Context, capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (c *simulatedCapability) UnregisterFromWorkflow(context.Context, capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+// simulatedChainWriter records the writes of the targets of a simulation in memory, instead of transmitting them.
+type simulatedChainWriter struct {
+	mu     sync.Mutex
+	writes []SimulatedWrite
+}
+
+func (w *simulatedChainWriter) record(write SimulatedWrite) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.writes = append(w.writes, write)
+}
+
+// Writes returns the recorded writes, ordered by execution and step.
+func (w *simulatedChainWriter) Writes() []SimulatedWrite {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	writes := append([]SimulatedWrite{}, w.writes...)
+	sort.SliceStable(writes, func(i, j int) bool {
+		if writes[i].ExecutionID != writes[j].ExecutionID {
+			return writes[i].ExecutionID < writes[j].ExecutionID
+		}
+		return writes[i].Ref < writes[j].Ref
+	})
+	return writes
+}
+
+// simulatedTarget is a stand-in for a target capability, which writes to the in-memory chain writer.
+type simulatedTarget struct {
+	capabilities.CapabilityInfo
+	writer *simulatedChainWriter
+}
+
+var _ capabilities.TargetCapability = (*simulatedTarget)(nil)
+
+func newSimulatedTarget(id string, writer *simulatedChainWriter) *simulatedTarget {
+	return &simulatedTarget{
+		CapabilityInfo: capabilities.MustNewCapabilityInfo(id, capabilities.CapabilityTypeTarget, "simulated target"),
+		writer:         writer,
+	}
+}
+
+func (t *simulatedTarget) Execute(_ context.Context, req capabilities.CapabilityRequest) (capabilities.CapabilityResponse, error) {
+	write := SimulatedWrite{
+		ExecutionID:  req.Metadata.WorkflowExecutionID,
+		Ref:          req.Metadata.ReferenceID,
+		CapabilityID: t.ID,
+	}
+	if req.Inputs != nil {
+		write.Inputs = unwrapTraceValue(req.Inputs)
+	}
+	if req.Config != nil {
+		var receiver string
+		if address, ok := req.Config.Underlying["address"]; ok && address.UnwrapTo(&receiver) == nil {
+			write.Receiver = receiver
+		}
+	}
+	t.writer.record(write)
+	return capabilities.CapabilityResponse{}, nil
+}
+
+func (t *simulatedTarget) RegisterToWorkflow(context.Context, capabilities.RegisterToWorkflowRequest) error {
+	return nil
+}
+
+func (t *simulatedTarget) UnregisterFromWorkflow(context.Context, capabilities.UnregisterFromWorkflowRequest) error {
+	return nil
+}
+
+const simulatedGatewayID = "simulated-gateway"
+
+// simulatedGatewayConnector is a stand-in for the gateway connector of the compute capability, which answers the
+// HTTP requests sent by compute steps with the recorded fetch responses instead of sending them.
+type simulatedGatewayConnector struct {
+	fetches []RecordedFetch
+
+	mu       sync.Mutex
+	handlers map[string]connector.GatewayConnectorHandler
+}
+
+var _ connector.GatewayConnector = (*simulatedGatewayConnector)(nil)
+
+func newSimulatedGatewayConnector(fetches []RecordedFetch) *simulatedGatewayConnector {
+	return &simulatedGatewayConnector{fetches: fetches, handlers: map[string]connector.GatewayConnectorHandler{}}
+}
+
+func (g *simulatedGatewayConnector) Start(context.Context) error { return nil }
+
+func (g *simulatedGatewayConnector) Close() error { return nil }
+
+func (g *simulatedGatewayConnector) Ready() error { return nil }
+
+func (g *simulatedGatewayConnector) HealthReport() map[string]error {
+	return map[string]error{g.Name(): nil}
+}
+
+func (g *simulatedGatewayConnector) Name() string { return "SimulatedGatewayConnector" }
+
+func (g *simulatedGatewayConnector) NewAuthHeader(*url.URL) ([]byte, error) { return nil, nil }
+
+func (g *simulatedGatewayConnector) ChallengeResponse(*url.URL, []byte) ([]byte, error) {
+	return nil, nil
+}
+
+func (g *simulatedGatewayConnector) AddHandler(methods []string, handler connector.GatewayConnectorHandler) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, method := range methods {
+		g.handlers[method] = handler
+	}
+	return nil
+}
+
+func (g *simulatedGatewayConnector) SendToGateway(ctx context.Context, gatewayID string, msg *api.Message) error {
+	return g.SignAndSendToGateway(ctx, gatewayID, &msg.Body)
+}
+
+// SignAndSendToGateway answers the request with the first recorded fetch matching its method and URL.
+func (g *simulatedGatewayConnector) SignAndSendToGateway(ctx context.Context, gatewayID string, body *api.MessageBody) error {
+	g.mu.Lock()
+	handler, ok := g.handlers[body.Method]
+	g.mu.Unlock()
+	if !ok {
+		return fmt.Errorf("no handler for method %s", body.Method)
+	}
+
+	var req ghcapabilities.Request
+	if err := json.Unmarshal(body.Payload, &req); err != nil {
+		return fmt.Errorf("failed to unmarshal request: %w", err)
+	}
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	resp := ghcapabilities.Response{
+		ExecutionError: true,
+		ErrorMessage:   fmt.Sprintf("no recorded response for %s %s", method, req.URL),
+	}
+	for _, f := range g.fetches {
+		if strings.EqualFold(f.method(), method) && f.URL == req.URL {
+			resp = ghcapabilities.Response{StatusCode: f.StatusCode, Body: []byte(f.Body)}
+			break
+		}
+	}
+	payload, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+
+	handler.HandleGatewayMessage(ctx, gatewayID, &api.Message{
+		Body: api.MessageBody{
+			MessageId: body.MessageId,
+			Method:    body.Method,
+			DonId:     body.DonId,
+			Payload:   payload,
+			Sender:    gatewayID,
+		},
+	})
+	return nil
+}
+
+func (g *simulatedGatewayConnector) GatewayIDs() []string { return []string{simulatedGatewayID} }
+
+func (g *simulatedGatewayConnector) DonID() string { return "simulated-don" }
@@ -0,0 +1,147 @@
+package workflows
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/workflows/wasm/host"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/wasmtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+const simulatedOwner = "0x0000000000000000000000000000000000000001"
+
+const simulationFixtures = `{
+	"triggers": {
+		"mercury-trigger@1.0.0": [
+			{"feedId": "0x1111111111111111111100000000000000000000000000000000000000000000", "price": 100},
+			{"feedId": "0x1111111111111111111100000000000000000000000000000000000000000000", "price": 101}
+		]
+	},
+	"responses": {
+		"evm_median": [
+			{"outputs": {"report": "first"}},
+			{"error": "not enough observations"}
+		]
+	}
+}`
+
+func TestSimulate(t *testing.T) {
+	ctx := testutils.Context(t)
+	spec, err := (&job.WorkflowSpec{Workflow: hardcodedWorkflow, SpecType: job.YamlSpec}).SDKSpec(ctx)
+	require.NoError(t, err)
+	fixtures, err := LoadSimulationFixtures(strings.NewReader(simulationFixtures))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), fixtures.Triggers["mercury-trigger@1.0.0"][0]["price"])
+
+	cfg := SimulatorConfig{
+		Lggr:          logger.TestLogger(t),
+		Workflow:      spec,
+		WorkflowOwner: simulatedOwner,
+		WorkflowName:  "simulated",
+		Fixtures:      fixtures,
+	}
+	trace, err := Simulate(ctx, cfg)
+	require.NoError(t, err)
+
+	require.Len(t, trace.Executions, 2)
+	assert.True(t, trace.Failed())
+
+	completed := trace.Executions[0]
+	assert.Equal(t, "simulated_event_0", completed.EventID)
+	assert.Equal(t, store.StatusCompleted, completed.Status)
+	var refs []string
+	for _, s := range completed.Steps {
+		refs = append(refs, s.Ref)
+		assert.Equal(t, store.StatusCompleted, s.Status, s.Ref)
+	}
+	assert.Equal(t, []string{"trigger", "evm_median", "write_ethereum-testnet-sepolia@1.0.0", "write_polygon-testnet-mumbai@1.0.0"}, refs)
+	assert.Equal(t, "mercury-trigger@1.0.0", completed.Steps[0].CapabilityID)
+	assert.Equal(t, map[string]any{"report": "first"}, completed.Steps[1].Outputs)
+
+	errored := trace.Executions[1]
+	assert.Equal(t, store.StatusErrored, errored.Status)
+	require.Len(t, errored.Steps, 2)
+	assert.Equal(t, "not enough observations", errored.Steps[1].Error)
+
+	// Only the completed execution reached the targets.
+	require.Len(t, trace.Writes, 2)
+	for _, w := range trace.Writes {
+		assert.Equal(t, completed.ExecutionID, w.ExecutionID)
+		assert.Equal(t, map[string]any{"report": "first"}, w.Inputs)
+	}
+	assert.Equal(t, "0x54e220867af6683aE6DcBF535B4f952cB5116510", trace.Writes[0].Receiver)
+	assert.Equal(t, "0x3F3554832c636721F1fD1822Ccca0354576741Ef", trace.Writes[1].Receiver)
+
+	// Simulations are deterministic.
+	again, err := Simulate(ctx, cfg)
+	require.NoError(t, err)
+	assert.Equal(t, trace, again)
+}
+
+func TestSimulate_NoRecordedResponse(t *testing.T) {
+	ctx := testutils.Context(t)
+	spec, err := (&job.WorkflowSpec{Workflow: hardcodedWorkflow, SpecType: job.YamlSpec}).SDKSpec(ctx)
+	require.NoError(t, err)
+
+	_, err = Simulate(ctx, SimulatorConfig{
+		Lggr:          logger.TestLogger(t),
+		Workflow:      spec,
+		WorkflowOwner: simulatedOwner,
+	})
+	require.ErrorContains(t, err, "no recorded trigger events")
+
+	trace, err := Simulate(ctx, SimulatorConfig{
+		Lggr:          logger.TestLogger(t),
+		Workflow:      spec,
+		WorkflowOwner: simulatedOwner,
+		Fixtures: SimulationFixtures{
+			Triggers: map[string][]map[string]any{"mercury-trigger@1.0.0": {{"price": 100}}},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, trace.Executions, 1)
+	assert.Equal(t, store.StatusErrored, trace.Executions[0].Status)
+	assert.Equal(t, "no recorded response for step evm_median (offchain_reporting@1.0.0)", trace.Executions[0].Steps[1].Error)
+}
+
+func TestSimulate_ComputeFetch(t *testing.T) {
+	ctx := testutils.Context(t)
+	lggr := logger.TestLogger(t)
+	binary := wasmtest.CreateTestBinary("core/capabilities/compute/test/fetch/cmd", filepath.Join(t.TempDir(), "testmodule.wasm"), true, t)
+	spec, err := host.GetWorkflowSpec(ctx, &host.ModuleConfig{Logger: lggr}, binary, nil)
+	require.NoError(t, err)
+
+	trace, err := Simulate(ctx, SimulatorConfig{
+		Lggr:          lggr,
+		Workflow:      *spec,
+		WorkflowOwner: simulatedOwner,
+		Binary:        binary,
+		Fixtures: SimulationFixtures{
+			Triggers: map[string][]map[string]any{"basic-test-trigger@1.0.0": {{"cool_output": "foo"}}},
+			Fetches: []RecordedFetch{{
+				URL:        "https://min-api.cryptocompare.com/data/pricemultifull?fsyms=ETH&tsyms=BTC",
+				StatusCode: 200,
+				Body:       `{"ETH":{"BTC":0.03}}`,
+			}},
+		},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, trace.Executions, 1)
+	execution := trace.Executions[0]
+	assert.Equal(t, store.StatusCompleted, execution.Status)
+	require.Len(t, execution.Steps, 2)
+	assert.Equal(t, "compute", execution.Steps[1].Ref)
+	outputs := execution.Steps[1].Outputs.(map[string]any)["Value"].(map[string]any)
+	assert.EqualValues(t, 200, outputs["StatusCode"])
+	assert.Equal(t, []byte(`{"ETH":{"BTC":0.03}}`), outputs["Body"])
+}
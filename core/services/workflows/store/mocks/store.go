@@ -0,0 +1,385 @@
+// Code generated by mockery v2.46.3. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	store "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Store is an autogenerated mock type for the Store type
+type Store struct {
+	mock.Mock
+}
+
+type Store_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *Store) EXPECT() *Store_Expecter {
+	return &Store_Expecter{mock: &_m.Mock}
+}
+
+// Add provides a mock function with given fields: ctx, state
+func (_m *Store) Add(ctx context.Context, state *store.WorkflowExecution) (store.WorkflowExecution, error) {
+	ret := _m.Called(ctx, state)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Add")
+	}
+
+	var r0 store.WorkflowExecution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *store.WorkflowExecution) (store.WorkflowExecution, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *store.WorkflowExecution) store.WorkflowExecution); ok {
+		r0 = rf(ctx, state)
+	} else {
+		r0 = ret.Get(0).(store.WorkflowExecution)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *store.WorkflowExecution) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_Add_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Add'
+type Store_Add_Call struct {
+	*mock.Call
+}
+
+// Add is a helper method to define mock.On call
+//   - ctx context.Context
+//   - state *store.WorkflowExecution
+func (_e *Store_Expecter) Add(ctx interface{}, state interface{}) *Store_Add_Call {
+	return &Store_Add_Call{Call: _e.mock.On("Add", ctx, state)}
+}
+
+func (_c *Store_Add_Call) Run(run func(ctx context.Context, state *store.WorkflowExecution)) *Store_Add_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*store.WorkflowExecution))
+	})
+	return _c
+}
+
+func (_c *Store_Add_Call) Return(_a0 store.WorkflowExecution, _a1 error) *Store_Add_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_Add_Call) RunAndReturn(run func(context.Context, *store.WorkflowExecution) (store.WorkflowExecution, error)) *Store_Add_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function with given fields: ctx, executionID
+func (_m *Store) Get(ctx context.Context, executionID string) (store.WorkflowExecution, error) {
+	ret := _m.Called(ctx, executionID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 store.WorkflowExecution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (store.WorkflowExecution, error)); ok {
+		return rf(ctx, executionID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) store.WorkflowExecution); ok {
+		r0 = rf(ctx, executionID)
+	} else {
+		r0 = ret.Get(0).(store.WorkflowExecution)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, executionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type Store_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+func (_e *Store_Expecter) Get(ctx interface{}, executionID interface{}) *Store_Get_Call {
+	return &Store_Get_Call{Call: _e.mock.On("Get", ctx, executionID)}
+}
+
+func (_c *Store_Get_Call) Run(run func(ctx context.Context, executionID string)) *Store_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *Store_Get_Call) Return(_a0 store.WorkflowExecution, _a1 error) *Store_Get_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_Get_Call) RunAndReturn(run func(context.Context, string) (store.WorkflowExecution, error)) *Store_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetUnfinished provides a mock function with given fields: ctx, workflowID, offset, limit
+func (_m *Store) GetUnfinished(ctx context.Context, workflowID string, offset int, limit int) ([]store.WorkflowExecution, error) {
+	ret := _m.Called(ctx, workflowID, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUnfinished")
+	}
+
+	var r0 []store.WorkflowExecution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) ([]store.WorkflowExecution, error)); ok {
+		return rf(ctx, workflowID, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, int) []store.WorkflowExecution); ok {
+		r0 = rf(ctx, workflowID, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.WorkflowExecution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, int) error); ok {
+		r1 = rf(ctx, workflowID, offset, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_GetUnfinished_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUnfinished'
+type Store_GetUnfinished_Call struct {
+	*mock.Call
+}
+
+// GetUnfinished is a helper method to define mock.On call
+//   - ctx context.Context
+//   - workflowID string
+//   - offset int
+//   - limit int
+func (_e *Store_Expecter) GetUnfinished(ctx interface{}, workflowID interface{}, offset interface{}, limit interface{}) *Store_GetUnfinished_Call {
+	return &Store_GetUnfinished_Call{Call: _e.mock.On("GetUnfinished", ctx, workflowID, offset, limit)}
+}
+
+func (_c *Store_GetUnfinished_Call) Run(run func(ctx context.Context, workflowID string, offset int, limit int)) *Store_GetUnfinished_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Store_GetUnfinished_Call) Return(_a0 []store.WorkflowExecution, _a1 error) *Store_GetUnfinished_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_GetUnfinished_Call) RunAndReturn(run func(context.Context, string, int, int) ([]store.WorkflowExecution, error)) *Store_GetUnfinished_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListExecutions provides a mock function with given fields: ctx, filter, offset, limit
+func (_m *Store) ListExecutions(ctx context.Context, filter store.ExecutionsFilter, offset int, limit int) ([]store.WorkflowExecution, int, error) {
+	ret := _m.Called(ctx, filter, offset, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListExecutions")
+	}
+
+	var r0 []store.WorkflowExecution
+	var r1 int
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, store.ExecutionsFilter, int, int) ([]store.WorkflowExecution, int, error)); ok {
+		return rf(ctx, filter, offset, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, store.ExecutionsFilter, int, int) []store.WorkflowExecution); ok {
+		r0 = rf(ctx, filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.WorkflowExecution)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, store.ExecutionsFilter, int, int) int); ok {
+		r1 = rf(ctx, filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, store.ExecutionsFilter, int, int) error); ok {
+		r2 = rf(ctx, filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// Store_ListExecutions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListExecutions'
+type Store_ListExecutions_Call struct {
+	*mock.Call
+}
+
+// ListExecutions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter store.ExecutionsFilter
+//   - offset int
+//   - limit int
+func (_e *Store_Expecter) ListExecutions(ctx interface{}, filter interface{}, offset interface{}, limit interface{}) *Store_ListExecutions_Call {
+	return &Store_ListExecutions_Call{Call: _e.mock.On("ListExecutions", ctx, filter, offset, limit)}
+}
+
+func (_c *Store_ListExecutions_Call) Run(run func(ctx context.Context, filter store.ExecutionsFilter, offset int, limit int)) *Store_ListExecutions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(store.ExecutionsFilter), args[2].(int), args[3].(int))
+	})
+	return _c
+}
+
+func (_c *Store_ListExecutions_Call) Return(_a0 []store.WorkflowExecution, _a1 int, _a2 error) *Store_ListExecutions_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *Store_ListExecutions_Call) RunAndReturn(run func(context.Context, store.ExecutionsFilter, int, int) ([]store.WorkflowExecution, int, error)) *Store_ListExecutions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateStatus provides a mock function with given fields: ctx, executionID, status
+func (_m *Store) UpdateStatus(ctx context.Context, executionID string, status string) error {
+	ret := _m.Called(ctx, executionID, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, executionID, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Store_UpdateStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateStatus'
+type Store_UpdateStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - executionID string
+//   - status string
+func (_e *Store_Expecter) UpdateStatus(ctx interface{}, executionID interface{}, status interface{}) *Store_UpdateStatus_Call {
+	return &Store_UpdateStatus_Call{Call: _e.mock.On("UpdateStatus", ctx, executionID, status)}
+}
+
+func (_c *Store_UpdateStatus_Call) Run(run func(ctx context.Context, executionID string, status string)) *Store_UpdateStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *Store_UpdateStatus_Call) Return(_a0 error) *Store_UpdateStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *Store_UpdateStatus_Call) RunAndReturn(run func(context.Context, string, string) error) *Store_UpdateStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpsertStep provides a mock function with given fields: ctx, step
+func (_m *Store) UpsertStep(ctx context.Context, step *store.WorkflowExecutionStep) (store.WorkflowExecution, error) {
+	ret := _m.Called(ctx, step)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpsertStep")
+	}
+
+	var r0 store.WorkflowExecution
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *store.WorkflowExecutionStep) (store.WorkflowExecution, error)); ok {
+		return rf(ctx, step)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *store.WorkflowExecutionStep) store.WorkflowExecution); ok {
+		r0 = rf(ctx, step)
+	} else {
+		r0 = ret.Get(0).(store.WorkflowExecution)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *store.WorkflowExecutionStep) error); ok {
+		r1 = rf(ctx, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Store_UpsertStep_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpsertStep'
+type Store_UpsertStep_Call struct {
+	*mock.Call
+}
+
+// UpsertStep is a helper method to define mock.On call
+//   - ctx context.Context
+//   - step *store.WorkflowExecutionStep
+func (_e *Store_Expecter) UpsertStep(ctx interface{}, step interface{}) *Store_UpsertStep_Call {
+	return &Store_UpsertStep_Call{Call: _e.mock.On("UpsertStep", ctx, step)}
+}
+
+func (_c *Store_UpsertStep_Call) Run(run func(ctx context.Context, step *store.WorkflowExecutionStep)) *Store_UpsertStep_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*store.WorkflowExecutionStep))
+	})
+	return _c
+}
+
+func (_c *Store_UpsertStep_Call) Return(_a0 store.WorkflowExecution, _a1 error) *Store_UpsertStep_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *Store_UpsertStep_Call) RunAndReturn(run func(context.Context, *store.WorkflowExecutionStep) (store.WorkflowExecution, error)) *Store_UpsertStep_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewStore creates a new instance of Store. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewStore(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Store {
+	mock := &Store{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,6 +1,7 @@
 package store
 
 import (
+	"sort"
 	"time"
 
 	"github.com/smartcontractkit/chainlink-common/pkg/values"
@@ -34,13 +35,37 @@ type WorkflowExecutionStep struct {
 	ExecutionID string
 	Ref         string
 	Status      string
+	// RequestID identifies the capability request issued for the step. It is persisted with the
+	// started status before the request is sent, so that a step interrupted by a restart is
+	// executed again with the same ID.
+	RequestID string
+	// CapabilityDONID is the ID of the DON of the capability which executed the step.
+	CapabilityDONID uint32
 
 	Inputs  *values.Map
 	Outputs StepOutput
 
+	StartedAt *time.Time
 	UpdatedAt *time.Time
 }
 
+// FinishedAt returns when the step finished, or nil if it is still in flight.
+func (s WorkflowExecutionStep) FinishedAt() *time.Time {
+	if s.Status == StatusStarted {
+		return nil
+	}
+	return s.UpdatedAt
+}
+
+// Latency returns the time the step took to execute, and false if it is still in flight or wasn't timed.
+func (s WorkflowExecutionStep) Latency() (time.Duration, bool) {
+	finishedAt := s.FinishedAt()
+	if s.StartedAt == nil || finishedAt == nil {
+		return 0, false
+	}
+	return finishedAt.Sub(*s.StartedAt), true
+}
+
 type WorkflowExecution struct {
 	Steps       map[string]*WorkflowExecutionStep
 	ExecutionID string
@@ -65,4 +90,28 @@ func (w WorkflowExecution) ResultForStep(s string) (*exec.Result, bool) {
 	}, true
 }
 
+// Timeline returns the steps of the execution ordered by the time they started.
+func (w WorkflowExecution) Timeline() []*WorkflowExecutionStep {
+	steps := make([]*WorkflowExecutionStep, 0, len(w.Steps))
+	for _, step := range w.Steps {
+		steps = append(steps, step)
+	}
+	sort.Slice(steps, func(i, j int) bool {
+		si, sj := steps[i].StartedAt, steps[j].StartedAt
+		switch {
+		case si == nil || sj == nil:
+			if si == nil && sj == nil {
+				return steps[i].Ref < steps[j].Ref
+			}
+			// Steps which weren't timed come last.
+			return sj == nil
+		case si.Equal(*sj):
+			return steps[i].Ref < steps[j].Ref
+		default:
+			return si.Before(*sj)
+		}
+	})
+	return steps
+}
+
 var _ exec.Results = WorkflowExecution{}
@@ -0,0 +1,42 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowExecution_Timeline(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := start.Add(d)
+		return &ts
+	}
+	execution := WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"target":    {Ref: "target", Status: StatusStarted, StartedAt: at(3 * time.Second), UpdatedAt: at(3 * time.Second)},
+			"consensus": {Ref: "consensus", Status: StatusCompleted, StartedAt: at(time.Second), UpdatedAt: at(3 * time.Second)},
+			"action":    {Ref: "action", Status: StatusErrored, StartedAt: at(time.Second), UpdatedAt: at(2 * time.Second)},
+			"trigger":   {Ref: "trigger", Status: StatusCompleted, StartedAt: at(0), UpdatedAt: at(0)},
+			"untimed":   {Ref: "untimed", Status: StatusCompleted},
+		},
+	}
+
+	var refs []string
+	for _, step := range execution.Timeline() {
+		refs = append(refs, step.Ref)
+	}
+	assert.Equal(t, []string{"trigger", "action", "consensus", "target", "untimed"}, refs)
+
+	latency, ok := execution.Steps["consensus"].Latency()
+	assert.True(t, ok)
+	assert.Equal(t, 2*time.Second, latency)
+
+	_, ok = execution.Steps["target"].Latency()
+	assert.False(t, ok, "in flight steps have no latency")
+	assert.Nil(t, execution.Steps["target"].FinishedAt())
+
+	_, ok = execution.Steps["untimed"].Latency()
+	assert.False(t, ok)
+}
@@ -2,14 +2,29 @@ package store
 
 import (
 	"context"
+	"errors"
+	"time"
 )
 
+// ErrExecutionNotFound is returned when fetching an execution which doesn't exist.
+var ErrExecutionNotFound = errors.New("workflow execution not found")
+
+// ExecutionsFilter selects the executions returned by ListExecutions. Empty fields match all executions.
+type ExecutionsFilter struct {
+	WorkflowID string
+	Status     string
+	// CreatedAfter and CreatedBefore bound the creation time of the executions, inclusively and exclusively.
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
 type Store interface {
 	Add(ctx context.Context, state *WorkflowExecution) (WorkflowExecution, error)
 	UpsertStep(ctx context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error)
 	UpdateStatus(ctx context.Context, executionID string, status string) error
 	Get(ctx context.Context, executionID string) (WorkflowExecution, error)
 	GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error)
+	ListExecutions(ctx context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error)
 }
 
 var _ Store = (*DBStore)(nil)
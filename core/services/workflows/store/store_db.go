@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
@@ -28,6 +30,26 @@ const (
 	defaultPruneBatchSize      = 3000
 )
 
+// executionWithStepColumns selects the columns of workflowExecutionWithStep from the join
+// of the workflow_executions and workflow_steps tables.
+const executionWithStepColumns = `
+		workflow_executions.id AS we_id,
+		workflow_executions.workflow_id AS we_workflow_id,
+		workflow_executions.status AS we_status,
+		workflow_executions.created_at AS we_created_at,
+		workflow_executions.updated_at AS we_updated_at,
+		workflow_executions.finished_at AS we_finished_at,
+		workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
+		workflow_steps.ref AS ws_ref,
+		workflow_steps.status AS ws_status,
+		workflow_steps.request_id AS ws_request_id,
+		workflow_steps.capability_don_id AS ws_capability_don_id,
+		workflow_steps.inputs AS ws_inputs,
+		workflow_steps.output_err AS ws_output_err,
+		workflow_steps.output_value AS ws_output_value,
+		workflow_steps.started_at AS ws_started_at,
+		workflow_steps.updated_at AS ws_updated_at`
+
 // `DBStore` is a postgres-backed
 // data store that persists workflow progress.
 type DBStore struct {
@@ -59,9 +81,12 @@ type workflowStepRow struct {
 	WorkflowExecutionID string `db:"workflow_execution_id"`
 	Ref                 string
 	Status              string
+	RequestID           *string `db:"request_id"`
+	CapabilityDONID     *int64  `db:"capability_don_id"`
 	Inputs              []byte
 	OutputErr           *string    `db:"output_err"`
 	OutputValue         []byte     `db:"output_value"`
+	StartedAt           *time.Time `db:"started_at"`
 	UpdatedAt           *time.Time `db:"updated_at"`
 }
 
@@ -71,9 +96,12 @@ type workflowExecutionWithStep struct {
 	WSWorkflowExecutionID string     `db:"ws_workflow_execution_id"`
 	WSRef                 string     `db:"ws_ref"`
 	WSStatus              string     `db:"ws_status"`
+	WSRequestID           *string    `db:"ws_request_id"`
+	WSCapabilityDONID     *int64     `db:"ws_capability_don_id"`
 	WSInputs              []byte     `db:"ws_inputs"`
 	WSOutputErr           *string    `db:"ws_output_err"`
 	WSOutputValue         []byte     `db:"ws_output_value"`
+	WSStartedAt           *time.Time `db:"ws_started_at"`
 	WSUpdatedAt           *time.Time `db:"ws_updated_at"`
 
 	// WorkflowExecution fields
@@ -165,20 +193,7 @@ func (d *DBStore) UpsertStep(ctx context.Context, stepState *WorkflowExecutionSt
 // Get fetches the ExecutionState from the database.
 func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecution, error) {
 	sql := `
-    SELECT
-			workflow_executions.id AS we_id,
-			workflow_executions.workflow_id AS we_workflow_id,
-			workflow_executions.status AS we_status,
-			workflow_executions.created_at AS we_created_at,
-			workflow_executions.updated_at AS we_updated_at,
-			workflow_executions.finished_at AS we_finished_at,
-			workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
-			workflow_steps.ref AS ws_ref,
-			workflow_steps.status AS ws_status,
-			workflow_steps.inputs AS ws_inputs,
-			workflow_steps.output_err AS ws_output_err,
-			workflow_steps.output_value AS ws_output_value,
-			workflow_steps.updated_at AS ws_updated_at
+	SELECT ` + executionWithStepColumns + `
 	FROM workflow_executions JOIN workflow_steps
 	ON workflow_executions.id = workflow_steps.workflow_execution_id
 	WHERE workflow_executions.id = $1`
@@ -194,7 +209,7 @@ func (d *DBStore) Get(ctx context.Context, executionID string) (WorkflowExecutio
 	}
 	state, ok := idToExecutionState[executionID]
 	if !ok {
-		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s", executionID)
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
 	}
 	return *state, nil
 }
@@ -225,6 +240,9 @@ func workflowExecutionsWithStepToWorkflowExecutions(wews []workflowExecutionWith
 			OutputValue:         jr.WSOutputValue,
 			Inputs:              jr.WSInputs,
 			Status:              jr.WSStatus,
+			RequestID:           jr.WSRequestID,
+			CapabilityDONID:     jr.WSCapabilityDONID,
+			StartedAt:           jr.WSStartedAt,
 			UpdatedAt:           jr.WSUpdatedAt,
 		})
 		if err != nil {
@@ -275,15 +293,29 @@ func stepToState(step workflowStepRow) (*WorkflowExecutionStep, error) {
 		}
 	}
 
+	var requestID string
+	if step.RequestID != nil {
+		requestID = *step.RequestID
+	}
+
+	var donID uint32
+	if step.CapabilityDONID != nil {
+		donID = uint32(*step.CapabilityDONID)
+	}
+
 	return &WorkflowExecutionStep{
-		ExecutionID: step.WorkflowExecutionID,
-		Ref:         step.Ref,
-		Status:      step.Status,
-		Inputs:      inputs,
+		ExecutionID:     step.WorkflowExecutionID,
+		Ref:             step.Ref,
+		Status:          step.Status,
+		RequestID:       requestID,
+		CapabilityDONID: donID,
+		Inputs:          inputs,
 		Outputs: StepOutput{
 			Err:   outputErr,
 			Value: outputs,
 		},
+		StartedAt: step.StartedAt,
+		UpdatedAt: step.UpdatedAt,
 	}, nil
 }
 
@@ -305,6 +337,15 @@ func stateToStep(state *WorkflowExecutionStep) (workflowStepRow, error) {
 		Inputs:              inpb,
 	}
 
+	if state.RequestID != "" {
+		wsr.RequestID = &state.RequestID
+	}
+
+	if state.CapabilityDONID != 0 {
+		donID := int64(state.CapabilityDONID)
+		wsr.CapabilityDONID = &donID
+	}
+
 	if state.Outputs.Value != nil {
 		p := values.Proto(state.Outputs.Value)
 		ob, err := proto.Marshal(p)
@@ -375,20 +416,24 @@ func (d *DBStore) Add(ctx context.Context, state *WorkflowExecution) (WorkflowEx
 }
 
 func (d *DBStore) upsertSteps(ctx context.Context, steps []workflowStepRow) error {
-	for _, s := range steps {
-		now := d.clock.Now()
-		s.UpdatedAt = &now
+	now := d.clock.Now()
+	for i := range steps {
+		steps[i].StartedAt = &now
+		steps[i].UpdatedAt = &now
 	}
 
 	sql := `
 	INSERT INTO
-	workflow_steps(workflow_execution_id, ref, status, inputs, output_err, output_value, updated_at)
-	VALUES (:workflow_execution_id, :ref, :status, :inputs, :output_err, :output_value, :updated_at)
+	workflow_steps(workflow_execution_id, ref, status, request_id, capability_don_id, inputs, output_err, output_value, started_at, updated_at)
+	VALUES (:workflow_execution_id, :ref, :status, :request_id, :capability_don_id, :inputs, :output_err, :output_value, :started_at, :updated_at)
 	ON CONFLICT ON CONSTRAINT uniq_workflow_execution_id_ref
 	DO UPDATE SET
 		workflow_execution_id = EXCLUDED.workflow_execution_id,
 		ref = EXCLUDED.ref,
 		status = EXCLUDED.status,
+		request_id = COALESCE(EXCLUDED.request_id, workflow_steps.request_id),
+		capability_don_id = COALESCE(EXCLUDED.capability_don_id, workflow_steps.capability_don_id),
+		started_at = COALESCE(workflow_steps.started_at, EXCLUDED.started_at),
 		inputs = EXCLUDED.inputs,
 		output_err = EXCLUDED.output_err,
 		output_value = EXCLUDED.output_value,
@@ -426,30 +471,22 @@ func (d *DBStore) transact(ctx context.Context, fn func(*DBStore) error) error {
 	)
 }
 
+// GetUnfinished returns a page of the executions of the workflow which haven't finished yet, with all their steps.
+// The offset and limit apply to executions, most recent first.
 func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
 	sql := `
-	SELECT
-		workflow_steps.workflow_execution_id AS ws_workflow_execution_id,
-		workflow_steps.ref AS ws_ref,
-		workflow_steps.status AS ws_status,
-		workflow_steps.inputs AS ws_inputs,
-		workflow_steps.output_err AS ws_output_err,
-		workflow_steps.output_value AS ws_output_value,
-		workflow_steps.updated_at AS ws_updated_at,
-		workflow_executions.id AS we_id,
-		workflow_executions.workflow_id AS we_workflow_id,
-		workflow_executions.status AS we_status,
-		workflow_executions.created_at AS we_created_at,
-		workflow_executions.updated_at AS we_updated_at,
-		workflow_executions.finished_at AS we_finished_at
+	SELECT ` + executionWithStepColumns + `
 	FROM workflow_executions
 	JOIN workflow_steps
 	ON  workflow_steps.workflow_execution_id = workflow_executions.id
-	WHERE workflow_executions.status = $1
-	AND workflow_executions.workflow_id = $2
-	ORDER BY workflow_executions.created_at DESC
-	LIMIT $3
-	OFFSET $4
+	WHERE workflow_executions.id IN (
+		SELECT id FROM workflow_executions
+		WHERE status = $1
+		AND workflow_id = $2
+		ORDER BY created_at DESC, id
+		LIMIT $3
+		OFFSET $4
+	)
 	`
 	var joinRecords []workflowExecutionWithStep
 	err := d.db.SelectContext(ctx, &joinRecords, sql, StatusStarted, workflowID, limit, offset)
@@ -462,12 +499,81 @@ func (d *DBStore) GetUnfinished(ctx context.Context, workflowID string, offset,
 		return []WorkflowExecution{}, err
 	}
 
+	return sortedExecutions(idToExecutionState), nil
+}
+
+// ListExecutions returns a page of the executions matching the filter, most recent first, with all their steps, and
+// the total number of executions matching the filter.
+func (d *DBStore) ListExecutions(ctx context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	var (
+		where []string
+		args  []any
+	)
+	if filter.WorkflowID != "" {
+		args = append(args, filter.WorkflowID)
+		where = append(where, fmt.Sprintf("workflow_id = $%d", len(args)))
+	}
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		where = append(where, fmt.Sprintf("status = $%d", len(args)))
+	}
+	if filter.CreatedAfter != nil {
+		args = append(args, *filter.CreatedAfter)
+		where = append(where, fmt.Sprintf("created_at >= $%d", len(args)))
+	}
+	if filter.CreatedBefore != nil {
+		args = append(args, *filter.CreatedBefore)
+		where = append(where, fmt.Sprintf("created_at < $%d", len(args)))
+	}
+	conditions := "TRUE"
+	if len(where) > 0 {
+		conditions = strings.Join(where, " AND ")
+	}
+
+	var count int
+	err := d.db.GetContext(ctx, &count, `SELECT count(*) FROM workflow_executions WHERE `+conditions, args...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count workflow executions: %w", err)
+	}
+
+	sql := fmt.Sprintf(`
+	SELECT `+executionWithStepColumns+`
+	FROM workflow_executions
+	JOIN workflow_steps
+	ON workflow_steps.workflow_execution_id = workflow_executions.id
+	WHERE workflow_executions.id IN (
+		SELECT id FROM workflow_executions
+		WHERE %s
+		ORDER BY created_at DESC, id
+		LIMIT $%d
+		OFFSET $%d
+	)`, conditions, len(args)+1, len(args)+2)
+	var joinRecords []workflowExecutionWithStep
+	err = d.db.SelectContext(ctx, &joinRecords, sql, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to list workflow executions: %w", err)
+	}
+
+	idToExecutionState, err := workflowExecutionsWithStepToWorkflowExecutions(joinRecords)
+	if err != nil {
+		return nil, 0, err
+	}
+	return sortedExecutions(idToExecutionState), count, nil
+}
+
+// sortedExecutions returns the executions most recent first.
+func sortedExecutions(idToExecutionState map[string]*WorkflowExecution) []WorkflowExecution {
 	var states []WorkflowExecution
 	for _, s := range idToExecutionState {
 		states = append(states, *s)
 	}
-
-	return states, nil
+	sort.Slice(states, func(i, j int) bool {
+		if states[i].CreatedAt == nil || states[j].CreatedAt == nil || states[i].CreatedAt.Equal(*states[j].CreatedAt) {
+			return states[i].ExecutionID < states[j].ExecutionID
+		}
+		return states[i].CreatedAt.After(*states[j].CreatedAt)
+	})
+	return states
 }
 
 func NewDBStore(ds sqlutil.DataSource, lggr logger.Logger, clock clockwork.Clock) *DBStore {
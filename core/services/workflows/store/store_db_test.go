@@ -5,6 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/jonboulle/clockwork"
 	"github.com/stretchr/testify/assert"
@@ -36,6 +37,14 @@ func newTestDBStore(t *testing.T) *DBStore {
 	return &DBStore{db: db, lggr: logger.TestLogger(t), clock: clockwork.NewFakeClock()}
 }
 
+// zeroStepTimestamps zeroes out the timestamps of the steps, which are added by the db store.
+func zeroStepTimestamps(steps map[string]*WorkflowExecutionStep) {
+	for _, step := range steps {
+		step.StartedAt = nil
+		step.UpdatedAt = nil
+	}
+}
+
 func Test_StoreDB(t *testing.T) {
 	store := newTestDBStore(t)
 
@@ -65,6 +74,7 @@ func Test_StoreDB(t *testing.T) {
 	// but is added by the db store.
 	gotEs.CreatedAt = nil
 	require.NoError(t, err)
+	zeroStepTimestamps(gotEs.Steps)
 	assert.Equal(t, es, gotEs)
 }
 
@@ -166,6 +176,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	es, err = store.UpsertStep(tests.Context(t), stepOne)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(es.Steps)
 	gotStep := es.Steps[stepOne.Ref]
 	assert.Equal(t, stepOne, gotStep)
 
@@ -173,6 +184,7 @@ func Test_StoreDB_UpdateStep(t *testing.T) {
 	es, err = store.UpsertStep(tests.Context(t), stepTwo)
 	require.NoError(t, err)
 
+	zeroStepTimestamps(es.Steps)
 	gotStep = es.Steps[stepTwo.Ref]
 	assert.Equal(t, stepTwo, gotStep)
 }
@@ -311,5 +323,145 @@ func Test_StoreDB_GetUnfinishedSteps(t *testing.T) {
 	assert.Len(t, states, 1)
 	// Zero out the completedAt timestamp
 	states[0].CreatedAt = nil
+	zeroStepTimestamps(states[0].Steps)
 	assert.Equal(t, es, states[0])
 }
+
+func Test_StoreDB_GetUnfinishedPaginatesExecutions(t *testing.T) {
+	store := newTestDBStore(t)
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	for i := 0; i < 3; i++ {
+		id := randomID()
+		es := WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+				"step2": {ExecutionID: id, Ref: "step2", Status: StatusStarted},
+			},
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      StatusStarted,
+		}
+		_, err := store.Add(tests.Context(t), &es)
+		require.NoError(t, err)
+	}
+
+	// The limit applies to executions, not to their steps.
+	first, err := store.GetUnfinished(tests.Context(t), wid, 0, 2)
+	require.NoError(t, err)
+	require.Len(t, first, 2)
+	second, err := store.GetUnfinished(tests.Context(t), wid, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, second, 1)
+
+	seen := map[string]bool{}
+	for _, es := range append(first, second...) {
+		assert.Len(t, es.Steps, 2)
+		seen[es.ExecutionID] = true
+	}
+	assert.Len(t, seen, 3)
+}
+
+func Test_StoreDB_UpsertStepKeepsRequestID(t *testing.T) {
+	store := newTestDBStore(t)
+
+	id := randomID()
+	es := WorkflowExecution{
+		Steps: map[string]*WorkflowExecutionStep{
+			"step1": {ExecutionID: id, Ref: "step1", Status: StatusCompleted},
+		},
+		ExecutionID: id,
+		Status:      StatusStarted,
+	}
+	_, err := store.Add(tests.Context(t), &es)
+	require.NoError(t, err)
+
+	got, err := store.UpsertStep(tests.Context(t), &WorkflowExecutionStep{
+		ExecutionID: id,
+		Ref:         "step2",
+		Status:      StatusStarted,
+		RequestID:   "request-id",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "request-id", got.Steps["step2"].RequestID)
+
+	got, err = store.UpsertStep(tests.Context(t), &WorkflowExecutionStep{
+		ExecutionID: id,
+		Ref:         "step2",
+		Status:      StatusCompleted,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Steps["step2"].Status)
+	assert.Equal(t, "request-id", got.Steps["step2"].RequestID)
+}
+
+func Test_StoreDB_ListExecutions(t *testing.T) {
+	clock := clockwork.NewFakeClock()
+	store := &DBStore{db: pgtest.NewSqlxDB(t), lggr: logger.TestLogger(t), clock: clock}
+
+	wid := randomID()
+	createWorkflow(t, store, wid)
+	start := clock.Now()
+	var ids []string
+	for i, status := range []string{StatusCompleted, StatusErrored, StatusCompleted} {
+		id := randomID()
+		ids = append(ids, id)
+		es := WorkflowExecution{
+			Steps: map[string]*WorkflowExecutionStep{
+				"trigger": {ExecutionID: id, Ref: "trigger", Status: StatusCompleted},
+			},
+			ExecutionID: id,
+			WorkflowID:  wid,
+			Status:      status,
+		}
+		_, err := store.Add(tests.Context(t), &es)
+		require.NoError(t, err, "execution %d", i)
+		clock.Advance(time.Minute)
+	}
+	_, err := store.UpsertStep(tests.Context(t), &WorkflowExecutionStep{
+		ExecutionID:     ids[2],
+		Ref:             "action",
+		Status:          StatusStarted,
+		CapabilityDONID: 2,
+	})
+	require.NoError(t, err)
+	clock.Advance(time.Second)
+	_, err = store.UpsertStep(tests.Context(t), &WorkflowExecutionStep{
+		ExecutionID: ids[2],
+		Ref:         "action",
+		Status:      StatusCompleted,
+	})
+	require.NoError(t, err)
+
+	t.Run("lists the executions of a workflow, most recent first", func(t *testing.T) {
+		executions, count, err := store.ListExecutions(tests.Context(t), ExecutionsFilter{WorkflowID: wid}, 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.Len(t, executions, 2)
+		assert.Equal(t, ids[2], executions[0].ExecutionID)
+		assert.Equal(t, ids[1], executions[1].ExecutionID)
+
+		action := executions[0].Steps["action"]
+		require.NotNil(t, action)
+		assert.Equal(t, uint32(2), action.CapabilityDONID)
+		assert.Equal(t, time.Second, action.UpdatedAt.Sub(*action.StartedAt))
+	})
+
+	t.Run("filters by status", func(t *testing.T) {
+		executions, count, err := store.ListExecutions(tests.Context(t), ExecutionsFilter{WorkflowID: wid, Status: StatusErrored}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, executions, 1)
+		assert.Equal(t, ids[1], executions[0].ExecutionID)
+	})
+
+	t.Run("filters by creation time", func(t *testing.T) {
+		after, before := start.Add(30*time.Second), start.Add(2*time.Minute)
+		executions, count, err := store.ListExecutions(tests.Context(t), ExecutionsFilter{WorkflowID: wid, CreatedAfter: &after, CreatedBefore: &before}, 0, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		require.Len(t, executions, 1)
+		assert.Equal(t, ids[1], executions[0].ExecutionID)
+	})
+}
@@ -0,0 +1,173 @@
+package store
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/jonboulle/clockwork"
+)
+
+// MemoryStore is an in-memory data store of workflow progress, which isn't persisted across restarts.
+// It follows the semantics of DBStore, and is used where no database is available, such as when simulating workflows.
+type MemoryStore struct {
+	mu         sync.RWMutex
+	executions map[string]*WorkflowExecution
+	clock      clockwork.Clock
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore(clock clockwork.Clock) *MemoryStore {
+	return &MemoryStore{executions: map[string]*WorkflowExecution{}, clock: clock}
+}
+
+// Add stores the passed in execution and its steps.
+func (m *MemoryStore) Add(_ context.Context, state *WorkflowExecution) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.executions[state.ExecutionID]; ok {
+		return WorkflowExecution{}, fmt.Errorf("could not insert workflow execution %s: already exists", state.ExecutionID)
+	}
+
+	now := m.clock.Now()
+	execution := &WorkflowExecution{
+		ExecutionID: state.ExecutionID,
+		WorkflowID:  state.WorkflowID,
+		Status:      state.Status,
+		Steps:       map[string]*WorkflowExecutionStep{},
+		CreatedAt:   &now,
+	}
+	m.executions[state.ExecutionID] = execution
+	for _, step := range state.Steps {
+		m.upsertStep(execution, step)
+	}
+
+	return WorkflowExecution{
+		ExecutionID: execution.ExecutionID,
+		WorkflowID:  execution.WorkflowID,
+		Status:      execution.Status,
+		Steps:       state.Steps,
+		CreatedAt:   execution.CreatedAt,
+	}, nil
+}
+
+// UpsertStep inserts or updates the given step, and returns the execution it belongs to.
+func (m *MemoryStore) UpsertStep(_ context.Context, step *WorkflowExecutionStep) (WorkflowExecution, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execution, ok := m.executions[step.ExecutionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", step.ExecutionID, ErrExecutionNotFound)
+	}
+
+	m.upsertStep(execution, step)
+	return copyExecution(execution), nil
+}
+
+func (m *MemoryStore) upsertStep(execution *WorkflowExecution, step *WorkflowExecutionStep) {
+	now := m.clock.Now()
+	updated := *step
+	updated.StartedAt = &now
+	updated.UpdatedAt = &now
+	if existing, ok := execution.Steps[step.Ref]; ok {
+		if updated.RequestID == "" {
+			updated.RequestID = existing.RequestID
+		}
+		if updated.CapabilityDONID == 0 {
+			updated.CapabilityDONID = existing.CapabilityDONID
+		}
+		if existing.StartedAt != nil {
+			updated.StartedAt = existing.StartedAt
+		}
+	}
+	execution.Steps[step.Ref] = &updated
+}
+
+// UpdateStatus updates the status of the given workflow execution.
+func (m *MemoryStore) UpdateStatus(_ context.Context, executionID string, status string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return nil
+	}
+
+	now := m.clock.Now()
+	execution.Status = status
+	execution.UpdatedAt = &now
+	// If we're completing the workflow execution, let's also set a finished_at timestamp.
+	if status != StatusStarted {
+		execution.FinishedAt = &now
+	}
+	return nil
+}
+
+// Get returns the execution with the given ID.
+func (m *MemoryStore) Get(_ context.Context, executionID string) (WorkflowExecution, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	execution, ok := m.executions[executionID]
+	if !ok {
+		return WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id %s: %w", executionID, ErrExecutionNotFound)
+	}
+	return copyExecution(execution), nil
+}
+
+// GetUnfinished returns a page of the executions of the workflow which haven't finished yet, most recent first.
+func (m *MemoryStore) GetUnfinished(ctx context.Context, workflowID string, offset, limit int) ([]WorkflowExecution, error) {
+	executions, _, err := m.ListExecutions(ctx, ExecutionsFilter{WorkflowID: workflowID, Status: StatusStarted}, offset, limit)
+	return executions, err
+}
+
+// ListExecutions returns a page of the executions matching the filter, most recent first, and the total number of
+// executions matching the filter.
+func (m *MemoryStore) ListExecutions(_ context.Context, filter ExecutionsFilter, offset, limit int) ([]WorkflowExecution, int, error) {
+	m.mu.RLock()
+	matching := map[string]*WorkflowExecution{}
+	for id, execution := range m.executions {
+		if filter.matches(execution) {
+			copied := copyExecution(execution)
+			matching[id] = &copied
+		}
+	}
+	m.mu.RUnlock()
+
+	executions := sortedExecutions(matching)
+	count := len(executions)
+	if offset >= count {
+		return []WorkflowExecution{}, count, nil
+	}
+	executions = executions[offset:]
+	if limit < len(executions) {
+		executions = executions[:limit]
+	}
+	return executions, count, nil
+}
+
+func (f ExecutionsFilter) matches(execution *WorkflowExecution) bool {
+	if f.WorkflowID != "" && execution.WorkflowID != f.WorkflowID {
+		return false
+	}
+	if f.Status != "" && execution.Status != f.Status {
+		return false
+	}
+	if f.CreatedAfter != nil && execution.CreatedAt.Before(*f.CreatedAfter) {
+		return false
+	}
+	if f.CreatedBefore != nil && !execution.CreatedAt.Before(*f.CreatedBefore) {
+		return false
+	}
+	return true
+}
+
+// copyExecution copies the execution and its steps, so that callers can't mutate the stored state.
+func copyExecution(execution *WorkflowExecution) WorkflowExecution {
+	copied := *execution
+	copied.Steps = make(map[string]*WorkflowExecutionStep, len(execution.Steps))
+	for ref, step := range execution.Steps {
+		s := *step
+		copied.Steps[ref] = &s
+	}
+	return copied
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jonboulle/clockwork"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+)
+
+func Test_MemoryStore(t *testing.T) {
+	ctx := testutils.Context(t)
+	clock := clockwork.NewFakeClock()
+	ms := NewMemoryStore(clock)
+
+	_, err := ms.Add(ctx, &WorkflowExecution{
+		ExecutionID: "execution-1",
+		WorkflowID:  "workflow-1",
+		Status:      StatusStarted,
+		Steps: map[string]*WorkflowExecutionStep{
+			"trigger": {ExecutionID: "execution-1", Ref: "trigger", Status: StatusCompleted},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ms.Add(ctx, &WorkflowExecution{ExecutionID: "execution-1"})
+	require.Error(t, err)
+
+	started := clock.Now()
+	_, err = ms.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "execution-1", Ref: "action", Status: StatusStarted, RequestID: "request-1", CapabilityDONID: 2})
+	require.NoError(t, err)
+
+	clock.Advance(time.Second)
+	execution, err := ms.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "execution-1", Ref: "action", Status: StatusCompleted})
+	require.NoError(t, err)
+	step := execution.Steps["action"]
+	assert.Equal(t, StatusCompleted, step.Status)
+	assert.Equal(t, "request-1", step.RequestID)
+	assert.Equal(t, uint32(2), step.CapabilityDONID)
+	assert.Equal(t, started, *step.StartedAt)
+	latency, ok := step.Latency()
+	require.True(t, ok)
+	assert.Equal(t, time.Second, latency)
+
+	// Mutating a returned execution doesn't affect the store.
+	execution.Steps["action"].Status = StatusErrored
+	got, err := ms.Get(ctx, "execution-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Steps["action"].Status)
+
+	require.NoError(t, ms.UpdateStatus(ctx, "execution-1", StatusCompleted))
+	got, err = ms.Get(ctx, "execution-1")
+	require.NoError(t, err)
+	assert.Equal(t, StatusCompleted, got.Status)
+	assert.NotNil(t, got.FinishedAt)
+
+	_, err = ms.Get(ctx, "unknown")
+	assert.True(t, errors.Is(err, ErrExecutionNotFound))
+	_, err = ms.UpsertStep(ctx, &WorkflowExecutionStep{ExecutionID: "unknown", Ref: "action"})
+	assert.True(t, errors.Is(err, ErrExecutionNotFound))
+}
+
+func Test_MemoryStore_ListExecutions(t *testing.T) {
+	ctx := testutils.Context(t)
+	clock := clockwork.NewFakeClock()
+	ms := NewMemoryStore(clock)
+
+	start := clock.Now()
+	for _, id := range []string{"execution-1", "execution-2", "execution-3"} {
+		_, err := ms.Add(ctx, &WorkflowExecution{ExecutionID: id, WorkflowID: "workflow-1", Status: StatusStarted})
+		require.NoError(t, err)
+		clock.Advance(time.Minute)
+	}
+	require.NoError(t, ms.UpdateStatus(ctx, "execution-2", StatusErrored))
+
+	executions, count, err := ms.ListExecutions(ctx, ExecutionsFilter{}, 0, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	require.Len(t, executions, 2)
+	assert.Equal(t, "execution-3", executions[0].ExecutionID)
+	assert.Equal(t, "execution-2", executions[1].ExecutionID)
+
+	executions, _, err = ms.ListExecutions(ctx, ExecutionsFilter{}, 2, 2)
+	require.NoError(t, err)
+	require.Len(t, executions, 1)
+	assert.Equal(t, "execution-1", executions[0].ExecutionID)
+
+	before := start.Add(time.Minute)
+	executions, count, err = ms.ListExecutions(ctx, ExecutionsFilter{CreatedBefore: &before}, 0, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.Equal(t, "execution-1", executions[0].ExecutionID)
+
+	unfinished, err := ms.GetUnfinished(ctx, "workflow-1", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, unfinished, 2)
+	assert.Equal(t, "execution-3", unfinished[0].ExecutionID)
+	assert.Equal(t, "execution-1", unfinished[1].ExecutionID)
+}
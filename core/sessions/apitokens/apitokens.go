@@ -0,0 +1,128 @@
+// Package apitokens implements named API tokens, which users create for automation in addition to their single
+// legacy API token.
+//
+// Each token has its own scope of permissions, which is intersected with the role of its user, an optional expiry,
+// and is revoked individually. Tokens are owned by email, so that users of any authentication method can create them.
+package apitokens
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+var (
+	ErrTokenNotFound = errors.New("API token not found")
+	ErrTokenExpired  = errors.New("API token has expired")
+	ErrTokenRevoked  = errors.New("API token has been revoked")
+)
+
+// Status is the status of a token.
+type Status string
+
+const (
+	StatusActive  Status = "active"
+	StatusExpired Status = "expired"
+	StatusRevoked Status = "revoked"
+)
+
+// Token is a named API token of a user. Its secret is only returned once, on creation, and stored hashed.
+type Token struct {
+	ID           int64
+	UserEmail    string
+	Name         string
+	AccessKey    string
+	Salt         string
+	HashedSecret string
+	// Permissions is the scope of the token. Requests authenticated with the token are allowed the actions allowed by
+	// both the scope and the role of the user. Tokens without a scope have the full role of the user.
+	Permissions rbac.Permissions
+	ExpiresAt   null.Time
+	LastUsed    null.Time
+	RevokedAt   null.Time
+	CreatedAt   time.Time
+}
+
+// NewToken returns a token for the user with the given email, and the credentials to authenticate with it.
+func NewToken(email, name string, permissions rbac.Permissions, expiresAt null.Time) (Token, *auth.Token, error) {
+	if strings.TrimSpace(name) == "" {
+		return Token{}, nil, errors.New("token name must not be empty")
+	}
+	if err := permissions.Validate(); err != nil {
+		return Token{}, nil, err
+	}
+	if expiresAt.Valid && !expiresAt.Time.After(time.Now()) {
+		return Token{}, nil, fmt.Errorf("token expiry %s is in the past", expiresAt.Time)
+	}
+
+	credentials := auth.NewToken()
+	salt := utils.NewSecret(utils.DefaultSecretSize)
+	hashedSecret, err := auth.HashedSecret(credentials, salt)
+	if err != nil {
+		return Token{}, nil, fmt.Errorf("failed to hash token secret: %w", err)
+	}
+	return Token{
+		UserEmail:    email,
+		Name:         name,
+		AccessKey:    credentials.AccessKey,
+		Salt:         salt,
+		HashedSecret: hashedSecret,
+		Permissions:  permissions,
+		ExpiresAt:    expiresAt,
+	}, credentials, nil
+}
+
+// Authenticate returns true if credentials are those of the token.
+func (t Token) Authenticate(credentials *auth.Token) (bool, error) {
+	hashedSecret, err := auth.HashedSecret(credentials, t.Salt)
+	if err != nil {
+		return false, err
+	}
+	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(t.HashedSecret)) == 1, nil
+}
+
+// Status returns the status of the token at now.
+func (t Token) Status(now time.Time) Status {
+	if t.RevokedAt.Valid {
+		return StatusRevoked
+	}
+	if t.ExpiresAt.Valid && !t.ExpiresAt.Time.After(now) {
+		return StatusExpired
+	}
+	return StatusActive
+}
+
+// Validate returns an error if the token is revoked or expired at now.
+func (t Token) Validate(now time.Time) error {
+	switch t.Status(now) {
+	case StatusRevoked:
+		return ErrTokenRevoked
+	case StatusExpired:
+		return ErrTokenExpired
+	default:
+		return nil
+	}
+}
+
+// Scope restricts role, of the user of the token, to the scope of the token. The name of a restricted role names the
+// token, so that responses to forbidden requests tell the token apart from the role.
+func (t Token) Scope(role rbac.Role) rbac.Role {
+	if len(t.Permissions) == 0 {
+		return role
+	}
+	return rbac.Role{
+		Name:        fmt.Sprintf("%s (API token %s)", role.Name, t.Name),
+		Description: role.Description,
+		Permissions: role.Permissions.Intersect(t.Permissions),
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
@@ -0,0 +1,76 @@
+package apitokens_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestNewToken(t *testing.T) {
+	t.Parallel()
+
+	_, _, err := apitokens.NewToken("ci@example.com", " ", nil, null.Time{})
+	require.ErrorContains(t, err, "token name must not be empty")
+	_, _, err = apitokens.NewToken("ci@example.com", "deploy", rbac.Permissions{{Resource: "nope", Actions: []rbac.Action{rbac.ActionRead}}}, null.Time{})
+	require.ErrorContains(t, err, `unknown resource "nope"`)
+	_, _, err = apitokens.NewToken("ci@example.com", "deploy", nil, null.TimeFrom(time.Now().Add(-time.Minute)))
+	require.ErrorContains(t, err, "in the past")
+
+	token, credentials, err := apitokens.NewToken("ci@example.com", "deploy", rbac.ReadOnly(), null.TimeFrom(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	assert.Equal(t, credentials.AccessKey, token.AccessKey)
+	assert.NotContains(t, token.HashedSecret, credentials.Secret)
+
+	ok, err := token.Authenticate(credentials)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = token.Authenticate(&auth.Token{AccessKey: credentials.AccessKey, Secret: "wrong"})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestToken_Status(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	token := apitokens.Token{}
+	assert.Equal(t, apitokens.StatusActive, token.Status(now))
+	require.NoError(t, token.Validate(now))
+
+	token.ExpiresAt = null.TimeFrom(now)
+	assert.Equal(t, apitokens.StatusExpired, token.Status(now))
+	require.ErrorIs(t, token.Validate(now), apitokens.ErrTokenExpired)
+	assert.Equal(t, apitokens.StatusActive, token.Status(now.Add(-time.Second)))
+
+	token.RevokedAt = null.TimeFrom(now)
+	assert.Equal(t, apitokens.StatusRevoked, token.Status(now.Add(-time.Second)))
+	require.ErrorIs(t, token.Validate(now), apitokens.ErrTokenRevoked)
+}
+
+func TestToken_Scope(t *testing.T) {
+	t.Parallel()
+
+	role := rbac.BuiltinRole(sessions.UserRoleEdit)
+	token := apitokens.Token{Name: "deploy"}
+	assert.Equal(t, role, token.Scope(role))
+
+	token.Permissions = rbac.Permissions{
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}},
+		{Resource: rbac.ResourceUsers, Actions: []rbac.Action{rbac.ActionRead}},
+	}
+	scoped := token.Scope(role)
+	assert.Equal(t, "edit (API token deploy)", scoped.Name)
+	assert.True(t, scoped.Permissions.Allows(rbac.ResourceJobs, rbac.ActionCreate))
+	assert.False(t, scoped.Permissions.Allows(rbac.ResourceJobs, rbac.ActionDelete))
+	assert.False(t, scoped.Permissions.Allows(rbac.ResourceBridges, rbac.ActionRead))
+	// The scope does not grant what the role does not.
+	assert.False(t, scoped.Permissions.Allows(rbac.ResourceUsers, rbac.ActionRead))
+}
@@ -0,0 +1,90 @@
+package apitokens
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+)
+
+// ORM stores named API tokens.
+type ORM interface {
+	CreateToken(ctx context.Context, token *Token) error
+	FindTokenByAccessKey(ctx context.Context, accessKey string) (Token, error)
+	// ListTokens lists the tokens of the user with the given email, or of all users when email is empty.
+	ListTokens(ctx context.Context, email string) ([]Token, error)
+	// RevokeToken revokes a token of the user with the given email, or of any user when email is empty.
+	RevokeToken(ctx context.Context, id int64, email string) (Token, error)
+	// MarkUsed records the use of a token. It is recorded at most once a minute, to spare the database a write on
+	// every request.
+	MarkUsed(ctx context.Context, id int64) error
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// CreateToken inserts a token.
+func (o *orm) CreateToken(ctx context.Context, token *Token) error {
+	stmt := `INSERT INTO api_tokens (user_email, name, access_key, salt, hashed_secret, permissions, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, now()) RETURNING id, created_at`
+	err := o.ds.QueryRowxContext(ctx, stmt, token.UserEmail, token.Name, token.AccessKey, token.Salt, token.HashedSecret,
+		token.Permissions, token.ExpiresAt).Scan(&token.ID, &token.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create API token %s: %w", token.Name, err)
+	}
+	return nil
+}
+
+// FindTokenByAccessKey finds a token, including revoked and expired ones, by its access key.
+func (o *orm) FindTokenByAccessKey(ctx context.Context, accessKey string) (Token, error) {
+	var token Token
+	err := o.ds.GetContext(ctx, &token, `SELECT * FROM api_tokens WHERE access_key = $1`, accessKey)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Token{}, ErrTokenNotFound
+	} else if err != nil {
+		return Token{}, fmt.Errorf("failed to find API token: %w", err)
+	}
+	return token, nil
+}
+
+// ListTokens lists tokens, ordered by creation.
+func (o *orm) ListTokens(ctx context.Context, email string) ([]Token, error) {
+	var tokens []Token
+	stmt := `SELECT * FROM api_tokens WHERE $1 = '' OR lower(user_email) = lower($1) ORDER BY created_at ASC, id ASC`
+	if err := o.ds.SelectContext(ctx, &tokens, stmt, email); err != nil {
+		return nil, fmt.Errorf("failed to list API tokens: %w", err)
+	}
+	return tokens, nil
+}
+
+// RevokeToken revokes a token which is not revoked already.
+func (o *orm) RevokeToken(ctx context.Context, id int64, email string) (Token, error) {
+	var token Token
+	stmt := `UPDATE api_tokens SET revoked_at = now()
+		WHERE id = $1 AND ($2 = '' OR lower(user_email) = lower($2)) AND revoked_at IS NULL RETURNING *`
+	err := o.ds.GetContext(ctx, &token, stmt, id, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Token{}, ErrTokenNotFound
+	} else if err != nil {
+		return Token{}, fmt.Errorf("failed to revoke API token %d: %w", id, err)
+	}
+	return token, nil
+}
+
+func (o *orm) MarkUsed(ctx context.Context, id int64) error {
+	stmt := `UPDATE api_tokens SET last_used = now()
+		WHERE id = $1 AND (last_used IS NULL OR last_used < now() - interval '1 minute')`
+	if _, err := o.ds.ExecContext(ctx, stmt, id); err != nil {
+		return fmt.Errorf("failed to mark API token %d used: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package apitokens_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestORM(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm := apitokens.NewORM(pgtest.NewSqlxDB(t))
+
+	token, _, err := apitokens.NewToken("CI@example.com", "deploy", rbac.ReadOnly(), null.TimeFrom(time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateToken(ctx, &token))
+	assert.NotZero(t, token.ID)
+
+	duplicate, _, err := apitokens.NewToken("ci@example.com", "deploy", nil, null.Time{})
+	require.NoError(t, err)
+	require.Error(t, orm.CreateToken(ctx, &duplicate))
+
+	other, _, err := apitokens.NewToken("other@example.com", "deploy", nil, null.Time{})
+	require.NoError(t, err)
+	require.NoError(t, orm.CreateToken(ctx, &other))
+
+	found, err := orm.FindTokenByAccessKey(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.Equal(t, token.Permissions, found.Permissions)
+	assert.Equal(t, token.HashedSecret, found.HashedSecret)
+	assert.False(t, found.LastUsed.Valid)
+	_, err = orm.FindTokenByAccessKey(ctx, "unknown")
+	require.ErrorIs(t, err, apitokens.ErrTokenNotFound)
+
+	require.NoError(t, orm.MarkUsed(ctx, token.ID))
+	found, err = orm.FindTokenByAccessKey(ctx, token.AccessKey)
+	require.NoError(t, err)
+	assert.True(t, found.LastUsed.Valid)
+
+	tokens, err := orm.ListTokens(ctx, "ci@example.com")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.Equal(t, token.ID, tokens[0].ID)
+	tokens, err = orm.ListTokens(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+
+	_, err = orm.RevokeToken(ctx, token.ID, "other@example.com")
+	require.ErrorIs(t, err, apitokens.ErrTokenNotFound)
+	revoked, err := orm.RevokeToken(ctx, token.ID, "ci@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, apitokens.StatusRevoked, revoked.Status(time.Now()))
+	_, err = orm.RevokeToken(ctx, token.ID, "")
+	require.ErrorIs(t, err, apitokens.ErrTokenNotFound)
+
+	// The name of a revoked token can be reused.
+	require.NoError(t, orm.CreateToken(ctx, &duplicate))
+}
@@ -15,6 +15,7 @@ type AuthenticationProviderName string
 const (
 	LocalAuth AuthenticationProviderName = "local"
 	LDAPAuth  AuthenticationProviderName = "ldap"
+	OIDCAuth  AuthenticationProviderName = "oidc"
 )
 
 // ErrUserSessionExpired defines the error triggered when the user session has expired
@@ -61,3 +62,27 @@ type AuthenticationProvider interface {
 
 	FindExternalInitiator(ctx context.Context, eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
 }
+
+// OIDCAuthenticationProvider is an AuthenticationProvider which signs users in through an upstream OpenID Connect
+// identity provider, with the authorization code flow.
+type OIDCAuthenticationProvider interface {
+	AuthenticationProvider
+
+	// BeginOIDCLogin starts a login, and returns its state and the URL of the identity provider to redirect the user to.
+	BeginOIDCLogin(ctx context.Context) (state string, authURL string, err error)
+	// FinishOIDCLogin completes the login with the given state, by exchanging the authorization code returned by the
+	// identity provider, and returns the ID of the new session. Users with registered MFA tokens must also pass the
+	// WebAuthn check of the session request: until they do, the login remains pending and can be finished again with
+	// an empty code.
+	FinishOIDCLogin(ctx context.Context, state string, code string, sr SessionRequest) (string, error)
+}
+
+// MFAChallengeError is returned by logins which require the user to answer a WebAuthn challenge before completing.
+// Its message is the JSON encoded challenge, as expected by the operator UI.
+type MFAChallengeError struct {
+	Challenge string
+}
+
+func (e *MFAChallengeError) Error() string {
+	return e.Challenge
+}
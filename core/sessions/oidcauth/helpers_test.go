@@ -0,0 +1,75 @@
+package oidcauth
+
+import (
+	"net/url"
+	"time"
+
+	commonconfig "github.com/smartcontractkit/chainlink-common/pkg/config"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+// Default identity provider group name mappings for test config and mock issuer users
+const (
+	NodeAdminsGroup   = "NodeAdmins"
+	NodeEditorsGroup  = "NodeEditors"
+	NodeRunnersGroup  = "NodeRunners"
+	NodeReadOnlyGroup = "NodeReadOnly"
+)
+
+// Implements config.OIDC
+type TestConfig struct {
+	Issuer *url.URL
+}
+
+func (t *TestConfig) IssuerURL() *url.URL {
+	return t.Issuer
+}
+
+func (t *TestConfig) ClientID() string {
+	return oidcauthtest.ClientID
+}
+
+func (t *TestConfig) ClientSecret() string {
+	return oidcauthtest.ClientSecret
+}
+
+func (t *TestConfig) RedirectURL() *url.URL {
+	return &url.URL{Scheme: "http", Host: "localhost:6688", Path: "/oidc/callback"}
+}
+
+func (t *TestConfig) Scopes() []string {
+	return []string{"openid", "email", "profile"}
+}
+
+func (t *TestConfig) EmailClaim() string {
+	return "email"
+}
+
+func (t *TestConfig) GroupsClaim() string {
+	return "groups"
+}
+
+func (t *TestConfig) AdminGroup() string {
+	return NodeAdminsGroup
+}
+
+func (t *TestConfig) EditGroup() string {
+	return NodeEditorsGroup
+}
+
+func (t *TestConfig) RunGroup() string {
+	return NodeRunnersGroup
+}
+
+func (t *TestConfig) ReadGroup() string {
+	return NodeReadOnlyGroup
+}
+
+func (t *TestConfig) SessionTimeout() commonconfig.Duration {
+	return *commonconfig.MustNewDuration(15 * time.Minute)
+}
+
+func (t *TestConfig) RequestTimeout() time.Duration {
+	return 10 * time.Second
+}
@@ -0,0 +1,550 @@
+/*
+The OIDC authentication package signs users in through an upstream OpenID Connect identity provider, with the
+authorization code flow and PKCE.
+
+The user is redirected to the identity provider, which redirects them back to the node with an authorization code.
+The code is exchanged for an ID token, whose email claim identifies the user, and whose groups claim is mapped to
+the role of the user, in the same way as the groups of the LDAP authentication provider.
+
+This package relies on the following local database table:
+
+	oidc_sessions: Upon successful login, creates a keyed local copy of the user email and role.
+
+Sessions expire after being idle for the configured session timeout. When the ID token of a session expires, it is
+refreshed with the refresh token, so that users removed from the identity provider, or from their groups, lose their
+access. Sessions without a refresh token end when their ID token expires.
+
+Refresh tokens are only kept in memory, so that they cannot be read from the database and used to sign in with the
+identity provider. Sessions created before the node restarted have no refresh token.
+
+Users are managed by the identity provider, so user mutation actions such as CreateUser only apply to the local
+users table, which remains supported for the local admin CLI user. API tokens are only supported for local users.
+
+MFA is supported with the WebAuthn tokens registered for the email of the user: the login remains pending until the
+user answers the WebAuthn challenge.
+*/
+package oidcauth
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	// pendingLoginTimeout is how long a user has to sign in with the identity provider, and to answer the MFA
+	// challenge, once a login has begun.
+	pendingLoginTimeout = 5 * time.Minute
+	// maxPendingLogins limits the number of logins which can be pending at once.
+	maxPendingLogins = 1000
+)
+
+var ErrLoginNotFound = errors.New("OIDC login not found or expired, please login again")
+
+type oidcAuthenticator struct {
+	ds          sqlutil.DataSource
+	local       sessions.AuthenticationProvider
+	provider    *provider
+	config      config.OIDC
+	lggr        logger.Logger
+	auditLogger audit.AuditLogger
+
+	loginsMu sync.Mutex
+	logins   map[string]*pendingLogin
+
+	refreshTokensMu sync.Mutex
+	refreshTokens   map[string]*sessionRefreshToken
+}
+
+// sessionRefreshToken is the refresh token of a session, kept until the session has been idle for the session
+// timeout.
+type sessionRefreshToken struct {
+	token    string
+	lastUsed time.Time
+}
+
+// pendingLogin is a login which has begun, but has not completed yet.
+type pendingLogin struct {
+	verifier  string
+	nonce     string
+	expiresAt time.Time
+	// identity is set once the authorization code has been exchanged, while the user answers the MFA challenge.
+	identity *identity
+}
+
+// oidcSession is a row of the oidc_sessions table.
+type oidcSession struct {
+	ID          string
+	UserEmail   string
+	UserRole    sessions.UserRole
+	TokenExpiry time.Time
+	LastUsed    time.Time
+	CreatedAt   time.Time
+}
+
+// oidcAuthenticator implements sessions.OIDCAuthenticationProvider interface
+var _ sessions.OIDCAuthenticationProvider = (*oidcAuthenticator)(nil)
+
+// NewOIDCAuthenticator returns an authentication provider for the configured identity provider, whose discovery
+// document is fetched on creation. The local provider is used for the local admin CLI users, which sign in with a
+// password.
+func NewOIDCAuthenticator(
+	ctx context.Context,
+	ds sqlutil.DataSource,
+	oidcCfg config.OIDC,
+	local sessions.AuthenticationProvider,
+	dev bool,
+	lggr logger.Logger,
+	auditLogger audit.AuditLogger,
+) (*oidcAuthenticator, error) {
+	if oidcCfg.IssuerURL() == nil || oidcCfg.ClientID() == "" || oidcCfg.RedirectURL() == nil {
+		return nil, errors.New("OIDC IssuerURL, ClientID and RedirectURL config required")
+	}
+	// If not chainlink dev and not https, error
+	if !dev && (oidcCfg.IssuerURL().Scheme != "https" || oidcCfg.RedirectURL().Scheme != "https") {
+		return nil, errors.New("OIDC Authentication driver requires https IssuerURL and RedirectURL when running in Production mode")
+	}
+	// Ensure all RBAC role mappings to OIDC groups are defined, or error on startup
+	if oidcCfg.AdminGroup() == "" || oidcCfg.EditGroup() == "" || oidcCfg.RunGroup() == "" || oidcCfg.ReadGroup() == "" {
+		return nil, errors.New("OIDC Group mapping from identity provider group name for all local RBAC role required. Set group names for `_Group` fields")
+	}
+
+	lggr = lggr.Named("OIDCAuthenticationProvider")
+	lggr.Infof("Fetching discovery document of OIDC issuer %s", oidcCfg.IssuerURL())
+	p, err := newProvider(ctx, oidcCfg, &http.Client{Timeout: oidcCfg.RequestTimeout()})
+	if err != nil {
+		return nil, fmt.Errorf("unable to reach OIDC issuer: %w", err)
+	}
+
+	return &oidcAuthenticator{
+		ds:          ds,
+		local:       local,
+		provider:    p,
+		config:      oidcCfg,
+		lggr:        lggr,
+		auditLogger: auditLogger,
+		logins:      map[string]*pendingLogin{},
+
+		refreshTokens: map[string]*sessionRefreshToken{},
+	}, nil
+}
+
+// BeginOIDCLogin starts a login, and returns its state and the URL of the identity provider to redirect the user to.
+func (o *oidcAuthenticator) BeginOIDCLogin(ctx context.Context) (string, string, error) {
+	state := utils.NewBytes32ID()
+	login := &pendingLogin{
+		verifier:  oauth2.GenerateVerifier(),
+		nonce:     utils.NewBytes32ID(),
+		expiresAt: time.Now().Add(pendingLoginTimeout),
+	}
+	if err := o.putLogin(state, login); err != nil {
+		return "", "", err
+	}
+	return state, o.provider.authCodeURL(state, login.nonce, login.verifier), nil
+}
+
+// FinishOIDCLogin exchanges the authorization code of the login with the identity provider, and creates a session
+// for the signed in user, once they have answered the MFA challenge if they have registered WebAuthn tokens.
+func (o *oidcAuthenticator) FinishOIDCLogin(ctx context.Context, state string, code string, sr sessions.SessionRequest) (string, error) {
+	// The login is taken, so that the authorization code of a login can only be used once. It is only put back while
+	// waiting for the answer to the MFA challenge.
+	login, ok := o.takeLogin(state)
+	if !ok {
+		return "", ErrLoginNotFound
+	}
+
+	if login.identity == nil {
+		if code == "" {
+			return "", errors.New("authorization code is required")
+		}
+		id, err := o.provider.exchange(ctx, code, login.verifier, login.nonce)
+		if err != nil {
+			o.lggr.Infof("Failed OIDC login: %v", err)
+			return "", errors.New("unable to log in with OIDC identity provider")
+		}
+		login.identity = &id
+	}
+	id := *login.identity
+	user := sessions.User{Email: id.email, Role: id.role}
+	lggr := o.lggr.With("user", user.Email)
+
+	uwas, err := o.local.GetUserWebAuthn(ctx, user.Email)
+	if err != nil {
+		lggr.Errorf("Could not fetch user's MFA data: %v", err)
+		return "", errors.New("MFA Error")
+	}
+	if len(uwas) > 0 {
+		if sr.WebAuthnData == "" {
+			options, webauthnError := sessions.BeginWebAuthnLogin(user, uwas, sr)
+			if webauthnError != nil {
+				lggr.Errorf("Could not begin WebAuthn verification: %v", webauthnError)
+				return "", errors.New("MFA Error")
+			}
+			j, jsonError := json.Marshal(options)
+			if jsonError != nil {
+				lggr.Errorf("Could not serialize WebAuthn challenge: %v", jsonError)
+				return "", errors.New("MFA Error")
+			}
+			if err = o.putLogin(state, login); err != nil {
+				return "", err
+			}
+			return "", &sessions.MFAChallengeError{Challenge: string(j)}
+		}
+		if err = sessions.FinishWebAuthnLogin(user, uwas, sr); err != nil {
+			o.auditLogger.Audit(audit.AuthLoginFailed2FA, map[string]interface{}{"email": user.Email, "error": err})
+			lggr.Errorf("User sent an invalid attestation: %v", err)
+			return "", errors.New("MFA Error")
+		}
+	}
+
+	lggr.Infof("Successful OIDC login request for user %s - %s", user.Email, user.Role)
+
+	session := sessions.NewSession()
+	_, err = o.ds.ExecContext(ctx,
+		"INSERT INTO oidc_sessions (id, user_email, user_role, token_expiry, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())",
+		session.ID, id.email, id.role, id.expiry,
+	)
+	if err != nil {
+		o.lggr.Errorf("unable to create new session in oidc_sessions table %v", err)
+		return "", fmt.Errorf("error creating local OIDC session: %w", err)
+	}
+	o.putRefreshToken(session.ID, id.refreshToken)
+
+	if len(uwas) > 0 {
+		o.auditLogger.Audit(audit.AuthLoginSuccessWith2FA, map[string]interface{}{"email": user.Email})
+	} else {
+		o.auditLogger.Audit(audit.AuthLoginSuccessNo2FA, map[string]interface{}{"email": user.Email})
+	}
+	return session.ID, nil
+}
+
+// putLogin saves a pending login, after purging the expired ones.
+func (o *oidcAuthenticator) putLogin(state string, login *pendingLogin) error {
+	o.loginsMu.Lock()
+	defer o.loginsMu.Unlock()
+	now := time.Now()
+	for s, l := range o.logins {
+		if now.After(l.expiresAt) {
+			delete(o.logins, s)
+		}
+	}
+	if len(o.logins) >= maxPendingLogins {
+		return errors.New("too many pending OIDC logins, please try again later")
+	}
+	o.logins[state] = login
+	return nil
+}
+
+// takeLogin returns the pending login with the given state, if it has not expired, and removes it.
+func (o *oidcAuthenticator) takeLogin(state string) (*pendingLogin, bool) {
+	o.loginsMu.Lock()
+	defer o.loginsMu.Unlock()
+	login, ok := o.logins[state]
+	delete(o.logins, state)
+	if !ok || time.Now().After(login.expiresAt) {
+		return nil, false
+	}
+	return login, true
+}
+
+// putRefreshToken saves the refresh token of a session, after purging the ones of idle sessions.
+func (o *oidcAuthenticator) putRefreshToken(sessionID string, refreshToken string) {
+	o.refreshTokensMu.Lock()
+	defer o.refreshTokensMu.Unlock()
+	now := time.Now()
+	for id, t := range o.refreshTokens {
+		if t.lastUsed.Add(o.config.SessionTimeout().Duration()).Before(now) {
+			delete(o.refreshTokens, id)
+		}
+	}
+	if refreshToken == "" {
+		delete(o.refreshTokens, sessionID)
+		return
+	}
+	o.refreshTokens[sessionID] = &sessionRefreshToken{token: refreshToken, lastUsed: now}
+}
+
+// useRefreshToken returns the refresh token of a session, if any, and records that the session was used.
+func (o *oidcAuthenticator) useRefreshToken(sessionID string) string {
+	o.refreshTokensMu.Lock()
+	defer o.refreshTokensMu.Unlock()
+	t, ok := o.refreshTokens[sessionID]
+	if !ok {
+		return ""
+	}
+	t.lastUsed = time.Now()
+	return t.token
+}
+
+// takeRefreshToken returns the refresh token of a session, if any, and removes it.
+func (o *oidcAuthenticator) takeRefreshToken(sessionID string) string {
+	o.refreshTokensMu.Lock()
+	defer o.refreshTokensMu.Unlock()
+	t, ok := o.refreshTokens[sessionID]
+	delete(o.refreshTokens, sessionID)
+	if !ok {
+		return ""
+	}
+	return t.token
+}
+
+// FindUser returns the local user with the given email, or else the OIDC user with the role of their latest session.
+func (o *oidcAuthenticator) FindUser(ctx context.Context, email string) (sessions.User, error) {
+	user, err := o.local.FindUser(ctx, email)
+	if err == nil || !errors.Is(err, sql.ErrNoRows) {
+		return user, err
+	}
+	err = o.ds.GetContext(ctx, &user,
+		"SELECT user_email AS email, user_role AS role, created_at, last_used AS updated_at FROM oidc_sessions WHERE lower(user_email) = lower($1) ORDER BY created_at DESC LIMIT 1",
+		email,
+	)
+	return user, err
+}
+
+// FindUserByAPIToken is only supported for local users, as OIDC users don't have API tokens.
+func (o *oidcAuthenticator) FindUserByAPIToken(ctx context.Context, apiToken string) (sessions.User, error) {
+	return o.local.FindUserByAPIToken(ctx, apiToken)
+}
+
+// ListUsers returns the local users, and the users of the OIDC sessions with the role of their latest session.
+func (o *oidcAuthenticator) ListUsers(ctx context.Context) ([]sessions.User, error) {
+	users, err := o.local.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var oidcUsers []sessions.User
+	if err = o.ds.SelectContext(ctx, &oidcUsers,
+		`SELECT DISTINCT ON (user_email) user_email AS email, user_role AS role, created_at, last_used AS updated_at
+		FROM oidc_sessions ORDER BY user_email, created_at DESC`,
+	); err != nil {
+		return nil, err
+	}
+
+	localEmails := map[string]bool{}
+	for _, user := range users {
+		localEmails[strings.ToLower(user.Email)] = true
+	}
+	for _, user := range oidcUsers {
+		if !localEmails[strings.ToLower(user.Email)] {
+			users = append(users, user)
+		}
+	}
+	return users, nil
+}
+
+// AuthorizedUserWithSession will return the user associated with the Session ID if it exists and hasn't expired,
+// and update session's LastUsed field. Sessions whose ID token has expired are refreshed with the identity
+// provider, and the role of the user is updated with the groups of the new ID token.
+func (o *oidcAuthenticator) AuthorizedUserWithSession(ctx context.Context, sessionID string) (sessions.User, error) {
+	if len(sessionID) == 0 {
+		return sessions.User{}, sessions.ErrEmptySessionID
+	}
+
+	var session oidcSession
+	err := o.ds.GetContext(ctx, &session, "SELECT * FROM oidc_sessions WHERE id = $1", sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// Sessions of local users are stored in the local sessions table
+		return o.local.AuthorizedUserWithSession(ctx, sessionID)
+	} else if err != nil {
+		o.lggr.Errorf("error querying oidc_sessions table: %v", err)
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+
+	now := time.Now()
+	if session.LastUsed.Add(o.config.SessionTimeout().Duration()).Before(now) {
+		o.purgeSession(ctx, sessionID)
+		return sessions.User{}, sessions.ErrUserSessionExpired
+	}
+
+	refreshToken := o.useRefreshToken(sessionID)
+	if session.TokenExpiry.Before(now) {
+		if refreshToken == "" {
+			o.purgeSession(ctx, sessionID)
+			return sessions.User{}, sessions.ErrUserSessionExpired
+		}
+		id, refreshErr := o.provider.refresh(ctx, identity{
+			email:        session.UserEmail,
+			role:         session.UserRole,
+			refreshToken: refreshToken,
+			expiry:       session.TokenExpiry,
+		})
+		if refreshErr != nil {
+			o.lggr.Infof("Unable to refresh OIDC session of user %s: %v", session.UserEmail, refreshErr)
+			o.purgeSession(ctx, sessionID)
+			return sessions.User{}, sessions.ErrUserSessionExpired
+		}
+		if id.role != session.UserRole {
+			o.lggr.Infof("Role of user %s changed from %s to %s", session.UserEmail, session.UserRole, id.role)
+		}
+		session.UserRole = id.role
+		if _, err = o.ds.ExecContext(ctx,
+			"UPDATE oidc_sessions SET user_role = $2, token_expiry = $3, last_used = now() WHERE id = $1",
+			sessionID, id.role, id.expiry,
+		); err != nil {
+			return sessions.User{}, err
+		}
+		o.putRefreshToken(sessionID, id.refreshToken)
+	} else if _, err = o.ds.ExecContext(ctx, "UPDATE oidc_sessions SET last_used = now() WHERE id = $1", sessionID); err != nil {
+		return sessions.User{}, err
+	}
+
+	return sessions.User{
+		Email: session.UserEmail,
+		Role:  session.UserRole,
+	}, nil
+}
+
+func (o *oidcAuthenticator) purgeSession(ctx context.Context, sessionID string) {
+	o.takeRefreshToken(sessionID)
+	if _, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE id = $1", sessionID); err != nil {
+		o.lggr.Errorf("error purging stale oidc session: %v", err)
+	}
+}
+
+// DeleteUser deletes a local user, OIDC users are managed by the identity provider.
+func (o *oidcAuthenticator) DeleteUser(ctx context.Context, email string) error {
+	return o.local.DeleteUser(ctx, email)
+}
+
+// DeleteUserSession revokes the refresh token of an OIDC session with the identity provider, and removes the session.
+func (o *oidcAuthenticator) DeleteUserSession(ctx context.Context, sessionID string) error {
+	var deletedID string
+	err := o.ds.GetContext(ctx, &deletedID, "DELETE FROM oidc_sessions WHERE id = $1 RETURNING id", sessionID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return o.local.DeleteUserSession(ctx, sessionID)
+	} else if err != nil {
+		return err
+	}
+	if err = o.provider.revoke(ctx, o.takeRefreshToken(sessionID)); err != nil {
+		// The session is removed regardless, so the refresh token will expire with the identity provider
+		o.lggr.Warnf("Unable to revoke refresh token of OIDC session: %v", err)
+	}
+	return nil
+}
+
+// GetUserWebAuthn returns the MFA tokens registered for the email of the user.
+func (o *oidcAuthenticator) GetUserWebAuthn(ctx context.Context, email string) ([]sessions.WebAuthn, error) {
+	return o.local.GetUserWebAuthn(ctx, email)
+}
+
+// CreateSession signs in local users with their password, such as the local admin CLI user. OIDC users sign in with
+// BeginOIDCLogin and FinishOIDCLogin.
+func (o *oidcAuthenticator) CreateSession(ctx context.Context, sr sessions.SessionRequest) (string, error) {
+	return o.local.CreateSession(ctx, sr)
+}
+
+// ClearNonCurrentSessions removes all OIDC and local sessions but the id passed in.
+func (o *oidcAuthenticator) ClearNonCurrentSessions(ctx context.Context, sessionID string) error {
+	if _, err := o.ds.ExecContext(ctx, "DELETE FROM oidc_sessions where id != $1", sessionID); err != nil {
+		return err
+	}
+	o.refreshTokensMu.Lock()
+	for id := range o.refreshTokens {
+		if id != sessionID {
+			delete(o.refreshTokens, id)
+		}
+	}
+	o.refreshTokensMu.Unlock()
+	return o.local.ClearNonCurrentSessions(ctx, sessionID)
+}
+
+// CreateUser creates a local user, OIDC users are managed by the identity provider.
+func (o *oidcAuthenticator) CreateUser(ctx context.Context, user *sessions.User) error {
+	return o.local.CreateUser(ctx, user)
+}
+
+// UpdateRole updates the role of a local user, the roles of OIDC users are mapped from their groups.
+func (o *oidcAuthenticator) UpdateRole(ctx context.Context, email, newRole string) (sessions.User, error) {
+	return o.local.UpdateRole(ctx, email, newRole)
+}
+
+// SetPassword updates the password of a local user, OIDC users don't have a password.
+func (o *oidcAuthenticator) SetPassword(ctx context.Context, user *sessions.User, newPassword string) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetPassword(ctx, user, newPassword)
+}
+
+// TestPassword checks the password of a local user, OIDC users don't have a password.
+func (o *oidcAuthenticator) TestPassword(ctx context.Context, email string, password string) error {
+	if err := o.requireLocalUser(ctx, email); err != nil {
+		return err
+	}
+	return o.local.TestPassword(ctx, email, password)
+}
+
+// CreateAndSetAuthToken creates an API token for a local user, API tokens are not supported for OIDC users.
+func (o *oidcAuthenticator) CreateAndSetAuthToken(ctx context.Context, user *sessions.User) (*auth.Token, error) {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return nil, err
+	}
+	return o.local.CreateAndSetAuthToken(ctx, user)
+}
+
+// SetAuthToken sets the API token of a local user, API tokens are not supported for OIDC users.
+func (o *oidcAuthenticator) SetAuthToken(ctx context.Context, user *sessions.User, token *auth.Token) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.SetAuthToken(ctx, user, token)
+}
+
+// DeleteAuthToken deletes the API token of a local user, API tokens are not supported for OIDC users.
+func (o *oidcAuthenticator) DeleteAuthToken(ctx context.Context, user *sessions.User) error {
+	if err := o.requireLocalUser(ctx, user.Email); err != nil {
+		return err
+	}
+	return o.local.DeleteAuthToken(ctx, user)
+}
+
+// requireLocalUser returns ErrNotSupported unless the user is a local user.
+func (o *oidcAuthenticator) requireLocalUser(ctx context.Context, email string) error {
+	_, err := o.local.FindUser(ctx, email)
+	if errors.Is(err, sql.ErrNoRows) {
+		return sessions.ErrNotSupported
+	}
+	return err
+}
+
+// SaveWebAuthn saves an MFA token for a local user.
+func (o *oidcAuthenticator) SaveWebAuthn(ctx context.Context, token *sessions.WebAuthn) error {
+	return o.local.SaveWebAuthn(ctx, token)
+}
+
+// Sessions returns all OIDC and local sessions limited by the parameters.
+func (o *oidcAuthenticator) Sessions(ctx context.Context, offset, limit int) ([]sessions.Session, error) {
+	var sessions []sessions.Session
+	sql := `SELECT id, email, last_used, created_at FROM (
+		SELECT id, user_email AS email, last_used, created_at FROM oidc_sessions
+		UNION ALL
+		SELECT id, email, last_used, created_at FROM sessions
+	) AS all_sessions ORDER BY created_at, id LIMIT $1 OFFSET $2;`
+	if err := o.ds.SelectContext(ctx, &sessions, sql, limit, offset); err != nil {
+		return nil, err
+	}
+	return sessions, nil
+}
+
+// FindExternalInitiator supports the 'Run' role external intiator header auth functionality
+func (o *oidcAuthenticator) FindExternalInitiator(ctx context.Context, eia *auth.Token) (*bridges.ExternalInitiator, error) {
+	return o.local.FindExternalInitiator(ctx, eia)
+}
@@ -0,0 +1,222 @@
+package oidcauth_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+// Setup OIDC Auth authenticator against a mock issuer
+func setupAuthenticationProvider(t *testing.T, user oidcauthtest.User) (*sqlx.DB, *oidcauthtest.Issuer, sessions.OIDCAuthenticationProvider) {
+	t.Helper()
+
+	issuer := oidcauthtest.NewIssuer(t, user)
+	issuerURL, err := url.Parse(issuer.URL)
+	require.NoError(t, err)
+
+	db := pgtest.NewSqlxDB(t)
+	lggr := logger.TestLogger(t)
+	local := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+	provider, err := oidcauth.NewOIDCAuthenticator(testutils.Context(t), db, &oidcauth.TestConfig{Issuer: issuerURL}, local, true, lggr, audit.NoopLogger)
+	require.NoError(t, err)
+	return db, issuer, provider
+}
+
+func login(t *testing.T, issuer *oidcauthtest.Issuer, provider sessions.OIDCAuthenticationProvider) (string, error) {
+	ctx := testutils.Context(t)
+	state, authURL, err := provider.BeginOIDCLogin(ctx)
+	require.NoError(t, err)
+	code, returnedState := issuer.Login(t, authURL)
+	require.Equal(t, state, returnedState)
+	return provider.FinishOIDCLogin(ctx, state, code, sessions.SessionRequest{})
+}
+
+func TestOIDCAuthenticator_Login(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeRunnersGroup}})
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+
+	user, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", user.Email)
+	assert.Equal(t, sessions.UserRoleRun, user.Role)
+
+	found, err := provider.FindUser(ctx, "Alice@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleRun, found.Role)
+
+	sessionList, err := provider.Sessions(ctx, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, sessionList, 1)
+	assert.Equal(t, sessionID, sessionList[0].ID)
+
+	// OIDC users have no password nor API tokens.
+	require.ErrorIs(t, provider.TestPassword(ctx, user.Email, "password"), sessions.ErrNotSupported)
+	_, err = provider.CreateAndSetAuthToken(ctx, &user)
+	require.ErrorIs(t, err, sessions.ErrNotSupported)
+
+	// The state of a login can only be used once.
+	state, authURL, err := provider.BeginOIDCLogin(ctx)
+	require.NoError(t, err)
+	code, _ := issuer.Login(t, authURL)
+	_, err = provider.FinishOIDCLogin(ctx, state, code, sessions.SessionRequest{})
+	require.NoError(t, err)
+	_, err = provider.FinishOIDCLogin(ctx, state, code, sessions.SessionRequest{})
+	require.ErrorIs(t, err, oidcauth.ErrLoginNotFound)
+	_, err = provider.FinishOIDCLogin(ctx, "unknown", code, sessions.SessionRequest{})
+	require.ErrorIs(t, err, oidcauth.ErrLoginNotFound)
+}
+
+func TestOIDCAuthenticator_Login_NoRole(t *testing.T) {
+	t.Parallel()
+	_, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{"Other"}})
+
+	_, err := login(t, issuer, provider)
+	require.Error(t, err)
+}
+
+func TestOIDCAuthenticator_RefreshSession(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeAdminsGroup}})
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+
+	expireToken := func() {
+		_, err := db.Exec("UPDATE oidc_sessions SET token_expiry = now() - interval '1 minute' WHERE id = $1", sessionID)
+		require.NoError(t, err)
+	}
+
+	// The role of the user is updated from their groups when the ID token of the session is refreshed.
+	issuer.SetUser(oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeEditorsGroup}})
+	user, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleAdmin, user.Role)
+	expireToken()
+	user, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleEdit, user.Role)
+
+	// Users removed from their groups lose their session.
+	issuer.SetUser(oidcauthtest.User{Email: "alice@example.com"})
+	expireToken()
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_IdleSession(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeAdminsGroup}})
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+	_, err = db.Exec("UPDATE oidc_sessions SET last_used = now() - interval '1 hour' WHERE id = $1", sessionID)
+	require.NoError(t, err)
+
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_NoRefreshToken(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeAdminsGroup}})
+	issuer.SetRefreshEnabled(false)
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+	_, err = db.Exec("UPDATE oidc_sessions SET token_expiry = now() - interval '1 minute' WHERE id = $1", sessionID)
+	require.NoError(t, err)
+
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_RefreshTokenNotStored(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	db, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeAdminsGroup}})
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+
+	var columns []string
+	require.NoError(t, db.Select(&columns, "SELECT column_name FROM information_schema.columns WHERE table_name = 'oidc_sessions'"))
+	assert.NotContains(t, columns, "refresh_token")
+
+	// After a restart, the session has no refresh token, so it ends when its ID token expires.
+	issuerURL, err := url.Parse(issuer.URL)
+	require.NoError(t, err)
+	lggr := logger.TestLogger(t)
+	local := localauth.NewORM(db, time.Minute, lggr, audit.NoopLogger)
+	restarted, err := oidcauth.NewOIDCAuthenticator(ctx, db, &oidcauth.TestConfig{Issuer: issuerURL}, local, true, lggr, audit.NoopLogger)
+	require.NoError(t, err)
+
+	_, err = restarted.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	_, err = db.Exec("UPDATE oidc_sessions SET token_expiry = now() - interval '1 minute' WHERE id = $1", sessionID)
+	require.NoError(t, err)
+	_, err = restarted.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_DeleteUserSession(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, issuer, provider := setupAuthenticationProvider(t, oidcauthtest.User{Email: "alice@example.com", Groups: []string{oidcauth.NodeAdminsGroup}})
+
+	sessionID, err := login(t, issuer, provider)
+	require.NoError(t, err)
+
+	require.NoError(t, provider.DeleteUserSession(ctx, sessionID))
+	require.Len(t, issuer.Revoked(), 1)
+	assert.NotEmpty(t, issuer.Revoked()[0])
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
+
+func TestOIDCAuthenticator_LocalUserFallback(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	_, _, provider := setupAuthenticationProvider(t, oidcauthtest.User{})
+
+	// Local admin users, such as the CLI user, sign in with their password
+	user := cltest.MustRandomUser(t)
+	require.NoError(t, provider.CreateUser(ctx, &user))
+	sessionID, err := provider.CreateSession(ctx, sessions.SessionRequest{Email: user.Email, Password: cltest.Password})
+	require.NoError(t, err)
+
+	found, err := provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.NoError(t, err)
+	assert.Equal(t, user.Email, found.Email)
+	require.NoError(t, provider.TestPassword(ctx, user.Email, cltest.Password))
+
+	users, err := provider.ListUsers(ctx)
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+
+	require.NoError(t, provider.DeleteUserSession(ctx, sessionID))
+	_, err = provider.AuthorizedUserWithSession(ctx, sessionID)
+	require.ErrorIs(t, err, sessions.ErrUserSessionExpired)
+}
@@ -0,0 +1,265 @@
+// Package oidcauthtest provides a mock OpenID Connect identity provider, for testing the OIDC authentication provider.
+package oidcauthtest
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+const (
+	ClientID     = "chainlink-node"
+	ClientSecret = "client-secret"
+	keyID        = "test-key"
+)
+
+// User is a user of the identity provider.
+type User struct {
+	Email  string
+	Groups []string
+}
+
+// Issuer is a mock identity provider, which signs in a single user, without prompting them.
+type Issuer struct {
+	*httptest.Server
+	key *rsa.PrivateKey
+
+	mu sync.Mutex
+	// user is signed in by the authorization endpoint, and their current groups are used when refreshing tokens.
+	user           User
+	tokenLifetime  time.Duration
+	refreshEnabled bool
+	codes          map[string]authRequest
+	refreshTokens  map[string]string
+	revoked        []string
+}
+
+type authRequest struct {
+	email         string
+	nonce         string
+	codeChallenge string
+	redirectURI   string
+}
+
+// NewIssuer starts a mock identity provider, which issues refresh tokens and ID tokens valid for an hour.
+func NewIssuer(t *testing.T, user User) *Issuer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	i := &Issuer{
+		key:            key,
+		user:           user,
+		tokenLifetime:  time.Hour,
+		refreshEnabled: true,
+		codes:          map[string]authRequest{},
+		refreshTokens:  map[string]string{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", i.discovery)
+	mux.HandleFunc("/jwks", i.jwks)
+	mux.HandleFunc("/authorize", i.authorize)
+	mux.HandleFunc("/token", i.token)
+	mux.HandleFunc("/revoke", i.revoke)
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+	return i
+}
+
+// SetUser sets the user signed in by the next logins, or updates the groups of the current one.
+func (i *Issuer) SetUser(user User) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.user = user
+}
+
+// SetTokenLifetime sets the lifetime of the ID tokens issued from now on.
+func (i *Issuer) SetTokenLifetime(lifetime time.Duration) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.tokenLifetime = lifetime
+}
+
+// SetRefreshEnabled sets whether refresh tokens are issued, and accepted.
+func (i *Issuer) SetRefreshEnabled(enabled bool) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.refreshEnabled = enabled
+}
+
+// Revoked returns the refresh tokens which have been revoked.
+func (i *Issuer) Revoked() []string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return append([]string(nil), i.revoked...)
+}
+
+// Login follows the redirect of the identity provider for the given authorization URL, as a browser would, and
+// returns the code and state it redirects back with.
+func (i *Issuer) Login(t *testing.T, authURL string) (code string, state string) {
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }}
+	resp, err := client.Get(authURL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func (i *Issuer) discovery(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]string{
+		"issuer":                 i.URL,
+		"authorization_endpoint": i.URL + "/authorize",
+		"token_endpoint":         i.URL + "/token",
+		"jwks_uri":               i.URL + "/jwks",
+		"revocation_endpoint":    i.URL + "/revoke",
+	})
+}
+
+func (i *Issuer) jwks(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, map[string]any{"keys": []map[string]string{{
+		"kty": "RSA",
+		"kid": keyID,
+		"use": "sig",
+		"alg": "RS256",
+		"n":   base64.RawURLEncoding.EncodeToString(i.key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(i.key.E)).Bytes()),
+	}}})
+}
+
+func (i *Issuer) authorize(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("client_id") != ClientID || q.Get("response_type") != "code" || q.Get("code_challenge_method") != "S256" {
+		http.Error(w, "invalid authorization request", http.StatusBadRequest)
+		return
+	}
+	i.mu.Lock()
+	code := utils.NewBytes32ID()
+	i.codes[code] = authRequest{
+		email:         i.user.Email,
+		nonce:         q.Get("nonce"),
+		codeChallenge: q.Get("code_challenge"),
+		redirectURI:   q.Get("redirect_uri"),
+	}
+	i.mu.Unlock()
+
+	redirect, err := url.Parse(q.Get("redirect_uri"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	redirectQuery := redirect.Query()
+	redirectQuery.Set("code", code)
+	redirectQuery.Set("state", q.Get("state"))
+	redirect.RawQuery = redirectQuery.Encode()
+	http.Redirect(w, r, redirect.String(), http.StatusFound)
+}
+
+func (i *Issuer) token(w http.ResponseWriter, r *http.Request) {
+	if clientID, clientSecret, ok := r.BasicAuth(); !ok || clientID != ClientID || clientSecret != ClientSecret {
+		tokenError(w, http.StatusUnauthorized, "invalid_client")
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		tokenError(w, http.StatusBadRequest, "invalid_request")
+		return
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	var email, nonce string
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		req, ok := i.codes[r.PostForm.Get("code")]
+		delete(i.codes, r.PostForm.Get("code"))
+		challenge := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if !ok || req.redirectURI != r.PostForm.Get("redirect_uri") ||
+			base64.RawURLEncoding.EncodeToString(challenge[:]) != req.codeChallenge {
+			tokenError(w, http.StatusBadRequest, "invalid_grant")
+			return
+		}
+		email, nonce = req.email, req.nonce
+	case "refresh_token":
+		var ok bool
+		email, ok = i.refreshTokens[r.PostForm.Get("refresh_token")]
+		delete(i.refreshTokens, r.PostForm.Get("refresh_token"))
+		if !ok || !i.refreshEnabled || email != i.user.Email {
+			tokenError(w, http.StatusBadRequest, "invalid_grant")
+			return
+		}
+	default:
+		tokenError(w, http.StatusBadRequest, "unsupported_grant_type")
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"iss":            i.URL,
+		"sub":            email,
+		"aud":            ClientID,
+		"iat":            now.Unix(),
+		"exp":            now.Add(i.tokenLifetime).Unix(),
+		"email":          email,
+		"email_verified": true,
+		"groups":         i.user.Groups,
+	}
+	if nonce != "" {
+		claims["nonce"] = nonce
+	}
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = keyID
+	idToken, err := token.SignedString(i.key)
+	if err != nil {
+		tokenError(w, http.StatusInternalServerError, "server_error")
+		return
+	}
+
+	resp := map[string]any{
+		"access_token": utils.NewBytes32ID(),
+		"token_type":   "Bearer",
+		"expires_in":   int(i.tokenLifetime.Seconds()),
+		"id_token":     idToken,
+	}
+	if i.refreshEnabled {
+		refreshToken := utils.NewBytes32ID()
+		i.refreshTokens[refreshToken] = email
+		resp["refresh_token"] = refreshToken
+	}
+	writeJSON(w, resp)
+}
+
+func (i *Issuer) revoke(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	delete(i.refreshTokens, r.PostForm.Get("token"))
+	i.revoked = append(i.revoked, r.PostForm.Get("token"))
+}
+
+func tokenError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": code})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,389 @@
+package oidcauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// discoveryPath is the path of the discovery document of an OpenID Connect identity provider, relative to its issuer.
+const discoveryPath = "/.well-known/openid-configuration"
+
+// keysRefreshRateLimit limits how often the signing keys of the identity provider are fetched again, when an ID token
+// is signed by an unknown key.
+const keysRefreshRateLimit = time.Minute
+
+var (
+	ErrNoRole        = errors.New("user is not a member of any group mapped to a role")
+	ErrEmailMissing  = errors.New("ID token has no email claim")
+	ErrEmailNotValid = errors.New("email of the user is not verified by the identity provider")
+)
+
+// discovery is the subset of the discovery document of an identity provider used by the authenticator.
+type discovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+	RevocationEndpoint    string `json:"revocation_endpoint"`
+}
+
+// identity is a user signed in by the identity provider, with the tokens issued for them.
+type identity struct {
+	email string
+	role  sessions.UserRole
+	// refreshToken is empty unless the identity provider issued one, in which case the session is refreshed with it
+	// when the ID token expires.
+	refreshToken string
+	expiry       time.Time
+}
+
+// provider is the client of the upstream identity provider.
+type provider struct {
+	config     config.OIDC
+	httpClient *http.Client
+	discovery  discovery
+	oauth2     oauth2.Config
+
+	keysMu       sync.Mutex
+	keys         map[string]any
+	keysUpdateAt time.Time
+}
+
+// newProvider fetches the discovery document of the identity provider, and returns a client for it.
+func newProvider(ctx context.Context, cfg config.OIDC, httpClient *http.Client) (*provider, error) {
+	issuer := strings.TrimSuffix(cfg.IssuerURL().String(), "/")
+	p := &provider{config: cfg, httpClient: httpClient}
+	if err := p.getJSON(ctx, issuer+discoveryPath, &p.discovery); err != nil {
+		return nil, fmt.Errorf("failed to fetch discovery document: %w", err)
+	}
+	if strings.TrimSuffix(p.discovery.Issuer, "/") != issuer {
+		return nil, fmt.Errorf("issuer %q of the discovery document does not match the configured issuer %q", p.discovery.Issuer, issuer)
+	}
+	if p.discovery.AuthorizationEndpoint == "" || p.discovery.TokenEndpoint == "" || p.discovery.JWKSURI == "" {
+		return nil, errors.New("discovery document is missing the authorization, token or JWKS endpoint")
+	}
+
+	p.oauth2 = oauth2.Config{
+		ClientID:     cfg.ClientID(),
+		ClientSecret: cfg.ClientSecret(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  p.discovery.AuthorizationEndpoint,
+			TokenURL: p.discovery.TokenEndpoint,
+		},
+		RedirectURL: cfg.RedirectURL().String(),
+		Scopes:      cfg.Scopes(),
+	}
+	return p, nil
+}
+
+func (p *provider) getJSON(ctx context.Context, url string, v any) error {
+	ctx, cancel := context.WithTimeout(ctx, p.config.RequestTimeout())
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from %s", resp.Status, url)
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+// authCodeURL returns the URL of the authorization endpoint for a login with the given state, nonce and PKCE verifier.
+func (p *provider) authCodeURL(state, nonce, verifier string) string {
+	return p.oauth2.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", nonce))
+}
+
+// exchange redeems the authorization code of a login, and returns the identity of the signed in user.
+func (p *provider) exchange(ctx context.Context, code, verifier, nonce string) (identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.RequestTimeout())
+	defer cancel()
+	token, err := p.oauth2.Exchange(p.clientContext(ctx), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return identity{}, fmt.Errorf("failed to exchange authorization code: %w", err)
+	}
+	return p.identityFromToken(ctx, token, nonce, nil)
+}
+
+// refresh redeems the refresh token of a session, and returns the refreshed identity of the user.
+// If the identity provider doesn't issue a new ID token, the email and role of the user are kept.
+func (p *provider) refresh(ctx context.Context, current identity) (identity, error) {
+	ctx, cancel := context.WithTimeout(ctx, p.config.RequestTimeout())
+	defer cancel()
+	token, err := p.oauth2.TokenSource(p.clientContext(ctx), &oauth2.Token{RefreshToken: current.refreshToken}).Token()
+	if err != nil {
+		return identity{}, fmt.Errorf("failed to refresh token: %w", err)
+	}
+	return p.identityFromToken(ctx, token, "", &current)
+}
+
+// revoke revokes the refresh token of a session, if the identity provider supports token revocation.
+func (p *provider) revoke(ctx context.Context, refreshToken string) error {
+	if refreshToken == "" || p.discovery.RevocationEndpoint == "" {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.config.RequestTimeout())
+	defer cancel()
+
+	form := url.Values{"token": {refreshToken}, "token_type_hint": {"refresh_token"}}
+	if p.oauth2.ClientSecret == "" {
+		form.Set("client_id", p.oauth2.ClientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.discovery.RevocationEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	if p.oauth2.ClientSecret != "" {
+		req.SetBasicAuth(url.QueryEscape(p.oauth2.ClientID), url.QueryEscape(p.oauth2.ClientSecret))
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s from revocation endpoint", resp.Status)
+	}
+	return nil
+}
+
+func (p *provider) clientContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, p.httpClient)
+}
+
+// identityFromToken verifies the ID token of the token response, and maps its claims to the identity of the user.
+// When refreshing, the ID token is optional, and the current identity is kept if there is none.
+func (p *provider) identityFromToken(ctx context.Context, token *oauth2.Token, nonce string, current *identity) (identity, error) {
+	rawIDToken, _ := token.Extra("id_token").(string)
+	if rawIDToken == "" {
+		if current == nil {
+			return identity{}, errors.New("token response has no ID token")
+		}
+		refreshed := *current
+		if token.RefreshToken != "" {
+			refreshed.refreshToken = token.RefreshToken
+		}
+		if !token.Expiry.IsZero() {
+			refreshed.expiry = token.Expiry
+		}
+		return refreshed, nil
+	}
+
+	claims, err := p.verifyIDToken(ctx, rawIDToken)
+	if err != nil {
+		return identity{}, err
+	}
+	if nonce != "" {
+		if claimNonce, _ := claims["nonce"].(string); claimNonce != nonce {
+			return identity{}, errors.New("ID token nonce does not match the login")
+		}
+	}
+
+	id, err := p.identityFromClaims(claims)
+	if err != nil {
+		return identity{}, err
+	}
+	if current != nil && !strings.EqualFold(id.email, current.email) {
+		return identity{}, errors.New("refreshed ID token is for a different user")
+	}
+	id.refreshToken = token.RefreshToken
+	if id.refreshToken == "" && current != nil {
+		id.refreshToken = current.refreshToken
+	}
+	exp, err := claims.GetExpirationTime()
+	if err != nil {
+		return identity{}, err
+	}
+	id.expiry = exp.Time
+	return id, nil
+}
+
+// identityFromClaims maps the email and groups claims of an ID token to a user, with the highest role of its groups.
+func (p *provider) identityFromClaims(claims jwt.MapClaims) (identity, error) {
+	email, _ := claims[p.config.EmailClaim()].(string)
+	if email == "" {
+		return identity{}, ErrEmailMissing
+	}
+	if verified, ok := claims["email_verified"].(bool); ok && !verified {
+		return identity{}, ErrEmailNotValid
+	}
+
+	groups := map[string]bool{}
+	switch v := claims[p.config.GroupsClaim()].(type) {
+	case string:
+		groups[v] = true
+	case []any:
+		for _, group := range v {
+			if s, ok := group.(string); ok {
+				groups[s] = true
+			}
+		}
+	}
+
+	for _, mapping := range []struct {
+		group string
+		role  sessions.UserRole
+	}{
+		{p.config.AdminGroup(), sessions.UserRoleAdmin},
+		{p.config.EditGroup(), sessions.UserRoleEdit},
+		{p.config.RunGroup(), sessions.UserRoleRun},
+		{p.config.ReadGroup(), sessions.UserRoleView},
+	} {
+		if groups[mapping.group] {
+			return identity{email: strings.ToLower(email), role: mapping.role}, nil
+		}
+	}
+	return identity{}, ErrNoRole
+}
+
+// verifyIDToken verifies the signature, issuer, audience and expiration of an ID token, and returns its claims.
+func (p *provider) verifyIDToken(ctx context.Context, rawIDToken string) (jwt.MapClaims, error) {
+	claims := jwt.MapClaims{}
+	_, err := jwt.ParseWithClaims(rawIDToken, claims,
+		func(token *jwt.Token) (any, error) {
+			kid, _ := token.Header["kid"].(string)
+			return p.signingKey(ctx, kid)
+		},
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}),
+		jwt.WithIssuer(p.discovery.Issuer),
+		jwt.WithAudience(p.oauth2.ClientID),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(time.Minute),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ID token: %w", err)
+	}
+	return claims, nil
+}
+
+// signingKey returns the signing key of the identity provider with the given ID, fetching the keys again if it is
+// unknown, as the identity provider may have rotated them.
+func (p *provider) signingKey(ctx context.Context, kid string) (any, error) {
+	p.keysMu.Lock()
+	defer p.keysMu.Unlock()
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.keysUpdateAt) < keysRefreshRateLimit {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+
+	var set jsonWebKeySet
+	if err := p.getJSON(ctx, p.discovery.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("failed to fetch signing keys: %w", err)
+	}
+	p.keys = map[string]any{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		p.keys[jwk.Kid] = key
+	}
+	p.keysUpdateAt = time.Now()
+
+	if key, ok := p.lookupKey(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown signing key %q", kid)
+}
+
+// lookupKey returns the key with the given ID, or the only key if the ID token doesn't name one.
+func (p *provider) lookupKey(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+type jsonWebKeySet struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+// jsonWebKey is a public RSA or EC key, as published by the JWKS endpoint of an identity provider.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (k jsonWebKey) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeBigInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := decodeBigInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := decodeBigInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := decodeBigInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+func decodeBigInt(s string) (*big.Int, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
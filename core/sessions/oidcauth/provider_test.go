@@ -0,0 +1,103 @@
+package oidcauth
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/oidcauth/oidcauthtest"
+)
+
+func newTestProvider(t *testing.T, issuer *oidcauthtest.Issuer) *provider {
+	issuerURL, err := url.Parse(issuer.URL)
+	require.NoError(t, err)
+	p, err := newProvider(testutils.Context(t), &TestConfig{Issuer: issuerURL}, http.DefaultClient)
+	require.NoError(t, err)
+	return p
+}
+
+func TestProvider_ExchangeAndRefresh(t *testing.T) {
+	ctx := testutils.Context(t)
+	issuer := oidcauthtest.NewIssuer(t, oidcauthtest.User{Email: "Alice@example.com", Groups: []string{"Other", NodeEditorsGroup}})
+	p := newTestProvider(t, issuer)
+
+	code, state := issuer.Login(t, p.authCodeURL("state-1", "nonce-1", "verifier-verifier-verifier-verifier-verifier"))
+	assert.Equal(t, "state-1", state)
+
+	// The authorization code is bound to the PKCE verifier and nonce of the login.
+	_, err := p.exchange(ctx, code, "wrong-verifier-wrong-verifier-wrong-verifier", "nonce-1")
+	require.Error(t, err)
+	code, _ = issuer.Login(t, p.authCodeURL("state-1", "nonce-1", "verifier-verifier-verifier-verifier-verifier"))
+	_, err = p.exchange(ctx, code, "verifier-verifier-verifier-verifier-verifier", "nonce-2")
+	require.ErrorContains(t, err, "nonce")
+
+	code, _ = issuer.Login(t, p.authCodeURL("state-1", "nonce-1", "verifier-verifier-verifier-verifier-verifier"))
+	id, err := p.exchange(ctx, code, "verifier-verifier-verifier-verifier-verifier", "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", id.email)
+	assert.Equal(t, sessions.UserRoleEdit, id.role)
+	assert.NotEmpty(t, id.refreshToken)
+	assert.False(t, id.expiry.IsZero())
+
+	// Refreshing maps the role from the current groups of the user.
+	issuer.SetUser(oidcauthtest.User{Email: "Alice@example.com", Groups: []string{NodeReadOnlyGroup}})
+	refreshed, err := p.refresh(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, sessions.UserRoleView, refreshed.role)
+	assert.NotEqual(t, id.refreshToken, refreshed.refreshToken)
+
+	// Refresh tokens are single use.
+	_, err = p.refresh(ctx, id)
+	require.Error(t, err)
+
+	// Users removed from all groups can't refresh their session.
+	issuer.SetUser(oidcauthtest.User{Email: "Alice@example.com"})
+	_, err = p.refresh(ctx, refreshed)
+	require.ErrorIs(t, err, ErrNoRole)
+
+	require.NoError(t, p.revoke(ctx, refreshed.refreshToken))
+	assert.Equal(t, []string{refreshed.refreshToken}, issuer.Revoked())
+}
+
+func TestProvider_IssuerMismatch(t *testing.T) {
+	issuer := oidcauthtest.NewIssuer(t, oidcauthtest.User{})
+	issuerURL, err := url.Parse(issuer.URL + "/other")
+	require.NoError(t, err)
+	_, err = newProvider(testutils.Context(t), &TestConfig{Issuer: issuerURL}, http.DefaultClient)
+	require.Error(t, err)
+}
+
+func TestProvider_IdentityFromClaims(t *testing.T) {
+	p := &provider{config: &TestConfig{}}
+
+	for _, tc := range []struct {
+		name   string
+		claims jwt.MapClaims
+		role   sessions.UserRole
+		err    error
+	}{
+		{"admin wins", jwt.MapClaims{"email": "a@example.com", "groups": []any{NodeReadOnlyGroup, NodeAdminsGroup}}, sessions.UserRoleAdmin, nil},
+		{"single group string", jwt.MapClaims{"email": "a@example.com", "groups": NodeRunnersGroup}, sessions.UserRoleRun, nil},
+		{"read only", jwt.MapClaims{"email": "a@example.com", "groups": []any{NodeReadOnlyGroup}}, sessions.UserRoleView, nil},
+		{"no mapped group", jwt.MapClaims{"email": "a@example.com", "groups": []any{"Other"}}, "", ErrNoRole},
+		{"no groups", jwt.MapClaims{"email": "a@example.com"}, "", ErrNoRole},
+		{"no email", jwt.MapClaims{"groups": []any{NodeAdminsGroup}}, "", ErrEmailMissing},
+		{"unverified email", jwt.MapClaims{"email": "a@example.com", "email_verified": false, "groups": []any{NodeAdminsGroup}}, "", ErrEmailNotValid},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			id, err := p.identityFromClaims(tc.claims)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.role, id.role)
+		})
+	}
+}
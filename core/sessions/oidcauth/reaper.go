@@ -0,0 +1,45 @@
+package oidcauth
+
+import (
+	"context"
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink-common/pkg/utils"
+
+	"github.com/smartcontractkit/chainlink/v2/core/config"
+	"github.com/smartcontractkit/chainlink/v2/core/logger"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/localauth"
+)
+
+type sessionReaper struct {
+	ds         sqlutil.DataSource
+	config     localauth.SessionReaperConfig
+	oidcConfig config.OIDC
+	lggr       logger.Logger
+}
+
+// NewSessionReaper creates a reaper that cleans stale OIDC sessions, and stale sessions of local users, from the store.
+func NewSessionReaper(ds sqlutil.DataSource, config localauth.SessionReaperConfig, oidcConfig config.OIDC, lggr logger.Logger) *utils.SleeperTask {
+	return utils.NewSleeperTaskCtx(&sessionReaper{
+		ds,
+		config,
+		oidcConfig,
+		lggr.Named("OIDCSessionReaper"),
+	})
+}
+
+func (sr *sessionReaper) Name() string { return sr.lggr.Name() }
+
+func (sr *sessionReaper) Work(ctx context.Context) {
+	now := time.Now()
+	oidcStaleThreshold := sr.config.SessionReaperExpiration().Before(sr.oidcConfig.SessionTimeout().Before(now))
+	if _, err := sr.ds.ExecContext(ctx, "DELETE FROM oidc_sessions WHERE last_used < $1", oidcStaleThreshold); err != nil {
+		sr.lggr.Error("unable to reap stale OIDC sessions: ", err)
+	}
+
+	localStaleThreshold := sr.config.SessionReaperExpiration().Before(sr.config.SessionTimeout().Before(now))
+	if _, err := sr.ds.ExecContext(ctx, "DELETE FROM sessions WHERE last_used < $1", localStaleThreshold); err != nil {
+		sr.lggr.Error("unable to reap stale sessions: ", err)
+	}
+}
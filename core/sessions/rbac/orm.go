@@ -0,0 +1,163 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/sqlutil"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// Assignment is the assignment of a custom role to a user.
+type Assignment struct {
+	Email    string
+	RoleName string
+}
+
+// ORM stores custom roles, and their assignments to users.
+type ORM interface {
+	CreateRole(ctx context.Context, role *Role) error
+	UpdateRole(ctx context.Context, role *Role) error
+	DeleteRole(ctx context.Context, name string) error
+	FindRole(ctx context.Context, name string) (Role, error)
+	ListRoles(ctx context.Context) ([]Role, error)
+
+	AssignRole(ctx context.Context, email, name string) error
+	UnassignRole(ctx context.Context, email, name string) error
+	ListAssignments(ctx context.Context) ([]Assignment, error)
+
+	// RoleOf returns the custom role assigned to user, or the built-in role of the user when none is.
+	RoleOf(ctx context.Context, user sessions.User) (Role, error)
+}
+
+type orm struct {
+	ds sqlutil.DataSource
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(ds sqlutil.DataSource) ORM {
+	return &orm{ds: ds}
+}
+
+// CreateRole validates and inserts a custom role.
+func (o *orm) CreateRole(ctx context.Context, role *Role) error {
+	if err := ValidateRole(*role); err != nil {
+		return err
+	}
+	stmt := `INSERT INTO custom_roles (name, description, permissions, created_at, updated_at)
+		VALUES ($1, $2, $3, now(), now()) RETURNING created_at, updated_at`
+	err := o.ds.QueryRowxContext(ctx, stmt, role.Name, role.Description, role.Permissions).Scan(&role.CreatedAt, &role.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to create role %s: %w", role.Name, err)
+	}
+	return nil
+}
+
+// UpdateRole validates and replaces the description and permissions of a custom role.
+func (o *orm) UpdateRole(ctx context.Context, role *Role) error {
+	if err := ValidateRole(*role); err != nil {
+		return err
+	}
+	stmt := `UPDATE custom_roles SET description = $2, permissions = $3, updated_at = now()
+		WHERE name = $1 RETURNING created_at, updated_at`
+	err := o.ds.QueryRowxContext(ctx, stmt, role.Name, role.Description, role.Permissions).Scan(&role.CreatedAt, &role.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrRoleNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to update role %s: %w", role.Name, err)
+	}
+	return nil
+}
+
+// DeleteRole deletes a custom role. Users the role was assigned to fall back to their built-in role.
+func (o *orm) DeleteRole(ctx context.Context, name string) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM custom_roles WHERE name = $1`, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete role %s: %w", name, err)
+	}
+	if rows, err := result.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrRoleNotFound
+	}
+	return nil
+}
+
+// FindRole finds a custom role by name.
+func (o *orm) FindRole(ctx context.Context, name string) (Role, error) {
+	var role Role
+	err := o.ds.GetContext(ctx, &role, `SELECT * FROM custom_roles WHERE name = $1`, name)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Role{}, ErrRoleNotFound
+	} else if err != nil {
+		return Role{}, fmt.Errorf("failed to find role %s: %w", name, err)
+	}
+	return role, nil
+}
+
+// ListRoles lists the custom roles, ordered by name.
+func (o *orm) ListRoles(ctx context.Context) ([]Role, error) {
+	var roles []Role
+	if err := o.ds.SelectContext(ctx, &roles, `SELECT * FROM custom_roles ORDER BY name ASC`); err != nil {
+		return nil, fmt.Errorf("failed to list roles: %w", err)
+	}
+	return roles, nil
+}
+
+// AssignRole assigns a custom role to the user with the given email, replacing any custom role assigned before.
+func (o *orm) AssignRole(ctx context.Context, email, name string) error {
+	if _, err := o.FindRole(ctx, name); err != nil {
+		return err
+	}
+	stmt := `INSERT INTO user_custom_roles (email, role_name, created_at) VALUES ($1, $2, now())
+		ON CONFLICT (email) DO UPDATE SET role_name = EXCLUDED.role_name, created_at = EXCLUDED.created_at`
+	if _, err := o.ds.ExecContext(ctx, stmt, strings.ToLower(email), name); err != nil {
+		return fmt.Errorf("failed to assign role %s to %s: %w", name, email, err)
+	}
+	return nil
+}
+
+// UnassignRole removes the custom role assigned to the user with the given email, who falls back to their built-in
+// role.
+func (o *orm) UnassignRole(ctx context.Context, email, name string) error {
+	result, err := o.ds.ExecContext(ctx, `DELETE FROM user_custom_roles WHERE email = $1 AND role_name = $2`, strings.ToLower(email), name)
+	if err != nil {
+		return fmt.Errorf("failed to unassign role %s of %s: %w", name, email, err)
+	}
+	if rows, err := result.RowsAffected(); err != nil {
+		return err
+	} else if rows == 0 {
+		return ErrAssignmentNotFound
+	}
+	return nil
+}
+
+// ListAssignments lists the assignments of custom roles, ordered by email.
+func (o *orm) ListAssignments(ctx context.Context) (assignments []Assignment, err error) {
+	err = o.ds.SelectContext(ctx, &assignments, `SELECT email, role_name FROM user_custom_roles ORDER BY email ASC`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list role assignments: %w", err)
+	}
+	return assignments, nil
+}
+
+func (o *orm) RoleOf(ctx context.Context, user sessions.User) (Role, error) {
+	if user.Email == "" {
+		return BuiltinRole(user.Role), nil
+	}
+	var role Role
+	stmt := `SELECT custom_roles.* FROM custom_roles
+		JOIN user_custom_roles ON user_custom_roles.role_name = custom_roles.name
+		WHERE user_custom_roles.email = $1`
+	err := o.ds.GetContext(ctx, &role, stmt, strings.ToLower(user.Email))
+	if errors.Is(err, sql.ErrNoRows) {
+		return BuiltinRole(user.Role), nil
+	} else if err != nil {
+		return Role{}, fmt.Errorf("failed to find role of %s: %w", user.Email, err)
+	}
+	return role, nil
+}
@@ -0,0 +1,84 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestORM_Roles(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm := rbac.NewORM(pgtest.NewSqlxDB(t))
+
+	role := rbac.Role{
+		Name:        "bridge-operator",
+		Description: "Manages bridges",
+		Permissions: rbac.Permissions{{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}}},
+	}
+	require.NoError(t, orm.CreateRole(ctx, &role))
+	assert.False(t, role.CreatedAt.IsZero())
+	require.Error(t, orm.CreateRole(ctx, &role))
+	require.ErrorIs(t, orm.CreateRole(ctx, &rbac.Role{Name: "edit"}), rbac.ErrRoleNameReserved)
+
+	found, err := orm.FindRole(ctx, role.Name)
+	require.NoError(t, err)
+	assert.Equal(t, role.Permissions, found.Permissions)
+	assert.Equal(t, role.Description, found.Description)
+
+	role.Permissions = append(role.Permissions, rbac.Permission{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{"offchainreporting2"}})
+	require.NoError(t, orm.UpdateRole(ctx, &role))
+	found, err = orm.FindRole(ctx, role.Name)
+	require.NoError(t, err)
+	assert.Equal(t, role.Permissions, found.Permissions)
+	require.ErrorIs(t, orm.UpdateRole(ctx, &rbac.Role{Name: "unknown"}), rbac.ErrRoleNotFound)
+
+	roles, err := orm.ListRoles(ctx)
+	require.NoError(t, err)
+	require.Len(t, roles, 1)
+	assert.Equal(t, role.Name, roles[0].Name)
+
+	require.NoError(t, orm.DeleteRole(ctx, role.Name))
+	require.ErrorIs(t, orm.DeleteRole(ctx, role.Name), rbac.ErrRoleNotFound)
+	_, err = orm.FindRole(ctx, role.Name)
+	require.ErrorIs(t, err, rbac.ErrRoleNotFound)
+}
+
+func TestORM_RoleOf(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+	orm := rbac.NewORM(pgtest.NewSqlxDB(t))
+
+	user := sessions.User{Email: "Alice@example.com", Role: sessions.UserRoleEdit}
+	role, err := orm.RoleOf(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, rbac.BuiltinRole(sessions.UserRoleEdit), role)
+
+	require.ErrorIs(t, orm.AssignRole(ctx, user.Email, "jobs-viewer"), rbac.ErrRoleNotFound)
+	custom := rbac.Role{Name: "jobs-viewer", Permissions: rbac.Permissions{{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}}}}
+	require.NoError(t, orm.CreateRole(ctx, &custom))
+	require.NoError(t, orm.AssignRole(ctx, user.Email, custom.Name))
+
+	role, err = orm.RoleOf(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, custom.Name, role.Name)
+	assert.False(t, role.Permissions.Allows(rbac.ResourceBridges, rbac.ActionRead))
+
+	assignments, err := orm.ListAssignments(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []rbac.Assignment{{Email: "alice@example.com", RoleName: custom.Name}}, assignments)
+	require.ErrorIs(t, orm.UnassignRole(ctx, user.Email, "other"), rbac.ErrAssignmentNotFound)
+
+	// Users fall back to their built-in role when their custom role is deleted.
+	require.NoError(t, orm.DeleteRole(ctx, custom.Name))
+	role, err = orm.RoleOf(ctx, user)
+	require.NoError(t, err)
+	assert.Equal(t, string(sessions.UserRoleEdit), role.Name)
+	require.ErrorIs(t, orm.UnassignRole(ctx, user.Email, custom.Name), rbac.ErrAssignmentNotFound)
+}
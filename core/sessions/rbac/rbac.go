@@ -0,0 +1,350 @@
+// Package rbac implements custom roles, which grant users permissions on resource types and actions, in place of the
+// fixed admin, edit, run and view roles.
+//
+// Custom roles are stored in the database and assigned to users by email, so that they apply to users of any
+// authentication method. Users without a custom role keep the permissions of their built-in role.
+package rbac
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+// Resource is a type of resource permissions are granted on.
+type Resource string
+
+const (
+	ResourceUsers              Resource = "users"
+	ResourceBridges            Resource = "bridges"
+	ResourceExternalInitiators Resource = "external_initiators"
+	ResourceTransfers          Resource = "transfers"
+	ResourceConfig             Resource = "config"
+	ResourceLogs               Resource = "logs"
+	ResourceTransactions       Resource = "transactions"
+	ResourceChains             Resource = "chains"
+	ResourceKeys               Resource = "keys"
+	ResourceJobs               Resource = "jobs"
+	ResourceFeedsManagers      Resource = "feeds_managers"
+	ResourceWorkflows          Resource = "workflows"
+	ResourceAuditLogs          Resource = "audit_logs"
+)
+
+// Resources lists all resources, in the order they are documented.
+var Resources = []Resource{
+	ResourceUsers,
+	ResourceBridges,
+	ResourceExternalInitiators,
+	ResourceTransfers,
+	ResourceConfig,
+	ResourceLogs,
+	ResourceTransactions,
+	ResourceChains,
+	ResourceKeys,
+	ResourceJobs,
+	ResourceFeedsManagers,
+	ResourceWorkflows,
+	ResourceAuditLogs,
+}
+
+// Action is an action on a resource.
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionRun    Action = "run"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+	ActionImport Action = "import"
+	ActionExport Action = "export"
+)
+
+// Actions lists all actions, in the order they are documented.
+var Actions = []Action{ActionRead, ActionRun, ActionCreate, ActionUpdate, ActionDelete, ActionImport, ActionExport}
+
+var (
+	ErrRoleNotFound       = errors.New("role not found")
+	ErrAssignmentNotFound = errors.New("role is not assigned to user")
+	ErrRoleNameReserved   = errors.New("role name is reserved for a built-in role")
+)
+
+// Permission grants actions on a resource.
+type Permission struct {
+	Resource Resource `json:"resource"`
+	Actions  []Action `json:"actions"`
+	// JobTypes restricts a permission on jobs, and their runs, to jobs of the given types. All job types are allowed
+	// when empty.
+	JobTypes []string `json:"jobTypes,omitempty"`
+}
+
+// ParsePermission parses a permission from the form resource:action[,action...][:jobType[,jobType...]], for example
+// "bridges:read,create" or "jobs:read:offchainreporting2".
+func ParsePermission(s string) (p Permission, err error) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return p, fmt.Errorf("invalid permission %q: expected resource:actions[:jobTypes]", s)
+	}
+	p.Resource = Resource(strings.TrimSpace(parts[0]))
+	for _, a := range strings.Split(parts[1], ",") {
+		p.Actions = append(p.Actions, Action(strings.TrimSpace(a)))
+	}
+	if len(parts) == 3 {
+		for _, t := range strings.Split(parts[2], ",") {
+			p.JobTypes = append(p.JobTypes, strings.TrimSpace(t))
+		}
+	}
+	return p, p.Validate()
+}
+
+// String formats the permission in the form accepted by ParsePermission.
+func (p Permission) String() string {
+	actions := make([]string, len(p.Actions))
+	for i, a := range p.Actions {
+		actions[i] = string(a)
+	}
+	s := string(p.Resource) + ":" + strings.Join(actions, ",")
+	if len(p.JobTypes) > 0 {
+		s += ":" + strings.Join(p.JobTypes, ",")
+	}
+	return s
+}
+
+// Validate checks the resource and actions are known, and that job types only restrict permissions on jobs.
+func (p Permission) Validate() error {
+	if !slices.Contains(Resources, p.Resource) {
+		return fmt.Errorf("unknown resource %q", p.Resource)
+	}
+	if len(p.Actions) == 0 {
+		return fmt.Errorf("no actions for resource %q", p.Resource)
+	}
+	for _, a := range p.Actions {
+		if !slices.Contains(Actions, a) {
+			return fmt.Errorf("unknown action %q for resource %q", a, p.Resource)
+		}
+	}
+	if len(p.JobTypes) > 0 && p.Resource != ResourceJobs {
+		return fmt.Errorf("job types can only restrict permissions on %q, not %q", ResourceJobs, p.Resource)
+	}
+	for _, t := range p.JobTypes {
+		if t == "" {
+			return errors.New("job types must not be empty")
+		}
+	}
+	return nil
+}
+
+func (p Permission) allows(resource Resource, action Action) bool {
+	return p.Resource == resource && slices.Contains(p.Actions, action)
+}
+
+// Permissions is a set of permissions, stored as JSON.
+type Permissions []Permission
+
+// Allows returns true if action is allowed on resource. Permissions restricted to job types allow the action, and
+// callers acting on a particular job must check AllowsJobType too.
+func (ps Permissions) Allows(resource Resource, action Action) bool {
+	for _, p := range ps {
+		if p.allows(resource, action) {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsJobType returns true if action is allowed on jobs of type jobType.
+func (ps Permissions) AllowsJobType(action Action, jobType string) bool {
+	for _, p := range ps {
+		if p.allows(ResourceJobs, action) && (len(p.JobTypes) == 0 || slices.Contains(p.JobTypes, jobType)) {
+			return true
+		}
+	}
+	return false
+}
+
+// JobTypes returns the job types action is allowed on, or all as true if it is allowed on jobs of any type.
+func (ps Permissions) JobTypes(action Action) (jobTypes []string, all bool) {
+	for _, p := range ps {
+		if !p.allows(ResourceJobs, action) {
+			continue
+		}
+		if len(p.JobTypes) == 0 {
+			return nil, true
+		}
+		for _, t := range p.JobTypes {
+			if !slices.Contains(jobTypes, t) {
+				jobTypes = append(jobTypes, t)
+			}
+		}
+	}
+	sort.Strings(jobTypes)
+	return jobTypes, false
+}
+
+// Intersect returns the permissions allowed by both ps and other, including the job types both allow.
+func (ps Permissions) Intersect(other Permissions) (intersection Permissions) {
+	for _, p := range ps {
+		for _, o := range other {
+			if p.Resource != o.Resource {
+				continue
+			}
+			var actions []Action
+			for _, a := range p.Actions {
+				if slices.Contains(o.Actions, a) && !slices.Contains(actions, a) {
+					actions = append(actions, a)
+				}
+			}
+			if len(actions) == 0 {
+				continue
+			}
+			jobTypes, ok := intersectJobTypes(p.JobTypes, o.JobTypes)
+			if !ok {
+				continue
+			}
+			intersection = append(intersection, Permission{Resource: p.Resource, Actions: actions, JobTypes: jobTypes})
+		}
+	}
+	return intersection
+}
+
+// intersectJobTypes intersects two job type restrictions, where an empty restriction allows all job types. It returns
+// false if no job type is allowed by both.
+func intersectJobTypes(a, b []string) ([]string, bool) {
+	if len(a) == 0 {
+		return b, true
+	}
+	if len(b) == 0 {
+		return a, true
+	}
+	var jobTypes []string
+	for _, t := range a {
+		if slices.Contains(b, t) {
+			jobTypes = append(jobTypes, t)
+		}
+	}
+	return jobTypes, len(jobTypes) > 0
+}
+
+// ReadOnly returns permissions to read all resources.
+func ReadOnly() Permissions {
+	ps := make(Permissions, len(Resources))
+	for i, resource := range Resources {
+		ps[i] = Permission{Resource: resource, Actions: []Action{ActionRead}}
+	}
+	return ps
+}
+
+// Validate validates each permission.
+func (ps Permissions) Validate() error {
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Value returns this instance serialized for database storage.
+func (ps Permissions) Value() (driver.Value, error) {
+	if ps == nil {
+		ps = Permissions{}
+	}
+	return json.Marshal(ps)
+}
+
+// Scan reads the database value and returns an instance.
+func (ps *Permissions) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, ps)
+	case string:
+		return json.Unmarshal([]byte(v), ps)
+	case nil:
+		*ps = nil
+		return nil
+	default:
+		return fmt.Errorf("unable to convert %v of %T to Permissions", value, value)
+	}
+}
+
+// Role is a named set of permissions.
+type Role struct {
+	Name        string
+	Description string
+	Permissions Permissions
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ValidateRole checks the name of a custom role is neither empty nor the name of a built-in role, and validates its
+// permissions.
+func ValidateRole(role Role) error {
+	if strings.TrimSpace(role.Name) == "" {
+		return errors.New("role name must not be empty")
+	}
+	if IsBuiltinRole(role.Name) {
+		return fmt.Errorf("%w: %s", ErrRoleNameReserved, role.Name)
+	}
+	return role.Permissions.Validate()
+}
+
+// IsBuiltinRole returns true if name is the name of one of the built-in roles.
+func IsBuiltinRole(name string) bool {
+	switch sessions.UserRole(name) {
+	case sessions.UserRoleAdmin, sessions.UserRoleEdit, sessions.UserRoleRun, sessions.UserRoleView:
+		return true
+	}
+	return false
+}
+
+// BuiltinRole returns the permissions of a built-in role, which match the access the role had before custom roles:
+//   - view may read all resources, except users and the audit log.
+//   - run may also run jobs, and replay blocks of chains.
+//   - edit may also manage bridges, external initiators, jobs, feeds managers and forwarders, and create keys.
+//   - admin may perform any action on any resource.
+func BuiltinRole(role sessions.UserRole) Role {
+	r := Role{Name: string(role)}
+	switch role {
+	case sessions.UserRoleAdmin:
+		for _, resource := range Resources {
+			r.Permissions = append(r.Permissions, Permission{Resource: resource, Actions: Actions})
+		}
+		return r
+	case sessions.UserRoleEdit, sessions.UserRoleRun, sessions.UserRoleView:
+	default:
+		// Unknown roles, such as those of external initiators, have no permissions.
+		return r
+	}
+
+	for _, resource := range Resources {
+		if resource == ResourceUsers || resource == ResourceAuditLogs {
+			continue
+		}
+		p := Permission{Resource: resource, Actions: []Action{ActionRead}}
+		if role != sessions.UserRoleView {
+			switch resource {
+			case ResourceJobs, ResourceChains:
+				p.Actions = append(p.Actions, ActionRun)
+			}
+		}
+		if role == sessions.UserRoleEdit {
+			switch resource {
+			case ResourceBridges, ResourceJobs, ResourceFeedsManagers:
+				p.Actions = append(p.Actions, ActionCreate, ActionUpdate, ActionDelete)
+			case ResourceExternalInitiators, ResourceChains:
+				p.Actions = append(p.Actions, ActionCreate, ActionDelete)
+			case ResourceKeys:
+				p.Actions = append(p.Actions, ActionCreate)
+			}
+		}
+		r.Permissions = append(r.Permissions, p)
+	}
+	return r
+}
@@ -0,0 +1,157 @@
+package rbac_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestParsePermission(t *testing.T) {
+	t.Parallel()
+
+	p, err := rbac.ParsePermission("bridges:read,create")
+	require.NoError(t, err)
+	assert.Equal(t, rbac.Permission{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}}, p)
+	assert.Equal(t, "bridges:read,create", p.String())
+
+	p, err = rbac.ParsePermission("jobs:read:offchainreporting2,cron")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"offchainreporting2", "cron"}, p.JobTypes)
+	assert.Equal(t, "jobs:read:offchainreporting2,cron", p.String())
+
+	for _, s := range []string{
+		"bridges",
+		"bridges:",
+		"unknown:read",
+		"bridges:fly",
+		"bridges:read:cron",
+		"jobs:read:",
+		"jobs:read:cron:extra",
+	} {
+		_, err := rbac.ParsePermission(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestPermissions(t *testing.T) {
+	t.Parallel()
+
+	ps := rbac.Permissions{
+		{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}},
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{"offchainreporting2"}},
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionRun}, JobTypes: []string{"cron"}},
+	}
+
+	assert.True(t, ps.Allows(rbac.ResourceBridges, rbac.ActionCreate))
+	assert.False(t, ps.Allows(rbac.ResourceBridges, rbac.ActionDelete))
+	assert.False(t, ps.Allows(rbac.ResourceKeys, rbac.ActionRead))
+	assert.True(t, ps.Allows(rbac.ResourceJobs, rbac.ActionRead))
+
+	assert.True(t, ps.AllowsJobType(rbac.ActionRead, "offchainreporting2"))
+	assert.False(t, ps.AllowsJobType(rbac.ActionRun, "offchainreporting2"))
+	assert.True(t, ps.AllowsJobType(rbac.ActionRun, "cron"))
+	assert.False(t, ps.AllowsJobType(rbac.ActionRead, "webhook"))
+
+	jobTypes, all := ps.JobTypes(rbac.ActionRead)
+	assert.False(t, all)
+	assert.Equal(t, []string{"cron", "offchainreporting2"}, jobTypes)
+	jobTypes, all = ps.JobTypes(rbac.ActionDelete)
+	assert.False(t, all)
+	assert.Empty(t, jobTypes)
+
+	ps = append(ps, rbac.Permission{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}})
+	_, all = ps.JobTypes(rbac.ActionRead)
+	assert.True(t, all)
+	assert.True(t, ps.AllowsJobType(rbac.ActionRead, "webhook"))
+}
+
+func TestPermissions_Intersect(t *testing.T) {
+	t.Parallel()
+
+	role := rbac.BuiltinRole(sessions.UserRoleRun).Permissions
+	assert.Empty(t, role.Intersect(nil))
+
+	readOnly := role.Intersect(rbac.ReadOnly())
+	assert.True(t, readOnly.Allows(rbac.ResourceJobs, rbac.ActionRead))
+	assert.False(t, readOnly.Allows(rbac.ResourceJobs, rbac.ActionRun))
+	assert.False(t, readOnly.Allows(rbac.ResourceUsers, rbac.ActionRead))
+
+	scope := rbac.Permissions{
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionRun, rbac.ActionCreate}, JobTypes: []string{"cron", "webhook"}},
+		{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionCreate}},
+	}
+	scoped := role.Intersect(scope)
+	assert.Equal(t, rbac.Permissions{
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionRun}, JobTypes: []string{"cron", "webhook"}},
+	}, scoped)
+
+	narrower := scoped.Intersect(rbac.Permissions{
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRun}, JobTypes: []string{"webhook", "offchainreporting2"}},
+		{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{"offchainreporting2"}},
+	})
+	assert.True(t, narrower.AllowsJobType(rbac.ActionRun, "webhook"))
+	assert.False(t, narrower.AllowsJobType(rbac.ActionRun, "cron"))
+	assert.False(t, narrower.Allows(rbac.ResourceJobs, rbac.ActionRead))
+}
+
+func TestPermissions_Scan(t *testing.T) {
+	t.Parallel()
+
+	ps := rbac.Permissions{{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{"cron"}}}
+	v, err := ps.Value()
+	require.NoError(t, err)
+
+	var scanned rbac.Permissions
+	require.NoError(t, scanned.Scan(v))
+	assert.Equal(t, ps, scanned)
+
+	v, err = rbac.Permissions(nil).Value()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("[]"), v)
+}
+
+func TestValidateRole(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, rbac.ValidateRole(rbac.Role{Name: "bridge-operator", Permissions: rbac.Permissions{{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead}}}}))
+	require.Error(t, rbac.ValidateRole(rbac.Role{Name: " "}))
+	require.ErrorIs(t, rbac.ValidateRole(rbac.Role{Name: "admin"}), rbac.ErrRoleNameReserved)
+	require.Error(t, rbac.ValidateRole(rbac.Role{Name: "bridge-operator", Permissions: rbac.Permissions{{Resource: rbac.ResourceBridges}}}))
+}
+
+func TestBuiltinRole(t *testing.T) {
+	t.Parallel()
+
+	view := rbac.BuiltinRole(sessions.UserRoleView).Permissions
+	run := rbac.BuiltinRole(sessions.UserRoleRun).Permissions
+	edit := rbac.BuiltinRole(sessions.UserRoleEdit).Permissions
+	admin := rbac.BuiltinRole(sessions.UserRoleAdmin).Permissions
+
+	for _, resource := range rbac.Resources {
+		allowed := resource != rbac.ResourceUsers && resource != rbac.ResourceAuditLogs
+		assert.Equal(t, allowed, view.Allows(resource, rbac.ActionRead), resource)
+		assert.Equal(t, allowed, edit.Allows(resource, rbac.ActionRead), resource)
+		for _, action := range rbac.Actions {
+			assert.True(t, admin.Allows(resource, action), "%s:%s", resource, action)
+		}
+	}
+
+	assert.False(t, view.Allows(rbac.ResourceJobs, rbac.ActionRun))
+	assert.True(t, run.Allows(rbac.ResourceJobs, rbac.ActionRun))
+	assert.True(t, run.Allows(rbac.ResourceChains, rbac.ActionRun))
+	assert.False(t, run.Allows(rbac.ResourceJobs, rbac.ActionCreate))
+
+	assert.True(t, edit.Allows(rbac.ResourceBridges, rbac.ActionDelete))
+	assert.True(t, edit.AllowsJobType(rbac.ActionCreate, "offchainreporting2"))
+	assert.True(t, edit.Allows(rbac.ResourceKeys, rbac.ActionCreate))
+	assert.False(t, edit.Allows(rbac.ResourceKeys, rbac.ActionDelete))
+	assert.False(t, edit.Allows(rbac.ResourceKeys, rbac.ActionExport))
+	assert.False(t, edit.Allows(rbac.ResourceTransfers, rbac.ActionCreate))
+	assert.False(t, edit.Allows(rbac.ResourceLogs, rbac.ActionUpdate))
+
+	assert.Empty(t, rbac.BuiltinRole("").Permissions)
+}
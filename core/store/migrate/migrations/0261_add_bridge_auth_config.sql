@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+ADD COLUMN auth_config BYTEA;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE bridge_types
+DROP COLUMN IF EXISTS auth_config;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE evm.log_poller_filter_replays (
+    evm_chain_id NUMERIC(78) NOT NULL,
+    filter_name TEXT NOT NULL CHECK (length(filter_name) > 0),
+    from_block BIGINT NOT NULL CHECK (from_block > 0),
+    to_block BIGINT NOT NULL,
+    next_block BIGINT NOT NULL,
+    state TEXT NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (evm_chain_id, filter_name)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS evm.log_poller_filter_replays;
+-- +goose StatementEnd
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE evm.txes ADD COLUMN priority text NOT NULL DEFAULT 'normal';
+ALTER TABLE evm.txes ADD CONSTRAINT chk_priority CHECK (priority IN ('low', 'normal', 'high'));
+CREATE INDEX idx_txes_unstarted_priority_subject ON evm.txes(evm_chain_id, from_address, priority, subject) WHERE state = 'unstarted'::evm.txes_state;
+-- +goose Down
+DROP INDEX IF EXISTS evm.idx_txes_unstarted_priority_subject;
+ALTER TABLE evm.txes DROP COLUMN priority;
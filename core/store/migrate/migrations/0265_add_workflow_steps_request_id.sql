@@ -0,0 +1,4 @@
+-- +goose Up
+ALTER TABLE workflow_steps ADD COLUMN request_id text;
+-- +goose Down
+ALTER TABLE workflow_steps DROP COLUMN request_id;
@@ -0,0 +1,8 @@
+-- +goose Up
+ALTER TABLE workflow_steps ADD COLUMN started_at timestamp with time zone;
+ALTER TABLE workflow_steps ADD COLUMN capability_don_id bigint;
+CREATE INDEX idx_workflow_executions_workflow_id_created_at ON workflow_executions(workflow_id, created_at);
+-- +goose Down
+DROP INDEX IF EXISTS idx_workflow_executions_workflow_id_created_at;
+ALTER TABLE workflow_steps DROP COLUMN capability_don_id;
+ALTER TABLE workflow_steps DROP COLUMN started_at;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS oidc_sessions (
+    id text PRIMARY KEY,
+    user_email text NOT NULL,
+    user_role user_roles NOT NULL,
+    token_expiry timestamp with time zone NOT NULL,
+    last_used timestamp with time zone NOT NULL,
+    created_at timestamp with time zone NOT NULL
+);
+
+-- +goose Down
+DROP TABLE oidc_sessions;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS custom_roles (
+    name text PRIMARY KEY,
+    description text NOT NULL DEFAULT '',
+    permissions jsonb NOT NULL DEFAULT '[]',
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+-- Custom roles are assigned by email, so that they apply to users of any authentication method
+CREATE TABLE IF NOT EXISTS user_custom_roles (
+    email text PRIMARY KEY,
+    role_name text NOT NULL REFERENCES custom_roles (name) ON DELETE CASCADE,
+    created_at timestamp with time zone NOT NULL
+);
+
+CREATE INDEX idx_user_custom_roles_role_name ON user_custom_roles (role_name);
+
+-- +goose Down
+DROP TABLE user_custom_roles;
+DROP TABLE custom_roles;
@@ -0,0 +1,21 @@
+-- +goose Up
+-- Named API tokens are owned by email, so that they can be created by users of any authentication method
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id BIGSERIAL PRIMARY KEY,
+    user_email text NOT NULL,
+    name text NOT NULL,
+    access_key text UNIQUE NOT NULL,
+    salt text NOT NULL,
+    hashed_secret text NOT NULL,
+    permissions jsonb NOT NULL DEFAULT '[]',
+    expires_at timestamp with time zone,
+    last_used timestamp with time zone,
+    revoked_at timestamp with time zone,
+    created_at timestamp with time zone NOT NULL
+);
+
+-- Names are unique among the tokens of a user which are not revoked
+CREATE UNIQUE INDEX idx_api_tokens_user_email_name ON api_tokens (lower(user_email), name) WHERE revoked_at IS NULL;
+
+-- +goose Down
+DROP TABLE api_tokens;
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Audit log records form a hash chain: each record commits to the hash of the previous one, so that records which are
+-- modified, removed or reordered are detected. Data is stored as json rather than jsonb, to keep the exact text it was
+-- hashed from.
+CREATE TABLE audit_log_records (
+    id BIGINT PRIMARY KEY CHECK (id > 0),
+    event_id TEXT NOT NULL,
+    user_email TEXT NOT NULL DEFAULT '',
+    data JSON NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL,
+    prev_hash TEXT NOT NULL,
+    hash TEXT NOT NULL
+);
+
+CREATE INDEX idx_audit_log_records_event_id ON audit_log_records (event_id);
+CREATE INDEX idx_audit_log_records_user_email ON audit_log_records (lower(user_email));
+CREATE INDEX idx_audit_log_records_created_at ON audit_log_records (created_at);
+
+CREATE FUNCTION audit_log_records_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log_records is append-only';
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_log_records_append_only BEFORE UPDATE OR DELETE ON audit_log_records
+    FOR EACH ROW EXECUTE FUNCTION audit_log_records_append_only();
+CREATE TRIGGER audit_log_records_no_truncate BEFORE TRUNCATE ON audit_log_records
+    FOR EACH STATEMENT EXECUTE FUNCTION audit_log_records_append_only();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_log_records;
+DROP FUNCTION audit_log_records_append_only;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE job_proposal_policy_decisions (
+    id BIGSERIAL PRIMARY KEY,
+    job_proposal_id BIGINT NOT NULL REFERENCES job_proposals (id) ON DELETE CASCADE,
+    job_proposal_spec_id BIGINT NOT NULL REFERENCES job_proposal_specs (id) ON DELETE CASCADE,
+    policy TEXT NOT NULL DEFAULT '',
+    decision TEXT NOT NULL,
+    reason TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX idx_job_proposal_policy_decisions_job_proposal_id ON job_proposal_policy_decisions (job_proposal_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE job_proposal_policy_decisions;
+-- +goose StatementEnd
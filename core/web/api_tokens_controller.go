@@ -0,0 +1,180 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+	"gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// APITokensController manages named API tokens. Users manage their own tokens, and users allowed to update users may
+// list and revoke the tokens of any user.
+type APITokensController struct {
+	App chainlink.Application
+}
+
+// CreateAPITokenRequest defines the request to create a named API token.
+type CreateAPITokenRequest struct {
+	Name     string `json:"name"`
+	Password string `json:"password"`
+	// Permissions is the scope of the token, which is intersected with the role of the user. The token has the full
+	// role of the user when it is empty, unless ReadOnly is set.
+	Permissions []rbac.Permission `json:"permissions"`
+	// ReadOnly adds permissions to read all resources to the scope of the token.
+	ReadOnly bool `json:"readOnly"`
+	// ExpiresAt is the expiry of the token, which never expires when it is not set.
+	ExpiresAt *time.Time `json:"expiresAt"`
+}
+
+var errAPITokenAuthenticated = errors.New("API tokens can not be managed with a named API token, login to manage them")
+
+// Index lists the named API tokens of the current user.
+// Example:
+// "GET <application>/user/tokens"
+func (atc *APITokensController) Index(c *gin.Context) {
+	user, ok := atc.tokenManager(c)
+	if !ok {
+		return
+	}
+	tokens, err := atc.App.APITokenORM().ListTokens(c.Request.Context(), user.Email)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// Create creates a named API token for the current user, whose password must be confirmed. The credentials of the
+// token are only returned in the response.
+// Example:
+// "POST <application>/user/tokens"
+func (atc *APITokensController) Create(c *gin.Context) {
+	ctx := c.Request.Context()
+	user, ok := atc.tokenManager(c)
+	if !ok {
+		return
+	}
+	var request CreateAPITokenRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if err := atc.App.AuthenticationProvider().TestPassword(ctx, user.Email, request.Password); err != nil {
+		if errors.Is(err, clsession.ErrNotSupported) {
+			jsonAPIError(c, http.StatusBadRequest, errUnsupportedForAuth)
+			return
+		}
+		atc.App.GetAuditLogger().Audit(audit.APITokenCreateAttemptPasswordMismatch, map[string]interface{}{"user": user.Email})
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	permissions := rbac.Permissions(request.Permissions)
+	if request.ReadOnly {
+		permissions = append(permissions, rbac.ReadOnly()...)
+	}
+	token, credentials, err := apitokens.NewToken(user.Email, request.Name, permissions, null.TimeFromPtr(request.ExpiresAt))
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+	if err = atc.App.APITokenORM().CreateToken(ctx, &token); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("API token %s already exists", request.Name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	atc.App.GetAuditLogger().Audit(audit.APITokenCreated, map[string]interface{}{
+		"user":        user.Email,
+		"token":       token.Name,
+		"permissions": token.Permissions,
+		"expiresAt":   token.ExpiresAt,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewCreatedAPITokenResource(token, credentials), "api_token", http.StatusCreated)
+}
+
+// Revoke revokes a named API token of the current user.
+// Example:
+// "DELETE <application>/user/tokens/:ID"
+func (atc *APITokensController) Revoke(c *gin.Context) {
+	user, ok := atc.tokenManager(c)
+	if !ok {
+		return
+	}
+	atc.revoke(c, user.Email)
+}
+
+// IndexAll lists the named API tokens of all users.
+// Example:
+// "GET <application>/api_tokens"
+func (atc *APITokensController) IndexAll(c *gin.Context) {
+	tokens, err := atc.App.APITokenORM().ListTokens(c.Request.Context(), "")
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewAPITokenResources(tokens), "api_tokens")
+}
+
+// RevokeAny revokes a named API token of any user.
+// Example:
+// "DELETE <application>/api_tokens/:ID"
+func (atc *APITokensController) RevokeAny(c *gin.Context) {
+	atc.revoke(c, "")
+}
+
+func (atc *APITokensController) revoke(c *gin.Context, email string) {
+	id, err := strconv.ParseInt(c.Param("ID"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	token, err := atc.App.APITokenORM().RevokeToken(c.Request.Context(), id, email)
+	if err != nil {
+		if errors.Is(err, apitokens.ErrTokenNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	auditData := map[string]interface{}{"user": token.UserEmail, "token": token.Name}
+	if sessionUser, ok := webauth.GetAuthenticatedUser(c); ok {
+		auditData["revokedBy"] = sessionUser.Email
+	}
+	atc.App.GetAuditLogger().Audit(audit.APITokenRevoked, auditData)
+	jsonAPIResponse(c, presenters.NewAPITokenResource(token), "api_token")
+}
+
+// tokenManager returns the current user, unless the request was authenticated with a named API token, which could
+// otherwise create tokens beyond its own scope.
+func (atc *APITokensController) tokenManager(c *gin.Context) (*clsession.User, bool) {
+	if _, ok := webauth.GetAuthenticatedAPIToken(c); ok {
+		jsonAPIError(c, http.StatusForbidden, errAPITokenAuthenticated)
+		return nil, false
+	}
+	user, ok := webauth.GetAuthenticatedUser(c)
+	if !ok {
+		jsonAPIError(c, http.StatusInternalServerError, errors.New("failed to obtain current user from context"))
+		return nil, false
+	}
+	return user, true
+}
@@ -0,0 +1,133 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAPITokensController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Incorrect password",
+			reqBody:        `{"name": "ci", "password": "wrong"}`,
+			wantStatusCode: http.StatusUnauthorized,
+			wantErrMessage: "incorrect password",
+		},
+		{
+			name:           "Expired",
+			reqBody:        fmt.Sprintf(`{"name": "ci", "password": "%s", "expiresAt": "2020-01-01T00:00:00Z"}`, cltest.Password),
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "in the past",
+		},
+		{
+			name:           "Success",
+			reqBody:        fmt.Sprintf(`{"name": "ci", "password": "%s", "readOnly": true}`, cltest.Password),
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "Duplicate",
+			reqBody:        fmt.Sprintf(`{"name": "ci", "password": "%s"}`, cltest.Password),
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "API token ci already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBufferString(tc.reqBody))
+		t.Cleanup(cleanup)
+		errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+
+		require.Equal(t, tc.wantStatusCode, resp.StatusCode, tc.name)
+		if tc.wantErrMessage != "" {
+			require.Len(t, errors.Errors, 1, tc.name)
+			assert.Contains(t, errors.Errors[0].Detail, tc.wantErrMessage, tc.name)
+		}
+	}
+}
+
+func TestAPITokensController_ScopeAndRevoke(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleEdit})
+	expiresAt := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	resp, cleanup := client.Post("/v2/user/tokens", bytes.NewBufferString(fmt.Sprintf(
+		`{"name": "ci", "password": "%s", "permissions": [{"resource": "jobs", "actions": ["read"]}], "expiresAt": "%s"}`,
+		cltest.Password, expiresAt)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+	var token presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &token))
+	require.NotEmpty(t, token.Secret)
+
+	tokenRequest := func(method, path string) *http.Response {
+		request, err := http.NewRequestWithContext(ctx, method, app.Server.URL+path, bytes.NewBufferString("{}"))
+		require.NoError(t, err)
+		request.Header.Set(webauth.APIKey, token.AccessKey)
+		request.Header.Set(webauth.APISecret, token.Secret)
+		response, err := clhttptest.NewTestLocalOnlyHTTPClient().Do(request)
+		require.NoError(t, err)
+		_, err = io.Copy(io.Discard, response.Body)
+		require.NoError(t, err)
+		require.NoError(t, response.Body.Close())
+		return response
+	}
+
+	assert.Equal(t, http.StatusOK, tokenRequest(http.MethodGet, "/v2/jobs").StatusCode)
+	forbidden := tokenRequest(http.MethodPost, "/v2/bridge_types")
+	assert.Equal(t, http.StatusForbidden, forbidden.StatusCode)
+	assert.Equal(t, "bridges:create", forbidden.Header.Get("forbidden-required-permission"))
+	assert.Equal(t, http.StatusForbidden, tokenRequest(http.MethodGet, "/v2/user/tokens").StatusCode)
+
+	resp, cleanup = client.Get("/v2/user/tokens")
+	t.Cleanup(cleanup)
+	var tokens []presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &tokens))
+	require.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].LastUsed)
+	assert.Empty(t, tokens[0].Secret)
+
+	resp, cleanup = client.Delete("/v2/user/tokens/" + token.ID)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var revoked presenters.APITokenResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &revoked))
+	assert.Equal(t, apitokens.StatusRevoked, revoked.Status)
+
+	assert.Equal(t, http.StatusUnauthorized, tokenRequest(http.MethodGet, "/v2/jobs").StatusCode)
+}
@@ -0,0 +1,73 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// AuditLogsController queries and verifies the local audit log, which is
+// stored when AuditLogger.Persist is enabled.
+type AuditLogsController struct {
+	App chainlink.Application
+}
+
+// Index lists audit log records, most recent first. They can be filtered by
+// user, event type, and creation time in RFC3339 format.
+// Example:
+// "GET <application>/audit_logs?user=<email>&eventID=AUTH_LOGIN_FAILED_EMAIL&createdAfter=2024-01-01T00:00:00Z"
+func (alc *AuditLogsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseAuditLogsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	records, count, err := alc.App.AuditLogORM().ListRecords(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "auditLogRecords", size, page, presenters.NewAuditLogRecordResources(records), count, err)
+}
+
+// Verify verifies the hash chain of the whole audit log, and returns the
+// first record which has been tampered with, if any.
+// Example:
+// "GET <application>/audit_logs/verify"
+func (alc *AuditLogsController) Verify(c *gin.Context) {
+	result, err := alc.App.AuditLogORM().Verify(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewAuditLogVerificationResource(result), "auditLogVerification")
+}
+
+func parseAuditLogsFilter(c *gin.Context) (audit.RecordsFilter, error) {
+	filter := audit.RecordsFilter{
+		UserEmail: c.Query("user"),
+		EventID:   audit.EventID(c.Query("eventID")),
+	}
+	for param, t := range map[string]**time.Time{
+		"createdAfter":  &filter.CreatedAfter,
+		"createdBefore": &filter.CreatedBefore,
+	} {
+		if v := c.Query(param); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s: %w", param, err)
+			}
+			*t = &parsed
+		}
+	}
+	return filter, nil
+}
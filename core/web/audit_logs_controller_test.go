@@ -0,0 +1,75 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestAuditLogsController_Index(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	for _, email := range []string{"a@example.com", "b@example.com", "a@example.com"} {
+		_, err := app.AuditLogORM().AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": email})
+		require.NoError(t, err)
+	}
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Get("/v2/audit_logs?size=1&user=a@example.com&eventID=AUTH_LOGIN_FAILED_EMAIL")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var records []presenters.AuditLogRecordResource
+	meta, err := cltest.ParseJSONAPIResponseMetaCount(cltest.ParseResponseBody(t, resp))
+	require.NoError(t, err)
+	assert.Equal(t, 2, meta)
+
+	resp, cleanup = client.Get("/v2/audit_logs?user=a@example.com")
+	t.Cleanup(cleanup)
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &records))
+	require.Len(t, records, 2)
+	assert.Equal(t, "3", records[0].ID)
+	assert.JSONEq(t, `{"email":"a@example.com"}`, string(records[0].Data))
+
+	resp, cleanup = client.Get("/v2/audit_logs?createdAfter=yesterday")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnprocessableEntity)
+
+	// The audit log is only readable by admins.
+	viewer := app.NewHTTPClient(&cltest.User{Role: sessions.UserRoleView})
+	resp, cleanup = viewer.Get("/v2/audit_logs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+}
+
+func TestAuditLogsController_Verify(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	record, err := app.AuditLogORM().AppendRecord(ctx, audit.AuthLoginFailedEmail, audit.Data{"email": "a@example.com"})
+	require.NoError(t, err)
+
+	client := app.NewHTTPClient(nil)
+	resp, cleanup := client.Get("/v2/audit_logs/verify")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+	var result presenters.AuditLogVerificationResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &result))
+	assert.True(t, result.Valid)
+	assert.Equal(t, int64(1), result.Records)
+	assert.Equal(t, record.Hash, result.HeadHash)
+}
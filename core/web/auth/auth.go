@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"net/http"
+	"time"
 
 	"github.com/gin-contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -11,7 +12,10 @@ import (
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/static"
 )
 
@@ -33,6 +37,12 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// SessionRoleKey is the Role key in the session map
+	SessionRoleKey = "role"
+
+	// SessionAPITokenKey is the named API token key in the session map
+	SessionAPITokenKey = "api_token"
 )
 
 // Authenticator defines the interface to authenticate requests against a
@@ -44,6 +54,17 @@ type Authenticator interface {
 	FindUserByAPIToken(ctx context.Context, apiToken string) (clsessions.User, error)
 }
 
+// RoleResolver resolves the role, and so the permissions, of a user.
+type RoleResolver interface {
+	RoleOf(ctx context.Context, user clsessions.User) (rbac.Role, error)
+}
+
+// TokenStore finds the named API tokens of users, and records their use.
+type TokenStore interface {
+	FindTokenByAccessKey(ctx context.Context, accessKey string) (apitokens.Token, error)
+	MarkUsed(ctx context.Context, id int64) error
+}
+
 // authMethod defines a method which can be used to authenticate a request. This
 // can be implemented according to your authentication method (i.e by session,
 // token, etc)
@@ -113,6 +134,69 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 
 var _ authMethod = AuthenticateByToken
 
+// AuthenticateByNamedToken returns an authMethod which authenticates a User by one of their named API tokens, whose
+// scope ResolveRole applies to the role of the user. Uses of tokens, and attempts to use revoked or expired tokens, are
+// audited.
+func AuthenticateByNamedToken(tokens TokenStore, auditLogger audit.AuditLogger) authMethod {
+	return func(c *gin.Context, authr Authenticator) error {
+		ctx := c.Request.Context()
+		credentials := &auth.Token{
+			AccessKey: c.GetHeader(APIKey),
+			Secret:    c.GetHeader(APISecret),
+		}
+		if credentials.AccessKey == "" {
+			return auth.ErrorAuthFailed
+		}
+
+		token, err := tokens.FindTokenByAccessKey(ctx, credentials.AccessKey)
+		if err != nil {
+			if errors.Is(err, apitokens.ErrTokenNotFound) {
+				return auth.ErrorAuthFailed
+			}
+			return err
+		}
+		ok, err := token.Authenticate(credentials)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return auth.ErrorAuthFailed
+		}
+		if err = token.Validate(time.Now()); err != nil {
+			auditLogger.Audit(audit.APITokenRejected, map[string]interface{}{
+				"user":   token.UserEmail,
+				"token":  token.Name,
+				"reason": err.Error(),
+			})
+			return err
+		}
+
+		// The user is loaded on each use, so that the token follows changes to the role of the user, and stops working
+		// when the user is deleted.
+		user, err := authr.FindUser(ctx, token.UserEmail)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return auth.ErrorAuthFailed
+			}
+			return err
+		}
+		if err = tokens.MarkUsed(ctx, token.ID); err != nil {
+			return err
+		}
+		auditLogger.Audit(audit.APITokenUsed, map[string]interface{}{
+			"user":   user.Email,
+			"token":  token.Name,
+			"method": c.Request.Method,
+			"path":   c.Request.URL.Path,
+		})
+
+		c.Set(SessionUserKey, &user)
+		c.Set(SessionAPITokenKey, &token)
+
+		return nil
+	}
+}
+
 // AuthenticateExternalInitiator authenticates an external initiator request.
 //
 // Implements authMethod
@@ -197,28 +281,62 @@ func GetAuthenticatedExternalInitiator(c *gin.Context) (*bridges.ExternalInitiat
 	return obj.(*bridges.ExternalInitiator), ok
 }
 
-// RequiresRunRole extracts the user object from the context, and asserts the user's role is at least
-// 'run'
-func RequiresRunRole(handler func(*gin.Context)) func(*gin.Context) {
+// ResolveRole is middleware which resolves the role of the authenticated user, whose permissions RequiresPermission
+// checks. The role of requests authenticated with a named API token is restricted to the scope of the token. Requests
+// without a user, such as those of external initiators, are passed through.
+func ResolveRole(roles RoleResolver) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
+			c.Next()
 			return
 		}
-		if user.Role == clsessions.UserRoleView {
+		role, err := roles.RoleOf(c.Request.Context(), *user)
+		if err != nil {
 			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+			jsonAPIError(c, http.StatusInternalServerError, err)
 			return
 		}
-		handler(c)
+		if token, ok := GetAuthenticatedAPIToken(c); ok {
+			role = token.Scope(role)
+		}
+		c.Set(SessionRoleKey, &role)
+		c.Next()
 	}
 }
 
-// RequiresEditRole extracts the user object from the context, and asserts the user's role is at least
-// 'edit'
-func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
+// GetAuthenticatedRole extracts the role of the authenticated user from the context.
+func GetAuthenticatedRole(c *gin.Context) (*rbac.Role, bool) {
+	obj, ok := c.Get(SessionRoleKey)
+	if !ok {
+		return nil, false
+	}
+
+	role, ok := obj.(*rbac.Role)
+
+	return role, ok
+}
+
+// GetAuthenticatedAPIToken extracts the named API token the request was authenticated with from the context.
+func GetAuthenticatedAPIToken(c *gin.Context) (*apitokens.Token, bool) {
+	obj, ok := c.Get(SessionAPITokenKey)
+	if !ok {
+		return nil, false
+	}
+
+	token, ok := obj.(*apitokens.Token)
+
+	return token, ok
+}
+
+// RequiresPermission extracts the user and their role from the context, and asserts the role allows action on
+// resource.
+//
+// Users with a built-in role are denied with the same responses as before custom roles: unauthorized for actions an
+// edit role may perform, and forbidden for those only admins may perform.
+func RequiresPermission(resource rbac.Resource, action rbac.Action, handler func(*gin.Context)) func(*gin.Context) {
+	required := rbac.Permission{Resource: resource, Actions: []rbac.Action{action}}.String()
+	adminOnly := !rbac.BuiltinRole(clsessions.UserRoleEdit).Permissions.Allows(resource, action)
 	return func(c *gin.Context) {
 		user, ok := GetAuthenticatedUser(c)
 		if !ok {
@@ -226,30 +344,28 @@ func RequiresEditRole(handler func(*gin.Context)) func(*gin.Context) {
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role == clsessions.UserRoleView || user.Role == clsessions.UserRoleRun {
-			c.Abort()
-			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
-			return
-		}
-		handler(c)
-	}
-}
-
-// RequiresAdminRole extracts the user object from the context, and asserts the user's role is 'admin'
-func RequiresAdminRole(handler func(*gin.Context)) func(*gin.Context) {
-	return func(c *gin.Context) {
-		user, ok := GetAuthenticatedUser(c)
+		role, ok := GetAuthenticatedRole(c)
 		if !ok {
 			c.Abort()
 			jsonAPIError(c, http.StatusUnauthorized, errors.New("not a valid session"))
 			return
 		}
-		if user.Role != clsessions.UserRoleAdmin {
-			c.Abort()
-			addForbiddenErrorHeaders(c, "admin", string(user.Role), user.Email)
+		if role.Permissions.Allows(resource, action) {
+			handler(c)
+			return
+		}
+
+		c.Abort()
+		if !rbac.IsBuiltinRole(role.Name) {
+			addForbiddenPermissionHeaders(c, required, role.Name, user.Email)
 			jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
 			return
 		}
-		handler(c)
+		if !adminOnly {
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("Unauthorized"))
+			return
+		}
+		addForbiddenErrorHeaders(c, "admin", role.Name, user.Email)
+		jsonAPIError(c, http.StatusForbidden, errors.New("Forbidden"))
 	}
 }
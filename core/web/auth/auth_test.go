@@ -7,17 +7,22 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web"
 	webauth "github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
@@ -206,6 +211,143 @@ func TestRequireAuth_Error(t *testing.T) {
 	assert.Equal(t, http.StatusText(http.StatusUnauthorized), http.StatusText(w.Code))
 }
 
+type customRoles map[string]rbac.Role
+
+func (r customRoles) RoleOf(_ context.Context, user sessions.User) (rbac.Role, error) {
+	if role, ok := r[user.Email]; ok {
+		return role, nil
+	}
+	return rbac.BuiltinRole(user.Role), nil
+}
+
+func TestRequiresPermission(t *testing.T) {
+	t.Parallel()
+
+	bridgeOperator := rbac.Role{Name: "bridge-operator", Permissions: rbac.Permissions{
+		{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead, rbac.ActionCreate}},
+	}}
+	roles := customRoles{"bridges@example.com": bridgeOperator}
+
+	for _, tc := range []struct {
+		name       string
+		user       sessions.User
+		resource   rbac.Resource
+		action     rbac.Action
+		status     int
+		permission string
+	}{
+		{"custom role allowed", sessions.User{Email: "bridges@example.com", Role: sessions.UserRoleView}, rbac.ResourceBridges, rbac.ActionCreate, http.StatusOK, ""},
+		{"custom role denied", sessions.User{Email: "bridges@example.com", Role: sessions.UserRoleAdmin}, rbac.ResourceKeys, rbac.ActionRead, http.StatusForbidden, "keys:read"},
+		{"built-in role allowed", sessions.User{Email: "edit@example.com", Role: sessions.UserRoleEdit}, rbac.ResourceJobs, rbac.ActionCreate, http.StatusOK, ""},
+		{"built-in role denied edit action", sessions.User{Email: "run@example.com", Role: sessions.UserRoleRun}, rbac.ResourceJobs, rbac.ActionCreate, http.StatusUnauthorized, ""},
+		{"built-in role denied admin action", sessions.User{Email: "edit@example.com", Role: sessions.UserRoleEdit}, rbac.ResourceKeys, rbac.ActionExport, http.StatusForbidden, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set(webauth.SessionUserKey, &tc.user)
+			}, webauth.ResolveRole(roles))
+			router.GET("/", webauth.RequiresPermission(tc.resource, tc.action, func(c *gin.Context) {
+				c.String(http.StatusOK, "")
+			}))
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mustRequest(t, "GET", "/", nil))
+
+			assert.Equal(t, tc.status, w.Code)
+			assert.Equal(t, tc.permission, w.Header().Get("forbidden-required-permission"))
+		})
+	}
+}
+
+type namedTokens map[string]apitokens.Token
+
+func (ts namedTokens) FindTokenByAccessKey(ctx context.Context, accessKey string) (apitokens.Token, error) {
+	token, ok := ts[accessKey]
+	if !ok {
+		return apitokens.Token{}, apitokens.ErrTokenNotFound
+	}
+	return token, nil
+}
+
+func (ts namedTokens) MarkUsed(ctx context.Context, id int64) error {
+	return nil
+}
+
+type auditRecorder struct {
+	audit.AuditLogger
+	events []audit.EventID
+}
+
+func (r *auditRecorder) Audit(eventID audit.EventID, data audit.Data) {
+	r.events = append(r.events, eventID)
+}
+
+func TestAuthenticateByNamedToken(t *testing.T) {
+	t.Parallel()
+
+	user := sessions.User{Email: "ci@example.com", Role: sessions.UserRoleEdit}
+	readOnly, readOnlyCredentials, err := apitokens.NewToken(user.Email, "read-only", rbac.ReadOnly(), null.Time{})
+	require.NoError(t, err)
+	full, fullCredentials, err := apitokens.NewToken(user.Email, "full", nil, null.Time{})
+	require.NoError(t, err)
+	expired, expiredCredentials, err := apitokens.NewToken(user.Email, "expired", nil, null.Time{})
+	require.NoError(t, err)
+	expired.ExpiresAt = null.TimeFrom(time.Now().Add(-time.Minute))
+	tokens := namedTokens{
+		readOnly.AccessKey: readOnly,
+		full.AccessKey:     full,
+		expired.AccessKey:  expired,
+	}
+
+	for _, tc := range []struct {
+		name        string
+		credentials auth.Token
+		action      rbac.Action
+		status      int
+		event       audit.EventID
+	}{
+		{"scoped token allowed read", *readOnlyCredentials, rbac.ActionRead, http.StatusOK, audit.APITokenUsed},
+		{"scoped token denied create", *readOnlyCredentials, rbac.ActionCreate, http.StatusForbidden, audit.APITokenUsed},
+		{"unscoped token has role of user", *fullCredentials, rbac.ActionCreate, http.StatusOK, audit.APITokenUsed},
+		{"expired token", *expiredCredentials, rbac.ActionRead, http.StatusUnauthorized, audit.APITokenRejected},
+		{"wrong secret", auth.Token{AccessKey: fullCredentials.AccessKey, Secret: "wrong"}, rbac.ActionRead, http.StatusUnauthorized, ""},
+		{"unknown token", auth.Token{AccessKey: "unknown", Secret: "wrong"}, rbac.ActionRead, http.StatusUnauthorized, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			auditLogger := &auditRecorder{}
+			router := gin.New()
+			router.Use(
+				webauth.Authenticate(userFindSuccesser{user: user}, webauth.AuthenticateByNamedToken(tokens, auditLogger)),
+				webauth.ResolveRole(customRoles{}),
+			)
+			router.GET("/", webauth.RequiresPermission(rbac.ResourceJobs, tc.action, func(c *gin.Context) {
+				c.String(http.StatusOK, "")
+			}))
+
+			w := httptest.NewRecorder()
+			req := mustRequest(t, "GET", "/", nil)
+			req.Header.Set(webauth.APIKey, tc.credentials.AccessKey)
+			req.Header.Set(webauth.APISecret, tc.credentials.Secret)
+			router.ServeHTTP(w, req)
+
+			assert.Equal(t, tc.status, w.Code)
+			if tc.event == "" {
+				assert.Empty(t, auditLogger.events)
+			} else {
+				assert.Equal(t, []audit.EventID{tc.event}, auditLogger.events)
+			}
+			if tc.status == http.StatusForbidden {
+				assert.Equal(t, "edit (API token read-only)", w.Header().Get("forbidden-provided-role"))
+			}
+		})
+	}
+}
+
 // Test RBAC (Role based access control) of each route and their required user roles
 // Admin is omitted from the fields here since admin should be able to access all routes
 type routeRules struct {
@@ -222,9 +364,22 @@ var routesRolesMap = [...]routeRules{
 	{"POST", "/v2/users", false, false, false},
 	{"PATCH", "/v2/users", false, false, false},
 	{"DELETE", "/v2/users/MOCK", false, false, false},
+	{"GET", "/v2/roles", false, false, false},
+	{"POST", "/v2/roles", false, false, false},
+	{"PUT", "/v2/roles/MOCK", false, false, false},
+	{"DELETE", "/v2/roles/MOCK", false, false, false},
+	{"POST", "/v2/roles/MOCK/users", false, false, false},
+	{"DELETE", "/v2/roles/MOCK/users/MOCK", false, false, false},
 	{"PATCH", "/v2/user/password", true, true, true},
 	{"POST", "/v2/user/token", true, true, true},
 	{"POST", "/v2/user/token/delete", true, true, true},
+	{"GET", "/v2/user/tokens", true, true, true},
+	{"POST", "/v2/user/tokens", true, true, true},
+	{"DELETE", "/v2/user/tokens/MOCK", true, true, true},
+	{"GET", "/v2/api_tokens", false, false, false},
+	{"DELETE", "/v2/api_tokens/MOCK", false, false, false},
+	{"GET", "/v2/audit_logs", false, false, false},
+	{"GET", "/v2/audit_logs/verify", false, false, false},
 	{"GET", "/v2/enroll_webauthn", true, true, true},
 	{"POST", "/v2/enroll_webauthn", true, true, true},
 	{"GET", "/v2/external_initiators", true, true, true},
@@ -248,6 +403,7 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/transactions", true, true, true},
 	{"GET", "/v2/transactions/MOCK", true, true, true},
 	{"POST", "/v2/replay_from_block/MOCK", false, true, true},
+	{"GET", "/v2/filter_replays/MOCK", true, true, true},
 	{"GET", "/v2/keys/csa", true, true, true},
 	{"POST", "/v2/keys/csa", false, false, true},
 	{"POST", "/v2/keys/csa/import", false, false, false},
@@ -304,6 +460,8 @@ var routesRolesMap = [...]routeRules{
 	{"GET", "/v2/pipeline/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs", true, true, true},
 	{"GET", "/v2/jobs/MOCK/runs/MOCK", true, true, true},
+	{"GET", "/v2/workflows/executions", true, true, true},
+	{"GET", "/v2/workflows/executions/MOCK", true, true, true},
 	{"GET", "/v2/features", true, true, true},
 	{"DELETE", "/v2/pipeline/job_spec_errors/MOCK", false, false, true},
 	{"GET", "/v2/log", true, true, true},
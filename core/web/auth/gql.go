@@ -11,12 +11,14 @@ import (
 	"github.com/gin-gonic/gin"
 
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 )
 
 type sessionUserKey struct{}
 type GQLSession struct {
 	SessionID string
 	User      *clsessions.User
+	Role      *rbac.Role
 }
 
 // AuthenticateGQL middleware checks the session cookie for a user and sets it
@@ -24,7 +26,7 @@ type GQLSession struct {
 // to validate whether it requires an authenticated user.
 //
 // We currently only support GQL authentication by session cookie.
-func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.HandlerFunc {
+func AuthenticateGQL(authenticator Authenticator, roles RoleResolver, lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		ctx := c.Request.Context()
 		session := sessions.Default(c)
@@ -43,20 +45,31 @@ func AuthenticateGQL(authenticator Authenticator, lggr logger.Logger) gin.Handle
 			return
 		}
 
-		ctx = WithGQLAuthenticatedSession(c.Request.Context(), user, sessionID)
+		role, err := roles.RoleOf(ctx, user)
+		if err != nil {
+			lggr.Errorw("Failed call to RoleOf, unable to get role of user", "err", err)
+			return
+		}
+
+		ctx = WithGQLAuthenticatedRole(c.Request.Context(), user, role, sessionID)
 
 		c.Request = c.Request.WithContext(ctx)
 	}
 }
 
-// WithGQLAuthenticatedSession sets the authenticated session in the context
+// WithGQLAuthenticatedSession sets the authenticated session, of a user with their built-in role, in the context
 //
 // There shouldn't be a need to do this outside of testing
 func WithGQLAuthenticatedSession(ctx context.Context, user clsessions.User, sessionID string) context.Context {
+	return WithGQLAuthenticatedRole(ctx, user, rbac.BuiltinRole(user.Role), sessionID)
+}
+
+// WithGQLAuthenticatedRole sets the authenticated session, of a user with the given role, in the context
+func WithGQLAuthenticatedRole(ctx context.Context, user clsessions.User, role rbac.Role, sessionID string) context.Context {
 	return context.WithValue(
 		ctx,
 		sessionUserKey{},
-		&GQLSession{sessionID, &user},
+		&GQLSession{sessionID, &user, &role},
 	)
 }
 
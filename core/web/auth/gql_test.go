@@ -1,6 +1,7 @@
 package auth_test
 
 import (
+	"context"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -16,9 +17,17 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
+// builtinRoles resolves the built-in role of users, as if none had a custom role.
+type builtinRoles struct{}
+
+func (builtinRoles) RoleOf(_ context.Context, user clsessions.User) (rbac.Role, error) {
+	return rbac.BuiltinRole(user.Role), nil
+}
+
 func Test_AuthenticateGQL_Unauthenticated(t *testing.T) {
 	t.Parallel()
 
@@ -27,7 +36,7 @@ func Test_AuthenticateGQL_Unauthenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, builtinRoles{}, logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c)
@@ -51,12 +60,13 @@ func Test_AuthenticateGQL_Authenticated(t *testing.T) {
 
 	r := gin.Default()
 	r.Use(sessions.Sessions(auth.SessionName, sessionStore))
-	r.Use(auth.AuthenticateGQL(sessionORM, logger.TestLogger(t)))
+	r.Use(auth.AuthenticateGQL(sessionORM, builtinRoles{}, logger.TestLogger(t)))
 
 	r.GET("/", func(c *gin.Context) {
 		session, ok := auth.GetGQLAuthenticatedSession(c.Request.Context())
 		assert.True(t, ok)
 		assert.NotNil(t, session)
+		assert.Equal(t, string(clsessions.UserRoleAdmin), session.Role.Name)
 
 		c.String(http.StatusOK, "")
 	})
@@ -83,4 +93,5 @@ func Test_GetAndSetGQLAuthenticatedSession(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, &user, actual.User)
 	assert.Equal(t, "sessionID", actual.SessionID)
+	assert.Equal(t, rbac.BuiltinRole(clsessions.UserRoleAdmin), *actual.Role)
 }
@@ -32,3 +32,11 @@ func addForbiddenErrorHeaders(c *gin.Context, requiredRole string, providedRole
 	c.Header("forbidden-provided-role", providedRole)
 	c.Header("forbidden-provided-email", providedEmail)
 }
+
+// addForbiddenPermissionHeaders adds custom headers to the 403 (Forbidden) response of a user with a custom role, which
+// does not grant the permission required for the action.
+func addForbiddenPermissionHeaders(c *gin.Context, requiredPermission string, providedRole string, providedEmail string) {
+	c.Header("forbidden-required-permission", requiredPermission)
+	c.Header("forbidden-provided-role", providedRole)
+	c.Header("forbidden-provided-email", providedEmail)
+}
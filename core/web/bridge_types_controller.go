@@ -50,6 +50,9 @@ func ValidateBridgeType(bt *bridges.BridgeTypeRequest) error {
 		bt.MinimumContractPayment.Cmp(assets.NewLinkFromJuels(0)) < 0 {
 		fe.Add("MinimumContractPayment must be positive")
 	}
+	if err := bt.AuthConfig.Validate(); err != nil {
+		fe.Add(err.Error())
+	}
 	return fe.CoerceEmptyToNil()
 }
 
@@ -104,6 +107,7 @@ func (btc *BridgeTypesController) Create(c *gin.Context) {
 		"bridgeConfirmations":          bta.Confirmations,
 		"bridgeMinimumContractPayment": bta.MinimumContractPayment,
 		"bridgeURL":                    bta.URL,
+		"bridgeAuthType":               resource.AuthType,
 	})
 
 	jsonAPIResponse(c, resource, "bridge")
@@ -182,14 +186,16 @@ func (btc *BridgeTypesController) Update(c *gin.Context) {
 		return
 	}
 
+	resource := presenters.NewBridgeResource(bt)
 	btc.App.GetAuditLogger().Audit(audit.BridgeUpdated, map[string]interface{}{
 		"bridgeName":                   bt.Name,
 		"bridgeConfirmations":          bt.Confirmations,
 		"bridgeMinimumContractPayment": bt.MinimumContractPayment,
 		"bridgeURL":                    bt.URL,
+		"bridgeAuthType":               resource.AuthType,
 	})
 
-	jsonAPIResponse(c, presenters.NewBridgeResource(bt), "bridge")
+	jsonAPIResponse(c, resource, "bridge")
 }
 
 // Destroy removes a specific Bridge.
@@ -100,6 +100,24 @@ func TestValidateBridgeType(t *testing.T) {
 				URL:  cltest.WebURL(t, "https://denergy.eth"),
 			},
 			nil,
+		},
+		{
+			"valid auth config",
+			bridges.BridgeTypeRequest{
+				Name:       "authadapter",
+				URL:        cltest.WebURL(t, "https://denergy.eth"),
+				AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeBearer, Bearer: &bridges.BearerAuth{Token: "token"}},
+			},
+			nil,
+		},
+		{
+			"incomplete auth config",
+			bridges.BridgeTypeRequest{
+				Name:       "authadapter",
+				URL:        cltest.WebURL(t, "https://denergy.eth"),
+				AuthConfig: &bridges.AuthConfig{Type: bridges.AuthTypeHMAC},
+			},
+			models.NewJSONAPIErrorsWith("hmac auth requires a secret"),
 		}}
 
 	for _, test := range tests {
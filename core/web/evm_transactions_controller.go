@@ -3,7 +3,11 @@ package web
 import (
 	"database/sql"
 	"net/http"
+	"strconv"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 
@@ -47,3 +51,106 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// Cancel cancels the Ethereum Transaction of the given attempt hash or ID, if it
+// has not been confirmed yet. Transactions without attempts, such as unstarted
+// ones, can only be cancelled by ID.
+// Example:
+//
+//	"<application>/transactions/evm/:TxHash/cancel"
+//	"<application>/transactions/evm/:TxID/cancel"
+func (tc *TransactionsController) Cancel(c *gin.Context) {
+	if id, err := strconv.ParseInt(c.Param("TxHash"), 10, 64); err == nil {
+		tc.cancelByID(c, id)
+		return
+	}
+	hash := common.HexToHash(c.Param("TxHash"))
+
+	ethTxAttempt, err := tc.App.TxmStorageService().FindTxAttempt(c, hash)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !tc.cancelTx(c, ethTxAttempt.Tx) {
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTxID": ethTxAttempt.TxID,
+		"txHash":  hash,
+	})
+
+	// reload the transaction state
+	ethTxAttempt, err = tc.App.TxmStorageService().FindTxAttempt(c, hash)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
+}
+
+func (tc *TransactionsController) cancelByID(c *gin.Context, id int64) {
+	etx, err := tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if !tc.cancelTx(c, etx) {
+		return
+	}
+
+	tc.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, map[string]interface{}{
+		"ethTxID": etx.ID,
+	})
+
+	// reload the transaction state
+	etx, err = tc.App.TxmStorageService().FindTxWithAttempts(c, id)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if len(etx.TxAttempts) == 0 {
+		r := presenters.NewEthTxResource(etx)
+		r.JAID = presenters.NewJAIDInt64(etx.ID)
+		jsonAPIResponse(c, r, "transaction")
+		return
+	}
+	etx.TxAttempts[0].Tx = etx
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(etx.TxAttempts[0]), "transaction")
+}
+
+// cancelTx cancels etx, rendering the error response and returning false if it
+// could not be cancelled.
+func (tc *TransactionsController) cancelTx(c *gin.Context, etx txmgr.Tx) bool {
+	chain, err := getChain(tc.App.GetRelayers().LegacyEVMChains(), etx.ChainID.String())
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+
+	if err = chain.TxManager().CancelTx(c, etx.ID); err != nil {
+		if errors.Is(err, txmgrcommon.ErrTxNotCancellable) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return false
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	return true
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"testing"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	txmgrtypes "github.com/smartcontractkit/chainlink/v2/common/txmgr/types"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
@@ -129,3 +130,32 @@ func TestTransactionsController_Show_NotFound(t *testing.T) {
 	t.Cleanup(cleanup)
 	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
 }
+
+func TestTransactionsController_Cancel_Unstarted(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	ctx := testutils.Context(t)
+	require.NoError(t, app.Start(ctx))
+
+	txStore := cltest.NewTestTxStore(t, app.GetDB())
+	client := app.NewHTTPClient(nil)
+
+	// unstarted transactions have no attempt yet, so they are cancelled by ID
+	etx := cltest.NewEthTx(testutils.NewAddress())
+	etx.ChainID = &cltest.FixtureChainID
+	require.NoError(t, txStore.InsertTx(ctx, &etx))
+
+	resp, cleanup := client.Post(fmt.Sprintf("/v2/transactions/evm/%d/cancel", etx.ID), nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	ptx := presenters.EthTxResource{}
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &ptx))
+	assert.Equal(t, fmt.Sprint(etx.ID), ptx.ID)
+	assert.Equal(t, string(txmgrcommon.TxFatalError), ptx.State)
+
+	resp, cleanup = client.Post("/v2/transactions/evm/123456789/cancel", nil)
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
@@ -27,11 +27,14 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
 
@@ -49,7 +52,14 @@ func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	var jobs []job.Job
+	var count int
+	var err error
+	if jobTypes, all := allowedJobTypes(c, rbac.ActionRead); all {
+		jobs, count, err = jc.App.JobORM().FindJobs(c.Request.Context(), offset, size)
+	} else {
+		jobs, count, err = jc.App.JobORM().FindJobsByTypes(c.Request.Context(), jobTypes, offset, size)
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -88,6 +98,9 @@ func (jc *JobsController) Show(c *gin.Context) {
 		}
 		return
 	}
+	if !allowsJobType(c, rbac.ActionRead, jobSpec.Type) {
+		return
+	}
 
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
@@ -112,6 +125,9 @@ func (jc *JobsController) Create(c *gin.Context) {
 		jsonAPIError(c, status, err)
 		return
 	}
+	if !allowsJobType(c, rbac.ActionCreate, jb.Type) {
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -135,6 +151,48 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
+// DryRunJobRequest represents a request to execute a job's pipeline without side effects.
+type DryRunJobRequest struct {
+	TOML string `json:"toml"`
+	// Vars are passed to the pipeline as-is, e.g. {"jobRun": {"requestBody": "..."}}
+	Vars map[string]interface{} `json:"vars"`
+	// Stubs are the values returned by side-effecting tasks, keyed by dot ID
+	Stubs map[string]interface{} `json:"stubs"`
+}
+
+// DryRun validates a job spec and executes its pipeline in memory, with
+// side-effecting tasks (ethtx, bridge, vrf) stubbed out. Nothing is persisted.
+// Example:
+// "POST <application>/jobs/dry_run"
+func (jc *JobsController) DryRun(c *gin.Context) {
+	request := DryRunJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jb, status, err := jc.validateJobSpec(c.Request.Context(), request.TOML)
+	if err != nil {
+		jsonAPIError(c, status, err)
+		return
+	}
+	if !allowsJobType(c, rbac.ActionCreate, jb.Type) {
+		return
+	}
+	if strings.TrimSpace(jb.Pipeline.Source) == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("job type %s has no pipeline to execute", jb.Type))
+		return
+	}
+
+	run, trrs, err := jc.App.PipelineRunner().ExecuteDryRun(c.Request.Context(), jb.DryRunPipelineSpec(), pipeline.NewVarsFrom(request.Vars), request.Stubs)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewPipelineDryRunResource(*run, trrs, jc.App.GetLogger()), "pipelineDryRun")
+}
+
 // Delete hard deletes a job spec.
 // Example:
 // "DELETE <application>/specs/:ID"
@@ -145,6 +203,9 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	if !allowsJobID(c, jc.App, rbac.ActionDelete, j.ID) {
+		return
+	}
 
 	// Delete the job
 	err = jc.App.DeleteJob(c.Request.Context(), j.ID)
@@ -187,6 +248,10 @@ func (jc *JobsController) Update(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	// Both the job and its replacement must be of types the user may update.
+	if !allowsJobType(c, rbac.ActionUpdate, jb.Type) || !allowsJobID(c, jc.App, rbac.ActionUpdate, jb.ID) {
+		return
+	}
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -270,3 +335,45 @@ func (jc *JobsController) validateJobSpec(ctx context.Context, tomlString string
 	}
 	return jb, 0, nil
 }
+
+// allowsJobType checks the role of the authenticated user allows action on jobs of jobType, and responds with forbidden
+// when it does not. Requests without a role, such as those of external initiators, are allowed.
+func allowsJobType(c *gin.Context, action rbac.Action, jobType job.Type) bool {
+	role, ok := auth.GetAuthenticatedRole(c)
+	if !ok || role.Permissions.AllowsJobType(action, jobType.String()) {
+		return true
+	}
+	jsonAPIError(c, http.StatusForbidden, errors.Errorf("role %s does not allow %s on jobs of type %s", role.Name, action, jobType))
+	return false
+}
+
+// allowsJobID is like allowsJobType, but loads the job with id to check its type, when the role of the authenticated
+// user only allows action on jobs of some types. Jobs which are not found are allowed, for the caller to respond with
+// not found.
+func allowsJobID(c *gin.Context, app chainlink.Application, action rbac.Action, id int32) bool {
+	if _, all := allowedJobTypes(c, action); all {
+		return true
+	}
+	jb, err := app.JobORM().FindJob(c.Request.Context(), id)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		return true
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return false
+	}
+	return allowsJobType(c, action, jb.Type)
+}
+
+// allowedJobTypes returns the job types the role of the authenticated user allows action on, or all as true when it
+// allows action on jobs of any type.
+func allowedJobTypes(c *gin.Context, action rbac.Action) (jobTypes []job.Type, all bool) {
+	role, ok := auth.GetAuthenticatedRole(c)
+	if !ok {
+		return nil, true
+	}
+	names, all := role.Permissions.JobTypes(action)
+	for _, name := range names {
+		jobTypes = append(jobTypes, job.Type(name))
+	}
+	return jobTypes, all
+}
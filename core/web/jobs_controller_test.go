@@ -522,6 +522,60 @@ func TestJobsController_Create_WebhookSpec(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestJobsController_DryRun_WebhookSpec(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	_, fetchBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+	_, submitBridge := cltest.MustCreateBridge(t, app.GetDB(), cltest.BridgeOpts{})
+
+	client := app.NewHTTPClient(nil)
+
+	t.Run("stubs the bridge tasks", func(t *testing.T) {
+		body, err := json.Marshal(web.DryRunJobRequest{
+			TOML: testspecs.GetWebhookSpecNoBody(uuid.New(), fetchBridge.Name.String(), submitBridge.Name.String()),
+			Stubs: map[string]interface{}{
+				"fetch":  `{"data": {"result": "3"}}`,
+				"submit": "submitted",
+			},
+		})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/dry_run", bytes.NewReader(body))
+		defer cleanup()
+		require.Equal(t, http.StatusOK, response.StatusCode)
+
+		resource := presenters.PipelineDryRunResource{}
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, response), &resource))
+		require.Len(t, resource.Outputs, 1)
+		assert.Equal(t, "submitted", *resource.Outputs[0])
+
+		taskRuns := map[string]presenters.PipelineDryRunTaskResource{}
+		for _, tr := range resource.TaskRuns {
+			taskRuns[tr.DotID] = tr
+		}
+		require.Len(t, taskRuns, 4)
+		assert.True(t, taskRuns["fetch"].Stubbed)
+		assert.False(t, taskRuns["parse_request"].Stubbed)
+		assert.Equal(t, `"300"`, *taskRuns["multiply"].Output)
+		require.True(t, taskRuns["submit"].Stubbed)
+		require.Len(t, taskRuns["submit"].RecordedInputs, 1)
+		assert.Equal(t, []interface{}{"300"}, taskRuns["submit"].RecordedInputs[0].Val)
+
+		jobs, _, err := app.JobORM().FindJobs(ctx, 0, 10)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+	})
+
+	t.Run("invalid TOML", func(t *testing.T) {
+		body, err := json.Marshal(web.DryRunJobRequest{TOML: "some wrong value"})
+		require.NoError(t, err)
+		response, cleanup := client.Post("/v2/jobs/dry_run", bytes.NewReader(body))
+		defer cleanup()
+		cltest.AssertServerResponse(t, response, http.StatusUnprocessableEntity)
+	})
+}
+
 //go:embed webhook-spec-template.yml
 var webhookSpecTemplate string
 
@@ -0,0 +1,68 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/v2/core/utils"
+)
+
+// ChangeKeystorePasswordRequest is the request to re-encrypt the keystore with a new password, or stronger scrypt
+// parameters. ScryptN and ScryptP are optional, and default to the current parameters of the keystore.
+type ChangeKeystorePasswordRequest struct {
+	OldPassword string `json:"oldPassword"`
+	NewPassword string `json:"newPassword"`
+	ScryptN     int    `json:"scryptN,omitempty"`
+	ScryptP     int    `json:"scryptP,omitempty"`
+}
+
+// KeystoreController manages the keystore as a whole
+type KeystoreController struct {
+	App chainlink.Application
+}
+
+// ChangePassword re-encrypts all the keys with a new keystore password, or stronger scrypt parameters
+// Example:
+// "PATCH <application>/keys/password"
+func (ctrl *KeystoreController) ChangePassword(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request ChangeKeystorePasswordRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if request.NewPassword == request.OldPassword && request.ScryptN == 0 && request.ScryptP == 0 {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("new password must differ from the old one, or scrypt parameters must be given"))
+		return
+	}
+	if err := utils.VerifyPasswordComplexity(request.NewPassword); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	scryptParams := utils.ScryptParams{N: request.ScryptN, P: request.ScryptP}
+	err := ctrl.App.ChangeKeystorePassword(ctx, request.OldPassword, request.NewPassword, scryptParams)
+	switch {
+	case errors.Is(err, keystore.ErrPasswordMismatch):
+		ctrl.App.GetAuditLogger().Audit(audit.KeystorePasswordChangeAttemptFailedMismatch, map[string]interface{}{})
+		jsonAPIError(c, http.StatusConflict, errors.New("old password does not match"))
+		return
+	case errors.Is(err, keystore.ErrInvalidScryptParams):
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case errors.Is(err, keystore.ErrLocked):
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	case err != nil:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	ctrl.App.GetAuditLogger().Audit(audit.KeystorePasswordChanged, map[string]interface{}{})
+	jsonAPIResponseWithStatus(c, nil, "keystore", http.StatusNoContent)
+}
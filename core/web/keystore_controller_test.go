@@ -0,0 +1,47 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+)
+
+func TestKeystoreController_ChangePassword(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+	client := app.NewHTTPClient(nil)
+
+	const newPassword = "n3wP4sSw0rd-16char!@#_"
+	changeKeystore := func(request web.ChangeKeystorePasswordRequest) *http.Response {
+		body, err := json.Marshal(request)
+		require.NoError(t, err)
+		resp, cleanup := client.Patch("/v2/keys/password", bytes.NewReader(body))
+		t.Cleanup(cleanup)
+		return resp
+	}
+	changePassword := func(oldPassword, newPassword string) *http.Response {
+		return changeKeystore(web.ChangeKeystorePasswordRequest{OldPassword: oldPassword, NewPassword: newPassword})
+	}
+
+	cltest.AssertServerResponse(t, changePassword(newPassword, cltest.Password), http.StatusConflict)
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, "password"), http.StatusUnprocessableEntity)
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, newPassword), http.StatusNoContent)
+	// the keystore now only accepts the new password
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, newPassword), http.StatusConflict)
+	cltest.AssertServerResponse(t, changePassword(newPassword, cltest.Password), http.StatusNoContent)
+
+	// the scrypt parameters can be raised without changing the password, but not lowered
+	cltest.AssertServerResponse(t, changePassword(cltest.Password, cltest.Password), http.StatusUnprocessableEntity)
+	cltest.AssertServerResponse(t, changeKeystore(web.ChangeKeystorePasswordRequest{OldPassword: cltest.Password, NewPassword: cltest.Password, ScryptN: 3}), http.StatusUnprocessableEntity)
+	cltest.AssertServerResponse(t, changeKeystore(web.ChangeKeystorePasswordRequest{OldPassword: cltest.Password, NewPassword: cltest.Password, ScryptN: 4}), http.StatusNoContent)
+	cltest.AssertServerResponse(t, changeKeystore(web.ChangeKeystorePasswordRequest{OldPassword: cltest.Password, NewPassword: newPassword, ScryptN: 2}), http.StatusUnprocessableEntity)
+}
@@ -137,6 +137,25 @@ func GetSpecsByJobProposalID(ctx context.Context, jpID string) ([]feeds.JobPropo
 	return specs, nil
 }
 
+// GetPolicyDecisionsByJobProposalID fetches the approval policy decisions for
+// a job proposal id.
+func GetPolicyDecisionsByJobProposalID(ctx context.Context, jpID string) ([]feeds.JobProposalPolicyDecision, error) {
+	ldr := For(ctx)
+
+	thunk := ldr.JobProposalPolicyDecisionsByJobProposalID.Load(ctx, dataloader.StringKey(jpID))
+	result, err := thunk()
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, ok := result.([]feeds.JobProposalPolicyDecision)
+	if !ok {
+		return nil, ErrInvalidType
+	}
+
+	return decisions, nil
+}
+
 // GetLatestSpecByJobProposalID fetches the latest spec for a job proposal id.
 func GetLatestSpecByJobProposalID(ctx context.Context, jpID string) (*feeds.JobProposalSpec, error) {
 	ldr := For(ctx)
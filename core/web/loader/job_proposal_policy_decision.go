@@ -0,0 +1,49 @@
+package loader
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/graph-gophers/dataloader"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+)
+
+type jobProposalPolicyDecisionBatcher struct {
+	app chainlink.Application
+}
+
+func (b *jobProposalPolicyDecisionBatcher) loadByJobProposalsIDs(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+	ids, keyOrder := keyOrderInt64(keys)
+
+	decisions, err := b.app.GetFeedsService().ListPolicyDecisionsByJobProposalIDs(ctx, ids)
+	if err != nil {
+		return []*dataloader.Result{{Data: nil, Error: err}}
+	}
+
+	// Generate a map of decisions to job proposal IDs
+	decisionsForJP := map[string][]feeds.JobProposalPolicyDecision{}
+	for _, d := range decisions {
+		jpID := strconv.Itoa(int(d.JobProposalID))
+		decisionsForJP[jpID] = append(decisionsForJP[jpID], d)
+	}
+
+	// Construct the output array of dataloader results
+	results := make([]*dataloader.Result, len(keys))
+	for k, ds := range decisionsForJP {
+		ix, ok := keyOrder[k]
+		// if found, remove from index lookup map so we know elements were found
+		if ok {
+			results[ix] = &dataloader.Result{Data: ds, Error: nil}
+			delete(keyOrder, k)
+		}
+	}
+
+	// fill array positions without any decisions as an empty slice
+	for _, ix := range keyOrder {
+		results[ix] = &dataloader.Result{Data: []feeds.JobProposalPolicyDecision{}, Error: nil}
+	}
+
+	return results
+}
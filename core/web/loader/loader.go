@@ -21,6 +21,7 @@ type Dataloader struct {
 	FeedsManagerChainConfigsByManagerIDLoader *dataloader.Loader
 	JobProposalsByManagerIDLoader             *dataloader.Loader
 	JobProposalSpecsByJobProposalID           *dataloader.Loader
+	JobProposalPolicyDecisionsByJobProposalID *dataloader.Loader
 	JobRunsByIDLoader                         *dataloader.Loader
 	JobsByExternalJobIDs                      *dataloader.Loader
 	JobsByPipelineSpecIDLoader                *dataloader.Loader
@@ -37,6 +38,7 @@ func New(app chainlink.Application) *Dataloader {
 		jobRuns  = &jobRunBatcher{app: app}
 		jps      = &jobProposalBatcher{app: app}
 		jpSpecs  = &jobProposalSpecBatcher{app: app}
+		jpDecs   = &jobProposalPolicyDecisionBatcher{app: app}
 		jbs      = &jobBatcher{app: app}
 		attmpts  = &ethTransactionAttemptBatcher{app: app}
 		specErrs = &jobSpecErrorsBatcher{app: app}
@@ -52,6 +54,7 @@ func New(app chainlink.Application) *Dataloader {
 		FeedsManagerChainConfigsByManagerIDLoader: dataloader.NewBatchedLoader(ccfgs.loadByManagerIDs),
 		JobProposalsByManagerIDLoader:             dataloader.NewBatchedLoader(jps.loadByManagersIDs),
 		JobProposalSpecsByJobProposalID:           dataloader.NewBatchedLoader(jpSpecs.loadByJobProposalsIDs),
+		JobProposalPolicyDecisionsByJobProposalID: dataloader.NewBatchedLoader(jpDecs.loadByJobProposalsIDs),
 		JobRunsByIDLoader:                         dataloader.NewBatchedLoader(jobRuns.loadByIDs),
 		JobsByExternalJobIDs:                      dataloader.NewBatchedLoader(jbs.loadByExternalJobIDs),
 		JobsByPipelineSpecIDLoader:                dataloader.NewBatchedLoader(jbs.loadByPipelineSpecIDs),
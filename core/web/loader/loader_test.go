@@ -270,6 +270,40 @@ func TestLoader_JobProposals(t *testing.T) {
 	assert.Equal(t, []feeds.JobProposal{}, found[2].Data)
 }
 
+func TestLoader_JobProposalPolicyDecisions(t *testing.T) {
+	t.Parallel()
+
+	fsvc := feedsMocks.NewService(t)
+	app := coremocks.NewApplication(t)
+	ctx := InjectDataloader(testutils.Context(t), app)
+
+	d1 := feeds.JobProposalPolicyDecision{
+		ID:            int64(1),
+		JobProposalID: int64(2),
+		Decision:      feeds.PolicyDecisionManualApproval,
+	}
+	d2 := feeds.JobProposalPolicyDecision{
+		ID:            int64(2),
+		JobProposalID: int64(1),
+		Decision:      feeds.PolicyDecisionAutoApproved,
+	}
+
+	fsvc.On("ListPolicyDecisionsByJobProposalIDs", mock.Anything, []int64{1, 2, 3}).Return([]feeds.JobProposalPolicyDecision{
+		d2, d1,
+	}, nil)
+	app.On("GetFeedsService").Return(fsvc)
+
+	batcher := jobProposalPolicyDecisionBatcher{app}
+
+	keys := dataloader.NewKeysFromStrings([]string{"1", "2", "3"})
+	found := batcher.loadByJobProposalsIDs(ctx, keys)
+
+	require.Len(t, found, 3)
+	assert.Equal(t, []feeds.JobProposalPolicyDecision{d2}, found[0].Data)
+	assert.Equal(t, []feeds.JobProposalPolicyDecision{d1}, found[1].Data)
+	assert.Equal(t, []feeds.JobProposalPolicyDecision{}, found[2].Data)
+}
+
 func TestLoader_JobRuns(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,147 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-contrib/sessions"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
+)
+
+const (
+	// oidcStateCookie holds the state of the pending OIDC login, which binds the login to the browser which began it.
+	// It is separate from the session cookie, which is SameSite strict, and so not sent when the identity provider
+	// redirects the user back to the node.
+	oidcStateCookie = "clsession_oidc_state"
+	// oidcStateCookieMaxAge is the lifetime of the state cookie, in seconds.
+	oidcStateCookieMaxAge = 5 * 60
+)
+
+// OIDCController manages the logins of users through an OpenID Connect identity provider.
+type OIDCController struct {
+	App      chainlink.Application
+	sessions *clsessions.WebAuthnSessionStore
+}
+
+func NewOIDCController(app chainlink.Application) *OIDCController {
+	return &OIDCController{app, clsessions.NewWebAuthnSessionStore()}
+}
+
+// Login begins a login, and redirects the user to the identity provider.
+func (oc *OIDCController) Login(c *gin.Context) {
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, clsessions.ErrNotSupported)
+		return
+	}
+
+	state, authURL, err := provider.BeginOIDCLogin(c.Request.Context())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	oc.setStateCookie(c, state, oidcStateCookieMaxAge)
+	c.Redirect(http.StatusFound, authURL)
+}
+
+// Callback completes a login, with the authorization code the identity provider redirects the user back with, and
+// redirects them to the operator UI. Users with MFA enabled are redirected to the sign in page to answer the MFA
+// challenge.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, clsessions.ErrNotSupported)
+		return
+	}
+
+	if errParam := c.Query("error"); errParam != "" {
+		jsonAPIError(c, http.StatusUnauthorized, fmt.Errorf("identity provider returned error %q: %s", errParam, c.Query("error_description")))
+		return
+	}
+
+	state, err := c.Cookie(oidcStateCookie)
+	if err != nil || state == "" || state != c.Query("state") {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("OIDC login state does not match, please login again"))
+		return
+	}
+
+	sid, err := provider.FinishOIDCLogin(c.Request.Context(), state, c.Query("code"), oc.sessionRequest(""))
+	var challengeErr *clsessions.MFAChallengeError
+	if errors.As(err, &challengeErr) {
+		c.Redirect(http.StatusFound, "/signin?mfa=oidc")
+		return
+	}
+	oc.setStateCookie(c, "", -1)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	if err := saveSessionID(sessions.Default(c), sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	c.Redirect(http.StatusFound, "/")
+}
+
+// MFA completes a pending login of a user with MFA enabled. Without WebAuthn data, it responds with the challenge to
+// answer, in the same way as the creation of a session with a password.
+func (oc *OIDCController) MFA(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+	provider, ok := oc.App.AuthenticationProvider().(clsessions.OIDCAuthenticationProvider)
+	if !ok {
+		jsonAPIError(c, http.StatusNotFound, clsessions.ErrNotSupported)
+		return
+	}
+
+	var sr clsessions.SessionRequest
+	if err := c.ShouldBindJSON(&sr); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("error binding json %v", err))
+		return
+	}
+
+	state, err := c.Cookie(oidcStateCookie)
+	if err != nil || state == "" {
+		jsonAPIError(c, http.StatusUnauthorized, clsessions.ErrUserSessionExpired)
+		return
+	}
+
+	sid, err := provider.FinishOIDCLogin(c.Request.Context(), state, "", oc.sessionRequest(sr.WebAuthnData))
+	if err != nil {
+		var challengeErr *clsessions.MFAChallengeError
+		if !errors.As(err, &challengeErr) {
+			oc.setStateCookie(c, "", -1)
+		}
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	oc.setStateCookie(c, "", -1)
+	if err := saveSessionID(sessions.Default(c), sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, multierr.Append(errors.New("unable to save session id"), err))
+		return
+	}
+	jsonAPIResponse(c, Session{Authenticated: true}, "session")
+}
+
+// setStateCookie sets the state cookie, which is SameSite lax so that it is sent on the redirect back from the
+// identity provider, or deletes it with a negative max age.
+func (oc *OIDCController) setStateCookie(c *gin.Context, state string, maxAge int) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcStateCookie, state, maxAge, "/oidc", "", oc.App.GetConfig().WebServer().SecureCookies(), true)
+}
+
+func (oc *OIDCController) sessionRequest(webAuthnData string) clsessions.SessionRequest {
+	return clsessions.SessionRequest{
+		WebAuthnData:   webAuthnData,
+		WebAuthnConfig: oc.App.GetWebAuthnConfiguration(),
+		SessionStore:   oc.sessions,
+	}
+}
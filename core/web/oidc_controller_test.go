@@ -0,0 +1,31 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	clhttptest "github.com/smartcontractkit/chainlink/v2/core/internal/testutils/httptest"
+)
+
+func TestOIDCController_NotSupported(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	client := clhttptest.NewTestLocalOnlyHTTPClient()
+	for _, path := range []string{"/oidc/login", "/oidc/callback?state=state&code=code"} {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, app.Server.URL+path, nil)
+		require.NoError(t, err)
+		resp, err := client.Do(request)
+		require.NoError(t, err)
+		assert.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode, path)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 )
 
 // PipelineJobSpecErrorsController manages PipelineJobSpecError requests
@@ -26,6 +27,15 @@ func (psec *PipelineJobSpecErrorsController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusUnprocessableEntity, err)
 		return
 	}
+	if _, all := allowedJobTypes(c, rbac.ActionUpdate); !all {
+		specErr, err2 := psec.App.JobORM().FindSpecError(c.Request.Context(), jobSpec.ID)
+		if err2 != nil && !errors.Is(errors.Cause(err2), sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		} else if err2 == nil && !allowsJobID(c, psec.App, rbac.ActionUpdate, specErr.JobID) {
+			return
+		}
+	}
 
 	err = psec.App.JobORM().DismissError(c.Request.Context(), jobSpec.ID)
 	if errors.Is(err, sql.ErrNoRows) {
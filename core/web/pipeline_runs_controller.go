@@ -1,6 +1,7 @@
 package web
 
 import (
+	"database/sql"
 	"encoding/json"
 	"io"
 	"net/http"
@@ -16,6 +17,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
 )
@@ -42,6 +44,11 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 
 	ctx := c.Request.Context()
 	if id == "" {
+		// The runs of all jobs can only be listed by users who may read jobs of any type.
+		if _, all := allowedJobTypes(c, rbac.ActionRead); !all {
+			jsonAPIError(c, http.StatusForbidden, errors.New("role only allows reading the runs of jobs of some types, list the runs of a job instead"))
+			return
+		}
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(ctx, nil, offset, size)
 	} else {
 		jobSpec := job.Job{}
@@ -50,6 +57,9 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 			jsonAPIError(c, http.StatusUnprocessableEntity, err)
 			return
 		}
+		if !allowsJobID(c, prc.App, rbac.ActionRead, jobSpec.ID) {
+			return
+		}
 
 		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(ctx, &jobSpec.ID, offset, size)
 	}
@@ -80,6 +90,9 @@ func (prc *PipelineRunsController) Show(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if !allowsJobType(c, rbac.ActionRead, job.Type(pipelineRun.PipelineSpec.JobType)) {
+		return
+	}
 
 	res := presenters.NewPipelineRunResource(pipelineRun, prc.App.GetLogger())
 	jsonAPIResponse(c, res, "pipelineRun")
@@ -114,6 +127,17 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 	// Is it a UUID? Then process it as a webhook job
 	jobUUID, err := uuid.Parse(idStr)
 	if err == nil {
+		if isUser {
+			if _, all := allowedJobTypes(c, rbac.ActionRun); !all {
+				jb, err2 := prc.App.JobORM().FindJobByExternalJobID(ctx, jobUUID)
+				if err2 != nil && !errors.Is(errors.Cause(err2), sql.ErrNoRows) {
+					jsonAPIError(c, http.StatusInternalServerError, err2)
+					return
+				} else if err2 == nil && !allowsJobType(c, rbac.ActionRun, jb.Type) {
+					return
+				}
+			}
+		}
 		canRun, err2 := authorizer.CanRun(ctx, prc.App.GetConfig().JobPipeline(), jobUUID)
 		if err2 != nil {
 			jsonAPIError(c, http.StatusInternalServerError, err2)
@@ -142,6 +166,9 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 		jobID64, err := strconv.ParseInt(idStr, 10, 32)
 		if err == nil {
 			jobID = int32(jobID64)
+			if !allowsJobID(c, prc.App, rbac.ActionRun, jobID) {
+				return
+			}
 			jobRunID, err := prc.App.RunJobV2(ctx, jobID, nil)
 			if err != nil {
 				jsonAPIError(c, http.StatusInternalServerError, err)
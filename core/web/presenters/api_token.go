@@ -0,0 +1,67 @@
+package presenters
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/auth"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/apitokens"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// APITokenResource represents a named API token JSONAPI resource. The credentials of the token are only present in
+// the response to its creation.
+type APITokenResource struct {
+	JAID
+	Name        string            `json:"name"`
+	UserEmail   string            `json:"userEmail"`
+	Permissions []rbac.Permission `json:"permissions"`
+	Status      apitokens.Status  `json:"status"`
+	ExpiresAt   *time.Time        `json:"expiresAt"`
+	LastUsed    *time.Time        `json:"lastUsed"`
+	RevokedAt   *time.Time        `json:"revokedAt"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	AccessKey   string            `json:"accessKey,omitempty"`
+	Secret      string            `json:"secret,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r APITokenResource) GetName() string {
+	return "api_tokens"
+}
+
+// NewAPITokenResource constructs a new APITokenResource.
+func NewAPITokenResource(token apitokens.Token) *APITokenResource {
+	permissions := []rbac.Permission(token.Permissions)
+	if permissions == nil {
+		permissions = []rbac.Permission{}
+	}
+	return &APITokenResource{
+		JAID:        NewJAID(strconv.FormatInt(token.ID, 10)),
+		Name:        token.Name,
+		UserEmail:   token.UserEmail,
+		Permissions: permissions,
+		Status:      token.Status(time.Now()),
+		ExpiresAt:   token.ExpiresAt.Ptr(),
+		LastUsed:    token.LastUsed.Ptr(),
+		RevokedAt:   token.RevokedAt.Ptr(),
+		CreatedAt:   token.CreatedAt,
+	}
+}
+
+// NewCreatedAPITokenResource constructs a new APITokenResource, with the credentials of the token.
+func NewCreatedAPITokenResource(token apitokens.Token, credentials *auth.Token) *APITokenResource {
+	r := NewAPITokenResource(token)
+	r.AccessKey = credentials.AccessKey
+	r.Secret = credentials.Secret
+	return r
+}
+
+// NewAPITokenResources constructs APITokenResources.
+func NewAPITokenResources(tokens []apitokens.Token) []APITokenResource {
+	rs := []APITokenResource{}
+	for _, token := range tokens {
+		rs = append(rs, *NewAPITokenResource(token))
+	}
+	return rs
+}
@@ -0,0 +1,80 @@
+package presenters
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// AuditLogRecordResource represents a record of the local audit log.
+type AuditLogRecordResource struct {
+	JAID
+	EventID   string          `json:"eventID"`
+	UserEmail string          `json:"userEmail"`
+	Data      json.RawMessage `json:"data"`
+	CreatedAt time.Time       `json:"createdAt"`
+	PrevHash  string          `json:"prevHash"`
+	Hash      string          `json:"hash"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditLogRecordResource) GetName() string {
+	return "auditLogRecords"
+}
+
+// NewAuditLogRecordResource constructs a new AuditLogRecordResource.
+func NewAuditLogRecordResource(record audit.Record) AuditLogRecordResource {
+	return AuditLogRecordResource{
+		JAID:      NewJAID(strconv.FormatInt(record.ID, 10)),
+		EventID:   string(record.EventID),
+		UserEmail: record.UserEmail,
+		Data:      json.RawMessage(record.Data),
+		CreatedAt: record.CreatedAt,
+		PrevHash:  record.PrevHash,
+		Hash:      record.Hash,
+	}
+}
+
+// NewAuditLogRecordResources constructs a slice of AuditLogRecordResources.
+func NewAuditLogRecordResources(records []audit.Record) []AuditLogRecordResource {
+	rs := []AuditLogRecordResource{}
+	for _, record := range records {
+		rs = append(rs, NewAuditLogRecordResource(record))
+	}
+	return rs
+}
+
+// AuditLogVerificationResource represents the result of verifying the hash chain of the local audit log.
+type AuditLogVerificationResource struct {
+	JAID
+	Valid    bool   `json:"valid"`
+	Records  int64  `json:"records"`
+	HeadID   int64  `json:"headID"`
+	HeadHash string `json:"headHash"`
+	// TamperedID and Reason identify the first record which breaks the chain, if any.
+	TamperedID int64  `json:"tamperedID,omitempty"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r AuditLogVerificationResource) GetName() string {
+	return "auditLogVerifications"
+}
+
+// NewAuditLogVerificationResource constructs a new AuditLogVerificationResource, identified by the verified head.
+func NewAuditLogVerificationResource(result audit.VerifyResult) AuditLogVerificationResource {
+	r := AuditLogVerificationResource{
+		JAID:     NewJAID(strconv.FormatInt(result.HeadID, 10)),
+		Valid:    result.Valid(),
+		Records:  result.Records,
+		HeadID:   result.HeadID,
+		HeadHash: result.HeadHash,
+	}
+	if result.Tampered != nil {
+		r.TamperedID = result.Tampered.ID
+		r.Reason = result.Tampered.Reason
+	}
+	return r
+}
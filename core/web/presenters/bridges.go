@@ -17,7 +17,9 @@ type BridgeResource struct {
 	IncomingToken          string       `json:"incomingToken,omitempty"`
 	OutgoingToken          string       `json:"outgoingToken"`
 	MinimumContractPayment *assets.Link `json:"minimumContractPayment"`
-	CreatedAt              time.Time    `json:"createdAt"`
+	// AuthType is the scheme used to authenticate requests to the bridge. Credentials are never returned.
+	AuthType  bridges.AuthType `json:"authType,omitempty"`
+	CreatedAt time.Time        `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -35,6 +37,14 @@ func NewBridgeResource(b bridges.BridgeType) *BridgeResource {
 		Confirmations:          b.Confirmations,
 		OutgoingToken:          b.OutgoingToken,
 		MinimumContractPayment: b.MinimumContractPayment,
+		AuthType:               authType(b.AuthConfig),
 		CreatedAt:              b.CreatedAt,
 	}
 }
+
+func authType(cfg *bridges.AuthConfig) bridges.AuthType {
+	if cfg.IsNone() {
+		return ""
+	}
+	return cfg.Type
+}
@@ -104,3 +104,87 @@ func NewPipelineRunResources(prs []pipeline.Run, lggr logger.Logger) []PipelineR
 
 	return out
 }
+
+// PipelineDryRunResource represents the results of a pipeline run executed
+// without side effects. It is never persisted, so it carries no ID.
+type PipelineDryRunResource struct {
+	JAID
+	Outputs     []*string                         `json:"outputs"`
+	AllErrors   []*string                         `json:"allErrors"`
+	FatalErrors []*string                         `json:"fatalErrors"`
+	Inputs      jsonserializable.JSONSerializable `json:"inputs"`
+	TaskRuns    []PipelineDryRunTaskResource      `json:"taskRuns"`
+	CreatedAt   time.Time                         `json:"createdAt"`
+	FinishedAt  null.Time                         `json:"finishedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r PipelineDryRunResource) GetName() string {
+	return "pipelineDryRun"
+}
+
+// PipelineDryRunTaskResource is a task run result with timing and, for
+// stubbed side-effecting tasks, the inputs they would have acted on.
+type PipelineDryRunTaskResource struct {
+	PipelineTaskRunResource
+	Attempts       uint                                `json:"attempts"`
+	ElapsedMs      int64                               `json:"elapsedMs"`
+	Stubbed        bool                                `json:"stubbed"`
+	RecordedInputs []jsonserializable.JSONSerializable `json:"recordedInputs,omitempty"`
+}
+
+func NewPipelineDryRunResource(pr pipeline.Run, trrs pipeline.TaskRunResults, lggr logger.Logger) PipelineDryRunResource {
+	lggr = lggr.Named("PipelineDryRunResource")
+	var trs []PipelineDryRunTaskResource
+	for _, trr := range trrs {
+		trs = append(trs, NewPipelineDryRunTaskResource(trr))
+	}
+
+	outputs, err := pr.StringOutputs()
+	if err != nil {
+		lggr.Errorw(err.Error(), "out", pr.Outputs)
+	}
+
+	return PipelineDryRunResource{
+		JAID:        NewJAID("dry_run"),
+		Outputs:     outputs,
+		AllErrors:   pr.StringAllErrors(),
+		FatalErrors: pr.StringFatalErrors(),
+		Inputs:      pr.Inputs,
+		TaskRuns:    trs,
+		CreatedAt:   pr.CreatedAt,
+		FinishedAt:  pr.FinishedAt,
+	}
+}
+
+func NewPipelineDryRunTaskResource(trr pipeline.TaskRunResult) PipelineDryRunTaskResource {
+	r := PipelineDryRunTaskResource{
+		PipelineTaskRunResource: NewPipelineTaskRunResource(pipeline.TaskRun{
+			Type:       trr.Task.Type(),
+			Output:     trr.Result.OutputDB(),
+			Error:      trr.Result.ErrorDB(),
+			DotID:      trr.Task.DotID(),
+			CreatedAt:  trr.CreatedAt,
+			FinishedAt: trr.FinishedAt,
+		}),
+		Attempts: trr.Attempts,
+	}
+	if trr.FinishedAt.Valid {
+		r.ElapsedMs = trr.FinishedAt.Time.Sub(trr.CreatedAt).Milliseconds()
+	}
+
+	var recorded [][]pipeline.Result
+	r.Stubbed, recorded = pipeline.IsDryRunStub(trr.Task)
+	for _, inputs := range recorded {
+		vals := make([]interface{}, len(inputs))
+		for i, input := range inputs {
+			if input.Error != nil {
+				vals[i] = input.Error.Error()
+			} else {
+				vals[i] = input.Value
+			}
+		}
+		r.RecordedInputs = append(r.RecordedInputs, jsonserializable.JSONSerializable{Val: vals, Valid: true})
+	}
+	return r
+}
@@ -0,0 +1,56 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+// RoleResource represents a custom role JSONAPI resource.
+type RoleResource struct {
+	JAID
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []rbac.Permission `json:"permissions"`
+	Users       []string          `json:"users"`
+	CreatedAt   time.Time         `json:"createdAt"`
+	UpdatedAt   time.Time         `json:"updatedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r RoleResource) GetName() string {
+	return "roles"
+}
+
+// NewRoleResource constructs a new RoleResource, with the emails of the users the role is assigned to.
+func NewRoleResource(role rbac.Role, users []string) *RoleResource {
+	permissions := []rbac.Permission(role.Permissions)
+	if permissions == nil {
+		permissions = []rbac.Permission{}
+	}
+	if users == nil {
+		users = []string{}
+	}
+	return &RoleResource{
+		JAID:        NewJAID(role.Name),
+		Name:        role.Name,
+		Description: role.Description,
+		Permissions: permissions,
+		Users:       users,
+		CreatedAt:   role.CreatedAt,
+		UpdatedAt:   role.UpdatedAt,
+	}
+}
+
+// NewRoleResources constructs RoleResources, with the users of each role from assignments.
+func NewRoleResources(roles []rbac.Role, assignments []rbac.Assignment) []RoleResource {
+	users := map[string][]string{}
+	for _, a := range assignments {
+		users[a.RoleName] = append(users[a.RoleName], a.Email)
+	}
+	rs := []RoleResource{}
+	for _, role := range roles {
+		rs = append(rs, *NewRoleResource(role, users[role.Name]))
+	}
+	return rs
+}
@@ -0,0 +1,109 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+// WorkflowExecutionResource represents a workflow execution. Its steps are only set when showing a single execution,
+// and are ordered by the time they started.
+type WorkflowExecutionResource struct {
+	JAID
+	WorkflowID string                          `json:"workflowID"`
+	Status     string                          `json:"status"`
+	CreatedAt  *time.Time                      `json:"createdAt"`
+	UpdatedAt  *time.Time                      `json:"updatedAt"`
+	FinishedAt *time.Time                      `json:"finishedAt"`
+	Steps      []WorkflowExecutionStepResource `json:"steps,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r WorkflowExecutionResource) GetName() string {
+	return "workflowExecution"
+}
+
+// NewWorkflowExecutionResource constructs a WorkflowExecutionResource with the timeline of its steps.
+func NewWorkflowExecutionResource(we store.WorkflowExecution) WorkflowExecutionResource {
+	r := newWorkflowExecutionSummary(we)
+	for _, step := range we.Timeline() {
+		r.Steps = append(r.Steps, NewWorkflowExecutionStepResource(we, *step))
+	}
+	return r
+}
+
+// NewWorkflowExecutionResources constructs WorkflowExecutionResources without their steps.
+func NewWorkflowExecutionResources(wes []store.WorkflowExecution) []WorkflowExecutionResource {
+	rs := []WorkflowExecutionResource{}
+	for _, we := range wes {
+		rs = append(rs, newWorkflowExecutionSummary(we))
+	}
+	return rs
+}
+
+func newWorkflowExecutionSummary(we store.WorkflowExecution) WorkflowExecutionResource {
+	return WorkflowExecutionResource{
+		JAID:       NewJAID(we.ExecutionID),
+		WorkflowID: we.WorkflowID,
+		Status:     we.Status,
+		CreatedAt:  we.CreatedAt,
+		UpdatedAt:  we.UpdatedAt,
+		FinishedAt: we.FinishedAt,
+	}
+}
+
+// WorkflowExecutionStepResource represents a step of a workflow execution, and its place in the timeline of the
+// execution.
+type WorkflowExecutionStepResource struct {
+	Ref             string     `json:"ref"`
+	Status          string     `json:"status"`
+	CapabilityDONID uint32     `json:"capabilityDONID,omitempty"`
+	Inputs          any        `json:"inputs"`
+	Outputs         any        `json:"outputs"`
+	Error           *string    `json:"error"`
+	StartedAt       *time.Time `json:"startedAt"`
+	FinishedAt      *time.Time `json:"finishedAt"`
+	// StartOffsetMs is the time from the creation of the execution to the start of the step.
+	StartOffsetMs *int64 `json:"startOffsetMs"`
+	// LatencyMs is the time the step took to execute, unset while it is in flight.
+	LatencyMs *int64 `json:"latencyMs"`
+}
+
+// NewWorkflowExecutionStepResource constructs a WorkflowExecutionStepResource for a step of the execution.
+func NewWorkflowExecutionStepResource(we store.WorkflowExecution, step store.WorkflowExecutionStep) WorkflowExecutionStepResource {
+	r := WorkflowExecutionStepResource{
+		Ref:             step.Ref,
+		Status:          step.Status,
+		CapabilityDONID: step.CapabilityDONID,
+		Outputs:         unwrapValue(step.Outputs.Value),
+		StartedAt:       step.StartedAt,
+		FinishedAt:      step.FinishedAt(),
+	}
+	if step.Inputs != nil {
+		r.Inputs = unwrapValue(step.Inputs)
+	}
+	if step.Outputs.Err != nil {
+		errMsg := step.Outputs.Err.Error()
+		r.Error = &errMsg
+	}
+	if we.CreatedAt != nil && step.StartedAt != nil {
+		offset := step.StartedAt.Sub(*we.CreatedAt).Milliseconds()
+		r.StartOffsetMs = &offset
+	}
+	if latency, ok := step.Latency(); ok {
+		ms := latency.Milliseconds()
+		r.LatencyMs = &ms
+	}
+	return r
+}
+
+// unwrapValue returns the native representation of v, or nil if it can't be unwrapped.
+func unwrapValue(v values.Value) any {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		return nil
+	}
+	return unwrapped
+}
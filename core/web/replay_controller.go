@@ -1,12 +1,16 @@
 package web
 
 import (
+	"database/sql"
+	"fmt"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/logpoller"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 )
@@ -15,10 +19,13 @@ type ReplayController struct {
 	App chainlink.Application
 }
 
-// ReplayFromBlock causes the node to process blocks again from the given block number
+// ReplayFromBlock causes the node to process blocks again from the given block number.
+// If a log poller filter is given, only the logs of that filter are replayed, up to toBlock if set. Filter replays
+// cannot be forced.
 // Example:
 //
 //	"<application>/v2/replay_from_block/:number"
+//	"<application>/v2/replay_from_block/:number?filter=:name&toBlock=:number"
 func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	if c.Param("number") == "" {
 		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("missing 'number' parameter"))
@@ -57,18 +64,108 @@ func (bdc *ReplayController) ReplayFromBlock(c *gin.Context) {
 	}
 	chainID := chain.ID()
 
-	if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber), force); err != nil {
+	message := "Replay started"
+	if filter := c.Query("filter"); filter != "" {
+		if force {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("'force' query string param cannot be used with 'filter'"))
+			return
+		}
+		var toBlock int64
+		if tb := c.Query("toBlock"); tb != "" {
+			toBlock, err = strconv.ParseInt(tb, 10, 0)
+			if err != nil {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Wrap(err, "integer value required for 'toBlock' query string param"))
+				return
+			}
+		}
+		if err := chain.LogPoller().ReplayFilter(c.Request.Context(), filter, blockNumber, toBlock); err != nil {
+			if errors.Is(err, logpoller.ErrFilterNotFound) {
+				jsonAPIError(c, http.StatusNotFound, err)
+				return
+			}
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		message = fmt.Sprintf("Replay of filter %s started", filter)
+	} else if err := bdc.App.ReplayFromBlock(chainID, uint64(blockNumber), force); err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
 
 	response := ReplayResponse{
-		Message:    "Replay started",
+		Message:    message,
 		EVMChainID: big.New(chainID),
 	}
 	jsonAPIResponse(c, &response, "response")
 }
 
+// ShowFilterReplay returns the progress of the latest replay of a log poller filter.
+// Example:
+//
+//	"<application>/v2/filter_replays/:name"
+func (bdc *ReplayController) ShowFilterReplay(c *gin.Context) {
+	chain, err := getChain(bdc.App.GetRelayers().LegacyEVMChains(), c.Query("evmChainID"))
+	if err != nil {
+		if errors.Is(err, ErrInvalidChainID) || errors.Is(err, ErrMultipleChains) || errors.Is(err, ErrMissingChainID) {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	name := c.Param("name")
+	replay, err := chain.LogPoller().GetFilterReplay(c.Request.Context(), name)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(c, http.StatusNotFound, errors.Errorf("no replay of filter %s found", name))
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	response := FilterReplayResponse{
+		FilterName: replay.FilterName,
+		FromBlock:  replay.FromBlock,
+		ToBlock:    replay.ToBlock,
+		NextBlock:  replay.NextBlock,
+		State:      string(replay.State),
+		Error:      replay.Error,
+		UpdatedAt:  replay.UpdatedAt,
+		EVMChainID: big.New(chain.ID()),
+	}
+	jsonAPIResponse(c, &response, "filter_replay")
+}
+
+type FilterReplayResponse struct {
+	FilterName string    `json:"filterName"`
+	FromBlock  int64     `json:"fromBlock"`
+	ToBlock    int64     `json:"toBlock"`
+	NextBlock  int64     `json:"nextBlock"`
+	State      string    `json:"state"`
+	Error      string    `json:"error"`
+	UpdatedAt  time.Time `json:"updatedAt"`
+	EVMChainID *big.Big  `json:"evmChainID"`
+}
+
+// GetID returns the jsonapi ID.
+func (r FilterReplayResponse) GetID() string {
+	return r.FilterName
+}
+
+// GetName returns the collection name for jsonapi.
+func (FilterReplayResponse) GetName() string {
+	return "filter_replays"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (r *FilterReplayResponse) SetID(id string) error {
+	r.FilterName = id
+	return nil
+}
+
 type ReplayResponse struct {
 	Message    string   `json:"message"`
 	EVMChainID *big.Big `json:"evmChainID"`
@@ -0,0 +1,79 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+)
+
+// AuditLogRecordResolver resolves the AuditLogRecord type.
+type AuditLogRecordResolver struct {
+	record audit.Record
+}
+
+func NewAuditLogRecord(record audit.Record) *AuditLogRecordResolver {
+	return &AuditLogRecordResolver{record: record}
+}
+
+func NewAuditLogRecords(records []audit.Record) []*AuditLogRecordResolver {
+	var resolvers []*AuditLogRecordResolver
+
+	for _, record := range records {
+		resolvers = append(resolvers, NewAuditLogRecord(record))
+	}
+
+	return resolvers
+}
+
+func (r *AuditLogRecordResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.record.ID, 10))
+}
+
+func (r *AuditLogRecordResolver) EventID() string {
+	return string(r.record.EventID)
+}
+
+func (r *AuditLogRecordResolver) UserEmail() string {
+	return r.record.UserEmail
+}
+
+// Data resolves the JSON encoded data of the event.
+func (r *AuditLogRecordResolver) Data() string {
+	return r.record.Data.String()
+}
+
+func (r *AuditLogRecordResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.record.CreatedAt}
+}
+
+func (r *AuditLogRecordResolver) PrevHash() string {
+	return r.record.PrevHash
+}
+
+func (r *AuditLogRecordResolver) Hash() string {
+	return r.record.Hash
+}
+
+// -- AuditLogRecords query --
+
+// AuditLogRecordsPayloadResolver resolves a page of audit log records
+type AuditLogRecordsPayloadResolver struct {
+	records []audit.Record
+	total   int32
+}
+
+func NewAuditLogRecordsPayload(records []audit.Record, total int32) *AuditLogRecordsPayloadResolver {
+	return &AuditLogRecordsPayloadResolver{records: records, total: total}
+}
+
+// Results returns the audit log records.
+func (r *AuditLogRecordsPayloadResolver) Results() []*AuditLogRecordResolver {
+	return NewAuditLogRecords(r.records)
+}
+
+// Metadata returns the pagination metadata.
+func (r *AuditLogRecordsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
@@ -0,0 +1,98 @@
+package resolver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	auditmocks "github.com/smartcontractkit/chainlink/v2/core/logger/audit/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+)
+
+func TestQuery_PaginatedAuditLogRecords(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetAuditLogRecords {
+			auditLogRecords(user: "apiuser@chainlink.test", eventID: "AUTH_LOGIN_SUCCESS_NO_2FA", createdAfter: "2024-01-01T00:00:00Z") {
+				results {
+					id
+					eventID
+					userEmail
+					data
+					createdAt
+					prevHash
+					hash
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := audit.RecordsFilter{UserEmail: "apiuser@chainlink.test", EventID: audit.AuthLoginSuccessNo2FA, CreatedAfter: &createdAfter}
+	viewer := rbac.BuiltinRole(sessions.UserRoleView)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "auditLogRecords"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				auditORM := auditmocks.NewORM(f.t)
+				auditORM.On("ListRecords", mock.Anything, filter, PageDefaultOffset, PageDefaultLimit).Return([]audit.Record{
+					{
+						ID:        2,
+						EventID:   audit.AuthLoginSuccessNo2FA,
+						UserEmail: "apiuser@chainlink.test",
+						Data:      []byte(`{"email":"apiuser@chainlink.test"}`),
+						CreatedAt: createdAfter.Add(time.Hour),
+						PrevHash:  "aa",
+						Hash:      "bb",
+					},
+				}, 1, nil)
+				f.App.On("AuditLogORM").Return(auditORM)
+			},
+			query: query,
+			result: `
+				{
+					"auditLogRecords": {
+						"results": [{
+							"id": "2",
+							"eventID": "AUTH_LOGIN_SUCCESS_NO_2FA",
+							"userEmail": "apiuser@chainlink.test",
+							"data": "{\"email\":\"apiuser@chainlink.test\"}",
+							"createdAt": "2024-01-01T01:00:00Z",
+							"prevHash": "aa",
+							"hash": "bb"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not permitted",
+			authenticated: true,
+			role:          &viewer,
+			query:         query,
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: RoleNotPermittedErr{"view"},
+					Path:          []interface{}{"auditLogRecords"},
+					Message:       "Not permitted with current role: view",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
@@ -2,9 +2,14 @@ package resolver
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 )
 
@@ -16,44 +21,71 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'run' role.
-func authenticateUserCanRun(ctx context.Context) error {
+// Authenticates the user from the session cookie and asserts their role allows action on resource.
+func authorize(ctx context.Context, resource rbac.Resource, action rbac.Action) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role == sessions.UserRoleView {
-		return RoleNotPermittedErr{session.User.Role}
+	if !session.Role.Permissions.Allows(resource, action) {
+		return RoleNotPermittedErr{sessions.UserRole(session.Role.Name)}
 	}
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts at least 'edit' role.
-func authenticateUserCanEdit(ctx context.Context) error {
+// Asserts the role of the authenticated user allows action on jobs of jobType.
+func authorizeJobType(ctx context.Context, action rbac.Action, jobType job.Type) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	switch session.User.Role {
-	case sessions.UserRoleView, sessions.UserRoleRun:
-		return RoleNotPermittedErr{session.User.Role}
-	default:
+	if !session.Role.Permissions.AllowsJobType(action, jobType.String()) {
+		return RoleNotPermittedErr{sessions.UserRole(session.Role.Name)}
 	}
 	return nil
 }
 
-// Authenticates the user from the session cookie and asserts has 'admin' role
-func authenticateUserIsAdmin(ctx context.Context) error {
+// Asserts the role of the authenticated user allows action on jobs of any type.
+func authorizeAllJobTypes(ctx context.Context, action rbac.Action) error {
 	session, ok := auth.GetGQLAuthenticatedSession(ctx)
 	if !ok {
 		return unauthorizedError{}
 	}
-	if session.User.Role != sessions.UserRoleAdmin {
-		return RoleNotPermittedErr{session.User.Role}
+	if _, all := session.Role.Permissions.JobTypes(action); !all {
+		return RoleNotPermittedErr{sessions.UserRole(session.Role.Name)}
 	}
 	return nil
 }
 
+// Returns the job types the role of the authenticated user allows action on, or all as true when it allows action on
+// jobs of any type.
+func allowedJobTypes(ctx context.Context, action rbac.Action) (jobTypes []job.Type, all bool) {
+	session, ok := auth.GetGQLAuthenticatedSession(ctx)
+	if !ok {
+		return nil, false
+	}
+	names, all := session.Role.Permissions.JobTypes(action)
+	for _, name := range names {
+		jobTypes = append(jobTypes, job.Type(name))
+	}
+	return jobTypes, all
+}
+
+// Asserts the role of the authenticated user allows action on the job with id. The job is only loaded when the role
+// allows action on jobs of some types, and jobs which are not found are allowed, for the caller to handle.
+func (r *Resolver) authorizeJobID(ctx context.Context, action rbac.Action, id int32) error {
+	if _, all := allowedJobTypes(ctx, action); all {
+		return nil
+	}
+	j, err := r.App.JobORM().FindJobWithoutSpecErrors(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	return authorizeJobType(ctx, action, j.Type)
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
@@ -2,12 +2,14 @@ package resolver
 
 import (
 	"context"
+	"errors"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/graph-gophers/graphql-go"
 
 	commonTypes "github.com/smartcontractkit/chainlink-common/pkg/types"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/assets"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/relay"
@@ -168,3 +170,66 @@ func (r *EthTransactionsPayloadResolver) Results() []*EthTransactionResolver {
 func (r *EthTransactionsPayloadResolver) Metadata() *PaginationMetadataResolver {
 	return NewPaginationMetadata(r.total)
 }
+
+// -- CancelEthTransaction Mutation --
+
+type CancelEthTransactionPayloadResolver struct {
+	tx *txmgr.Tx
+	// notCancellableErr is set when the transaction can't be cancelled in its current state
+	notCancellableErr error
+	NotFoundErrorUnionType
+}
+
+func NewCancelEthTransactionPayload(tx *txmgr.Tx, err error) *CancelEthTransactionPayloadResolver {
+	var notCancellableErr error
+	if errors.Is(err, txmgrcommon.ErrTxNotCancellable) {
+		notCancellableErr, err = err, nil
+	}
+	e := NotFoundErrorUnionType{err: err, message: "transaction not found"}
+
+	return &CancelEthTransactionPayloadResolver{tx: tx, notCancellableErr: notCancellableErr, NotFoundErrorUnionType: e}
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionSuccess() (*CancelEthTransactionSuccessResolver, bool) {
+	if r.tx != nil {
+		return NewCancelEthTransactionSuccess(r.tx), true
+	}
+
+	return nil, false
+}
+
+func (r *CancelEthTransactionPayloadResolver) ToCancelEthTransactionNotCancellableError() (*CancelEthTransactionNotCancellableErrorResolver, bool) {
+	if r.notCancellableErr != nil {
+		return NewCancelEthTransactionNotCancellableError(r.notCancellableErr.Error()), true
+	}
+
+	return nil, false
+}
+
+type CancelEthTransactionSuccessResolver struct {
+	tx *txmgr.Tx
+}
+
+func NewCancelEthTransactionSuccess(tx *txmgr.Tx) *CancelEthTransactionSuccessResolver {
+	return &CancelEthTransactionSuccessResolver{tx: tx}
+}
+
+func (r *CancelEthTransactionSuccessResolver) Transaction() *EthTransactionResolver {
+	return NewEthTransaction(*r.tx)
+}
+
+type CancelEthTransactionNotCancellableErrorResolver struct {
+	message string
+}
+
+func NewCancelEthTransactionNotCancellableError(message string) *CancelEthTransactionNotCancellableErrorResolver {
+	return &CancelEthTransactionNotCancellableErrorResolver{message: message}
+}
+
+func (r *CancelEthTransactionNotCancellableErrorResolver) Message() string {
+	return r.message
+}
+
+func (r *CancelEthTransactionNotCancellableErrorResolver) Code() ErrorCode {
+	return ErrorCodeUnprocessable
+}
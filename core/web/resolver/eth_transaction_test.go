@@ -19,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/config/toml"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/gas"
 	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
+	txmmocks "github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr/mocks"
 	evmtypes "github.com/smartcontractkit/chainlink/v2/core/chains/evm/types"
 	ubig "github.com/smartcontractkit/chainlink/v2/core/chains/evm/utils/big"
 	chainlinkmocks "github.com/smartcontractkit/chainlink/v2/core/services/chainlink/mocks"
@@ -454,3 +455,160 @@ func TestResolver_EthTransactionsAttempts(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_CancelEthTransaction(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation CancelEthTransaction($hash: ID!) {
+			cancelEthTransaction(hash: $hash) {
+				... on CancelEthTransactionSuccess {
+					transaction {
+						from
+						state
+					}
+				}
+				... on CancelEthTransactionNotCancellableError {
+					code
+					message
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+	variables := map[string]interface{}{
+		"hash": "0x5431F5F973781809D18643b87B44921b11355d81",
+	}
+	hash := common.HexToHash("0x5431F5F973781809D18643b87B44921b11355d81")
+	gError := errors.New("error")
+	etx := &txmgr.Tx{
+		ID:          1,
+		ToAddress:   common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81"),
+		FromAddress: common.HexToAddress("0x5431F5F973781809D18643b87B44921b11355d81"),
+		State:       txmgrcommon.TxUnconfirmed,
+		ChainID:     big.NewInt(22),
+	}
+	setupChain := func(f *gqlTestFramework, cancelErr error) {
+		txm := txmmocks.NewMockEvmTxManager(t)
+		txm.On("CancelTx", mock.Anything, int64(1)).Return(cancelErr)
+		f.Mocks.chain.On("TxManager").Return(txm)
+		f.Mocks.legacyEVMChains.On("Get", "22").Return(f.Mocks.chain, nil)
+		f.Mocks.relayerChainInterops.EVMChains = f.Mocks.legacyEVMChains
+		f.App.On("GetRelayers").Return(f.Mocks.relayerChainInterops)
+		f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+	}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "cancelEthTransaction"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				setupChain(f, nil)
+				f.Mocks.txmStore.On("FindTxByHash", mock.Anything, hash).Return(etx, nil).Twice()
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"transaction": {
+							"from": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"state": "unconfirmed"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "success by ID",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				setupChain(f, nil)
+				unstarted := *etx
+				unstarted.State = txmgrcommon.TxUnstarted
+				cancelled := *etx
+				cancelled.State = txmgrcommon.TxFatalError
+				f.Mocks.txmStore.On("FindTxWithAttempts", mock.Anything, int64(1)).Return(unstarted, nil).Once()
+				f.Mocks.txmStore.On("FindTxWithAttempts", mock.Anything, int64(1)).Return(cancelled, nil).Once()
+			},
+			query: `
+				mutation CancelEthTransaction($id: ID!) {
+					cancelEthTransaction(id: $id) {
+						... on CancelEthTransactionSuccess {
+							transaction {
+								from
+								state
+							}
+						}
+					}
+				}`,
+			variables: map[string]interface{}{"id": "1"},
+			result: `
+				{
+					"cancelEthTransaction": {
+						"transaction": {
+							"from": "0x5431F5F973781809D18643b87B44921b11355d81",
+							"state": "fatal_error"
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.txmStore.On("FindTxByHash", mock.Anything, hash).Return(nil, sql.ErrNoRows)
+				f.App.On("TxmStorageService").Return(f.Mocks.txmStore)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"code": "NOT_FOUND",
+						"message": "transaction not found"
+					}
+				}`,
+		},
+		{
+			name:          "not cancellable error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				setupChain(f, txmgrcommon.ErrTxNotCancellable)
+				f.Mocks.txmStore.On("FindTxByHash", mock.Anything, hash).Return(etx, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"cancelEthTransaction": {
+						"code": "UNPROCESSABLE",
+						"message": "transaction cannot be cancelled"
+					}
+				}`,
+		},
+		{
+			name:          "generic error on CancelTx",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				setupChain(f, gError)
+				f.Mocks.txmStore.On("FindTxByHash", mock.Anything, hash).Return(etx, nil)
+			},
+			query:     mutation,
+			variables: variables,
+			result:    `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					Extensions:    nil,
+					ResolverError: gError,
+					Path:          []interface{}{"cancelEthTransaction"},
+					Message:       gError.Error(),
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
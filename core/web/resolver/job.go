@@ -2,11 +2,13 @@ package resolver
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/graph-gophers/graphql-go"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
@@ -224,6 +226,158 @@ func (r *CreateJobSuccessResolver) Job() *JobResolver {
 	return NewJob(r.app, *r.j)
 }
 
+// -- DryRunJob Mutation --
+
+type DryRunJobPayloadResolver struct {
+	run       *pipeline.Run
+	trrs      pipeline.TaskRunResults
+	inputErrs map[string]string
+}
+
+func NewDryRunJobPayload(run *pipeline.Run, trrs pipeline.TaskRunResults, inputErrs map[string]string) *DryRunJobPayloadResolver {
+	return &DryRunJobPayloadResolver{run: run, trrs: trrs, inputErrs: inputErrs}
+}
+
+func (r *DryRunJobPayloadResolver) ToDryRunJobSuccess() (*DryRunJobSuccessResolver, bool) {
+	if r.inputErrs != nil {
+		return nil, false
+	}
+
+	return &DryRunJobSuccessResolver{run: r.run, trrs: r.trrs}, true
+}
+
+func (r *DryRunJobPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs == nil {
+		return nil, false
+	}
+
+	var errs []*InputErrorResolver
+
+	for path, message := range r.inputErrs {
+		errs = append(errs, NewInputError(path, message))
+	}
+
+	return NewInputErrors(errs), true
+}
+
+// DryRunJobSuccessResolver resolves the results of a pipeline run executed
+// without side effects.
+type DryRunJobSuccessResolver struct {
+	run  *pipeline.Run
+	trrs pipeline.TaskRunResults
+}
+
+func (r *DryRunJobSuccessResolver) Outputs() []*string {
+	outputs, err := r.run.StringOutputs()
+	if err != nil {
+		errMsg := err.Error()
+		return []*string{&errMsg}
+	}
+
+	return outputs
+}
+
+func (r *DryRunJobSuccessResolver) AllErrors() []string {
+	var errs []string
+
+	for _, err := range r.run.StringAllErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+func (r *DryRunJobSuccessResolver) FatalErrors() []string {
+	var errs []string
+
+	for _, err := range r.run.StringFatalErrors() {
+		if err != nil {
+			errs = append(errs, *err)
+		}
+	}
+
+	return errs
+}
+
+func (r *DryRunJobSuccessResolver) TaskRuns() []*DryRunTaskRunResolver {
+	var resolvers []*DryRunTaskRunResolver
+	for _, trr := range r.trrs {
+		resolvers = append(resolvers, &DryRunTaskRunResolver{trr: trr})
+	}
+
+	return resolvers
+}
+
+// DryRunTaskRunResolver resolves the result of a task of a dry run.
+type DryRunTaskRunResolver struct {
+	trr pipeline.TaskRunResult
+}
+
+func (r *DryRunTaskRunResolver) DotID() string {
+	return r.trr.Task.DotID()
+}
+
+func (r *DryRunTaskRunResolver) Type() string {
+	return string(r.trr.Task.Type())
+}
+
+func (r *DryRunTaskRunResolver) Output() string {
+	val, err := r.trr.Result.OutputDB().MarshalJSON()
+	if err != nil {
+		return "error: unable to retrieve output"
+	}
+	return string(val)
+}
+
+func (r *DryRunTaskRunResolver) Error() *string {
+	if errDB := r.trr.Result.ErrorDB(); errDB.Valid {
+		return errDB.Ptr()
+	}
+
+	return nil
+}
+
+func (r *DryRunTaskRunResolver) Attempts() int32 {
+	return int32(r.trr.Attempts) //nolint:gosec // attempts are bounded by the retries of the task
+}
+
+func (r *DryRunTaskRunResolver) ElapsedMs() int32 {
+	if !r.trr.FinishedAt.Valid {
+		return 0
+	}
+	return int32(r.trr.FinishedAt.Time.Sub(r.trr.CreatedAt).Milliseconds()) //nolint:gosec // tasks are bounded by their timeout
+}
+
+func (r *DryRunTaskRunResolver) Stubbed() bool {
+	stubbed, _ := pipeline.IsDryRunStub(r.trr.Task)
+	return stubbed
+}
+
+func (r *DryRunTaskRunResolver) RecordedInputs() []string {
+	_, recorded := pipeline.IsDryRunStub(r.trr.Task)
+	inputs := []string{}
+	for _, results := range recorded {
+		vals := make([]interface{}, len(results))
+		for i, result := range results {
+			if result.Error != nil {
+				vals[i] = result.Error.Error()
+			} else {
+				vals[i] = result.Value
+			}
+		}
+		b, err := json.Marshal(vals)
+		if err != nil {
+			inputs = append(inputs, "error: unable to retrieve inputs")
+			continue
+		}
+		inputs = append(inputs, string(b))
+	}
+
+	return inputs
+}
+
 // -- DeleteJob Mutation --
 
 type DeleteJobPayloadResolver struct {
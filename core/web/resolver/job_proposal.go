@@ -152,6 +152,92 @@ func (r *JobProposalResolver) RemoteUUID(ctx context.Context) string {
 	return r.jp.RemoteUUID.String()
 }
 
+// PolicyDecisions returns the approval policy decisions on the specs of the
+// proposal, most recent first.
+func (r *JobProposalResolver) PolicyDecisions(ctx context.Context) ([]*JobProposalPolicyDecisionResolver, error) {
+	decisions, err := loader.GetPolicyDecisionsByJobProposalID(ctx, strconv.FormatInt(r.jp.ID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewJobProposalPolicyDecisions(decisions), nil
+}
+
+// PolicyDecision defines the enum values for GQL
+type PolicyDecision string
+
+const (
+	// revive:disable
+	PolicyDecisionAutoApproved       PolicyDecision = "AUTO_APPROVED"
+	PolicyDecisionManualApproval     PolicyDecision = "MANUAL_APPROVAL"
+	PolicyDecisionAutoApprovalFailed PolicyDecision = "AUTO_APPROVAL_FAILED"
+	// revive:enable
+)
+
+// ToPolicyDecision converts the feeds policy decision into the enum value.
+func ToPolicyDecision(d feeds.PolicyDecisionType) (PolicyDecision, error) {
+	switch d {
+	case feeds.PolicyDecisionAutoApproved:
+		return PolicyDecisionAutoApproved, nil
+	case feeds.PolicyDecisionManualApproval:
+		return PolicyDecisionManualApproval, nil
+	case feeds.PolicyDecisionAutoApprovalFailed:
+		return PolicyDecisionAutoApprovalFailed, nil
+	default:
+		return "", errors.New("invalid policy decision")
+	}
+}
+
+// JobProposalPolicyDecisionResolver resolves the Job Proposal Policy Decision
+// type.
+type JobProposalPolicyDecisionResolver struct {
+	d feeds.JobProposalPolicyDecision
+}
+
+// NewJobProposalPolicyDecisions creates a slice of
+// JobProposalPolicyDecisionResolvers.
+func NewJobProposalPolicyDecisions(decisions []feeds.JobProposalPolicyDecision) []*JobProposalPolicyDecisionResolver {
+	resolvers := []*JobProposalPolicyDecisionResolver{}
+	for _, d := range decisions {
+		resolvers = append(resolvers, &JobProposalPolicyDecisionResolver{d: d})
+	}
+
+	return resolvers
+}
+
+// ID resolves to the decision ID
+func (r *JobProposalPolicyDecisionResolver) ID() graphql.ID {
+	return int64GQLID(r.d.ID)
+}
+
+// SpecID resolves to the ID of the spec the decision was made on
+func (r *JobProposalPolicyDecisionResolver) SpecID() graphql.ID {
+	return int64GQLID(r.d.JobProposalSpecID)
+}
+
+// Policy resolves to the name of the deciding policy, if any policy matched
+func (r *JobProposalPolicyDecisionResolver) Policy() *string {
+	if r.d.Policy == "" {
+		return nil
+	}
+	return &r.d.Policy
+}
+
+// Decision resolves to the decision
+func (r *JobProposalPolicyDecisionResolver) Decision() (PolicyDecision, error) {
+	return ToPolicyDecision(r.d.Decision)
+}
+
+// Reason resolves to the reason of the decision
+func (r *JobProposalPolicyDecisionResolver) Reason() string {
+	return r.d.Reason
+}
+
+// CreatedAt resolves to the time the decision was made
+func (r *JobProposalPolicyDecisionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.d.CreatedAt}
+}
+
 // -- GetJobProposal Query --
 
 // JobProposalPayloadResolver resolves the job proposal payload type
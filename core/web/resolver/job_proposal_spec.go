@@ -1,10 +1,14 @@
 package resolver
 
 import (
+	"context"
+	"strconv"
+
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/v2/core/services/feeds"
+	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
 // SpecStatus defines the enum values for GQL
@@ -96,6 +100,160 @@ func (r *JobProposalSpecResolver) UpdatedAt() graphql.Time {
 	return graphql.Time{Time: r.spec.UpdatedAt}
 }
 
+// Diff resolves to the difference from the approved spec of the job proposal,
+// which the node is running, to this spec. Every element of the spec is added
+// when no spec is approved.
+func (r *JobProposalSpecResolver) Diff(ctx context.Context) (*JobProposalSpecDiffResolver, error) {
+	specs, err := loader.GetSpecsByJobProposalID(ctx, strconv.FormatInt(r.spec.JobProposalID, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	var running string
+	for _, spec := range specs {
+		if spec.Status == feeds.SpecStatusApproved {
+			running = spec.Definition
+			break
+		}
+	}
+
+	diff, err := feeds.DiffSpecs(running, r.spec.Definition)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobProposalSpecDiffResolver{diff: diff}, nil
+}
+
+// SpecChangeKind defines the enum values for GQL
+type SpecChangeKind string
+
+const (
+	// revive:disable
+	SpecChangeKindAdded   SpecChangeKind = "ADDED"
+	SpecChangeKindRemoved SpecChangeKind = "REMOVED"
+	SpecChangeKindChanged SpecChangeKind = "CHANGED"
+	// revive:enable
+)
+
+// ToSpecChangeKind converts the feeds change kind into the enum value.
+func ToSpecChangeKind(k feeds.ChangeKind) SpecChangeKind {
+	switch k {
+	case feeds.ChangeKindAdded:
+		return SpecChangeKindAdded
+	case feeds.ChangeKindRemoved:
+		return SpecChangeKindRemoved
+	default:
+		return SpecChangeKindChanged
+	}
+}
+
+// JobProposalSpecDiffResolver resolves the Job Proposal Spec Diff type.
+type JobProposalSpecDiffResolver struct {
+	diff feeds.SpecDiff
+}
+
+// Fields resolves to the changes of the fields of the spec
+func (r *JobProposalSpecDiffResolver) Fields() []*SpecFieldChangeResolver {
+	return newSpecFieldChanges(r.diff.Fields)
+}
+
+// Tasks resolves to the changes of the tasks of the observation source
+func (r *JobProposalSpecDiffResolver) Tasks() []*SpecTaskChangeResolver {
+	resolvers := []*SpecTaskChangeResolver{}
+	for _, t := range r.diff.Tasks {
+		resolvers = append(resolvers, &SpecTaskChangeResolver{change: t})
+	}
+	return resolvers
+}
+
+// Edges resolves to the changes of the edges of the observation source
+func (r *JobProposalSpecDiffResolver) Edges() []*SpecEdgeChangeResolver {
+	resolvers := []*SpecEdgeChangeResolver{}
+	for _, e := range r.diff.Edges {
+		resolvers = append(resolvers, &SpecEdgeChangeResolver{change: e})
+	}
+	return resolvers
+}
+
+// SpecFieldChangeResolver resolves the Spec Field Change type.
+type SpecFieldChangeResolver struct {
+	change feeds.FieldChange
+}
+
+func newSpecFieldChanges(changes []feeds.FieldChange) []*SpecFieldChangeResolver {
+	resolvers := []*SpecFieldChangeResolver{}
+	for _, c := range changes {
+		resolvers = append(resolvers, &SpecFieldChangeResolver{change: c})
+	}
+	return resolvers
+}
+
+// Path resolves to the dotted path of the field
+func (r *SpecFieldChangeResolver) Path() string {
+	return r.change.Path
+}
+
+// Kind resolves to the kind of change
+func (r *SpecFieldChangeResolver) Kind() SpecChangeKind {
+	return ToSpecChangeKind(r.change.Kind)
+}
+
+// Old resolves to the running value
+func (r *SpecFieldChangeResolver) Old() string {
+	return r.change.Old
+}
+
+// New resolves to the proposed value
+func (r *SpecFieldChangeResolver) New() string {
+	return r.change.New
+}
+
+// SpecTaskChangeResolver resolves the Spec Task Change type.
+type SpecTaskChangeResolver struct {
+	change feeds.TaskChange
+}
+
+// ID resolves to the DOT ID of the task
+func (r *SpecTaskChangeResolver) ID() string {
+	return r.change.ID
+}
+
+// Type resolves to the type of the task
+func (r *SpecTaskChangeResolver) Type() string {
+	return r.change.Type
+}
+
+// Kind resolves to the kind of change
+func (r *SpecTaskChangeResolver) Kind() SpecChangeKind {
+	return ToSpecChangeKind(r.change.Kind)
+}
+
+// Attributes resolves to the changes of the attributes of a changed task
+func (r *SpecTaskChangeResolver) Attributes() []*SpecFieldChangeResolver {
+	return newSpecFieldChanges(r.change.Attributes)
+}
+
+// SpecEdgeChangeResolver resolves the Spec Edge Change type.
+type SpecEdgeChangeResolver struct {
+	change feeds.EdgeChange
+}
+
+// From resolves to the DOT ID of the task the edge starts from
+func (r *SpecEdgeChangeResolver) From() string {
+	return r.change.From
+}
+
+// To resolves to the DOT ID of the task the edge ends at
+func (r *SpecEdgeChangeResolver) To() string {
+	return r.change.To
+}
+
+// Kind resolves to the kind of change
+func (r *SpecEdgeChangeResolver) Kind() SpecChangeKind {
+	return ToSpecChangeKind(r.change.Kind)
+}
+
 // -- ApproveJobProposal Mutation --
 
 // ApproveJobProposalSpecPayloadResolver resolves the spec payload.
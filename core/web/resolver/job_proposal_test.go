@@ -107,3 +107,107 @@ func TestResolver_GetJobProposal(t *testing.T) {
 
 	RunGQLTests(t, testCases)
 }
+
+func TestResolver_JobProposalDiffAndPolicyDecisions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetJobProposal {
+			jobProposal(id: "1") {
+				... on JobProposal {
+					latestSpec {
+						id
+						diff {
+							fields {
+								path
+								kind
+								old
+								new
+							}
+							tasks {
+								id
+								type
+								kind
+								attributes {
+									path
+									kind
+									old
+									new
+								}
+							}
+							edges {
+								from
+								to
+								kind
+							}
+						}
+					}
+					policyDecisions {
+						id
+						specID
+						policy
+						decision
+						reason
+					}
+				}
+			}
+		}`
+
+	jpID := int64(1)
+	spec := func(url string) string {
+		return fmt.Sprintf(`
+type = "offchainreporting2"
+observationSource = """
+ds1 [type=http method=GET url="%s"];
+ds1_parse [type=jsonparse path="data"];
+ds1 -> ds1_parse;
+"""`, url)
+	}
+
+	testCases := []GQLTestCase{
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.Mocks.feedsSvc.On("GetJobProposal", mock.Anything, jpID).Return(&feeds.JobProposal{
+					ID:     jpID,
+					Status: feeds.JobProposalStatusApproved,
+				}, nil)
+				f.Mocks.feedsSvc.On("ListSpecsByJobProposalIDs", mock.Anything, []int64{jpID}).Return([]feeds.JobProposalSpec{
+					{ID: 1, JobProposalID: jpID, Version: 1, Status: feeds.SpecStatusApproved, Definition: spec("https://a.example.com")},
+					{ID: 2, JobProposalID: jpID, Version: 2, Status: feeds.SpecStatusPending, Definition: spec("https://b.example.com")},
+				}, nil)
+				f.Mocks.feedsSvc.On("ListPolicyDecisionsByJobProposalIDs", mock.Anything, []int64{jpID}).Return([]feeds.JobProposalPolicyDecision{
+					{ID: 2, JobProposalID: jpID, JobProposalSpecID: 2, Policy: "adapter-urls", Decision: feeds.PolicyDecisionAutoApprovalFailed, Reason: "approval failed"},
+					{ID: 1, JobProposalID: jpID, JobProposalSpecID: 1, Decision: feeds.PolicyDecisionManualApproval, Reason: "no approval policy matched"},
+				}, nil)
+				f.App.On("GetFeedsService").Return(f.Mocks.feedsSvc)
+			},
+			query: query,
+			result: `
+			{
+				"jobProposal": {
+					"latestSpec": {
+						"id": "2",
+						"diff": {
+							"fields": [],
+							"tasks": [{
+								"id": "ds1",
+								"type": "http",
+								"kind": "CHANGED",
+								"attributes": [{"path": "url", "kind": "CHANGED", "old": "https://a.example.com", "new": "https://b.example.com"}]
+							}],
+							"edges": []
+						}
+					},
+					"policyDecisions": [
+						{"id": "2", "specID": "2", "policy": "adapter-urls", "decision": "AUTO_APPROVAL_FAILED", "reason": "approval failed"},
+						{"id": "1", "specID": "1", "policy": null, "decision": "MANUAL_APPROVAL", "reason": "no approval policy matched"}
+					]
+				}
+			}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
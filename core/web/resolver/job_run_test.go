@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 )
 
@@ -235,6 +236,71 @@ func TestResolver_JobRun(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_JobRun_CustomRole(t *testing.T) {
+	t.Parallel()
+
+	var (
+		query = `
+			query GetJobRun($id: ID!) {
+				jobRun(id: $id) {
+					... on JobRun {
+						id
+					}
+				}
+			}`
+		variables  = map[string]interface{}{"id": "2"}
+		ocr2Viewer = &rbac.Role{Name: "ocr2-viewer", Permissions: rbac.Permissions{
+			{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{string(job.OffchainReporting2)}},
+		}}
+	)
+	runOfType := func(jobType job.Type) func(context.Context, *gqlTestFramework) {
+		return func(ctx context.Context, f *gqlTestFramework) {
+			f.Mocks.jobORM.On("FindPipelineRunByID", mock.Anything, int64(2)).Return(pipeline.Run{
+				ID:             2,
+				PipelineSpecID: 5,
+				PipelineSpec:   pipeline.Spec{ID: 5, JobID: 1, JobType: string(jobType)},
+				State:          pipeline.RunStatusCompleted,
+			}, nil)
+			f.App.On("JobORM").Return(f.Mocks.jobORM)
+		}
+	}
+
+	testCases := []GQLTestCase{
+		{
+			name:          "run of an allowed job type",
+			authenticated: true,
+			role:          ocr2Viewer,
+			before:        runOfType(job.OffchainReporting2),
+			query:         query,
+			variables:     variables,
+			result: `
+				{
+					"jobRun": {
+						"id": "2"
+					}
+				}`,
+		},
+		{
+			name:          "run of another job type",
+			authenticated: true,
+			role:          ocr2Viewer,
+			before:        runOfType(job.Webhook),
+			query:         query,
+			variables:     variables,
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: RoleNotPermittedErr{"ocr2-viewer"},
+					Path:          []interface{}{"jobRun"},
+					Message:       "Not permitted with current role: ocr2-viewer",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_RunJob(t *testing.T) {
 	t.Parallel()
 
@@ -15,11 +15,15 @@ import (
 	"github.com/stretchr/testify/mock"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink-common/pkg/utils/jsonserializable"
+
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
 	clnull "github.com/smartcontractkit/chainlink/v2/core/null"
 	"github.com/smartcontractkit/chainlink/v2/core/services/directrequest"
 	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/v2/core/services/pipeline/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/testdata/testspecs"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
@@ -137,6 +141,70 @@ func TestResolver_Jobs(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_Jobs_CustomRole(t *testing.T) {
+	var (
+		query = `
+			query GetJobs {
+				jobs {
+					results {
+						id
+					}
+					metadata {
+						total
+					}
+				}
+			}`
+		ocr2Viewer = &rbac.Role{Name: "ocr2-viewer", Permissions: rbac.Permissions{
+			{Resource: rbac.ResourceJobs, Actions: []rbac.Action{rbac.ActionRead}, JobTypes: []string{string(job.OffchainReporting2)}},
+		}}
+		bridgeOperator = &rbac.Role{Name: "bridge-operator", Permissions: rbac.Permissions{
+			{Resource: rbac.ResourceBridges, Actions: []rbac.Action{rbac.ActionRead}},
+		}}
+	)
+
+	testCases := []GQLTestCase{
+		{
+			name:          "only jobs of allowed types",
+			authenticated: true,
+			role:          ocr2Viewer,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				f.App.On("JobORM").Return(f.Mocks.jobORM)
+				f.Mocks.jobORM.On("FindJobsByTypes", mock.Anything, []job.Type{job.OffchainReporting2}, 0, 50).Return([]job.Job{
+					{ID: 1, Type: job.OffchainReporting2},
+				}, 1, nil)
+			},
+			query: query,
+			result: `
+				{
+					"jobs": {
+						"results": [{
+							"id": "1"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+		{
+			name:          "not permitted",
+			authenticated: true,
+			role:          bridgeOperator,
+			query:         query,
+			result:        `null`,
+			errors: []*gqlerrors.QueryError{
+				{
+					ResolverError: RoleNotPermittedErr{"bridge-operator"},
+					Path:          []interface{}{"jobs"},
+					Message:       "Not permitted with current role: bridge-operator",
+				},
+			},
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_Job(t *testing.T) {
 	var (
 		id            = int32(1)
@@ -400,6 +468,141 @@ func TestResolver_CreateJob(t *testing.T) {
 	RunGQLTests(t, testCases)
 }
 
+func TestResolver_DryRunJob(t *testing.T) {
+	t.Parallel()
+
+	mutation := `
+		mutation DryRunJob($input: DryRunJobInput!) {
+			dryRunJob(input: $input) {
+				... on DryRunJobSuccess {
+					outputs
+					allErrors
+					fatalErrors
+					taskRuns {
+						dotID
+						type
+						output
+						error
+						attempts
+						elapsedMs
+						stubbed
+						recordedInputs
+					}
+				}
+				... on InputErrors {
+					errors {
+						path
+						message
+						code
+					}
+				}
+			}
+		}`
+	uuid := uuid.New()
+	spec := fmt.Sprintf(testspecs.DirectRequestSpecTemplate, uuid, uuid)
+	variables := map[string]interface{}{
+		"input": map[string]interface{}{
+			"TOML":  spec,
+			"vars":  `{"jobRun": {"requestBody": "{}"}}`,
+			"stubs": `{"ds1": "stubbed"}`,
+		},
+	}
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	task := &pipeline.MultiplyTask{BaseTask: pipeline.NewBaseTask(0, "ds1_multiply", nil, nil, 0)}
+	run := &pipeline.Run{
+		Outputs:   jsonserializable.JSONSerializable{Val: []interface{}{"100"}, Valid: true},
+		AllErrors: pipeline.RunErrors{null.String{}},
+	}
+	trrs := pipeline.TaskRunResults{{
+		Task:       task,
+		Result:     pipeline.Result{Value: "100"},
+		Attempts:   1,
+		CreatedAt:  createdAt,
+		FinishedAt: null.TimeFrom(createdAt.Add(5 * time.Millisecond)),
+	}}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: mutation, variables: variables}, "dryRunJob"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				runner := pipelinemocks.NewRunner(t)
+				runner.On("ExecuteDryRun", mock.Anything, mock.Anything,
+					pipeline.NewVarsFrom(map[string]interface{}{"jobRun": map[string]interface{}{"requestBody": "{}"}}),
+					pipeline.DryRunStubs{"ds1": "stubbed"}).
+					Return(run, trrs, nil)
+				f.App.On("GetConfig").Return(f.Mocks.cfg)
+				f.App.On("PipelineRunner").Return(runner)
+			},
+			query:     mutation,
+			variables: variables,
+			result: `
+				{
+					"dryRunJob": {
+						"outputs": ["100"],
+						"allErrors": [],
+						"fatalErrors": [],
+						"taskRuns": [{
+							"dotID": "ds1_multiply",
+							"type": "multiply",
+							"output": "\"100\"",
+							"error": null,
+							"attempts": 1,
+							"elapsedMs": 5,
+							"stubbed": false,
+							"recordedInputs": []
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid stubs",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML":  spec,
+					"stubs": "[]",
+				},
+			},
+			result: `
+				{
+					"dryRunJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "json: cannot unmarshal array into Go value of type pipeline.DryRunStubs",
+							"path": "stubs"
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "invalid TOML error",
+			authenticated: true,
+			query:         mutation,
+			variables: map[string]interface{}{
+				"input": map[string]interface{}{
+					"TOML": "some wrong value",
+				},
+			},
+			result: `
+				{
+					"dryRunJob": {
+						"errors": [{
+							"code": "INVALID_INPUT",
+							"message": "failed to parse TOML: (1, 6): was expecting token =, but got \"wrong\" instead",
+							"path": "TOML spec"
+						}]
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
 func TestResolver_DeleteJob(t *testing.T) {
 	t.Parallel()
 
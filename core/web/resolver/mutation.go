@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/graph-gophers/graphql-go"
 	"github.com/pkg/errors"
 	"go.uber.org/zap/zapcore"
@@ -15,9 +17,11 @@ import (
 
 	"github.com/smartcontractkit/chainlink-common/pkg/assets"
 
+	txmgrcommon "github.com/smartcontractkit/chainlink/v2/common/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	ccip "github.com/smartcontractkit/chainlink/v2/core/capabilities/ccip/validate"
+	"github.com/smartcontractkit/chainlink/v2/core/chains/evm/txmgr"
 	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/blockheaderfeeder"
@@ -38,11 +42,13 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocr2/validate"
 	"github.com/smartcontractkit/chainlink/v2/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/v2/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/v2/core/services/standardcapabilities"
 	"github.com/smartcontractkit/chainlink/v2/core/services/streams"
 	"github.com/smartcontractkit/chainlink/v2/core/services/vrf/vrfcommon"
 	"github.com/smartcontractkit/chainlink/v2/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/v2/core/services/workflows"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/store/models"
 	"github.com/smartcontractkit/chainlink/v2/core/utils"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/crypto"
@@ -63,7 +69,7 @@ type createBridgeInput struct {
 
 // CreateBridge creates a new bridge.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceBridges, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -113,7 +119,7 @@ func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBr
 }
 
 func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -137,7 +143,7 @@ func (r *Resolver) CreateCSAKey(ctx context.Context) (*CreateCSAKeyPayloadResolv
 func (r *Resolver) DeleteCSAKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteCSAKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -180,7 +186,7 @@ type createFeedsManagerChainConfigInput struct {
 func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struct {
 	Input *createFeedsManagerChainConfigInput
 }) (*CreateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -265,7 +271,7 @@ func (r *Resolver) CreateFeedsManagerChainConfig(ctx context.Context, args struc
 func (r *Resolver) DeleteFeedsManagerChainConfig(ctx context.Context, args struct {
 	ID string
 }) (*DeleteFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -321,7 +327,7 @@ func (r *Resolver) UpdateFeedsManagerChainConfig(ctx context.Context, args struc
 	ID    string
 	Input *updateFeedsManagerChainConfigInput
 }) (*UpdateFeedsManagerChainConfigPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -405,7 +411,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -458,7 +464,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceBridges, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -526,7 +532,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -574,7 +580,7 @@ func (r *Resolver) EnableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*EnableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -596,7 +602,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 	ID graphql.ID
 },
 ) (*DisableFeedsManagerPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -615,7 +621,7 @@ func (r *Resolver) DisableFeedsManager(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -635,7 +641,7 @@ func (r *Resolver) CreateOCRKeyBundle(ctx context.Context) (*CreateOCRKeyBundleP
 func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 	ID string
 }) (*DeleteOCRKeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -654,7 +660,7 @@ func (r *Resolver) DeleteOCRKeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteBridgePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceBridges, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -690,7 +696,7 @@ func (r *Resolver) DeleteBridge(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -714,7 +720,7 @@ func (r *Resolver) CreateP2PKey(ctx context.Context) (*CreateP2PKeyPayloadResolv
 func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteP2PKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -740,7 +746,7 @@ func (r *Resolver) DeleteP2PKey(ctx context.Context, args struct {
 }
 
 func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -762,7 +768,7 @@ func (r *Resolver) CreateVRFKey(ctx context.Context) (*CreateVRFKeyPayloadResolv
 func (r *Resolver) DeleteVRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteVRFKeyPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -787,7 +793,7 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	ID    graphql.ID
 	Force *bool
 }) (*ApproveJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -822,11 +828,77 @@ func (r *Resolver) ApproveJobProposalSpec(ctx context.Context, args struct {
 	return NewApproveJobProposalSpecPayload(spec, err), nil
 }
 
+// CancelEthTransaction cancels the EVM transaction of the given attempt hash or ID.
+// Transactions without attempts, such as unstarted ones, can only be cancelled by ID.
+func (r *Resolver) CancelEthTransaction(ctx context.Context, args struct {
+	Hash *graphql.ID
+	ID   *graphql.ID
+}) (*CancelEthTransactionPayloadResolver, error) {
+	if err := authorize(ctx, rbac.ResourceTransactions, rbac.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	txStore := r.App.TxmStorageService()
+	auditData := map[string]interface{}{}
+	var findTx func() (*txmgr.Tx, error)
+	switch {
+	case args.ID != nil:
+		id, err := stringutils.ToInt64(string(*args.ID))
+		if err != nil {
+			return nil, err
+		}
+		findTx = func() (*txmgr.Tx, error) {
+			etx, err := txStore.FindTxWithAttempts(ctx, id)
+			return &etx, err
+		}
+	case args.Hash != nil:
+		hash := common.HexToHash(string(*args.Hash))
+		auditData["txHash"] = hash
+		findTx = func() (*txmgr.Tx, error) {
+			return txStore.FindTxByHash(ctx, hash)
+		}
+	default:
+		return nil, errors.New("either the hash or the ID of the transaction is required")
+	}
+
+	etx, err := findTx()
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	chain, err := r.App.GetRelayers().LegacyEVMChains().Get(etx.ChainID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = chain.TxManager().CancelTx(ctx, etx.ID); err != nil {
+		if errors.Is(err, txmgrcommon.ErrTxNotCancellable) {
+			return NewCancelEthTransactionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	auditData["ethTxID"] = etx.ID
+	r.App.GetAuditLogger().Audit(audit.EthTransactionCancelled, auditData)
+
+	etx, err = findTx()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCancelEthTransactionPayload(etx, nil), nil
+}
+
 // CancelJobProposalSpec cancels the job proposal spec.
 func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*CancelJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -861,7 +933,7 @@ func (r *Resolver) CancelJobProposalSpec(ctx context.Context, args struct {
 func (r *Resolver) RejectJobProposalSpec(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RejectJobProposalSpecPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -897,7 +969,7 @@ func (r *Resolver) UpdateJobProposalSpecDefinition(ctx context.Context, args str
 	ID    graphql.ID
 	Input *struct{ Definition string }
 }) (*UpdateJobProposalSpecDefinitionPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -971,7 +1043,7 @@ func (r *Resolver) UpdateUserPassword(ctx context.Context, args struct {
 func (r *Resolver) SetSQLLogging(ctx context.Context, args struct {
 	Input struct{ Enabled bool }
 }) (*SetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceLogs, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -1062,85 +1134,147 @@ func (r *Resolver) CreateJob(ctx context.Context, args struct {
 		TOML string
 	}
 }) (*CreateJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	jb, inputErrs, err := r.validateJobSpec(ctx, args.Input.TOML)
+	if inputErrs != nil {
+		return NewCreateJobPayload(r.App, nil, inputErrs), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = authorizeJobType(ctx, rbac.ActionCreate, jb.Type); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	err = r.App.AddJobV2(ctx, &jb)
+	if err != nil {
 		return nil, err
 	}
 
-	jbt, err := job.ValidateSpec(args.Input.TOML)
+	jbj, _ := json.Marshal(jb)
+	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+
+	return NewCreateJobPayload(r.App, &jb, nil), nil
+}
+
+// validateJobSpec validates a job spec of any type. Invalid TOML and unknown
+// job types are returned as input errors.
+func (r *Resolver) validateJobSpec(ctx context.Context, tomlString string) (jb job.Job, inputErrs map[string]string, err error) {
+	jbt, err := job.ValidateSpec(tomlString)
 	if err != nil {
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"TOML spec": errors.Wrap(err, "failed to parse TOML").Error(),
-		}), nil
+		}, nil
 	}
 
-	var jb job.Job
 	config := r.App.GetConfig()
 	switch jbt {
 	case job.OffchainReporting:
-		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), args.Input.TOML)
+		jb, err = ocr.ValidatedOracleSpecToml(config, r.App.GetRelayers().LegacyEVMChains(), tomlString)
 		if !config.OCR().Enabled() {
-			return nil, errors.New("The Offchain Reporting feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting feature is disabled by configuration")
 		}
 	case job.OffchainReporting2:
-		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), args.Input.TOML, r.App.GetLoopRegistrarConfig())
+		jb, err = validate.ValidatedOracleSpecToml(ctx, r.App.GetConfig().OCR2(), r.App.GetConfig().Insecure(), tomlString, r.App.GetLoopRegistrarConfig())
 		if !config.OCR2().Enabled() {
-			return nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
+			return jb, nil, errors.New("The Offchain Reporting 2 feature is disabled by configuration")
 		}
 	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(args.Input.TOML)
+		jb, err = directrequest.ValidatedDirectRequestSpec(tomlString)
 	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), args.Input.TOML)
+		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(config.JobPipeline(), tomlString)
 	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(args.Input.TOML)
+		jb, err = keeper.ValidatedKeeperSpec(tomlString)
 	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(args.Input.TOML)
+		jb, err = cron.ValidatedCronSpec(tomlString)
 	case job.VRF:
-		jb, err = vrfcommon.ValidatedVRFSpec(args.Input.TOML)
+		jb, err = vrfcommon.ValidatedVRFSpec(tomlString)
 	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(ctx, args.Input.TOML, r.App.GetExternalInitiatorManager())
+		jb, err = webhook.ValidatedWebhookSpec(ctx, tomlString, r.App.GetExternalInitiatorManager())
 	case job.BlockhashStore:
-		jb, err = blockhashstore.ValidatedSpec(args.Input.TOML)
+		jb, err = blockhashstore.ValidatedSpec(tomlString)
 	case job.BlockHeaderFeeder:
-		jb, err = blockheaderfeeder.ValidatedSpec(args.Input.TOML)
+		jb, err = blockheaderfeeder.ValidatedSpec(tomlString)
 	case job.Bootstrap:
-		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(args.Input.TOML)
+		jb, err = ocrbootstrap.ValidatedBootstrapSpecToml(tomlString)
 	case job.Gateway:
-		jb, err = gateway.ValidatedGatewaySpec(args.Input.TOML)
+		jb, err = gateway.ValidatedGatewaySpec(tomlString)
 	case job.Workflow:
-		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, args.Input.TOML)
+		jb, err = workflows.ValidatedWorkflowJobSpec(ctx, tomlString)
 	case job.StandardCapabilities:
-		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(args.Input.TOML)
+		jb, err = standardcapabilities.ValidatedStandardCapabilitiesSpec(tomlString)
 	case job.Stream:
-		jb, err = streams.ValidatedStreamSpec(args.Input.TOML)
+		jb, err = streams.ValidatedStreamSpec(tomlString)
 	case job.CCIP:
-		jb, err = ccip.ValidatedCCIPSpec(args.Input.TOML)
+		jb, err = ccip.ValidatedCCIPSpec(tomlString)
 	default:
-		return NewCreateJobPayload(r.App, nil, map[string]string{
+		return jb, map[string]string{
 			"Job Type": fmt.Sprintf("unknown job type: %s", jbt),
-		}), nil
+		}, nil
 	}
-	if err != nil {
+	return jb, nil, err
+}
+
+// DryRunJob validates a job spec and executes its pipeline in memory, with
+// side-effecting tasks stubbed out. Nothing is persisted.
+func (r *Resolver) DryRunJob(ctx context.Context, args struct {
+	Input struct {
+		TOML  string
+		Vars  *string
+		Stubs *string
+	}
+}) (*DryRunJobPayloadResolver, error) {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
+	var vars map[string]interface{}
+	if args.Input.Vars != nil {
+		if err := json.Unmarshal([]byte(*args.Input.Vars), &vars); err != nil {
+			return NewDryRunJobPayload(nil, nil, map[string]string{"vars": err.Error()}), nil
+		}
+	}
+	var stubs pipeline.DryRunStubs
+	if args.Input.Stubs != nil {
+		if err := json.Unmarshal([]byte(*args.Input.Stubs), &stubs); err != nil {
+			return NewDryRunJobPayload(nil, nil, map[string]string{"stubs": err.Error()}), nil
+		}
+	}
 
-	err = r.App.AddJobV2(ctx, &jb)
+	jb, inputErrs, err := r.validateJobSpec(ctx, args.Input.TOML)
+	if inputErrs != nil {
+		return NewDryRunJobPayload(nil, nil, inputErrs), nil
+	}
 	if err != nil {
 		return nil, err
 	}
+	if err = authorizeJobType(ctx, rbac.ActionCreate, jb.Type); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(jb.Pipeline.Source) == "" {
+		return NewDryRunJobPayload(nil, nil, map[string]string{
+			"TOML spec": fmt.Sprintf("job type %s has no pipeline to execute", jb.Type),
+		}), nil
+	}
 
-	jbj, _ := json.Marshal(jb)
-	r.App.GetAuditLogger().Audit(audit.JobCreated, map[string]interface{}{"job": string(jbj)})
+	run, trrs, err := r.App.PipelineRunner().ExecuteDryRun(ctx, jb.DryRunPipelineSpec(), pipeline.NewVarsFrom(vars), stubs)
+	if err != nil {
+		return nil, err
+	}
 
-	return NewCreateJobPayload(r.App, &jb, nil), nil
+	return NewDryRunJobPayload(run, trrs, nil), nil
 }
 
 func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteJobPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -1157,6 +1291,9 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	if err = authorizeJobType(ctx, rbac.ActionDelete, j.Type); err != nil {
+		return nil, err
+	}
 
 	err = r.App.DeleteJob(ctx, id)
 	if err != nil {
@@ -1174,7 +1311,7 @@ func (r *Resolver) DeleteJob(ctx context.Context, args struct {
 func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DismissJobErrorPayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -1191,6 +1328,9 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	if err = r.authorizeJobID(ctx, rbac.ActionUpdate, specErr.JobID); err != nil {
+		return nil, err
+	}
 
 	err = r.App.JobORM().DismissError(ctx, id)
 	if err != nil {
@@ -1208,7 +1348,7 @@ func (r *Resolver) DismissJobError(ctx context.Context, args struct {
 func (r *Resolver) RunJob(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*RunJobPayloadResolver, error) {
-	if err := authenticateUserCanRun(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionRun); err != nil {
 		return nil, err
 	}
 
@@ -1216,6 +1356,9 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 	if err != nil {
 		return nil, err
 	}
+	if err = r.authorizeJobID(ctx, rbac.ActionRun, jobID); err != nil {
+		return nil, err
+	}
 
 	jobRunID, err := r.App.RunJobV2(ctx, jobID, nil)
 	if err != nil {
@@ -1238,7 +1381,7 @@ func (r *Resolver) RunJob(ctx context.Context, args struct {
 func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 	Level LogLevel
 }) (*SetGlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceLogs, rbac.ActionUpdate); err != nil {
 		return nil, err
 	}
 
@@ -1264,7 +1407,7 @@ func (r *Resolver) SetGlobalLogLevel(ctx context.Context, args struct {
 func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 	ChainType OCR2ChainType
 }) (*CreateOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserCanEdit(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionCreate); err != nil {
 		return nil, err
 	}
 
@@ -1292,7 +1435,7 @@ func (r *Resolver) CreateOCR2KeyBundle(ctx context.Context, args struct {
 func (r *Resolver) DeleteOCR2KeyBundle(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*DeleteOCR2KeyBundlePayloadResolver, error) {
-	if err := authenticateUserIsAdmin(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionDelete); err != nil {
 		return nil, err
 	}
 
@@ -15,16 +15,55 @@ import (
 	commonTypes "github.com/smartcontractkit/chainlink/v2/common/types"
 	"github.com/smartcontractkit/chainlink/v2/core/bridges"
 	"github.com/smartcontractkit/chainlink/v2/core/chains"
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore"
 	"github.com/smartcontractkit/chainlink/v2/core/services/keystore/keys/vrfkey"
 	evmrelay "github.com/smartcontractkit/chainlink/v2/core/services/relay/evm"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/utils/stringutils"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 )
 
+// AuditLogRecords retrieves a page of the local audit log, most recent first.
+func (r *Resolver) AuditLogRecords(ctx context.Context, args struct {
+	Offset        *int32
+	Limit         *int32
+	User          *string
+	EventID       *string
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+}) (*AuditLogRecordsPayloadResolver, error) {
+	if err := authorize(ctx, rbac.ResourceAuditLogs, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+
+	var filter audit.RecordsFilter
+	if args.User != nil {
+		filter.UserEmail = *args.User
+	}
+	if args.EventID != nil {
+		filter.EventID = audit.EventID(*args.EventID)
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	records, count, err := r.App.AuditLogORM().ListRecords(ctx, filter, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAuditLogRecordsPayload(records, int32(count)), nil
+}
+
 // Bridge retrieves a bridges by name.
 func (r *Resolver) Bridge(ctx context.Context, args struct{ ID graphql.ID }) (*BridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceBridges, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -50,7 +89,7 @@ func (r *Resolver) Bridges(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*BridgesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceBridges, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -71,7 +110,7 @@ func (r *Resolver) Chain(ctx context.Context,
 		ID      graphql.ID
 		Network *string
 	}) (*ChainPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceChains, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -104,7 +143,7 @@ func (r *Resolver) Chains(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*ChainsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceChains, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -159,7 +198,7 @@ func sortByNetworkAndID(chains []commonTypes.ChainStatusWithID) {
 
 // FeedsManager retrieves a feeds manager by id.
 func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID }) (*FeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -181,7 +220,7 @@ func (r *Resolver) FeedsManager(ctx context.Context, args struct{ ID graphql.ID
 }
 
 func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -195,7 +234,7 @@ func (r *Resolver) FeedsManagers(ctx context.Context) (*FeedsManagersPayloadReso
 
 // Job retrieves a job by id.
 func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -217,6 +256,9 @@ func (r *Resolver) Job(ctx context.Context, args struct{ ID graphql.ID }) (*JobP
 
 		return nil, err
 	}
+	if err := authorizeJobType(ctx, rbac.ActionRead, j.Type); err != nil {
+		return nil, err
+	}
 
 	return NewJobPayload(r.App, &j, nil), nil
 }
@@ -226,14 +268,21 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
 	offset := pageOffset(args.Offset)
 	limit := pageLimit(args.Limit)
 
-	jobs, count, err := r.App.JobORM().FindJobs(ctx, offset, limit)
+	var jobs []job.Job
+	var count int
+	var err error
+	if jobTypes, all := allowedJobTypes(ctx, rbac.ActionRead); all {
+		jobs, count, err = r.App.JobORM().FindJobs(ctx, offset, limit)
+	} else {
+		jobs, count, err = r.App.JobORM().FindJobsByTypes(ctx, jobTypes, offset, limit)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -242,7 +291,7 @@ func (r *Resolver) Jobs(ctx context.Context, args struct {
 }
 
 func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -255,7 +304,7 @@ func (r *Resolver) OCRKeyBundles(ctx context.Context) (*OCRKeyBundlesPayloadReso
 }
 
 func (r *Resolver) CSAKeys(ctx context.Context) (*CSAKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -278,7 +327,7 @@ func (r *Resolver) Features(ctx context.Context) (*FeaturesPayloadResolver, erro
 
 // Node retrieves a node by ID (Name)
 func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*NodePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceChains, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 	r.App.GetLogger().Debug("resolver Node args %v", args)
@@ -306,7 +355,7 @@ func (r *Resolver) Node(ctx context.Context, args struct{ ID graphql.ID }) (*Nod
 }
 
 func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -320,7 +369,7 @@ func (r *Resolver) P2PKeys(ctx context.Context) (*P2PKeysPayloadResolver, error)
 
 // VRFKeys fetches all VRF keys.
 func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -336,7 +385,7 @@ func (r *Resolver) VRFKeys(ctx context.Context) (*VRFKeysPayloadResolver, error)
 func (r *Resolver) VRFKey(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*VRFKeyPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -355,7 +404,7 @@ func (r *Resolver) VRFKey(ctx context.Context, args struct {
 func (r *Resolver) JobProposal(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobProposalPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceFeedsManagers, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -381,7 +430,7 @@ func (r *Resolver) Nodes(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*NodesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceChains, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -406,7 +455,12 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*JobRunsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+
+	// The runs of all jobs can only be listed by users who may read jobs of any type.
+	if err := authorizeAllJobTypes(ctx, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -424,7 +478,7 @@ func (r *Resolver) JobRuns(ctx context.Context, args struct {
 func (r *Resolver) JobRun(ctx context.Context, args struct {
 	ID graphql.ID
 }) (*JobRunPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceJobs, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -441,12 +495,15 @@ func (r *Resolver) JobRun(ctx context.Context, args struct {
 
 		return nil, err
 	}
+	if err := authorizeJobType(ctx, rbac.ActionRead, job.Type(jr.PipelineSpec.JobType)); err != nil {
+		return nil, err
+	}
 
 	return NewJobRunPayload(&jr, r.App, err), nil
 }
 
 func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -499,7 +556,7 @@ func (r *Resolver) ETHKeys(ctx context.Context) (*ETHKeysPayloadResolver, error)
 
 // ConfigV2 retrieves the Chainlink node's configuration (V2 mode)
 func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceConfig, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -510,7 +567,7 @@ func (r *Resolver) ConfigV2(ctx context.Context) (*ConfigV2PayloadResolver, erro
 func (r *Resolver) EthTransaction(ctx context.Context, args struct {
 	Hash graphql.ID
 }) (*EthTransactionPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceTransactions, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -531,7 +588,7 @@ func (r *Resolver) EthTransactions(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceTransactions, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -550,7 +607,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 	Offset *int32
 	Limit  *int32
 }) (*EthTransactionsAttemptsPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceTransactions, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -566,7 +623,7 @@ func (r *Resolver) EthTransactionsAttempts(ctx context.Context, args struct {
 }
 
 func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceLogs, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -576,7 +633,7 @@ func (r *Resolver) GlobalLogLevel(ctx context.Context) (*GlobalLogLevelPayloadRe
 }
 
 func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -589,7 +646,7 @@ func (r *Resolver) SolanaKeys(ctx context.Context) (*SolanaKeysPayloadResolver,
 }
 
 func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -602,7 +659,7 @@ func (r *Resolver) AptosKeys(ctx context.Context) (*AptosKeysPayloadResolver, er
 }
 
 func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().Cosmos().GetAll()
@@ -614,7 +671,7 @@ func (r *Resolver) CosmosKeys(ctx context.Context) (*CosmosKeysPayloadResolver,
 }
 
 func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 	keys, err := r.App.GetKeyStore().StarkNet().GetAll()
@@ -626,7 +683,7 @@ func (r *Resolver) StarkNetKeys(ctx context.Context) (*StarkNetKeysPayloadResolv
 }
 
 func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceLogs, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -637,7 +694,7 @@ func (r *Resolver) SQLLogging(ctx context.Context) (*GetSQLLoggingPayloadResolve
 
 // OCR2KeyBundles resolves the list of OCR2 key bundles
 func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authorize(ctx, rbac.ResourceKeys, rbac.ActionRead); err != nil {
 		return nil, err
 	}
 
@@ -648,3 +705,56 @@ func (r *Resolver) OCR2KeyBundles(ctx context.Context) (*OCR2KeyBundlesPayloadRe
 
 	return NewOCR2KeyBundlesPayload(ekbs), nil
 }
+
+func (r *Resolver) WorkflowExecution(ctx context.Context, args struct {
+	ID graphql.ID
+}) (*WorkflowExecutionPayloadResolver, error) {
+	if err := authorize(ctx, rbac.ResourceWorkflows, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+
+	execution, err := r.App.WorkflowORM().Get(ctx, string(args.ID))
+	if err != nil {
+		if errors.Is(err, store.ErrExecutionNotFound) {
+			return NewWorkflowExecutionPayload(nil, err), nil
+		}
+
+		return nil, err
+	}
+
+	return NewWorkflowExecutionPayload(&execution, nil), nil
+}
+
+func (r *Resolver) WorkflowExecutions(ctx context.Context, args struct {
+	Offset        *int32
+	Limit         *int32
+	WorkflowID    *string
+	Status        *WorkflowExecutionStatus
+	CreatedAfter  *graphql.Time
+	CreatedBefore *graphql.Time
+}) (*WorkflowExecutionsPayloadResolver, error) {
+	if err := authorize(ctx, rbac.ResourceWorkflows, rbac.ActionRead); err != nil {
+		return nil, err
+	}
+
+	var filter store.ExecutionsFilter
+	if args.WorkflowID != nil {
+		filter.WorkflowID = *args.WorkflowID
+	}
+	if args.Status != nil {
+		filter.Status = FromWorkflowExecutionStatus(*args.Status)
+	}
+	if args.CreatedAfter != nil {
+		filter.CreatedAfter = &args.CreatedAfter.Time
+	}
+	if args.CreatedBefore != nil {
+		filter.CreatedBefore = &args.CreatedBefore.Time
+	}
+
+	executions, count, err := r.App.WorkflowORM().ListExecutions(ctx, filter, pageOffset(args.Offset), pageLimit(args.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	return NewWorkflowExecutionsPayload(executions, int32(count)), nil
+}
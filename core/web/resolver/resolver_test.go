@@ -29,6 +29,7 @@ import (
 	webhookmocks "github.com/smartcontractkit/chainlink/v2/core/services/webhook/mocks"
 	clsessions "github.com/smartcontractkit/chainlink/v2/core/sessions"
 	authProviderMocks "github.com/smartcontractkit/chainlink/v2/core/sessions/mocks"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/schema"
@@ -158,11 +159,13 @@ func (f *gqlTestFramework) withAuthenticatedUser(ctx context.Context) context.Co
 type GQLTestCase struct {
 	name          string
 	authenticated bool
-	before        func(context.Context, *gqlTestFramework)
-	query         string
-	variables     map[string]interface{}
-	result        string
-	errors        []*gqlerrors.QueryError
+	// role of the authenticated user, who has the built-in admin role when nil
+	role      *rbac.Role
+	before    func(context.Context, *gqlTestFramework)
+	query     string
+	variables map[string]interface{}
+	result    string
+	errors    []*gqlerrors.QueryError
 }
 
 // RunGQLTests runs a set of GQL tests cases
@@ -179,6 +182,10 @@ func RunGQLTests(t *testing.T, testCases []GQLTestCase) {
 
 			if tc.authenticated {
 				ctx = f.withAuthenticatedUser(ctx)
+				if tc.role != nil {
+					session, _ := auth.GetGQLAuthenticatedSession(ctx)
+					ctx = auth.WithGQLAuthenticatedRole(ctx, *session.User, *tc.role, session.SessionID)
+				}
 			}
 
 			if tc.before != nil {
@@ -0,0 +1,210 @@
+package resolver
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/graph-gophers/graphql-go"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+)
+
+type WorkflowExecutionStatus string
+
+// ToWorkflowExecutionStatus converts a store status into a WorkflowExecutionStatus.
+func ToWorkflowExecutionStatus(status string) WorkflowExecutionStatus {
+	return WorkflowExecutionStatus(strings.ToUpper(status))
+}
+
+// FromWorkflowExecutionStatus converts a WorkflowExecutionStatus into a store status.
+func FromWorkflowExecutionStatus(status WorkflowExecutionStatus) string {
+	return strings.ToLower(string(status))
+}
+
+// WorkflowExecutionResolver resolves the WorkflowExecution type.
+type WorkflowExecutionResolver struct {
+	execution store.WorkflowExecution
+}
+
+func NewWorkflowExecution(execution store.WorkflowExecution) *WorkflowExecutionResolver {
+	return &WorkflowExecutionResolver{execution: execution}
+}
+
+func NewWorkflowExecutions(executions []store.WorkflowExecution) []*WorkflowExecutionResolver {
+	var resolvers []*WorkflowExecutionResolver
+
+	for _, execution := range executions {
+		resolvers = append(resolvers, NewWorkflowExecution(execution))
+	}
+
+	return resolvers
+}
+
+func (r *WorkflowExecutionResolver) ID() graphql.ID {
+	return graphql.ID(r.execution.ExecutionID)
+}
+
+func (r *WorkflowExecutionResolver) WorkflowID() string {
+	return r.execution.WorkflowID
+}
+
+func (r *WorkflowExecutionResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.execution.Status)
+}
+
+func (r *WorkflowExecutionResolver) CreatedAt() *graphql.Time {
+	return optionalTime(r.execution.CreatedAt)
+}
+
+func (r *WorkflowExecutionResolver) UpdatedAt() *graphql.Time {
+	return optionalTime(r.execution.UpdatedAt)
+}
+
+func (r *WorkflowExecutionResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.execution.FinishedAt)
+}
+
+// Steps resolves the steps of the execution, ordered by the time they started.
+func (r *WorkflowExecutionResolver) Steps() []*WorkflowExecutionStepResolver {
+	resolvers := []*WorkflowExecutionStepResolver{}
+	for _, step := range r.execution.Timeline() {
+		resolvers = append(resolvers, &WorkflowExecutionStepResolver{execution: r.execution, step: *step})
+	}
+	return resolvers
+}
+
+// WorkflowExecutionStepResolver resolves the WorkflowExecutionStep type.
+type WorkflowExecutionStepResolver struct {
+	execution store.WorkflowExecution
+	step      store.WorkflowExecutionStep
+}
+
+func (r *WorkflowExecutionStepResolver) Ref() string {
+	return r.step.Ref
+}
+
+func (r *WorkflowExecutionStepResolver) Status() WorkflowExecutionStatus {
+	return ToWorkflowExecutionStatus(r.step.Status)
+}
+
+func (r *WorkflowExecutionStepResolver) CapabilityDONID() *int32 {
+	if r.step.CapabilityDONID == 0 {
+		return nil
+	}
+	id := int32(r.step.CapabilityDONID)
+	return &id
+}
+
+func (r *WorkflowExecutionStepResolver) Inputs() string {
+	if r.step.Inputs == nil {
+		return "null"
+	}
+	return valueJSON(r.step.Inputs)
+}
+
+func (r *WorkflowExecutionStepResolver) Outputs() string {
+	return valueJSON(r.step.Outputs.Value)
+}
+
+func (r *WorkflowExecutionStepResolver) Error() *string {
+	if r.step.Outputs.Err == nil {
+		return nil
+	}
+	msg := r.step.Outputs.Err.Error()
+	return &msg
+}
+
+func (r *WorkflowExecutionStepResolver) StartedAt() *graphql.Time {
+	return optionalTime(r.step.StartedAt)
+}
+
+func (r *WorkflowExecutionStepResolver) FinishedAt() *graphql.Time {
+	return optionalTime(r.step.FinishedAt())
+}
+
+// StartOffsetMs resolves the time from the creation of the execution to the start of the step.
+func (r *WorkflowExecutionStepResolver) StartOffsetMs() *int32 {
+	if r.execution.CreatedAt == nil || r.step.StartedAt == nil {
+		return nil
+	}
+	offset := int32(r.step.StartedAt.Sub(*r.execution.CreatedAt).Milliseconds())
+	return &offset
+}
+
+// LatencyMs resolves the time the step took to execute, which is unset while it is in flight.
+func (r *WorkflowExecutionStepResolver) LatencyMs() *int32 {
+	latency, ok := r.step.Latency()
+	if !ok {
+		return nil
+	}
+	ms := int32(latency.Milliseconds())
+	return &ms
+}
+
+func optionalTime(t *time.Time) *graphql.Time {
+	if t == nil {
+		return nil
+	}
+	return &graphql.Time{Time: *t}
+}
+
+func valueJSON(v values.Value) string {
+	unwrapped, err := values.Unwrap(v)
+	if err != nil {
+		return "error: unable to unwrap value"
+	}
+	b, err := json.Marshal(unwrapped)
+	if err != nil {
+		return "error: unable to marshal value"
+	}
+	return string(b)
+}
+
+// -- WorkflowExecution query --
+
+type WorkflowExecutionPayloadResolver struct {
+	execution *store.WorkflowExecution
+	NotFoundErrorUnionType
+}
+
+func NewWorkflowExecutionPayload(execution *store.WorkflowExecution, err error) *WorkflowExecutionPayloadResolver {
+	e := NotFoundErrorUnionType{err: err, message: "workflow execution not found", isExpectedErrorFn: func(err error) bool {
+		return errors.Is(err, store.ErrExecutionNotFound)
+	}}
+
+	return &WorkflowExecutionPayloadResolver{execution: execution, NotFoundErrorUnionType: e}
+}
+
+func (r *WorkflowExecutionPayloadResolver) ToWorkflowExecution() (*WorkflowExecutionResolver, bool) {
+	if r.err != nil {
+		return nil, false
+	}
+
+	return NewWorkflowExecution(*r.execution), true
+}
+
+// -- WorkflowExecutions query --
+
+// WorkflowExecutionsPayloadResolver resolves a page of workflow executions
+type WorkflowExecutionsPayloadResolver struct {
+	executions []store.WorkflowExecution
+	total      int32
+}
+
+func NewWorkflowExecutionsPayload(executions []store.WorkflowExecution, total int32) *WorkflowExecutionsPayloadResolver {
+	return &WorkflowExecutionsPayloadResolver{executions: executions, total: total}
+}
+
+// Results returns the workflow executions.
+func (r *WorkflowExecutionsPayloadResolver) Results() []*WorkflowExecutionResolver {
+	return NewWorkflowExecutions(r.executions)
+}
+
+// Metadata returns the pagination metadata.
+func (r *WorkflowExecutionsPayloadResolver) Metadata() *PaginationMetadataResolver {
+	return NewPaginationMetadata(r.total)
+}
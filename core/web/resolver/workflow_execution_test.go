@@ -0,0 +1,185 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	storemocks "github.com/smartcontractkit/chainlink/v2/core/services/workflows/store/mocks"
+)
+
+func TestQuery_PaginatedWorkflowExecutions(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecutions {
+			workflowExecutions(workflowID: "workflow-1", status: ERRORED, createdAfter: "2024-01-01T00:00:00Z") {
+				results {
+					id
+					workflowID
+					status
+				}
+				metadata {
+					total
+				}
+			}
+		}`
+
+	createdAfter := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	filter := store.ExecutionsFilter{WorkflowID: "workflow-1", Status: store.StatusErrored, CreatedAfter: &createdAfter}
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query}, "workflowExecutions"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				workflowORM := storemocks.NewStore(f.t)
+				workflowORM.On("ListExecutions", mock.Anything, filter, PageDefaultOffset, PageDefaultLimit).Return([]store.WorkflowExecution{
+					{ExecutionID: "execution-1", WorkflowID: "workflow-1", Status: store.StatusErrored},
+				}, 1, nil)
+				f.App.On("WorkflowORM").Return(workflowORM)
+			},
+			query: query,
+			result: `
+				{
+					"workflowExecutions": {
+						"results": [{
+							"id": "execution-1",
+							"workflowID": "workflow-1",
+							"status": "ERRORED"
+						}],
+						"metadata": {
+							"total": 1
+						}
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
+
+func TestResolver_WorkflowExecution(t *testing.T) {
+	t.Parallel()
+
+	query := `
+		query GetWorkflowExecution($id: ID!) {
+			workflowExecution(id: $id) {
+				... on WorkflowExecution {
+					id
+					status
+					steps {
+						ref
+						status
+						capabilityDONID
+						outputs
+						error
+						startOffsetMs
+						latencyMs
+					}
+				}
+				... on NotFoundError {
+					code
+					message
+				}
+			}
+		}`
+
+	variables := map[string]interface{}{
+		"id": "execution-1",
+	}
+
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(d time.Duration) *time.Time {
+		ts := createdAt.Add(d)
+		return &ts
+	}
+	outputs, err := values.NewMap(map[string]any{"price": "1.5"})
+	require.NoError(t, err)
+
+	testCases := []GQLTestCase{
+		unauthorizedTestCase(GQLTestCase{query: query, variables: variables}, "workflowExecution"),
+		{
+			name:          "success",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				workflowORM := storemocks.NewStore(f.t)
+				workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{
+					ExecutionID: "execution-1",
+					Status:      store.StatusStarted,
+					CreatedAt:   &createdAt,
+					Steps: map[string]*store.WorkflowExecutionStep{
+						"write": {Ref: "write", Status: store.StatusStarted, CapabilityDONID: 2, StartedAt: at(1500 * time.Millisecond), UpdatedAt: at(1500 * time.Millisecond)},
+						"action": {Ref: "action", Status: store.StatusErrored, CapabilityDONID: 1, Outputs: store.StepOutput{Err: errors.New("boom")},
+							StartedAt: at(time.Second), UpdatedAt: at(1200 * time.Millisecond)},
+						"trigger": {Ref: "trigger", Status: store.StatusCompleted, Outputs: store.StepOutput{Value: outputs}, StartedAt: at(0), UpdatedAt: at(0)},
+					},
+				}, nil)
+				f.App.On("WorkflowORM").Return(workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"id": "execution-1",
+						"status": "STARTED",
+						"steps": [{
+							"ref": "trigger",
+							"status": "COMPLETED",
+							"capabilityDONID": null,
+							"outputs": "{\"price\":\"1.5\"}",
+							"error": null,
+							"startOffsetMs": 0,
+							"latencyMs": 0
+						}, {
+							"ref": "action",
+							"status": "ERRORED",
+							"capabilityDONID": 1,
+							"outputs": "null",
+							"error": "boom",
+							"startOffsetMs": 1000,
+							"latencyMs": 200
+						}, {
+							"ref": "write",
+							"status": "STARTED",
+							"capabilityDONID": 2,
+							"outputs": "null",
+							"error": null,
+							"startOffsetMs": 1500,
+							"latencyMs": null
+						}]
+					}
+				}`,
+		},
+		{
+			name:          "not found error",
+			authenticated: true,
+			before: func(ctx context.Context, f *gqlTestFramework) {
+				workflowORM := storemocks.NewStore(f.t)
+				workflowORM.On("Get", mock.Anything, "execution-1").Return(store.WorkflowExecution{}, fmt.Errorf("could not find workflow execution with id execution-1: %w", store.ErrExecutionNotFound))
+				f.App.On("WorkflowORM").Return(workflowORM)
+			},
+			query:     query,
+			variables: variables,
+			result: `
+				{
+					"workflowExecution": {
+						"code": "NOT_FOUND",
+						"message": "workflow execution not found"
+					}
+				}`,
+		},
+	}
+
+	RunGQLTests(t, testCases)
+}
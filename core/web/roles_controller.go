@@ -0,0 +1,208 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgconn"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/logger/audit"
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/job"
+	clsession "github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// RolesController manages custom roles, and their assignments to users.
+type RolesController struct {
+	App chainlink.Application
+}
+
+// RoleRequest defines the request to create or update a custom role.
+type RoleRequest struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description"`
+	Permissions []rbac.Permission `json:"permissions"`
+}
+
+// RoleAssignmentRequest defines the request to assign a custom role to a user.
+type RoleAssignmentRequest struct {
+	Email string `json:"email"`
+}
+
+// Index lists the custom roles, with the users they are assigned to.
+// Example:
+// "GET <application>/roles"
+func (rc *RolesController) Index(c *gin.Context) {
+	ctx := c.Request.Context()
+	roles, err := rc.App.RoleORM().ListRoles(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	assignments, err := rc.App.RoleORM().ListAssignments(ctx)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewRoleResources(roles, assignments), "roles")
+}
+
+// Create creates a custom role.
+// Example:
+// "POST <application>/roles"
+func (rc *RolesController) Create(c *gin.Context) {
+	var request RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	role, err := newRole(request)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = rc.App.RoleORM().CreateRole(c.Request.Context(), &role); err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			jsonAPIError(c, http.StatusBadRequest, errors.Errorf("role %s already exists", role.Name))
+			return
+		}
+		rc.App.GetLogger().Errorw("Error creating role", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "error creating role"))
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleCreated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+	jsonAPIResponseWithStatus(c, presenters.NewRoleResource(role, nil), "role", http.StatusCreated)
+}
+
+// Update replaces the description and permissions of a custom role.
+// Example:
+// "PUT <application>/roles/:name"
+func (rc *RolesController) Update(c *gin.Context) {
+	var request RoleRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request.Name = c.Param("name")
+	role, err := newRole(request)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if err = rc.App.RoleORM().UpdateRole(c.Request.Context(), &role); err != nil {
+		if errors.Is(err, rbac.ErrRoleNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		rc.App.GetLogger().Errorw("Error updating role", "err", err)
+		jsonAPIError(c, http.StatusInternalServerError, errors.Wrap(err, "error updating role"))
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleUpdated, map[string]interface{}{
+		"name":        role.Name,
+		"permissions": role.Permissions,
+	})
+	jsonAPIResponse(c, presenters.NewRoleResource(role, nil), "role")
+}
+
+// Delete deletes a custom role. Users it was assigned to fall back to their built-in role.
+// Example:
+// "DELETE <application>/roles/:name"
+func (rc *RolesController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	if err := rc.App.RoleORM().DeleteRole(c.Request.Context(), name); err != nil {
+		if errors.Is(err, rbac.ErrRoleNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleDeleted, map[string]interface{}{"name": name})
+	jsonAPIResponseWithStatus(c, nil, "role", http.StatusNoContent)
+}
+
+// Assign assigns a custom role to a user, replacing any custom role assigned to them before.
+// Example:
+// "POST <application>/roles/:name/users"
+func (rc *RolesController) Assign(c *gin.Context) {
+	ctx := c.Request.Context()
+	var request RoleAssignmentRequest
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	if err := clsession.ValidateEmail(request.Email); err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	name := c.Param("name")
+	if err := rc.App.RoleORM().AssignRole(ctx, request.Email, name); err != nil {
+		if errors.Is(err, rbac.ErrRoleNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	role, err := rc.App.RoleORM().FindRole(ctx, name)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleAssigned, map[string]interface{}{"name": name, "email": request.Email})
+	jsonAPIResponse(c, presenters.NewRoleResource(role, []string{request.Email}), "role")
+}
+
+// Unassign removes a custom role from a user, who falls back to their built-in role.
+// Example:
+// "DELETE <application>/roles/:name/users/:email"
+func (rc *RolesController) Unassign(c *gin.Context) {
+	name, email := c.Param("name"), c.Param("email")
+	if err := rc.App.RoleORM().UnassignRole(c.Request.Context(), email, name); err != nil {
+		if errors.Is(err, rbac.ErrAssignmentNotFound) {
+			jsonAPIError(c, http.StatusNotFound, err)
+			return
+		}
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	rc.App.GetAuditLogger().Audit(audit.RoleUnassigned, map[string]interface{}{"name": name, "email": email})
+	jsonAPIResponseWithStatus(c, nil, "role", http.StatusNoContent)
+}
+
+// newRole validates the request, including the job types permissions are restricted to, which the rbac package does
+// not know of.
+func newRole(request RoleRequest) (rbac.Role, error) {
+	role := rbac.Role{
+		Name:        request.Name,
+		Description: request.Description,
+		Permissions: request.Permissions,
+	}
+	if err := rbac.ValidateRole(role); err != nil {
+		return rbac.Role{}, err
+	}
+	for _, p := range role.Permissions {
+		for _, t := range p.JobTypes {
+			if job.Type(t).SchemaVersion() == 0 {
+				return rbac.Role{}, errors.Errorf("unknown job type %q", t)
+			}
+		}
+	}
+	return role, nil
+}
@@ -0,0 +1,142 @@
+package web_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestRolesController_Create(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(testutils.Context(t)))
+
+	client := app.NewHTTPClient(nil)
+
+	testCases := []struct {
+		name           string
+		reqBody        string
+		wantStatusCode int
+		wantErrMessage string
+	}{
+		{
+			name:           "Invalid request",
+			reqBody:        "",
+			wantStatusCode: http.StatusUnprocessableEntity,
+		},
+		{
+			name:           "Reserved name",
+			reqBody:        `{"name": "admin", "permissions": [{"resource": "bridges", "actions": ["read"]}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "role name is reserved",
+		},
+		{
+			name:           "Unknown resource",
+			reqBody:        `{"name": "operator", "permissions": [{"resource": "nope", "actions": ["read"]}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: `unknown resource "nope"`,
+		},
+		{
+			name:           "Unknown job type",
+			reqBody:        `{"name": "operator", "permissions": [{"resource": "jobs", "actions": ["read"], "jobTypes": ["nope"]}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: `unknown job type "nope"`,
+		},
+		{
+			name:           "Success",
+			reqBody:        `{"name": "operator", "permissions": [{"resource": "jobs", "actions": ["read"], "jobTypes": ["offchainreporting2"]}]}`,
+			wantStatusCode: http.StatusCreated,
+		},
+		{
+			name:           "Duplicate",
+			reqBody:        `{"name": "operator", "permissions": [{"resource": "bridges", "actions": ["read"]}]}`,
+			wantStatusCode: http.StatusBadRequest,
+			wantErrMessage: "role operator already exists",
+		},
+	}
+
+	for _, tc := range testCases {
+		resp, cleanup := client.Post("/v2/roles", bytes.NewBufferString(tc.reqBody))
+		t.Cleanup(cleanup)
+		errors := cltest.ParseJSONAPIErrors(t, resp.Body)
+
+		require.Equal(t, tc.wantStatusCode, resp.StatusCode, tc.name)
+		if tc.wantErrMessage != "" {
+			require.Len(t, errors.Errors, 1, tc.name)
+			assert.Contains(t, errors.Errors[0].Detail, tc.wantErrMessage, tc.name)
+		}
+	}
+}
+
+func TestRolesController_AssignAndEnforce(t *testing.T) {
+	t.Parallel()
+	ctx := testutils.Context(t)
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+
+	admin := app.NewHTTPClient(nil)
+	operator := &cltest.User{Role: sessions.UserRoleView}
+	client := app.NewHTTPClient(operator)
+
+	resp, cleanup := admin.Post("/v2/roles", bytes.NewBufferString(
+		`{"name": "bridge-operator", "description": "Manages bridges", "permissions": [{"resource": "bridges", "actions": ["read", "create"]}]}`))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusCreated)
+
+	// As a view user, the operator can not create bridges.
+	bridge := `{"name": "randomnumber", "url": "https://example.com/randomNumber"}`
+	resp, cleanup = client.Post("/v2/bridge_types", bytes.NewBufferString(bridge))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusUnauthorized)
+
+	resp, cleanup = admin.Post("/v2/roles/bridge-operator/users", bytes.NewBufferString(fmt.Sprintf(`{"email": "%s"}`, operator.Email)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = admin.Get("/v2/roles")
+	t.Cleanup(cleanup)
+	var roles []presenters.RoleResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &roles))
+	require.Len(t, roles, 1)
+	assert.Equal(t, "bridge-operator", roles[0].Name)
+	assert.Equal(t, []string{operator.Email}, roles[0].Users)
+
+	resp, cleanup = client.Post("/v2/bridge_types", bytes.NewBufferString(bridge))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	// The custom role replaces the built-in role, so the operator can no longer read jobs.
+	resp, cleanup = client.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusForbidden)
+	assert.Equal(t, "jobs:read", resp.Header.Get("forbidden-required-permission"))
+	assert.Equal(t, "bridge-operator", resp.Header.Get("forbidden-provided-role"))
+
+	resp, cleanup = admin.Delete(fmt.Sprintf("/v2/roles/bridge-operator/users/%s", url.PathEscape(operator.Email)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = client.Get("/v2/jobs")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	resp, cleanup = admin.Delete("/v2/roles/bridge-operator")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNoContent)
+
+	resp, cleanup = admin.Delete("/v2/roles/bridge-operator")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusNotFound)
+}
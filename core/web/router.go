@@ -38,6 +38,7 @@ import (
 	"github.com/smartcontractkit/chainlink/v2/core/build"
 	"github.com/smartcontractkit/chainlink/v2/core/logger"
 	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/sessions/rbac"
 	"github.com/smartcontractkit/chainlink/v2/core/web/auth"
 	"github.com/smartcontractkit/chainlink/v2/core/web/loader"
 	"github.com/smartcontractkit/chainlink/v2/core/web/resolver"
@@ -94,7 +95,7 @@ func NewRouter(app chainlink.Application, prometheus *ginprom.Prometheus) (*gin.
 	guiAssetRoutes(engine, config.Insecure().DisableRateLimiting(), app.GetLogger())
 
 	api.POST("/query",
-		auth.AuthenticateGQL(app.AuthenticationProvider(), app.GetLogger().Named("GQLHandler")),
+		auth.AuthenticateGQL(app.AuthenticationProvider(), app.RoleORM(), app.GetLogger().Named("GQLHandler")),
 		loader.Middleware(app),
 		graphqlHandler(app),
 	)
@@ -211,6 +212,10 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	oc := NewOIDCController(app)
+	unauth.GET("/oidc/login", oc.Login)
+	unauth.GET("/oidc/callback", oc.Callback)
+	unauth.POST("/oidc/mfa", oc.MFA)
 	auth := r.Group("/", auth.Authenticate(app.AuthenticationProvider(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -237,111 +242,138 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	psec := PipelineJobSpecErrorsController{app}
 	unauthedv2.PATCH("/resume/:runID", prc.Resume)
 
+	authenticateByNamedToken := auth.AuthenticateByNamedToken(app.APITokenORM(), app.GetAuditLogger())
 	authv2 := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
+		authenticateByNamedToken,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.ResolveRole(app.RoleORM()))
 	{
 		uc := UserController{app}
-		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
-		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
-		authv2.PATCH("/users", auth.RequiresAdminRole(uc.UpdateRole))
-		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Delete))
+		authv2.GET("/users", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionRead, uc.Index))
+		authv2.POST("/users", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionCreate, uc.Create))
+		authv2.PATCH("/users", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionUpdate, uc.UpdateRole))
+		authv2.DELETE("/users/:email", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionDelete, uc.Delete))
 		authv2.PATCH("/user/password", uc.UpdatePassword)
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
 
+		atc := APITokensController{app}
+		authv2.GET("/user/tokens", atc.Index)
+		authv2.POST("/user/tokens", atc.Create)
+		authv2.DELETE("/user/tokens/:ID", atc.Revoke)
+		authv2.GET("/api_tokens", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionRead, atc.IndexAll))
+		authv2.DELETE("/api_tokens/:ID", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionUpdate, atc.RevokeAny))
+
+		alc := AuditLogsController{app}
+		authv2.GET("/audit_logs", auth.RequiresPermission(rbac.ResourceAuditLogs, rbac.ActionRead, paginatedRequest(alc.Index)))
+		authv2.GET("/audit_logs/verify", auth.RequiresPermission(rbac.ResourceAuditLogs, rbac.ActionRead, alc.Verify))
+
+		rlc := RolesController{app}
+		authv2.GET("/roles", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionRead, rlc.Index))
+		authv2.POST("/roles", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionCreate, rlc.Create))
+		authv2.PUT("/roles/:name", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionUpdate, rlc.Update))
+		authv2.DELETE("/roles/:name", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionDelete, rlc.Delete))
+		authv2.POST("/roles/:name/users", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionUpdate, rlc.Assign))
+		authv2.DELETE("/roles/:name/users/:email", auth.RequiresPermission(rbac.ResourceUsers, rbac.ActionUpdate, rlc.Unassign))
+
 		wa := NewWebAuthnController(app)
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
 		authv2.POST("/enroll_webauthn", wa.FinishRegistration)
 
 		eia := ExternalInitiatorsController{app}
-		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
-		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
+		authv2.GET("/external_initiators", auth.RequiresPermission(rbac.ResourceExternalInitiators, rbac.ActionRead, paginatedRequest(eia.Index)))
+		authv2.POST("/external_initiators", auth.RequiresPermission(rbac.ResourceExternalInitiators, rbac.ActionCreate, eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresPermission(rbac.ResourceExternalInitiators, rbac.ActionDelete, eia.Destroy))
 
 		bt := BridgeTypesController{app}
-		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
-		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
-		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
+		authv2.GET("/bridge_types", auth.RequiresPermission(rbac.ResourceBridges, rbac.ActionRead, paginatedRequest(bt.Index)))
+		authv2.POST("/bridge_types", auth.RequiresPermission(rbac.ResourceBridges, rbac.ActionCreate, bt.Create))
+		authv2.GET("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.ResourceBridges, rbac.ActionRead, bt.Show))
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.ResourceBridges, rbac.ActionUpdate, bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresPermission(rbac.ResourceBridges, rbac.ActionDelete, bt.Destroy))
 
 		ets := EVMTransfersController{app}
-		authv2.POST("/transfers", auth.RequiresAdminRole(ets.Create))
-		authv2.POST("/transfers/evm", auth.RequiresAdminRole(ets.Create))
+		authv2.POST("/transfers", auth.RequiresPermission(rbac.ResourceTransfers, rbac.ActionCreate, ets.Create))
+		authv2.POST("/transfers/evm", auth.RequiresPermission(rbac.ResourceTransfers, rbac.ActionCreate, ets.Create))
 		tts := CosmosTransfersController{app}
-		authv2.POST("/transfers/cosmos", auth.RequiresAdminRole(tts.Create))
+		authv2.POST("/transfers/cosmos", auth.RequiresPermission(rbac.ResourceTransfers, rbac.ActionCreate, tts.Create))
 		sts := SolanaTransfersController{app}
-		authv2.POST("/transfers/solana", auth.RequiresAdminRole(sts.Create))
+		authv2.POST("/transfers/solana", auth.RequiresPermission(rbac.ResourceTransfers, rbac.ActionCreate, sts.Create))
 
 		cc := ConfigController{app}
-		authv2.GET("/config", cc.Show)
-		authv2.GET("/config/v2", cc.Show)
+		authv2.GET("/config", auth.RequiresPermission(rbac.ResourceConfig, rbac.ActionRead, cc.Show))
+		authv2.GET("/config/v2", auth.RequiresPermission(rbac.ResourceConfig, rbac.ActionRead, cc.Show))
 
 		tas := TxAttemptsController{app}
-		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
-		authv2.GET("/tx_attempts/evm", paginatedRequest(tas.Index))
+		authv2.GET("/tx_attempts", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, paginatedRequest(tas.Index)))
+		authv2.GET("/tx_attempts/evm", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, paginatedRequest(tas.Index)))
 
 		txs := TransactionsController{app}
-		authv2.GET("/transactions/evm", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/evm/:TxHash", txs.Show)
-		authv2.GET("/transactions", paginatedRequest(txs.Index))
-		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.GET("/transactions/evm", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/evm/:TxHash", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, txs.Show))
+		authv2.POST("/transactions/evm/:TxHash/cancel", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionUpdate, txs.Cancel))
+		authv2.GET("/transactions", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, paginatedRequest(txs.Index)))
+		authv2.GET("/transactions/:TxHash", auth.RequiresPermission(rbac.ResourceTransactions, rbac.ActionRead, txs.Show))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
+		authv2.POST("/replay_from_block/:number", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRun, rc.ReplayFromBlock))
+		authv2.GET("/filter_replays/:name", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, rc.ShowFilterReplay))
 		lcaC := LCAController{app}
-		authv2.GET("/find_lca", auth.RequiresRunRole(lcaC.FindLCA))
+		authv2.GET("/find_lca", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRun, lcaC.FindLCA))
+
+		ksc := KeystoreController{app}
+		authv2.PATCH("/keys/password", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionUpdate, ksc.ChangePassword))
 
 		csakc := CSAKeysController{app}
-		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", auth.RequiresEditRole(csakc.Create))
-		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
-		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(csakc.Export))
+		authv2.GET("/keys/csa", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, csakc.Index))
+		authv2.POST("/keys/csa", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, csakc.Create))
+		authv2.POST("/keys/csa/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, csakc.Export))
 
 		ekc := NewETHKeysController(app)
-		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", auth.RequiresEditRole(ekc.Create))
-		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
-		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(ekc.Export))
+		authv2.GET("/keys/eth", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, ekc.Index))
+		authv2.POST("/keys/eth", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, ekc.Create))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, ekc.Export))
 		// duplicated from above, with `evm` instead of `eth`
 		// legacy ones remain for backwards compatibility
 
 		ethKeysGroup := authv2.Group("", auth.Authenticate(app.AuthenticationProvider(),
+			authenticateByNamedToken,
 			auth.AuthenticateByToken,
 			auth.AuthenticateBySession,
 		))
 
 		ethKeysGroup.Use(ekc.formatETHKeyResponse())
-		authv2.GET("/keys/evm", ekc.Index)
-		ethKeysGroup.POST("/keys/evm", auth.RequiresEditRole(ekc.Create))
-		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresAdminRole(ekc.Delete))
-		ethKeysGroup.POST("/keys/evm/import", auth.RequiresAdminRole(ekc.Import))
-		authv2.POST("/keys/evm/export/:address", auth.RequiresAdminRole(ekc.Export))
-		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresAdminRole(ekc.Chain))
+		authv2.GET("/keys/evm", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, ekc.Index))
+		ethKeysGroup.POST("/keys/evm", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, ekc.Create))
+		ethKeysGroup.DELETE("/keys/evm/:address", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, ekc.Delete))
+		ethKeysGroup.POST("/keys/evm/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, ekc.Import))
+		authv2.POST("/keys/evm/export/:address", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, ekc.Export))
+		ethKeysGroup.POST("/keys/evm/chain", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionUpdate, ekc.Chain))
 
 		ocrkc := OCRKeysController{app}
-		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", auth.RequiresEditRole(ocrkc.Create))
-		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
-		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
-		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(ocrkc.Export))
+		authv2.GET("/keys/ocr", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, ocrkc.Index))
+		authv2.POST("/keys/ocr", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, ocrkc.Export))
 
 		ocr2kc := OCR2KeysController{app}
-		authv2.GET("/keys/ocr2", ocr2kc.Index)
-		authv2.POST("/keys/ocr2/:chainType", auth.RequiresEditRole(ocr2kc.Create))
-		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresAdminRole(ocr2kc.Delete))
-		authv2.POST("/keys/ocr2/import", auth.RequiresAdminRole(ocr2kc.Import))
-		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresAdminRole(ocr2kc.Export))
+		authv2.GET("/keys/ocr2", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, ocr2kc.Index))
+		authv2.POST("/keys/ocr2/:chainType", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, ocr2kc.Create))
+		authv2.DELETE("/keys/ocr2/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, ocr2kc.Delete))
+		authv2.POST("/keys/ocr2/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, ocr2kc.Import))
+		authv2.POST("/keys/ocr2/export/:ID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, ocr2kc.Export))
 
 		p2pkc := P2PKeysController{app}
-		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", auth.RequiresEditRole(p2pkc.Create))
-		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
-		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
-		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(p2pkc.Export))
+		authv2.GET("/keys/p2p", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, p2pkc.Index))
+		authv2.POST("/keys/p2p", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, p2pkc.Export))
 
 		for _, keys := range []struct {
 			path string
@@ -352,42 +384,47 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetKeysController(app)},
 			{"aptos", NewAptosKeysController(app)},
 		} {
-			authv2.GET("/keys/"+keys.path, keys.kc.Index)
-			authv2.POST("/keys/"+keys.path, auth.RequiresEditRole(keys.kc.Create))
-			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresAdminRole(keys.kc.Delete))
-			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresAdminRole(keys.kc.Import))
-			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresAdminRole(keys.kc.Export))
+			authv2.GET("/keys/"+keys.path, auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, keys.kc.Index))
+			authv2.POST("/keys/"+keys.path, auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, keys.kc.Create))
+			authv2.DELETE("/keys/"+keys.path+"/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, keys.kc.Delete))
+			authv2.POST("/keys/"+keys.path+"/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, keys.kc.Import))
+			authv2.POST("/keys/"+keys.path+"/export/:ID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, keys.kc.Export))
 		}
 
 		vrfkc := VRFKeysController{app}
-		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", auth.RequiresEditRole(vrfkc.Create))
-		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
-		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
-		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(vrfkc.Export))
+		authv2.GET("/keys/vrf", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionRead, vrfkc.Index))
+		authv2.POST("/keys/vrf", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionCreate, vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionDelete, vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionImport, vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresPermission(rbac.ResourceKeys, rbac.ActionExport, vrfkc.Export))
 
 		jc := JobsController{app}
-		authv2.GET("/jobs", paginatedRequest(jc.Index))
-		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
-		authv2.PUT("/jobs/:ID", auth.RequiresEditRole(jc.Update))
-		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.GET("/jobs", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRead, paginatedRequest(jc.Index)))
+		authv2.GET("/jobs/:ID", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRead, jc.Show))
+		authv2.POST("/jobs", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionCreate, jc.Create))
+		authv2.POST("/jobs/dry_run", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionCreate, jc.DryRun))
+		authv2.PUT("/jobs/:ID", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionUpdate, jc.Update))
+		authv2.DELETE("/jobs/:ID", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionDelete, jc.Delete))
 
 		// PipelineRunsController
-		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs", paginatedRequest(prc.Index))
-		authv2.GET("/jobs/:ID/runs/:runID", prc.Show)
+		authv2.GET("/pipeline/runs", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRead, paginatedRequest(prc.Index)))
+		authv2.GET("/jobs/:ID/runs/:runID", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRead, prc.Show))
+
+		wec := WorkflowExecutionsController{app}
+		authv2.GET("/workflows/executions", auth.RequiresPermission(rbac.ResourceWorkflows, rbac.ActionRead, paginatedRequest(wec.Index)))
+		authv2.GET("/workflows/executions/:ID", auth.RequiresPermission(rbac.ResourceWorkflows, rbac.ActionRead, wec.Show))
 
 		// FeaturesController
 		fc := FeaturesController{app}
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionUpdate, psec.Destroy))
 
 		lgc := LogController{app}
-		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+		authv2.GET("/log", auth.RequiresPermission(rbac.ResourceLogs, rbac.ActionRead, lgc.Get))
+		authv2.PATCH("/log", auth.RequiresPermission(rbac.ResourceLogs, rbac.ActionUpdate, lgc.Patch))
 
 		chains := authv2.Group("chains")
 		for _, chain := range []struct {
@@ -399,8 +436,8 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 			{"starknet", NewStarkNetChainsController(app)},
 			{"cosmos", NewCosmosChainsController(app)},
 		} {
-			chains.GET(chain.path, paginatedRequest(chain.cc.Index))
-			chains.GET(chain.path+"/:ID", chain.cc.Show)
+			chains.GET(chain.path, auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, paginatedRequest(chain.cc.Index)))
+			chains.GET(chain.path+"/:ID", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, chain.cc.Show))
 		}
 
 		nodes := authv2.Group("nodes")
@@ -415,16 +452,16 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		} {
 			if chain.path == "evm" {
 				// TODO still EVM only . Archive ticket: story/26276/multi-chain-type-ui-node-chain-configuration
-				nodes.GET("", paginatedRequest(chain.nc.Index))
+				nodes.GET("", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, paginatedRequest(chain.nc.Index)))
 			}
-			nodes.GET(chain.path, paginatedRequest(chain.nc.Index))
-			chains.GET(chain.path+"/:ID/nodes", paginatedRequest(chain.nc.Index))
+			nodes.GET(chain.path, auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, paginatedRequest(chain.nc.Index)))
+			chains.GET(chain.path+"/:ID/nodes", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, paginatedRequest(chain.nc.Index)))
 		}
 
 		efc := EVMForwardersController{app}
-		authv2.GET("/nodes/evm/forwarders", paginatedRequest(efc.Index))
-		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresEditRole(efc.Track))
-		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresEditRole(efc.Delete))
+		authv2.GET("/nodes/evm/forwarders", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionRead, paginatedRequest(efc.Index)))
+		authv2.POST("/nodes/evm/forwarders/track", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionCreate, efc.Track))
+		authv2.DELETE("/nodes/evm/forwarders/:fwdID", auth.RequiresPermission(rbac.ResourceChains, rbac.ActionDelete, efc.Delete))
 
 		buildInfo := BuildInfoController{app}
 		authv2.GET("/build_info", buildInfo.Show)
@@ -436,11 +473,12 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	ping := PingController{app}
 	userOrEI := r.Group("/v2", auth.Authenticate(app.AuthenticationProvider(),
 		auth.AuthenticateExternalInitiator,
+		authenticateByNamedToken,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auth.ResolveRole(app.RoleORM()))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresPermission(rbac.ResourceJobs, rbac.ActionRun, prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/v2/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+// WorkflowExecutionsController browses the history of workflow executions.
+type WorkflowExecutionsController struct {
+	App chainlink.Application
+}
+
+// Index lists workflow executions, most recent first. They can be filtered by
+// workflow ID, status, and creation time in RFC3339 format.
+// Example:
+// "GET <application>/workflows/executions?workflowID=<id>&status=errored&createdAfter=2024-01-01T00:00:00Z"
+func (wec *WorkflowExecutionsController) Index(c *gin.Context, size, page, offset int) {
+	filter, err := parseExecutionsFilter(c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	executions, count, err := wec.App.WorkflowORM().ListExecutions(c.Request.Context(), filter, offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	paginatedResponse(c, "workflowExecutions", size, page, presenters.NewWorkflowExecutionResources(executions), count, err)
+}
+
+// Show returns a workflow execution with the inputs, outputs, errors and timing of its steps.
+// Example:
+// "GET <application>/workflows/executions/:ID"
+func (wec *WorkflowExecutionsController) Show(c *gin.Context) {
+	execution, err := wec.App.WorkflowORM().Get(c.Request.Context(), c.Param("ID"))
+	if errors.Is(err, store.ErrExecutionNotFound) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("workflow execution not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewWorkflowExecutionResource(execution), "workflowExecution")
+}
+
+func parseExecutionsFilter(c *gin.Context) (store.ExecutionsFilter, error) {
+	filter := store.ExecutionsFilter{
+		WorkflowID: c.Query("workflowID"),
+		Status:     c.Query("status"),
+	}
+	if filter.Status != "" && !store.ValidStatuses[filter.Status] {
+		return filter, fmt.Errorf("invalid status: %s", filter.Status)
+	}
+	for param, t := range map[string]**time.Time{
+		"createdAfter":  &filter.CreatedAfter,
+		"createdBefore": &filter.CreatedBefore,
+	} {
+		if v := c.Query(param); v != "" {
+			parsed, err := time.Parse(time.RFC3339, v)
+			if err != nil {
+				return filter, fmt.Errorf("invalid %s: %w", param, err)
+			}
+			*t = &parsed
+		}
+	}
+	return filter, nil
+}
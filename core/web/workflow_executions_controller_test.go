@@ -0,0 +1,99 @@
+package web_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink-common/pkg/values"
+
+	"github.com/smartcontractkit/chainlink/v2/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/v2/core/internal/testutils"
+	"github.com/smartcontractkit/chainlink/v2/core/services/workflows/store"
+	"github.com/smartcontractkit/chainlink/v2/core/web"
+	"github.com/smartcontractkit/chainlink/v2/core/web/presenters"
+)
+
+func TestWorkflowExecutionsController(t *testing.T) {
+	ctx := testutils.Context(t)
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start(ctx))
+	client := app.NewHTTPClient(nil)
+
+	outputs, err := values.NewMap(map[string]any{"price": "1.5"})
+	require.NoError(t, err)
+	for _, es := range []store.WorkflowExecution{
+		{ExecutionID: "completed-execution", Status: store.StatusCompleted},
+		{ExecutionID: "errored-execution", Status: store.StatusErrored},
+	} {
+		es.Steps = map[string]*store.WorkflowExecutionStep{
+			"trigger": {ExecutionID: es.ExecutionID, Ref: "trigger", Status: store.StatusCompleted, Outputs: store.StepOutput{Value: outputs}},
+		}
+		_, err = app.WorkflowORM().Add(ctx, &es)
+		require.NoError(t, err)
+	}
+	_, err = app.WorkflowORM().UpsertStep(ctx, &store.WorkflowExecutionStep{
+		ExecutionID:     "errored-execution",
+		Ref:             "write",
+		Status:          store.StatusStarted,
+		CapabilityDONID: 3,
+	})
+	require.NoError(t, err)
+
+	t.Run("lists executions", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var resources []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 2)
+		assert.Empty(t, resources[0].Steps)
+	})
+
+	t.Run("filters executions by status", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=errored")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var resources []presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resources))
+		require.Len(t, resources, 1)
+		assert.Equal(t, "errored-execution", resources[0].ID)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions?status=unknown")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+
+		resp, cleanup = client.Get("/v2/workflows/executions?createdAfter=yesterday")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusUnprocessableEntity, resp.StatusCode)
+	})
+
+	t.Run("shows the timeline of an execution", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/errored-execution")
+		t.Cleanup(cleanup)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		var resource presenters.WorkflowExecutionResource
+		require.NoError(t, web.ParseJSONAPIResponse(cltest.ParseResponseBody(t, resp), &resource))
+		assert.Equal(t, store.StatusErrored, resource.Status)
+		require.Len(t, resource.Steps, 2)
+		assert.Equal(t, "trigger", resource.Steps[0].Ref)
+		assert.Equal(t, map[string]any{"price": "1.5"}, resource.Steps[0].Outputs)
+		assert.NotNil(t, resource.Steps[0].LatencyMs)
+		assert.Equal(t, "write", resource.Steps[1].Ref)
+		assert.Equal(t, uint32(3), resource.Steps[1].CapabilityDONID)
+		assert.Nil(t, resource.Steps[1].LatencyMs, "in flight steps have no latency")
+	})
+
+	t.Run("fails to show an unknown execution", func(t *testing.T) {
+		resp, cleanup := client.Get("/v2/workflows/executions/unknown")
+		t.Cleanup(cleanup)
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+}